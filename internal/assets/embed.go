@@ -19,6 +19,7 @@ import (
 //go:embed integrations/copilot-cli/scripts/*.ps1
 //go:embed integrations/copilot-cli/skills/*/SKILL.md
 //go:embed hooks/messages/*/*.txt hooks/messages/registry.yaml hooks/trace/*.sh
+//go:embed hooks/drift/*.sh
 //go:embed schema/*.json why/*.md
 //go:embed permissions/*.txt commands/*.yaml commands/text/*.yaml journal/*.css
 var FS embed.FS
@@ -354,6 +354,7 @@ func TestSchemaCoversCtxRC(t *testing.T) {
 		BillingTokenWarn    int    `yaml:"billing_token_warn"`
 		EventLog            bool   `yaml:"event_log"`
 		KeyRotationDays     int    `yaml:"key_rotation_days"`
+		KeyGraceDays        int    `yaml:"key_grace_days"`
 		TaskNudgeInterval   int    `yaml:"task_nudge_interval"`
 		KeyPathOverride     string `yaml:"key_path"`
 		StaleAgeDays        int    `yaml:"stale_age_days"`
@@ -368,6 +369,14 @@ func TestSchemaCoversCtxRC(t *testing.T) {
 		Steering            *int   `yaml:"steering"`
 		Hooks               *int   `yaml:"hooks"`
 		ProvenanceRequired  *int   `yaml:"provenance_required"`
+		Scoring             *int   `yaml:"scoring"`
+		Inherit             []int  `yaml:"inherit"`
+		Drift               *int   `yaml:"drift"`
+		Secrets             *int   `yaml:"secrets"`
+		Redact              string `yaml:"redact"`
+		Redaction           *int   `yaml:"redaction"`
+		Prices              *int   `yaml:"prices"`
+		Trace               *int   `yaml:"trace"`
 	}
 	yamlBytes, marshalErr := yaml.Marshal(ctxRC{})
 	if marshalErr != nil {
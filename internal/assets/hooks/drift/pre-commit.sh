@@ -0,0 +1,16 @@
+#!/bin/sh
+# ctx: pre-commit hook enforcing CONSTITUTION.md rules.
+# Installed by: ctx drift hook enable
+# Remove with:  ctx drift hook disable
+# Requires:     ctx on $PATH
+
+# Skip silently when ctx is not installed.
+command -v ctx >/dev/null 2>&1 || exit 0
+
+# Context directory recorded by ctx drift hook enable. Skip
+# silently when it no longer exists.
+CTX_DIR=%s
+[ -d "$CTX_DIR" ] || exit 0
+export CTX_DIR
+
+exec ctx drift --staged
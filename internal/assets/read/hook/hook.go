@@ -29,6 +29,23 @@ func TraceScript(filename string) (string, error) {
 	return string(data), nil
 }
 
+// DriftScript reads an embedded drift git hook script by
+// filename.
+//
+// Parameters:
+//   - filename: Script filename (e.g., "pre-commit.sh")
+//
+// Returns:
+//   - string: Script content
+//   - error: Non-nil if the file is not found or read fails
+func DriftScript(filename string) (string, error) {
+	data, err := assets.FS.ReadFile(path.Join(asset.DirHooksDrift, filename))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // Message reads a hook message template by hook name and filename.
 //
 // Parameters:
@@ -35,6 +35,9 @@
 //   - **`tpl_recall.go`**: the format the legacy
 //     `ctx recall` command used; kept here while the
 //     journal-merge transition completes.
+//   - **`tpl_task.go`**: the Graphviz DOT and Mermaid
+//     renderings of the task dependency graph
+//     (`ctx task graph --format dot|mermaid`).
 //   - **`tpl_trigger.go`**: the empty trigger script
 //     scaffold installed by `ctx trigger add`.
 //
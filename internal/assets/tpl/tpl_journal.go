@@ -220,3 +220,50 @@ combine_header_slug = true
 	// Must appear under [project] (after nav, before [project.theme]).
 	ZensicalExtraCSS = `extra_css = ["stylesheets/extra.css"]`
 )
+
+// Journal stats templates for the terminal table and the
+// journal site page.
+const (
+	// JournalStatsCellLeft left-aligns a table cell.
+	// Args: width, value.
+	JournalStatsCellLeft = "%-*s"
+	// JournalStatsCellRight right-aligns a table cell.
+	// Args: width, value.
+	JournalStatsCellRight = "%*s"
+	// JournalStatsCellSep separates terminal table cells.
+	JournalStatsCellSep = "  "
+
+	// JournalStatsMdRow wraps joined cells as a Markdown table row.
+	// Args: cells joined with JournalStatsMdSep.
+	JournalStatsMdRow = "| %s |"
+	// JournalStatsMdSep separates Markdown table cells.
+	JournalStatsMdSep = " | "
+	// JournalStatsMdAlignLeft is the delimiter cell of a
+	// left-aligned Markdown column.
+	JournalStatsMdAlignLeft = ":---"
+	// JournalStatsMdAlignRight is the delimiter cell of a
+	// right-aligned Markdown column.
+	JournalStatsMdAlignRight = "---:"
+	// JournalStatsMdTool formats a most-used tools row.
+	// Args: tool name, count.
+	JournalStatsMdTool = "| %s | %d |"
+
+	// JournalStatsPage is the journal site analytics page.
+	// Args: weekly table, model table, tools table.
+	JournalStatsPage = `# Session Analytics
+
+Token spend is estimated from the ` + "`prices:`" + ` table in ` +
+		"`.ctxrc`" + `; a dash means no price is configured for the model.
+
+## By Week
+
+%s
+## By Model
+
+%s
+## Most-Used Tools
+
+| Tool | Uses |
+|:---|---:|
+%s`
+)
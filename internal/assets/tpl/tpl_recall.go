@@ -101,6 +101,18 @@ const (
 	// Args: key, value.
 	FmInt = "%s: %d"
 
+	// FmBool formats a YAML frontmatter boolean field.
+	// Args: key, value.
+	FmBool = "%s: %t"
+
+	// FmListKey opens a YAML frontmatter list field.
+	// Args: key.
+	FmListKey = "%s:"
+
+	// FmRedaction formats one item of the redactions list.
+	// Args: placeholder, kind, count.
+	FmRedaction = "  - placeholder: %q\n    kind: %q\n    count: %d"
+
 	// ToolDisplay formats a tool name with its key parameter.
 	// Args: tool name, parameter value.
 	ToolDisplay = "%s: %s"
@@ -0,0 +1,52 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package tpl
+
+// Task dependency graph templates for ctx task graph.
+const (
+	// TaskGraphDOTHeader opens a Graphviz digraph.
+	TaskGraphDOTHeader = "digraph tasks {\n" +
+		"  rankdir=LR;\n" +
+		"  node [shape=box];\n"
+
+	// TaskGraphDOTNode declares a pending task node.
+	// Args: node key, quoted label.
+	TaskGraphDOTNode = "  %s [label=%q];\n"
+
+	// TaskGraphDOTNodeDone declares a completed task node.
+	// Args: node key, quoted label.
+	TaskGraphDOTNodeDone = "  %s [label=%q, style=dashed];\n"
+
+	// TaskGraphDOTEdge draws "dependency before dependent".
+	// Args: dependency key, dependent key.
+	TaskGraphDOTEdge = "  %s -> %s;\n"
+
+	// TaskGraphDOTFooter closes the digraph.
+	TaskGraphDOTFooter = "}\n"
+
+	// TaskGraphMermaidHeader opens a Mermaid flowchart.
+	TaskGraphMermaidHeader = "flowchart LR\n"
+
+	// TaskGraphMermaidNode declares a pending task node.
+	// Args: node key, label with quotes escaped.
+	TaskGraphMermaidNode = "  %s[\"%s\"]\n"
+
+	// TaskGraphMermaidNodeDone declares a completed task node.
+	// Args: node key, label with quotes escaped.
+	TaskGraphMermaidNodeDone = "  %s[\"%s\"]:::done\n"
+
+	// TaskGraphMermaidEdge draws "dependency before dependent".
+	// Args: dependency key, dependent key.
+	TaskGraphMermaidEdge = "  %s --> %s\n"
+
+	// TaskGraphMermaidFooter styles completed nodes.
+	TaskGraphMermaidFooter = "  classDef done stroke-dasharray: 5 5\n"
+
+	// TaskGraphLabel joins a task ID and its text in a node label.
+	// Args: ID, task text.
+	TaskGraphLabel = "%s: %s"
+)
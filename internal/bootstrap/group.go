@@ -24,6 +24,7 @@ import (
 	cliHub "github.com/ActiveMemory/ctx/internal/cli/hub"
 	"github.com/ActiveMemory/ctx/internal/cli/initialize"
 	"github.com/ActiveMemory/ctx/internal/cli/journal"
+	"github.com/ActiveMemory/ctx/internal/cli/key"
 	"github.com/ActiveMemory/ctx/internal/cli/learning"
 	"github.com/ActiveMemory/ctx/internal/cli/load"
 	"github.com/ActiveMemory/ctx/internal/cli/loop"
@@ -38,6 +39,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/setup"
 	"github.com/ActiveMemory/ctx/internal/cli/site"
 	"github.com/ActiveMemory/ctx/internal/cli/skill"
+	"github.com/ActiveMemory/ctx/internal/cli/snapshot"
 	"github.com/ActiveMemory/ctx/internal/cli/status"
 	"github.com/ActiveMemory/ctx/internal/cli/steering"
 	"github.com/ActiveMemory/ctx/internal/cli/sync"
@@ -77,7 +79,7 @@ func gettingStarted() []registration {
 //
 // Returns:
 //   - []registration: Load, agent, skill, sync, drift, compact,
-//     and fmt commands
+//     fmt, and snapshot commands
 func contextCmds() []registration {
 	return []registration{
 		{load.Cmd, embedCmd.GroupContext},
@@ -87,6 +89,7 @@ func contextCmds() []registration {
 		{drift.Cmd, embedCmd.GroupContext},
 		{compact.Cmd, embedCmd.GroupContext},
 		{ctxFmt.Cmd, embedCmd.GroupContext},
+		{snapshot.Cmd, embedCmd.GroupContext},
 	}
 }
 
@@ -128,11 +131,13 @@ func sessions() []registration {
 // runtime configuration group.
 //
 // Returns:
-//   - []registration: Config, permission, hook, and prune commands
+//   - []registration: Config, permission, key, hook, and prune
+//     commands
 func runtimeCmds() []registration {
 	return []registration{
 		{config.Cmd, embedCmd.GroupRuntime},
 		{permission.Cmd, embedCmd.GroupRuntime},
+		{key.Cmd, embedCmd.GroupRuntime},
 		{hook.Cmd, embedCmd.GroupRuntime},
 		{prune.Cmd, embedCmd.GroupRuntime},
 	}
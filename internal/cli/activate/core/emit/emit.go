@@ -13,6 +13,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/config/env"
 	cfgShell "github.com/ActiveMemory/ctx/internal/config/shell"
+	"github.com/ActiveMemory/ctx/internal/format"
 )
 
 // emitters maps supported shell identifiers to their set-emitter.
@@ -70,7 +71,7 @@ func Set(shell, path string) string {
 	if !ok {
 		fn = posixSet
 	}
-	return fn(env.CtxDir, shellQuote(path))
+	return fn(env.CtxDir, format.ShellQuote(path))
 }
 
 // Unset returns the shell command that clears CTX_DIR for the
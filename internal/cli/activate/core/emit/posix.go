@@ -8,7 +8,6 @@ package emit
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/config/shell"
 )
@@ -18,7 +17,7 @@ import (
 //
 // Parameters:
 //   - key:         environment variable name (already well-formed).
-//   - quotedValue: value wrapped by shellQuote.
+//   - quotedValue: value wrapped by [format.ShellQuote].
 //
 // Returns:
 //   - string: one-line export statement with trailing newline.
@@ -39,19 +38,3 @@ func posixSet(key, quotedValue string) string {
 func posixUnset(key, _ string) string {
 	return fmt.Sprintf(shell.FormatPOSIXUnset, key)
 }
-
-// shellQuote wraps s in single quotes, escaping any embedded single
-// quote as close-escape-reopen (`'` followed by `\'` followed by `'`).
-// The resulting string is safe to paste into any POSIX-compatible
-// shell regardless of s's contents.
-//
-// Parameters:
-//   - s: raw value (typically a filesystem path).
-//
-// Returns:
-//   - string: single-quoted, escape-safe shell literal.
-func shellQuote(s string) string {
-	return shell.SingleQuote +
-		strings.ReplaceAll(s, shell.SingleQuote, shell.SingleQuoteEscaped) +
-		shell.SingleQuote
-}
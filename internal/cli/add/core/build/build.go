@@ -44,6 +44,7 @@ func Cmd(noun, descKey, useStr string) *cobra.Command {
 		lesson      string
 		application string
 		share       bool
+		redact      bool
 	)
 
 	short, long := desc.Command(descKey)
@@ -68,6 +69,7 @@ func Cmd(noun, descKey, useStr string) *cobra.Command {
 				Lesson:      lesson,
 				Application: application,
 				Share:       share,
+				Redact:      redact,
 			})
 		},
 	}
@@ -112,6 +114,10 @@ func Cmd(noun, descKey, useStr string) *cobra.Command {
 		c, &share,
 		cFlag.Share, flag.DescKeyAddShare,
 	)
+	flagbind.BoolFlag(
+		c, &redact,
+		cFlag.Redact, flag.DescKeyAddRedact,
+	)
 
 	_ = c.RegisterFlagCompletionFunc(
 		cFlag.Priority, func(
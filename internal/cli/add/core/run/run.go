@@ -15,6 +15,7 @@ import (
 	coreEntry "github.com/ActiveMemory/ctx/internal/cli/add/core/entry"
 	"github.com/ActiveMemory/ctx/internal/cli/add/core/example"
 	"github.com/ActiveMemory/ctx/internal/cli/add/core/extract"
+	"github.com/ActiveMemory/ctx/internal/cli/add/core/scrub"
 	corePub "github.com/ActiveMemory/ctx/internal/cli/connection/core/publish"
 	"github.com/ActiveMemory/ctx/internal/cli/system/core/state"
 	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
@@ -72,6 +73,15 @@ func Run(cmd *cobra.Command, args []string, flags entity.AddConfig) error {
 		Application: flags.Application,
 	}
 
+	redacted, scrubErr := scrub.Params(&params, flags.Redact)
+	if scrubErr != nil {
+		cmd.SilenceUsage = true
+		return scrubErr
+	}
+	if redacted > 0 {
+		writeAdd.Redacted(cmd, redacted)
+	}
+
 	if validateErr := entry.Validate(
 		params, example.ForType,
 	); validateErr != nil {
@@ -97,7 +107,7 @@ func Run(cmd *cobra.Command, args []string, flags entity.AddConfig) error {
 	if flags.Share {
 		pubEntry := hub.PublishEntry{
 			Type:    fType,
-			Content: content,
+			Content: params.Content,
 			Origin:  filepath.Base(stateDir),
 		}
 		if pubErr := corePub.Run(
@@ -107,7 +117,7 @@ func Run(cmd *cobra.Command, args []string, flags entity.AddConfig) error {
 		}
 	}
 
-	if fType == cfgEntry.Task && coreEntry.NeedsSpec(content) {
+	if fType == cfgEntry.Task && coreEntry.NeedsSpec(params.Content) {
 		writeAdd.SpecNudge(cmd)
 	}
 
@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package scrub guards the add command against writing
+// credentials into context files.
+//
+// [Params] scans the entry content and every free-text
+// field (context, rationale, consequence, lesson,
+// application) with the secret scanner. Without redaction
+// the first finding becomes an error that names the rule
+// and a masked preview; with redaction each match is
+// replaced by a [REDACTED:<rule>] placeholder in place and
+// the total count is returned so the caller can report it.
+package scrub
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package scrub
+
+import (
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errAdd "github.com/ActiveMemory/ctx/internal/err/add"
+	"github.com/ActiveMemory/ctx/internal/secret"
+)
+
+// Params checks entry text fields for possible secrets.
+//
+// Parameters:
+//   - params: Entry parameters; fields are rewritten in place when
+//     redact is true
+//   - redact: Replace findings with placeholders instead of failing
+//
+// Returns:
+//   - int: Number of values redacted
+//   - error: Non-nil when a secret is found and redact is false
+func Params(params *entity.EntryParams, redact bool) (int, error) {
+	fields := []*string{
+		&params.Content, &params.Context, &params.Rationale,
+		&params.Consequence, &params.Lesson, &params.Application,
+	}
+
+	redacted := 0
+	for _, field := range fields {
+		if !redact {
+			if found := secret.Scan(*field); len(found) > 0 {
+				return 0, errAdd.SecretFound(
+					found[0].Rule, found[0].Preview,
+				)
+			}
+			continue
+		}
+		clean, found := secret.Redact(*field)
+		*field = clean
+		redacted += len(found)
+	}
+	return redacted, nil
+}
@@ -35,6 +35,7 @@ import (
 //   - --cooldown: Suppress repeated output within this duration (default 10m)
 //   - --session: Session identifier for cooldown tombstone isolation
 //   - --skill: Include named skill content in context packet
+//   - --at: Build the packet from a snapshot (ID, name, or date)
 //
 // Returns:
 //   - *cobra.Command: Configured agent command with flags registered
@@ -46,6 +47,7 @@ func Cmd() *cobra.Command {
 		session      string
 		skillName    string
 		includeShare bool
+		at           string
 	)
 
 	short, long := desc.Command(cmd.DescKeyAgent)
@@ -90,7 +92,7 @@ func Cmd() *cobra.Command {
 			}
 
 			return Run(
-				cmd, budget, format, cooldown, session,
+				cmd, budget, format, cooldown, session, at,
 				steeringBodies, skillBody, sharedBodies,
 			)
 		},
@@ -124,6 +126,10 @@ func Cmd() *cobra.Command {
 		cFlag.IncludeHub,
 		flag.DescKeyAgentIncludeHub,
 	)
+	flagbind.StringFlag(
+		c, &at,
+		cFlag.At, flag.DescKeyAgentAt,
+	)
 
 	return c
 }
@@ -15,9 +15,12 @@ import (
 	coreBudget "github.com/ActiveMemory/ctx/internal/cli/agent/core/budget"
 	coreCooldown "github.com/ActiveMemory/ctx/internal/cli/agent/core/cooldown"
 	"github.com/ActiveMemory/ctx/internal/config/fmt"
-	"github.com/ActiveMemory/ctx/internal/context/load"
+	"github.com/ActiveMemory/ctx/internal/context/layer"
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
 	errInit "github.com/ActiveMemory/ctx/internal/err/initialize"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 // Run executes the agent command logic.
@@ -35,6 +38,8 @@ import (
 //   - cooldown: duration to suppress repeated output (0 to disable)
 //   - session: session identifier for tombstone isolation (empty to
 //     disable cooldown)
+//   - at: snapshot reference to build the packet from (empty for
+//     the live context)
 //   - steeringBodies: pre-loaded steering file bodies (may be nil)
 //   - skillBody: pre-loaded skill content (empty to omit)
 //
@@ -46,6 +51,7 @@ func Run(
 	format string,
 	cooldown time.Duration,
 	session string,
+	at string,
 	steeringBodies []string,
 	skillBody string,
 	hubBodies []string,
@@ -58,7 +64,17 @@ func Run(
 		return nil
 	}
 
-	ctx, err := load.Do("")
+	var ctx *entity.Context
+	var err error
+	if at != "" {
+		ctxDir, dirErr := rc.ContextDir()
+		if dirErr != nil {
+			return dirErr
+		}
+		ctx, _, err = snapshot.Load(ctxDir, at)
+	} else {
+		ctx, err = layer.Load("")
+	}
 	if err != nil {
 		if _, ok := errors.AsType[*errCtx.NotFoundError](err); ok {
 			return errInit.NotInitialized()
@@ -13,23 +13,27 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/agent/core/extract"
 	"github.com/ActiveMemory/ctx/internal/cli/agent/core/score"
 	"github.com/ActiveMemory/ctx/internal/cli/agent/core/sort"
-	"github.com/ActiveMemory/ctx/internal/config/agent"
 	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
 	ctxToken "github.com/ActiveMemory/ctx/internal/context/token"
 	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 // AssemblePacket builds a context packet respecting the token budget.
 //
 // Allocation tiers:
 //   - Tier 1 (always): constitution, read order, instruction
-//   - Tier 2 (40%): active tasks
+//   - Tier 2 (40%): active tasks not blocked by pending dependencies
 //   - Tier 3 (20%): conventions
 //   - Tier 4+5 (remaining): decisions and learnings, scored by relevance
 //   - Tier 6 (remaining after 4+5): steering files
 //   - Tier 7 (remaining after 6): skill content (--skill flag)
 //
+// Tier percentages and scoring weights come from the .ctxrc scoring
+// block when set; the figures above are the defaults.
+//
 // Parameters:
 //   - ctx: Loaded context containing the files
 //   - budget: Token budget to respect
@@ -67,8 +71,8 @@ func AssemblePacket(
 		return pkt
 	}
 
-	// Tier 2: Tasks (up to 40% of the original budget)
-	taskCap := int(float64(budget) * agent.TaskBudgetPct)
+	// Tier 2: Tasks (up to 40% of the original budget by default)
+	taskCap := int(float64(budget) * rc.TaskBudgetPct())
 	allTasks := extract.ActiveTasks(ctx)
 	pkt.Tasks = FitItems(allTasks, taskCap)
 	taskTokens := EstimateSliceTokens(pkt.Tasks)
@@ -79,8 +83,8 @@ func AssemblePacket(
 		return pkt
 	}
 
-	// Tier 3: Conventions (up to 20% of the original budget)
-	convCap := int(float64(budget) * agent.ConventionBudgetPct)
+	// Tier 3: Conventions (up to 20% of the original budget by default)
+	convCap := int(float64(budget) * rc.ConventionBudgetPct())
 	allConventions := ExtractAllConventions(ctx)
 	pkt.Conventions = FitItems(allConventions, convCap)
 	convTokens := EstimateSliceTokens(pkt.Conventions)
@@ -98,8 +102,13 @@ func AssemblePacket(
 	decisionBlocks := ParseEntryBlocks(ctx, cfgCtx.Decision)
 	learningBlocks := ParseEntryBlocks(ctx, cfgCtx.Learning)
 
-	scoredDecisions := score.All(decisionBlocks, keywords, now)
-	scoredLearnings := score.All(learningBlocks, keywords, now)
+	scoring := score.Configured()
+	scoredDecisions := score.AllWeighted(
+		decisionBlocks, cfgEntry.Decision, keywords, now, scoring,
+	)
+	scoredLearnings := score.AllWeighted(
+		learningBlocks, cfgEntry.Learning, keywords, now, scoring,
+	)
 
 	// Split the remaining budget: proportional to content size, minimum 30% each
 	decTokens, learnTokens := Split(
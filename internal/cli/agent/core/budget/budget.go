@@ -8,14 +8,15 @@ package budget
 
 import (
 	"github.com/ActiveMemory/ctx/internal/cli/agent/core/score"
-	"github.com/ActiveMemory/ctx/internal/config/agent"
 	"github.com/ActiveMemory/ctx/internal/config/stats"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 // Split divides a token budget between two scored sections.
 //
 // Each section gets at least 30% of the budget (if content exists).
 // The remaining 40% is allocated proportionally to content size.
+// The minimum is configurable via scoring.tiers.split_min_pct.
 //
 // Parameters:
 //   - total: Total tokens to split
@@ -50,8 +51,9 @@ func Split(total int, a, b []score.Entry) (int, int) {
 	}
 
 	// Minimum 30% each, proportional split of the rest
-	minA := total * agent.SplitMinPct / stats.PercentMultiplier
-	minB := total * agent.SplitMinPct / stats.PercentMultiplier
+	minPct := min(rc.SplitMinPct(), stats.PercentMultiplier/2)
+	minA := total * minPct / stats.PercentMultiplier
+	minB := total * minPct / stats.PercentMultiplier
 	flex := total - minA - minB
 
 	aProportion := float64(aTokens) / float64(totalContent)
@@ -63,8 +65,9 @@ func Split(total int, a, b []score.Entry) (int, int) {
 // FillSection selects scored entries to fill a budget,
 // with graceful degradation.
 //
-// Includes full entries by score order until ~80% of the budget is consumed.
-// Remaining entries get title-only summaries.
+// Includes full entries by score order until ~80% of the budget is consumed
+// (scoring.tiers.full_entry_pct). Remaining entries get title-only
+// summaries.
 //
 // Parameters:
 //   - entries: Scored entries sorted by score descending
@@ -78,7 +81,7 @@ func FillSection(entries []score.Entry, budget int) ([]string, []string) {
 		return nil, nil
 	}
 
-	fullBudget := budget * agent.FullEntryPct / stats.PercentMultiplier
+	fullBudget := budget * rc.FullEntryPct() / stats.PercentMultiplier
 	used := 0
 	var full []string
 	var summaries []string
@@ -434,3 +434,19 @@ func TestAssemblePacket_SkillOmittedWhenBudgetExhausted(t *testing.T) {
 		t.Error("expected skill to be omitted when budget exhausted")
 	}
 }
+
+func TestAssemblePacket_SkipsBlockedTasks(t *testing.T) {
+	tasks := "# Tasks\n\n" +
+		"- [x] Design schema #id:schema\n" +
+		"- [ ] Build API #id:api #after:schema\n" +
+		"- [ ] Login screen #after:api\n"
+	ctx := &entity.Context{Files: []entity.FileInfo{
+		{Name: "TASKS.md", Content: []byte(tasks)},
+	}}
+
+	pkt := AssemblePacket(ctx, 8000, nil, "", nil)
+
+	if len(pkt.Tasks) != 1 || !strings.Contains(pkt.Tasks[0], "Build API") {
+		t.Errorf("expected only the unblocked task, got %v", pkt.Tasks)
+	}
+}
@@ -26,14 +26,16 @@
 //
 // [UncheckedTasks] returns only pending tasks (those
 // matching "- [ ]") with the checkbox prefix preserved
-// for display. It uses regex.TaskMultiline to handle
-// multi-line task bodies and task.Pending to filter.
+// for display. Tasks that wait for another pending
+// task through #after:/#blocks: tags are left out
+// ([internal/task/graph.BlockedLines]), so the agent
+// packet only offers work that can start now.
 //
 // # Context-Aware Helpers
 //
 // Two convenience functions operate on a loaded Context:
 //
-//   - [ActiveTasks] extracts unchecked tasks from the
+//   - [ActiveTasks] extracts unblocked unchecked tasks from the
 //     TASKS.md file in the context.
 //   - [ConstitutionRules] extracts checkbox items from
 //     CONSTITUTION.md for inclusion as inviolable rules.
@@ -15,6 +15,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/task"
+	"github.com/ActiveMemory/ctx/internal/task/graph"
 )
 
 // BulletItems extracts Markdown bullet items up to a limit.
@@ -77,27 +78,30 @@ func ConstitutionRules(ctx *entity.Context) []string {
 
 // UncheckedTasks extracts unchecked Markdown checkbox items.
 //
-// Only matches "- [ ]" items (not checked). Returns items with the
-// "- [ ]" prefix preserved for display.
+// Only matches "- [ ]" items (not checked) that are not blocked by
+// #after:/#blocks: dependencies on other unchecked items. Returns
+// items with the "- [ ]" prefix preserved for display.
 //
 // Parameters:
 //   - content: Markdown content to parse
 //
 // Returns:
-//   - []string: Unchecked task items with "- [ ]" prefix
+//   - []string: Unblocked unchecked task items with "- [ ]" prefix
 func UncheckedTasks(content string) []string {
-	matches := regex.TaskMultiline.FindAllStringSubmatch(content, -1)
-	items := make([]string, 0, len(matches))
-	for _, m := range matches {
-		if task.Pending(m) {
-			text := strings.TrimSpace(task.Content(m))
-			items = append(items, marker.PrefixTaskUndone+token.Space+text)
+	blocked := graph.BlockedLines(content)
+	items := make([]string, 0)
+	for i, line := range strings.Split(content, token.NewlineLF) {
+		m := regex.Task.FindStringSubmatch(line)
+		if m == nil || !task.Pending(m) || blocked[i] {
+			continue
 		}
+		text := strings.TrimSpace(task.Content(m))
+		items = append(items, marker.PrefixTaskUndone+token.Space+text)
 	}
 	return items
 }
 
-// ActiveTasks extracts unchecked task items from TASKS.md.
+// ActiveTasks extracts unblocked unchecked task items from TASKS.md.
 //
 // Parameters:
 //   - ctx: Loaded context containing the files
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package score
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/agent"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Default returns the built-in scoring configuration: bucketed
+// recency, the default match cap, unit weights, and no rules.
+//
+// Returns:
+//   - Config: Scoring parameters matching config/agent
+func Default() Config {
+	return Config{MatchCap: agent.RelevanceMatchCap}
+}
+
+// Configured returns the scoring configuration from the .ctxrc
+// scoring block, falling back to [Default] values for unset
+// fields.
+//
+// Returns:
+//   - Config: Scoring parameters for the current project
+func Configured() Config {
+	return Config{
+		HalfLifeDays: rc.RecencyHalfLifeDays(),
+		MatchCap:     rc.RelevanceMatchCap(),
+		Weights: map[string]float64{
+			cfgEntry.Decision: rc.ScoringWeight(cfgEntry.Decision),
+			cfgEntry.Learning: rc.ScoringWeight(cfgEntry.Learning),
+		},
+		Rules: rc.ScoringRules(),
+	}
+}
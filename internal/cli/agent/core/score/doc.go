@@ -50,7 +50,21 @@
 // (week, month, quarter), which is the cadence at which
 // users actually expect their context to age.
 //
+// # Per-Project Tuning
+//
+// [Weighted] is the configurable form used by the budget
+// allocator. A [Config] built by [Configured] from the
+// .ctxrc `scoring:` block can swap the buckets for a
+// continuous [HalfLife] decay (long-lived infra repos want
+// years-old decisions to stay visible; prototypes want last
+// week only), change the relevance match cap, multiply by a
+// per-type weight, and add [Boost] adjustments from rules
+// keyed on #tags or entry headings. [Default] reproduces
+// the plain [Score], so [All] and [AllWeighted] agree when
+// nothing is configured.
+//
 // # Concurrency
 //
-// All functions are pure. Concurrent callers never race.
+// All functions except [Configured] are pure. Concurrent
+// callers never race.
 package score
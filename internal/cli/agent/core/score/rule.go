@@ -0,0 +1,69 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package score
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/agent"
+	"github.com/ActiveMemory/ctx/internal/index"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Boost sums the adjustments of every rule matching an entry.
+//
+// A rule matches when all of its non-empty keys match: Tag
+// against a #tag token in the entry text, Section against the
+// entry heading, and Type against kind. Comparisons are
+// case-insensitive.
+//
+// Parameters:
+//   - eb: Entry block to inspect
+//   - kind: Entry type (e.g. "decision")
+//   - rules: Rules from the .ctxrc scoring block
+//
+// Returns:
+//   - float64: Sum of matching boosts (negative for penalties)
+func Boost(
+	eb *index.EntryBlock, kind string, rules []rc.ScoringRule,
+) float64 {
+	if len(rules) == 0 {
+		return 0
+	}
+	title := strings.ToLower(eb.Entry.Title)
+	tags := make(map[string]bool)
+	for _, f := range strings.Fields(strings.ToLower(eb.BlockContent())) {
+		if !strings.HasPrefix(f, agent.TagPrefix) {
+			continue
+		}
+		tag := strings.TrimRight(
+			strings.TrimPrefix(f, agent.TagPrefix), agent.TagTrim,
+		)
+		if tag != "" {
+			tags[tag] = true
+		}
+	}
+
+	total := 0.0
+	for _, r := range rules {
+		if r.Tag == "" && r.Section == "" {
+			continue
+		}
+		if r.Type != "" && !strings.EqualFold(r.Type, kind) {
+			continue
+		}
+		if r.Tag != "" && !tags[strings.ToLower(r.Tag)] {
+			continue
+		}
+		if r.Section != "" &&
+			!strings.Contains(title, strings.ToLower(r.Section)) {
+			continue
+		}
+		total += r.Boost
+	}
+	return total
+}
@@ -7,6 +7,7 @@
 package score
 
 import (
+	"math"
 	"strings"
 	"time"
 
@@ -51,6 +52,36 @@ func Recency(eb *index.EntryBlock, now time.Time) float64 {
 	}
 }
 
+// HalfLife returns a recency score that halves every halfLifeDays.
+//
+// Unlike [Recency] the decay is continuous: an entry exactly one
+// half-life old scores 0.5, two half-lives 0.25. Entries dated in
+// the future score 1.0.
+//
+// Parameters:
+//   - eb: Entry block to score
+//   - now: Current time for age calculation
+//   - halfLifeDays: Days for the score to halve (must be > 0)
+//
+// Returns:
+//   - float64: Recency score between 0.0 and 1.0, or
+//     agent.RecencyScoreOld for undated entries
+func HalfLife(
+	eb *index.EntryBlock, now time.Time, halfLifeDays float64,
+) float64 {
+	entryDate, err := time.ParseInLocation(
+		cfgTime.DateFormat, eb.Entry.Date, time.Local,
+	)
+	if err != nil {
+		return agent.RecencyScoreOld
+	}
+	days := now.Sub(entryDate).Hours() / cfgTime.HoursPerDay
+	if days <= 0 {
+		return 1.0
+	}
+	return math.Pow(agent.HalfLifeBase, days/halfLifeDays)
+}
+
 // Relevance computes keyword overlap between an entry and active tasks.
 //
 // Counts how many task keywords appear in the entry's title and body.
@@ -63,7 +94,23 @@ func Recency(eb *index.EntryBlock, now time.Time) float64 {
 // Returns:
 //   - float64: Relevance score between 0.0 and 1.0
 func Relevance(eb *index.EntryBlock, keywords []string) float64 {
-	if len(keywords) == 0 {
+	return RelevanceCap(eb, keywords, agent.RelevanceMatchCap)
+}
+
+// RelevanceCap computes keyword overlap normalized to 1.0 at
+// matchCap matches.
+//
+// Parameters:
+//   - eb: Entry block to score
+//   - keywords: Lowercase keywords extracted from active tasks
+//   - matchCap: Match count that yields maximum relevance
+//
+// Returns:
+//   - float64: Relevance score between 0.0 and 1.0
+func RelevanceCap(
+	eb *index.EntryBlock, keywords []string, matchCap int,
+) float64 {
+	if len(keywords) == 0 || matchCap <= 0 {
 		return 0.0
 	}
 	text := strings.ToLower(eb.BlockContent())
@@ -73,10 +120,10 @@ func Relevance(eb *index.EntryBlock, keywords []string) float64 {
 			matches++
 		}
 	}
-	if matches >= agent.RelevanceMatchCap {
+	if matches >= matchCap {
 		return 1.0
 	}
-	return float64(matches) / float64(agent.RelevanceMatchCap)
+	return float64(matches) / float64(matchCap)
 }
 
 // Score computes the combined relevance score for an entry block.
@@ -98,6 +145,43 @@ func Score(eb *index.EntryBlock, keywords []string, now time.Time) float64 {
 	return Recency(eb, now) + Relevance(eb, keywords)
 }
 
+// Weighted computes an entry score under a scoring configuration.
+//
+// The base score is recency (bucketed, or [HalfLife] when
+// cfg.HalfLifeDays is set) plus capped relevance. It is multiplied
+// by the weight for kind, then every matching rule's boost is
+// added. The result is clamped at 0.0, so a large enough penalty
+// drops the entry the same way supersession does.
+//
+// Parameters:
+//   - eb: Entry block to score
+//   - kind: Entry type (e.g. "decision", "learning")
+//   - keywords: Task keywords for relevance matching
+//   - now: Current time for recency calculation
+//   - cfg: Scoring parameters
+//
+// Returns:
+//   - float64: Weighted score, or 0.0 if superseded
+func Weighted(
+	eb *index.EntryBlock, kind string, keywords []string,
+	now time.Time, cfg Config,
+) float64 {
+	if eb.IsSuperseded() {
+		return 0.0
+	}
+	recency := Recency(eb, now)
+	if cfg.HalfLifeDays > 0 {
+		recency = HalfLife(eb, now, cfg.HalfLifeDays)
+	}
+	weight, ok := cfg.Weights[kind]
+	if !ok {
+		weight = agent.DefaultTypeWeight
+	}
+	s := (recency+RelevanceCap(eb, keywords, cfg.MatchCap))*weight +
+		Boost(eb, kind, cfg.Rules)
+	return max(s, 0.0)
+}
+
 // ExtractTaskKeywords extracts meaningful keywords from task text.
 //
 // Splits task text on whitespace and punctuation, lowercases, and filters
@@ -139,10 +223,29 @@ func ExtractTaskKeywords(tasks []string) []string {
 //   - []ScoredEntry: Entries sorted by score descending, with token estimates
 func All(
 	blocks []index.EntryBlock, keywords []string, now time.Time,
+) []Entry {
+	return AllWeighted(blocks, "", keywords, now, Default())
+}
+
+// AllWeighted scores entry blocks with [Weighted] and sorts them.
+//
+// Parameters:
+//   - blocks: Parsed entry blocks from a knowledge file
+//   - kind: Entry type of the file (e.g. "decision")
+//   - keywords: Task keywords for relevance matching
+//   - now: Current time for recency scoring
+//   - cfg: Scoring parameters
+//
+// Returns:
+//   - []Entry: Entries sorted by score descending, with token
+//     estimates
+func AllWeighted(
+	blocks []index.EntryBlock, kind string, keywords []string,
+	now time.Time, cfg Config,
 ) []Entry {
 	scored := make([]Entry, 0, len(blocks))
 	for i := range blocks {
-		s := Score(&blocks[i], keywords, now)
+		s := Weighted(&blocks[i], kind, keywords, now, cfg)
 		tokens := token.EstimateString(blocks[i].BlockContent())
 		scored = append(scored, Entry{
 			EntryBlock: blocks[i],
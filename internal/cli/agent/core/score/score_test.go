@@ -12,6 +12,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/index"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 func makeBlock(date, title, body string) index.EntryBlock {
@@ -245,3 +246,71 @@ func TestScoreEntries_TokenEstimate(t *testing.T) {
 		t.Error("expected positive token estimate")
 	}
 }
+
+func TestHalfLife(t *testing.T) {
+	now := time.Date(2026, 2, 19, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		name string
+		date string
+		want float64
+	}{
+		{"one half-life", "2026-01-20", 0.5},
+		{"two half-lives", "2025-12-21", 0.25},
+		{"future", "2026-03-01", 1.0},
+		{"invalid date", "not-a-date", 0.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eb := makeBlock(tt.date, "Test", "")
+			got := HalfLife(&eb, now, 30)
+			if got < tt.want-0.01 || got > tt.want+0.01 {
+				t.Errorf("HalfLife(%s) = %v, want ~%v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeighted_DefaultMatchesScore(t *testing.T) {
+	now := time.Date(2026, 2, 19, 12, 0, 0, 0, time.Local)
+	eb := makeBlock("2026-02-10", "Hook edge cases", "hooks in agent mode")
+	kw := []string{"hook", "agent"}
+	if got, want := Weighted(&eb, "decision", kw, now, Default()),
+		Score(&eb, kw, now); got != want {
+		t.Errorf("Weighted(Default) = %v, want %v", got, want)
+	}
+}
+
+func TestWeighted_WeightsAndRules(t *testing.T) {
+	now := time.Date(2026, 2, 19, 12, 0, 0, 0, time.Local)
+	infra := makeBlock("2024-01-01", "Use Postgres", "Chosen for #infra.")
+	proto := makeBlock("2026-02-19", "Spike notes", "Throwaway #spike")
+	cfg := Config{
+		HalfLifeDays: 3650,
+		MatchCap:     3,
+		Weights:      map[string]float64{"decision": 2.0},
+		Rules: []rc.ScoringRule{
+			{Tag: "INFRA", Boost: 0.5},
+			{Section: "spike", Boost: -5},
+		},
+	}
+
+	got := Weighted(&infra, "decision", nil, now, cfg)
+	// recency ≈ 0.5^(780/3650) ≈ 0.86, ×2 weight, +0.5 tag boost
+	if got < 2.1 || got > 2.3 {
+		t.Errorf("infra score = %v, want ~2.2", got)
+	}
+	if got := Weighted(&proto, "learning", nil, now, cfg); got != 0.0 {
+		t.Errorf("penalized score = %v, want clamped 0.0", got)
+	}
+}
+
+func TestBoost_TypeRestriction(t *testing.T) {
+	eb := makeBlock("2026-02-19", "Cache layer", "#perf tuning")
+	rules := []rc.ScoringRule{{Tag: "perf", Type: "learning", Boost: 1}}
+	if got := Boost(&eb, "decision", rules); got != 0 {
+		t.Errorf("Boost(decision) = %v, want 0", got)
+	}
+	if got := Boost(&eb, "learning", rules); got != 1 {
+		t.Errorf("Boost(learning) = %v, want 1", got)
+	}
+}
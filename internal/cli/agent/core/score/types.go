@@ -6,7 +6,10 @@
 
 package score
 
-import "github.com/ActiveMemory/ctx/internal/index"
+import (
+	"github.com/ActiveMemory/ctx/internal/index"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
 
 // Entry is an entry block with a computed relevance score.
 //
@@ -19,3 +22,22 @@ type Entry struct {
 	Score  float64
 	Tokens int
 }
+
+// Config holds the tunable scoring parameters.
+//
+// The zero value is not useful; build one with [Default] or
+// [Configured].
+//
+// Fields:
+//   - HalfLifeDays: Continuous recency half-life; 0 keeps the
+//     bucketed [Recency] scores
+//   - MatchCap: Keyword matches that yield relevance 1.0
+//   - Weights: Score multiplier per entry type; missing types
+//     use agent.DefaultTypeWeight
+//   - Rules: Boost/penalty rules from .ctxrc
+type Config struct {
+	HalfLifeDays float64
+	MatchCap     int
+	Weights      map[string]float64
+	Rules        []rc.ScoringRule
+}
@@ -23,7 +23,8 @@
 //     from the remaining budget before any other allocation.
 //   - Tier 2 (40%): Active tasks from TASKS.md. Tasks are included in
 //     file order until the 40% cap is reached. At least one task is
-//     always included regardless of budget.
+//     always included regardless of budget. Tasks blocked by
+//     #after:/#blocks: dependencies on pending tasks are skipped.
 //   - Tier 3 (20%): Convention items from CONVENTIONS.md. Same
 //     fill-until-cap strategy as tasks.
 //   - Tier 4+5 (remaining): Decisions and learnings share whatever
@@ -157,6 +157,41 @@ func TestBinaryIntegration(t *testing.T) {
 		}
 	})
 
+	// Subtest: ctx learning add refuses secrets unless --redact
+	t.Run("learning add guards secrets", func(t *testing.T) {
+		leaked := "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+		args := []string{
+			"learning", "add", "CI token " + leaked + " leaked",
+			"--session-id", "test1234", "--branch", "main", "--commit", "abc123",
+			"--context", "Testing secrets",
+			"--lesson", "Never paste tokens",
+			"--application", "Use the secret store",
+		}
+		refused := exec.Command(binaryPath, args...) //nolint:gosec // test binary
+		refused.Dir = testDir
+		if output, err := refused.CombinedOutput(); err == nil {
+			t.Fatalf("ctx learning add accepted a secret:\n%s", output)
+		}
+
+		redacted := exec.Command( //nolint:gosec // test binary
+			binaryPath, append(args, "--redact")...,
+		)
+		redacted.Dir = testDir
+		if output, err := redacted.CombinedOutput(); err != nil {
+			t.Fatalf("ctx learning add --redact failed: %v\n%s", err, output)
+		}
+
+		learningsPath := filepath.Join(testDir, ".context", "LEARNINGS.md")
+		content, err := os.ReadFile(filepath.Clean(learningsPath))
+		if err != nil {
+			t.Fatalf("failed to read LEARNINGS.md: %v", err)
+		}
+		if strings.Contains(string(content), leaked) ||
+			!strings.Contains(string(content), "[REDACTED:github_token]") {
+			t.Error("secret was not redacted in LEARNINGS.md")
+		}
+	})
+
 	// Subtest: ctx agent returns context packet
 	t.Run("agent returns context packet", func(t *testing.T) {
 		agentCmd := exec.Command(binaryPath, "agent") //nolint:gosec // test binary
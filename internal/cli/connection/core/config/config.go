@@ -63,12 +63,12 @@ func Load() (Config, error) {
 		return cfg, readErr
 	}
 
-	key, keyErr := loadKey()
+	keys, keyErr := loadKeyring()
 	if keyErr != nil {
 		return cfg, keyErr
 	}
 
-	data, decErr := crypto.Decrypt(key, encrypted)
+	data, decErr := crypto.DecryptAny(keys, encrypted)
 	if decErr != nil {
 		return cfg, decErr
 	}
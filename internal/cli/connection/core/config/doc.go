@@ -41,7 +41,9 @@
 // # Key Management
 //
 // The unexported loadKey helper reads the encryption
-// key from crypto.GlobalKeyPath(). The unexported
+// key from crypto.GlobalKeyPath(); loadKeyring adds
+// the grace key left by `ctx key rotate` so Load keeps
+// working during the grace period. The unexported
 // filePath helper resolves the absolute path to
 // .connect.enc within the context directory.
 //
@@ -36,3 +36,13 @@ func filePath() (string, error) {
 func loadKey() ([]byte, error) {
 	return crypto.LoadKey(crypto.GlobalKeyPath())
 }
+
+// loadKeyring reads the global key plus any grace key left
+// by a recent rotation, for decryption.
+//
+// Returns:
+//   - [][]byte: keys to try, active key first
+//   - error: non-nil if the active key cannot be read
+func loadKeyring() ([][]byte, error) {
+	return crypto.LoadKeyring(crypto.GlobalKeyPath(), rc.KeyGraceDays())
+}
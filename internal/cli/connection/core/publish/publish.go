@@ -13,11 +13,14 @@ import (
 
 	connectCfg "github.com/ActiveMemory/ctx/internal/cli/connection/core/config"
 	"github.com/ActiveMemory/ctx/internal/hub"
+	"github.com/ActiveMemory/ctx/internal/secret"
 	writeConnect "github.com/ActiveMemory/ctx/internal/write/connect"
 )
 
 // Run publishes local entries to the hub.
 //
+// Possible secrets in entry content are redacted before publishing.
+//
 // Currently publishes entries passed as arguments.
 // Future: read from local context files with --new flag.
 //
@@ -43,6 +46,16 @@ func Run(
 	}
 	defer func() { _ = client.Close() }()
 
+	redacted := 0
+	for i := range entries {
+		clean, findings := secret.Redact(entries[i].Content)
+		entries[i].Content = clean
+		redacted += len(findings)
+	}
+	if redacted > 0 {
+		writeConnect.Redacted(cmd, redacted)
+	}
+
 	_, pubErr := client.Publish(
 		context.Background(), entries,
 	)
@@ -32,8 +32,8 @@
 //
 // Publishing and export: journal, serve, site, memory.
 //
-// Infrastructure: backup, config, connection, hub, mcp,
-// prune, setup, steering, trigger, usage, sysinfo.
+// Infrastructure: backup, config, connection, hub, key,
+// mcp, prune, setup, steering, trigger, usage, sysinfo.
 //
 // Utilities: guide, loop, pad, resolve, skill, trace,
 // why, parent, hook, message, notify.
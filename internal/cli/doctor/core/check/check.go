@@ -28,6 +28,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/stats"
 	cfgSysinfo "github.com/ActiveMemory/ctx/internal/config/sysinfo"
 	cfgToken "github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/context/layer"
 	"github.com/ActiveMemory/ctx/internal/context/load"
 	"github.com/ActiveMemory/ctx/internal/context/token"
 	"github.com/ActiveMemory/ctx/internal/context/validate"
@@ -195,11 +196,11 @@ func CtxrcValidation(report *Report) error {
 //
 // Returns:
 //   - error: [errCtx.ErrDirNotDeclared] when the context directory
-//     cannot be resolved via [load.Do]; the runner renders a standard
+//     cannot be resolved via [layer.Load]; the runner renders a standard
 //     "did not run" line in that case. Transient load failures are
 //     reported inline as a StatusWarning and return nil.
 func Drift(report *Report) error {
-	c, loadErr := load.Do("")
+	c, loadErr := layer.Load("")
 	if loadErr != nil {
 		if errors.Is(loadErr, errCtx.ErrDirNotDeclared) {
 			return loadErr
@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package hook implements the "ctx drift hook" cobra
+// subcommand.
+//
+// # Usage
+//
+//	ctx drift hook <enable|disable>
+//
+// "enable" installs a git pre-commit hook that runs
+// "ctx drift --staged", blocking commits that break the
+// structured rules in CONSTITUTION.md. "disable" removes
+// it. A pre-commit hook not installed by ctx is left alone.
+//
+// # Delegation
+//
+// Installation and removal are handled by drift/core/hook.
+// Action constants are defined in config/drift.
+package hook
@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hook
+
+import (
+	"github.com/spf13/cobra"
+
+	coreHook "github.com/ActiveMemory/ctx/internal/cli/drift/core/hook"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	errDrift "github.com/ActiveMemory/ctx/internal/err/drift"
+)
+
+// Run dispatches the hook action.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - action: "enable" or "disable"
+//
+// Returns:
+//   - error: Non-nil on an unknown action or install failure
+func Run(cmd *cobra.Command, action string) error {
+	switch action {
+	case cfgDrift.ActionEnable:
+		return coreHook.Enable(cmd)
+	case cfgDrift.ActionDisable:
+		return coreHook.Disable(cmd)
+	default:
+		return errDrift.UnknownAction(action)
+	}
+}
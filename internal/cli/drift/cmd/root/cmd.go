@@ -23,7 +23,10 @@ import (
 //
 // Flags:
 //   - --json: Output results as JSON for machine parsing
-//   - --fix: Auto-fix supported issues (staleness, missing_file)
+//   - --fix: Auto-fix supported issues (staleness, missing_file,
+//     dead_path renamed in git history)
+//   - --dry-run: Preview --fix as a unified diff
+//   - --list-checks: List checks with their .ctxrc overrides
 //
 // Returns:
 //   - *cobra.Command: Configured drift command with flags registered
@@ -31,6 +34,9 @@ func Cmd() *cobra.Command {
 	var (
 		jsonOutput bool
 		fix        bool
+		dryRun     bool
+		listChecks bool
+		staged     bool
 	)
 
 	short, long := desc.Command(cmd.DescKeyDrift)
@@ -40,7 +46,7 @@ func Cmd() *cobra.Command {
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyDrift),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Run(cmd, jsonOutput, fix)
+			return Run(cmd, jsonOutput, fix, dryRun, listChecks, staged)
 		},
 	}
 
@@ -52,6 +58,18 @@ func Cmd() *cobra.Command {
 		c, &fix,
 		cFlag.Fix, flag.DescKeyDriftFix,
 	)
+	flagbind.BoolFlag(
+		c, &dryRun,
+		cFlag.DryRun, flag.DescKeyDriftDryRun,
+	)
+	flagbind.BoolFlag(
+		c, &listChecks,
+		cFlag.ListChecks, flag.DescKeyDriftListChecks,
+	)
+	flagbind.BoolFlag(
+		c, &staged,
+		cFlag.Staged, flag.DescKeyDriftStaged,
+	)
 
 	return c
 }
@@ -28,8 +28,14 @@
 //   - --json: Output results as machine-readable
 //     JSON instead of human-readable text.
 //   - --fix: Attempt to auto-fix supported issues
-//     (staleness, missing_file). Prints a summary
-//     of fixed, skipped, and errored items.
+//     (staleness, missing_file, and dead paths whose
+//     target was renamed in git history). Prints a
+//     summary of fixed, skipped, and errored items.
+//   - --dry-run: Preview --fix: dead path rewrites are
+//     printed as a unified diff and nothing is written.
+//   - --list-checks: List the built-in and external
+//     checks with their enabled state and severity
+//     override instead of running them.
 //
 // # Output
 //
@@ -13,7 +13,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/cli/drift/core/fix"
 	"github.com/ActiveMemory/ctx/internal/cli/drift/core/out"
-	"github.com/ActiveMemory/ctx/internal/context/load"
+	"github.com/ActiveMemory/ctx/internal/context/layer"
 	"github.com/ActiveMemory/ctx/internal/drift"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
 	errInit "github.com/ActiveMemory/ctx/internal/err/initialize"
@@ -31,17 +31,31 @@ import (
 //   - cmd: Cobra command for output stream
 //   - jsonOutput: If true, output as JSON
 //   - doFix: If true, attempt to auto-fix supported issues
+//   - dryRun: If true, preview fixes as a diff without writing;
+//     implies doFix
+//   - listChecks: If true, list the checks instead of running them
+//   - staged: If true, evaluate the CONSTITUTION.md rule block
+//     against the staged changes instead of the context files
 //
 // Returns:
 //   - error: Non-nil if context loading fails
 func Run(
-	cmd *cobra.Command, jsonOutput, doFix bool,
+	cmd *cobra.Command,
+	jsonOutput, doFix, dryRun, listChecks, staged bool,
 ) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	ctx, err := load.Do("")
+	if listChecks {
+		checks := drift.Checks(ctxDir)
+		if jsonOutput {
+			return out.ChecksJSON(cmd, checks)
+		}
+		return out.ChecksText(cmd, checks)
+	}
+	ctx, err := layer.Load("")
 	if err != nil {
 		if _, ok := errors.AsType[*errCtx.NotFoundError](err); ok {
 			return errInit.NotInitialized()
@@ -49,14 +63,28 @@ func Run(
 		return err
 	}
 
-	report := drift.Detect(ctx)
+	if staged {
+		stagedReport, stagedErr := drift.DetectStaged(ctx)
+		if stagedErr != nil {
+			cmd.SilenceUsage = true
+			return stagedErr
+		}
+		cmd.SilenceUsage = true
+		if jsonOutput {
+			return out.DriftJSON(cmd, stagedReport)
+		}
+		return out.DriftText(cmd, stagedReport)
+	}
+
+	report := drift.DetectFull(ctx)
 
 	// Apply fixes if requested
+	doFix = doFix || dryRun
 	if doFix && (len(report.Warnings) > 0 ||
 		len(report.Violations) > 0) {
 		writeDrift.FixHeader(cmd)
 
-		result := fix.Apply(cmd, ctx, report)
+		result := fix.Apply(cmd, ctx, report, dryRun)
 
 		writeDrift.BlankLine(cmd)
 		if result.Fixed > 0 {
@@ -72,8 +100,8 @@ func Run(
 		// Re-run detection to show the updated status
 		if result.Fixed > 0 {
 			writeDrift.FixRecheck(cmd)
-			ctx, _ = load.Do("")
-			report = drift.Detect(ctx)
+			ctx, _ = layer.Load("")
+			report = drift.DetectFull(ctx)
 		}
 	}
 
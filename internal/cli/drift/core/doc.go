@@ -24,7 +24,8 @@
 //   - fix: applies automated corrections for fixable
 //     drift issues. [fix.Apply] iterates the report,
 //     archiving completed tasks for staleness issues
-//     and creating files for missing-file issues.
+//     creating files for missing-file issues, and
+//     rewriting dead paths renamed in git history.
 //     Tracks results in [fix.Result].
 //   - sanitize: converts internal check identifiers
 //     to human-readable labels via [sanitize.FormatCheckName].
@@ -37,7 +38,8 @@
 //  1. out.DriftText or out.DriftJSON renders the
 //     report for display.
 //  2. If --fix is passed, fix.Apply walks the report
-//     and attempts auto-remediation.
+//     and attempts auto-remediation (--dry-run prints
+//     the path rewrites as a diff instead).
 //  3. sanitize helpers are used by the output layer
 //     to translate check names for display.
 package core
@@ -0,0 +1,101 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fix
+
+import (
+	"bufio"
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	writeDrift "github.com/ActiveMemory/ctx/internal/write/drift"
+)
+
+// DeadPaths rewrites dead path references whose target was
+// renamed in git history.
+//
+// A path with one existing rename target is rewritten directly;
+// with several, the user picks one from a numbered list read
+// from the command's stdin (no answer skips it). Paths with no
+// rename history, and paths in inherited layer content, are
+// skipped. In dry-run mode nothing is written or asked: each
+// changed file is printed as a unified diff and ambiguous paths
+// are listed with their candidates.
+//
+// Parameters:
+//   - cmd: Cobra command for output and input
+//   - ctx: Loaded context; files are read from ctx.Dir
+//   - issues: Dead path warnings from the drift report
+//   - dryRun: Preview changes instead of writing them
+//   - result: Fix summary to update (modified in place)
+func DeadPaths(
+	cmd *cobra.Command, ctx *entity.Context,
+	issues []drift.Issue, dryRun bool, result *Result,
+) {
+	root := filepath.Dir(ctx.Dir)
+	var (
+		renames map[string][]string
+		in      *bufio.Reader
+		files   []string
+	)
+	fixes := map[string][]rename{}
+	decided := map[string]bool{}
+
+	for _, issue := range issues {
+		if issue.Layer != "" {
+			writeDrift.SkipDeadPath(cmd, issue.File, issue.Line, issue.Path)
+			result.Skipped++
+			continue
+		}
+		key := path.Join(issue.File, issue.Path)
+		if decided[key] {
+			continue
+		}
+		decided[key] = true
+		if renames == nil {
+			renames = renameHistory(root)
+		}
+
+		targets := renameTargets(renames, root, issue.Path)
+		var to string
+		switch {
+		case len(targets) == 1:
+			to = targets[0]
+		case len(targets) > 1 && !dryRun && cmd != nil:
+			if in == nil {
+				in = bufio.NewReader(cmd.InOrStdin())
+			}
+			to = choose(cmd, in, issue.File, issue.Line, issue.Path, targets)
+		}
+		if to == "" {
+			if len(targets) > 1 {
+				writeDrift.SkipDeadPathAmbiguous(
+					cmd, issue.File, issue.Line, issue.Path, targets,
+				)
+			} else {
+				writeDrift.SkipDeadPath(
+					cmd, issue.File, issue.Line, issue.Path,
+				)
+			}
+			result.Skipped++
+			continue
+		}
+
+		if _, seen := fixes[issue.File]; !seen {
+			files = append(files, issue.File)
+		}
+		fixes[issue.File] = append(fixes[issue.File],
+			rename{from: issue.Path, to: to})
+	}
+
+	for _, name := range files {
+		applyRenames(cmd, ctx.Dir, name, fixes[name], dryRun, result)
+	}
+}
@@ -8,9 +8,9 @@
 // `ctx drift`: given a [drift.Report], it applies the
 // fixes the package knows how to apply safely (archiving
 // completed tasks, creating missing required files from
-// templates) and skips the issues that need human
-// judgment (dead paths, leaked secrets, constitution
-// violations).
+// templates, following renames for dead paths) and skips
+// the issues that need human judgment (leaked secrets,
+// constitution violations).
 //
 // The package is the conservative side of the drift
 // loop. Anything that could be wrong if applied
@@ -26,12 +26,17 @@
 //     for the foundation files (CONSTITUTION,
 //     CONVENTIONS, etc.) are deployed from the
 //     embedded templates.
+//   - **Renamed paths**: a dead path reference whose
+//     file was renamed in git history is rewritten to
+//     the new name ([DeadPaths]). When the file went to
+//     several places the user picks one; with no answer
+//     the reference is left alone.
 //
 // # What Stays Manual
 //
-//   - **Dead path references**: the package cannot
-//     know whether a path is genuinely gone or just
-//     temporarily missing.
+//   - **Dead path references** with no rename history:
+//     the package cannot know whether a path is
+//     genuinely gone or just temporarily missing.
 //   - **Leaked secrets**: the user must redact and
 //     rotate; auto-removal could corrupt history.
 //   - **Constitution violations**: the user agreed
@@ -41,10 +46,12 @@
 //
 // # Public Surface
 //
-//   - **[Apply](report, contextDir)**: walks the
-//     report, applies fixable issues, returns a
+//   - **[Apply](cmd, ctx, report, dryRun)**: walks
+//     the report, applies fixable issues, returns a
 //     summary of what was changed and what was
-//     skipped.
+//     skipped. With dryRun, dead path rewrites are
+//     printed as a unified diff and nothing is
+//     written.
 //
 // # Concurrency
 //
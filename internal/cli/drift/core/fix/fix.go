@@ -40,21 +40,34 @@ import (
 // Currently supports fixing:
 //   - staleness: Archives completed tasks from TASKS.md
 //   - missing_file: Creates missing required files
+//   - dead_path: Rewrites references renamed in git history
+//
+// In dry-run mode only dead path rewrites are previewed, as a
+// unified diff; other fixable issues are left unchanged.
 //
 // Parameters:
 //   - cmd: Cobra command for output messages
 //   - ctx: Loaded context
 //   - report: Drift report containing issues to fix
+//   - dryRun: Preview changes instead of writing them
 //
 // Returns:
 //   - *Result: Summary of fixes applied
 func Apply(
 	cmd *cobra.Command, ctx *entity.Context,
-	report *drift.Report,
+	report *drift.Report, dryRun bool,
 ) *Result {
 	result := &Result{}
+	var deadPaths []drift.Issue
 
 	for _, issue := range report.Warnings {
+		if dryRun && (issue.Type == cfgDrift.IssueStaleness ||
+			issue.Type == cfgDrift.IssueMissing) {
+			writeDrift.SkipDryRun(cmd, issue.File)
+			result.Skipped++
+			continue
+		}
+
 		switch issue.Type {
 		case cfgDrift.IssueStaleness:
 			if fixErr := Staleness(cmd, ctx); fixErr != nil {
@@ -81,10 +94,7 @@ func Apply(
 			}
 
 		case cfgDrift.IssueDeadPath:
-			writeDrift.SkipDeadPath(
-				cmd, issue.File, issue.Line, issue.Path,
-			)
-			result.Skipped++
+			deadPaths = append(deadPaths, issue)
 
 		case cfgDrift.IssueStaleAge:
 			writeDrift.SkipStaleAge(cmd, issue.File)
@@ -92,6 +102,10 @@ func Apply(
 		}
 	}
 
+	if len(deadPaths) > 0 {
+		DeadPaths(cmd, ctx, deadPaths, dryRun, result)
+	}
+
 	for _, issue := range report.Violations {
 		if issue.Type == cfgDrift.IssueSecret {
 			writeDrift.SkipSensitiveFile(cmd, issue.File)
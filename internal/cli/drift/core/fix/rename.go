@@ -0,0 +1,205 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fix
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+	"github.com/ActiveMemory/ctx/internal/format"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	writeDrift "github.com/ActiveMemory/ctx/internal/write/drift"
+)
+
+// renameHistory maps each path that was ever renamed to the
+// paths it was renamed to, oldest rename first.
+//
+// `git log --follow` only traces a file backwards from its
+// current name; a dead reference needs the forward direction,
+// so the whole rename history is read once and inverted.
+//
+// Parameters:
+//   - root: Project root; paths are relative to it
+//
+// Returns:
+//   - map[string][]string: Old path to new paths; empty when
+//     root is not in a git repository
+func renameHistory(root string) map[string][]string {
+	renames := map[string][]string{}
+	out, gitErr := execGit.Renames(root)
+	if gitErr != nil {
+		return renames
+	}
+	lines := strings.Split(
+		strings.TrimSpace(string(out)), token.NewlineLF,
+	)
+	// git lists newest first; walk backwards so targets keep
+	// chronological order.
+	for i := len(lines) - 1; i >= 0; i-- {
+		fields := strings.Split(lines[i], cfgGit.NameStatusSep)
+		if len(fields) != 3 ||
+			!strings.HasPrefix(fields[0], cfgGit.StatusRenamed) {
+			continue
+		}
+		from, to := fields[1], fields[2]
+		if !slices.Contains(renames[from], to) {
+			renames[from] = append(renames[from], to)
+		}
+	}
+	return renames
+}
+
+// renameTargets follows rename chains from a dead path and
+// returns every reachable path that exists today.
+//
+// Parameters:
+//   - renames: History from renameHistory
+//   - root: Project root for existence checks
+//   - dead: The dead path as written in the context file
+//
+// Returns:
+//   - []string: Existing targets, most recent rename last
+func renameTargets(
+	renames map[string][]string, root, dead string,
+) []string {
+	var targets []string
+	seen := map[string]bool{dead: true}
+	queue := []string{path.Clean(dead)}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range renames[cur] {
+			if seen[next] {
+				continue
+			}
+			seen[next] = true
+			queue = append(queue, next)
+			if _, statErr := os.Stat(
+				filepath.Join(root, filepath.FromSlash(next)),
+			); statErr == nil {
+				targets = append(targets, next)
+			}
+		}
+	}
+	return targets
+}
+
+// choose asks the user to pick one of several rename targets.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - in: Shared reader over the command's stdin
+//   - file: Context file holding the reference
+//   - line: Line of the reference
+//   - dead: The dead path
+//   - targets: Candidate paths
+//
+// Returns:
+//   - string: Chosen target, or empty when the user skipped or
+//     no answer could be read
+func choose(
+	cmd *cobra.Command, in *bufio.Reader,
+	file string, line int, dead string, targets []string,
+) string {
+	writeDrift.RenameChoice(cmd, file, line, dead, targets)
+	answer, readErr := in.ReadString(token.NewlineLF[0])
+	if readErr != nil && answer == "" {
+		return ""
+	}
+	n, convErr := strconv.Atoi(strings.TrimSpace(answer))
+	if convErr != nil || n < 1 || n > len(targets) {
+		return ""
+	}
+	return targets[n-1]
+}
+
+// rewritePaths replaces backtick-quoted path references using
+// the given mapping; other text is left untouched.
+//
+// Parameters:
+//   - content: Context file content
+//   - mapping: Dead path to replacement
+//
+// Returns:
+//   - string: Rewritten content
+func rewritePaths(content string, mapping map[string]string) string {
+	return regex.CodeFencePath.ReplaceAllStringFunc(
+		content, func(m string) string {
+			inner := strings.Trim(m, token.Backtick)
+			if to, ok := mapping[inner]; ok {
+				return token.Backtick + to + token.Backtick
+			}
+			return m
+		},
+	)
+}
+
+// applyRenames rewrites one context file, or prints its diff in
+// dry-run mode.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - ctxDir: Context directory
+//   - name: Context file name
+//   - renames: Rewrites for the file
+//   - dryRun: Print a diff instead of writing
+//   - result: Fix summary to update (modified in place)
+func applyRenames(
+	cmd *cobra.Command, ctxDir, name string,
+	renames []rename, dryRun bool, result *Result,
+) {
+	target := filepath.Join(ctxDir, name)
+	data, readErr := ctxIo.SafeReadUserFile(target)
+	if readErr != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf(
+			desc.Text(text.DescKeyDriftFixDeadPathErr), name, readErr,
+		))
+		return
+	}
+
+	mapping := make(map[string]string, len(renames))
+	for _, r := range renames {
+		mapping[r.from] = r.to
+	}
+	before := string(data)
+	after := rewritePaths(before, mapping)
+
+	if dryRun {
+		label := path.Join(dir.Context, name)
+		writeDrift.Diff(cmd, format.Unified(label, label, before, after))
+		return
+	}
+
+	if writeErr := ctxIo.SafeWriteFile(
+		target, []byte(after), fs.PermFile,
+	); writeErr != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf(
+			desc.Text(text.DescKeyDriftFixDeadPathErr), name, writeErr,
+		))
+		return
+	}
+	for _, r := range renames {
+		writeDrift.FixDeadPath(cmd, r.from, r.to, name)
+		result.Fixed++
+	}
+}
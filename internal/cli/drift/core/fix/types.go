@@ -17,3 +17,13 @@ type Result struct {
 	Skipped int
 	Errors  []string
 }
+
+// rename is one dead path reference and its replacement.
+//
+// Fields:
+//   - from: Dead path as written in the context file
+//   - to: Path it was renamed to
+type rename struct {
+	from string
+	to   string
+}
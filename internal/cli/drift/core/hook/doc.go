@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package hook installs and removes the git pre-commit hook
+// that runs ctx drift --staged.
+//
+// # Hook Lifecycle
+//
+// [Enable] reads the pre-commit script from embedded assets
+// and writes it as the repository's pre-commit hook. The
+// script carries a ctx marker so the package can tell its
+// own hook from a user-installed one; an existing foreign
+// hook is never overwritten.
+//
+// [Disable] removes the hook only if it carries the marker.
+//
+// Hook paths are resolved with git rev-parse --git-path, so
+// core.hooksPath and linked worktrees are honored.
+package hook
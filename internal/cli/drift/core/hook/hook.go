@@ -0,0 +1,64 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hook
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeDrift "github.com/ActiveMemory/ctx/internal/write/drift"
+)
+
+// Enable installs the drift pre-commit hook.
+//
+// The declared context directory is written into the script as
+// CTX_DIR, shell-quoted, since git runs hooks without the
+// caller's environment.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: Non-nil if no context directory is declared, the
+//     script cannot be read, the git directory cannot be found,
+//     or a foreign hook is in the way
+func Enable(cmd *cobra.Command) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	script, scriptErr := render(ctxDir)
+	if scriptErr != nil {
+		return scriptErr
+	}
+	path, pathErr := filePath()
+	if pathErr != nil {
+		return pathErr
+	}
+	if installErr := install(path, script); installErr != nil {
+		return installErr
+	}
+	writeDrift.HookEnabled(cmd)
+	return nil
+}
+
+// Disable removes the drift pre-commit hook if ctx installed it.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: Non-nil if the git directory cannot be found
+func Disable(cmd *cobra.Command) error {
+	path, pathErr := filePath()
+	if pathErr != nil {
+		return pathErr
+	}
+	remove(path)
+	writeDrift.HookDisabled(cmd)
+	return nil
+}
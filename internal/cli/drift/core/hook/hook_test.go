@@ -0,0 +1,79 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hook
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRender_QuotesContextDir(t *testing.T) {
+	ctxDir := "/home/o'brien/my project/.context"
+	script, renderErr := render(ctxDir)
+	if renderErr != nil {
+		t.Fatalf("render: %v", renderErr)
+	}
+	if out, synErr := exec.Command(
+		"sh", "-n", "-c", script,
+	).CombinedOutput(); synErr != nil {
+		t.Fatalf("script does not parse: %v\n%s", synErr, out)
+	}
+
+	var assign string
+	for _, line := range strings.Split(script, "\n") {
+		if strings.HasPrefix(line, "CTX_DIR=") {
+			assign = line
+		}
+	}
+	if assign == "" {
+		t.Fatalf("no CTX_DIR assignment in:\n%s", script)
+	}
+	out, runErr := exec.Command(
+		"sh", "-c", assign+`; printf %s "$CTX_DIR"`,
+	).Output()
+	if runErr != nil {
+		t.Fatalf("sh: %v", runErr)
+	}
+	if string(out) != ctxDir {
+		t.Errorf("CTX_DIR = %q, want %q", out, ctxDir)
+	}
+}
+
+func TestFilePath_HonorsHooksPath(t *testing.T) {
+	repo := t.TempDir()
+	gitCmd := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		if out, runErr := cmd.CombinedOutput(); runErr != nil {
+			t.Fatalf("git %v: %v\n%s", args, runErr, out)
+		}
+	}
+	gitCmd("init", "-q")
+	t.Chdir(repo)
+
+	got, pathErr := filePath()
+	if pathErr != nil {
+		t.Fatalf("filePath: %v", pathErr)
+	}
+	want := filepath.Join(".git", "hooks", "pre-commit")
+	if got != want {
+		t.Errorf("default = %q, want %q", got, want)
+	}
+
+	gitCmd("config", "core.hooksPath", ".githooks")
+	got, pathErr = filePath()
+	if pathErr != nil {
+		t.Fatalf("filePath: %v", pathErr)
+	}
+	want = filepath.Join(".githooks", "pre-commit")
+	if got != want {
+		t.Errorf("hooksPath = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package hook
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	readHook "github.com/ActiveMemory/ctx/internal/assets/read/hook"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	errDrift "github.com/ActiveMemory/ctx/internal/err/drift"
+	"github.com/ActiveMemory/ctx/internal/exec/git"
+	"github.com/ActiveMemory/ctx/internal/format"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// render fills the pre-commit script template with the
+// shell-quoted context directory.
+//
+// Parameters:
+//   - ctxDir: Absolute context directory
+//
+// Returns:
+//   - string: Script content
+//   - error: Non-nil if the template cannot be read
+func render(ctxDir string) (string, error) {
+	tpl, readErr := readHook.DriftScript(cfgDrift.ScriptPreCommit)
+	if readErr != nil {
+		return "", readErr
+	}
+	return fmt.Sprintf(tpl, format.ShellQuote(ctxDir)), nil
+}
+
+// install writes the hook script, refusing to replace a
+// pre-commit hook that ctx did not install.
+//
+// Parameters:
+//   - path: Hook file path
+//   - script: Script content
+//
+// Returns:
+//   - error: Non-nil if a foreign hook exists or the write fails
+func install(path, script string) error {
+	if _, statErr := io.SafeStat(path); statErr == nil {
+		existing, readErr := io.SafeReadUserFile(path)
+		if readErr == nil && !strings.Contains(
+			string(existing), cfgDrift.HookMarker,
+		) {
+			return errDrift.HookExists(path)
+		}
+	}
+	// A core.hooksPath directory may not exist yet.
+	if mkErr := io.SafeMkdirAll(
+		filepath.Dir(path), cfgFs.PermExec,
+	); mkErr != nil {
+		return errDrift.HookWrite(mkErr)
+	}
+	if writeErr := io.SafeWriteFile(
+		path, []byte(script), cfgFs.PermExec,
+	); writeErr != nil {
+		return errDrift.HookWrite(writeErr)
+	}
+	return nil
+}
+
+// remove deletes the hook file when it carries the ctx marker.
+//
+// Parameters:
+//   - path: Hook file path
+func remove(path string) {
+	existing, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return
+	}
+	if strings.Contains(string(existing), cfgDrift.HookMarker) {
+		_ = os.Remove(path)
+	}
+}
+
+// filePath resolves the pre-commit hook path in the current
+// repository. git rev-parse --git-path honors core.hooksPath
+// and maps linked worktrees to the shared hooks directory.
+//
+// Returns:
+//   - string: Path to the pre-commit hook
+//   - error: Non-nil if git rev-parse fails
+func filePath() (string, error) {
+	out, runErr := git.Run(
+		cfgGit.RevParse, cfgGit.FlagGitPath,
+		filepath.Join(cfgGit.HooksDir, cfgGit.HookPreCommit),
+	)
+	if runErr != nil {
+		return "", errDrift.GitDir(runErr)
+	}
+	return filepath.Clean(strings.TrimSpace(string(out))), nil
+}
@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package out
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	writeDrift "github.com/ActiveMemory/ctx/internal/write/drift"
+)
+
+// ChecksText writes the drift check list as a table.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - checks: Checks to list
+//
+// Returns:
+//   - error: Always nil
+func ChecksText(cmd *cobra.Command, checks []entity.DriftCheck) error {
+	writeDrift.Checks(cmd, checks)
+	return nil
+}
+
+// ChecksJSON writes the drift check list as pretty-printed JSON.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - checks: Checks to list
+//
+// Returns:
+//   - error: Non-nil if JSON encoding fails
+func ChecksJSON(cmd *cobra.Command, checks []entity.DriftCheck) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", token.Indent2)
+	return enc.Encode(checks)
+}
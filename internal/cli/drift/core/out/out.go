@@ -90,6 +90,13 @@ func DriftText(
 		if len(pathRefs) > 0 {
 			items := make([]string, len(pathRefs))
 			for i, w := range pathRefs {
+				if w.Layer != "" {
+					items[i] = fmt.Sprintf(
+						desc.Text(text.DescKeyDriftPathRefLayerLine),
+						w.File, w.Layer, w.Path,
+					)
+					continue
+				}
 				items[i] = fmt.Sprintf(
 					desc.Text(text.DescKeyDriftPathRefLine),
 					w.File, w.Line, w.Path,
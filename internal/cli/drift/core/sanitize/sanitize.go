@@ -37,6 +37,16 @@ func FormatCheckName(name cfgDrift.CheckName) string {
 		return desc.Text(
 			text.DescKeyDriftCheckTemplateHeader,
 		)
+	case cfgDrift.CheckLayers:
+		return desc.Text(text.DescKeyDriftCheckLayers)
+	case cfgDrift.CheckSymbols:
+		return desc.Text(text.DescKeyDriftCheckSymbols)
+	case cfgDrift.CheckSecretContent:
+		return desc.Text(text.DescKeyDriftCheckSecretContent)
+	case cfgDrift.CheckSecretArchive:
+		return desc.Text(text.DescKeyDriftCheckSecretArchive)
+	case cfgDrift.CheckConstitutionRules:
+		return desc.Text(text.DescKeyDriftCheckConstitutionRules)
 	default:
 		return name
 	}
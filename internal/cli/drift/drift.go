@@ -9,6 +9,7 @@ package drift
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/drift/cmd/hook"
 	driftRoot "github.com/ActiveMemory/ctx/internal/cli/drift/cmd/root"
 )
 
@@ -17,5 +18,7 @@ import (
 // Returns:
 //   - *cobra.Command: The drift command with subcommands registered
 func Cmd() *cobra.Command {
-	return driftRoot.Cmd()
+	c := driftRoot.Cmd()
+	c.AddCommand(hook.Cmd())
+	return c
 }
@@ -9,6 +9,7 @@ package drift
 import (
 	"bytes"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -281,3 +282,199 @@ func TestRunDrift_GenericError(t *testing.T) {
 		t.Fatal("expected error when .context is a file")
 	}
 }
+
+// setupRenamedPaths commits a project with two context path
+// references, then renames their targets: internal/a/old.go once,
+// docs/guide.md twice (to manual.md, and again after it was
+// recreated, to handbook.md).
+func setupRenamedPaths(t *testing.T) (string, func()) {
+	t.Helper()
+	tmpDir, cleanup := setupContextDir(t)
+	gitRun := func(args ...string) {
+		t.Helper()
+		full := append([]string{
+			"-c", "user.email=t@example.com", "-c", "user.name=t",
+		}, args...)
+		c := exec.Command("git", full...) //nolint:gosec // test input
+		c.Dir = tmpDir
+		if out, runErr := c.CombinedOutput(); runErr != nil {
+			t.Fatalf("git %v: %v\n%s", args, runErr, out)
+		}
+	}
+	write := func(rel, content string) {
+		t.Helper()
+		p := filepath.Join(tmpDir, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(filepath.Join("internal", "a", "old.go"), "package a\n")
+	write(filepath.Join("docs", "guide.md"), "# Guide\n")
+	archPath := filepath.Join(dir.Context, ctx.Architecture)
+	arch, readErr := os.ReadFile(filepath.Join(tmpDir, archPath))
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	write(archPath, string(arch)+"\n- `internal/a/old.go` is the core.\n"+
+		"- `docs/guide.md` is the guide.\n")
+
+	gitRun("init", "-q")
+	gitRun("add", "-A")
+	gitRun("commit", "-qm", "init")
+	gitRun("mv", "internal/a/old.go", "internal/a/new.go")
+	gitRun("mv", "docs/guide.md", "docs/manual.md")
+	gitRun("commit", "-qm", "rename")
+	write(filepath.Join("docs", "guide.md"), "# Guide v2\n")
+	gitRun("add", "-A")
+	gitRun("commit", "-qm", "recreate")
+	gitRun("mv", "docs/guide.md", "docs/handbook.md")
+	gitRun("commit", "-qm", "rename again")
+	return filepath.Join(tmpDir, archPath), cleanup
+}
+
+func TestRunDrift_DryRunRenamedPaths(t *testing.T) {
+	archPath, cleanup := setupRenamedPaths(t)
+	defer cleanup()
+	before, _ := os.ReadFile(archPath)
+
+	cmd := Cmd()
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"--dry-run"})
+	_ = cmd.Execute()
+
+	out := buf.String()
+	for _, want := range []string{
+		"--- .context/ARCHITECTURE.md",
+		"-- `internal/a/old.go` is the core.",
+		"+- `internal/a/new.go` is the core.",
+		"docs/manual.md, docs/handbook.md",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	after, _ := os.ReadFile(archPath)
+	if !bytes.Equal(before, after) {
+		t.Error("--dry-run modified ARCHITECTURE.md")
+	}
+}
+
+func TestRunDrift_FixRenamedPathsChoice(t *testing.T) {
+	archPath, cleanup := setupRenamedPaths(t)
+	defer cleanup()
+
+	cmd := Cmd()
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetIn(strings.NewReader("2\n"))
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"--fix"})
+	_ = cmd.Execute()
+
+	after, readErr := os.ReadFile(archPath)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	content := string(after)
+	if !strings.Contains(content, "`internal/a/new.go` is the core.") ||
+		!strings.Contains(content, "`docs/handbook.md` is the guide.") {
+		t.Errorf("references not rewritten:\n%s", content)
+	}
+	if !strings.Contains(buf.String(), "1) docs/manual.md") {
+		t.Errorf("expected numbered candidates, got:\n%s", buf.String())
+	}
+}
+
+func TestRunDrift_StagedAndHook(t *testing.T) {
+	tmpDir, cleanup := setupContextDir(t)
+	defer cleanup()
+	gitRun := func(args ...string) {
+		t.Helper()
+		full := append([]string{
+			"-c", "user.email=t@example.com", "-c", "user.name=t",
+		}, args...)
+		c := exec.Command("git", full...) //nolint:gosec // test input
+		c.Dir = tmpDir
+		if out, runErr := c.CombinedOutput(); runErr != nil {
+			t.Fatalf("git %v: %v\n%s", args, runErr, out)
+		}
+	}
+	run := func(args ...string) (string, error) {
+		cmd := Cmd()
+		buf := &bytes.Buffer{}
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SilenceErrors = true
+		cmd.SetArgs(args)
+		runErr := cmd.Execute()
+		return buf.String(), runErr
+	}
+
+	constPath := filepath.Join(tmpDir, dir.Context, ctx.Constitution)
+	orig, readErr := os.ReadFile(constPath)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	rules := "\n```ctx-rules\nforbidden_paths: [\"*.pem\"]\n```\n"
+	if err := os.WriteFile(
+		constPath, append(orig, rules...), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	gitRun("init", "-q")
+	gitRun("add", "-A")
+	gitRun("commit", "-qm", "init")
+
+	if out, err := run("--staged"); err != nil {
+		t.Fatalf("clean index should pass: %v\n%s", err, out)
+	}
+
+	pem := filepath.Join(tmpDir, "server.pem")
+	if err := os.WriteFile(pem, []byte("x\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	gitRun("add", "server.pem")
+	out, err := run("--staged")
+	if err == nil {
+		t.Fatalf("expected violation error, got:\n%s", out)
+	}
+	if !strings.Contains(out, "server.pem") ||
+		!strings.Contains(out, "forbidden_path") {
+		t.Errorf("output missing rule violation:\n%s", out)
+	}
+	if strings.Contains(out, "Usage:") {
+		t.Errorf("blocked commit printed usage:\n%s", out)
+	}
+
+	hookPath := filepath.Join(tmpDir, ".git", "hooks", "pre-commit")
+	if _, err := run("hook", "enable"); err != nil {
+		t.Fatalf("hook enable: %v", err)
+	}
+	script, hookErr := os.ReadFile(hookPath)
+	if hookErr != nil || !strings.Contains(string(script), "ctx drift --staged") {
+		t.Fatalf("pre-commit hook not installed: %v\n%s", hookErr, script)
+	}
+	ctxDir, _ := rc.ContextDir()
+	if !strings.Contains(string(script), "CTX_DIR='"+ctxDir+"'") {
+		t.Errorf("hook does not pin CTX_DIR to %s:\n%s", ctxDir, script)
+	}
+	if _, err := run("hook", "disable"); err != nil {
+		t.Fatalf("hook disable: %v", err)
+	}
+	if _, statErr := os.Stat(hookPath); !os.IsNotExist(statErr) {
+		t.Error("pre-commit hook not removed")
+	}
+	if _, err := run("hook", "bogus"); err == nil {
+		t.Error("unknown hook action should fail")
+	}
+}
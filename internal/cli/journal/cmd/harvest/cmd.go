@@ -0,0 +1,69 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package harvest
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/review"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/config/harvest"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the journal harvest subcommand.
+//
+// Returns:
+//   - *cobra.Command: Command for harvesting context entries
+//     from a session
+func Cmd() *cobra.Command {
+	var opts review.Opts
+
+	short, long := desc.Command(cmd.DescKeyJournalHarvest)
+
+	c := &cobra.Command{
+		Use:     cmd.UseJournalHarvest,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyJournalHarvest),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args, opts)
+		},
+	}
+
+	flagbind.BindBoolFlags(c,
+		[]*bool{&opts.Latest, &opts.AllProjects, &opts.DryRun},
+		[]string{cFlag.Latest, cFlag.AllProjects, cFlag.DryRun},
+		[]string{
+			flag.DescKeyJournalHarvestLatest,
+			flag.DescKeyJournalHarvestAllProjects,
+			flag.DescKeyJournalHarvestDryRun,
+		},
+	)
+	flagbind.BoolFlagP(
+		c, &opts.Yes, cFlag.Yes, cFlag.ShortYes,
+		flag.DescKeyJournalHarvestYes,
+	)
+	flagbind.StringFlagDefault(
+		c, &opts.Section, cFlag.Section, harvest.DefaultSection,
+		flag.DescKeyJournalHarvestSection,
+	)
+	flagbind.BindStringFlags(c,
+		[]*string{&opts.Branch, &opts.Commit},
+		[]string{cFlag.Branch, cFlag.Commit},
+		[]string{
+			flag.DescKeyJournalHarvestBranch,
+			flag.DescKeyJournalHarvestCommit,
+		},
+	)
+
+	return c
+}
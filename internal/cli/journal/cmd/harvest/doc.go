@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package harvest implements the "ctx journal harvest" command.
+//
+// # Overview
+//
+// Knowledge from a session only reaches DECISIONS.md and
+// LEARNINGS.md when the agent remembers to run ctx add.
+// Harvest closes the gap after the fact: it proposes
+// decisions, learnings and tasks found in a session
+// transcript and adds the ones the user accepts.
+//
+// # Flags
+//
+//	--latest        Harvest the most recent session
+//	--all-projects  Search sessions from every project
+//	--dry-run       List candidates; prompt and write nothing
+//	-y, --yes       Accept every candidate without prompting
+//	--section       TASKS.md section for tasks (default Harvested)
+//	--branch        Provenance branch (default: the session's)
+//	--commit        Provenance commit (default: HEAD)
+//
+// # Behavior
+//
+// [Run] resolves the session like "ctx journal source --show",
+// extracts candidates with internal/journal/harvest, drops the
+// ones already recorded, fills in provenance, and hands the
+// rest to the review loop in core/review, which writes accepted
+// entries through the ctx add path.
+package harvest
@@ -0,0 +1,102 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package harvest
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/review"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/source"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/entry"
+	journalHarvest "github.com/ActiveMemory/ctx/internal/journal/harvest"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/trace"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Run executes the journal harvest command.
+//
+// Parameters:
+//   - cmd: Cobra command for prompts and output
+//   - args: Session ID prefix or slug fragment (optional with
+//     --latest)
+//   - opts: Harvest flags
+//
+// Returns:
+//   - error: Non-nil if the session cannot be resolved or an
+//     accepted entry cannot be written
+func Run(cmd *cobra.Command, args []string, opts review.Opts) error {
+	session, selectErr := source.Select(
+		cmd, args, opts.Latest, opts.AllProjects,
+	)
+	if selectErr != nil {
+		return selectErr
+	}
+	cmd.SilenceUsage = true
+	contextDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+
+	candidates, known := journalHarvest.Fresh(
+		contextDir, journalHarvest.Extract(session),
+	)
+	name := session.Slug
+	if name == "" {
+		name = session.ID
+	}
+	if len(candidates) == 0 {
+		writeRecall.HarvestNone(cmd, name, known)
+		return nil
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch = session.GitBranch
+	}
+	commit := opts.Commit
+	if commit == "" {
+		// Outside a git repository there is no HEAD; validation
+		// reports the missing --commit if the project requires it.
+		if head, headErr := trace.ResolveCommitHash(
+			cfgGit.RefHead,
+		); headErr == nil {
+			commit = trace.ShortHash(head)
+		}
+	}
+	for i := range candidates {
+		p := &candidates[i].Params
+		p.SessionID = session.ID
+		p.Branch = branch
+		p.Commit = commit
+		if p.Type == cfgEntry.Task && p.Section == "" {
+			p.Section = opts.Section
+		}
+	}
+
+	if opts.DryRun {
+		for i, c := range candidates {
+			writeRecall.HarvestCandidate(cmd, i+1, len(candidates), c)
+		}
+		writeRecall.HarvestDryRun(cmd, len(candidates))
+		return nil
+	}
+
+	// Fail before the first prompt, not after the first answer,
+	// when provenance the project requires is missing.
+	for _, c := range candidates {
+		if vErr := entry.Validate(c.Params, nil); vErr != nil {
+			return vErr
+		}
+	}
+
+	added, rejected, reviewErr := review.Review(cmd, candidates, opts.Yes)
+	writeRecall.HarvestSummary(cmd, added, rejected, known)
+	return reviewErr
+}
@@ -24,11 +24,13 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	"github.com/ActiveMemory/ctx/internal/config/journal"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
 	errJournal "github.com/ActiveMemory/ctx/internal/err/journal"
 	errSession "github.com/ActiveMemory/ctx/internal/err/session"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/journal/redact"
 	"github.com/ActiveMemory/ctx/internal/journal/schema"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
 	"github.com/ActiveMemory/ctx/internal/rc"
@@ -97,6 +99,19 @@ func Run(cmd *cobra.Command, args []string, opts entity.ImportOpts) error {
 		singleSession = true
 	}
 
+	if singleSession {
+		if loadErr := query.LoadSession(toImport[0]); loadErr != nil {
+			return errSession.Find(loadErr)
+		}
+	} else {
+		toImport = query.LoadMessages(toImport)
+	}
+	if rc.RedactMode() != cfgRedact.ModeOff {
+		for _, s := range toImport {
+			redact.Session(s)
+		}
+	}
+
 	// 4. Ensure journal directory exists.
 	ctxDir, ctxErr := rc.RequireContextDir()
 	if ctxErr != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgObsidian "github.com/ActiveMemory/ctx/internal/config/obsidian"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
 )
 
 func TestRunJournalObsidianIntegration(t *testing.T) {
@@ -202,3 +203,56 @@ func assertFileExists(t *testing.T, path string) {
 		t.Errorf("expected file to exist: %s", path)
 	}
 }
+
+func TestRunJournalObsidian_RedactRequired(t *testing.T) {
+	tmpDir := t.TempDir()
+	journalDir := filepath.Join(tmpDir, dir.Context, dir.Journal)
+	if mkErr := os.MkdirAll(journalDir, fs.PermExec); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if wErr := os.WriteFile(
+		filepath.Join(tmpDir, ".ctxrc"), []byte("redact: required\n"),
+		fs.PermFile,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+	testctx.Declare(t, tmpDir)
+
+	entries := map[string]string{
+		"2026-02-14-clean-abc12345.md": "---\ntitle: \"Clean\"\n" +
+			"redacted: true\n---\n\n# Clean\n",
+		"2026-02-13-raw-def67890.md": "---\ntitle: \"Raw\"\n---\n\n# Raw\n",
+	}
+	for name, content := range entries {
+		if writeErr := os.WriteFile(
+			filepath.Join(journalDir, name), []byte(content), fs.PermFile,
+		); writeErr != nil {
+			t.Fatal(writeErr)
+		}
+	}
+
+	outputDir := filepath.Join(tmpDir, "vault-output")
+	out := &strings.Builder{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+
+	if buildErr := coreObsidian.BuildVault(
+		cmd, journalDir, outputDir,
+	); buildErr != nil {
+		t.Fatalf("BuildVault failed: %v", buildErr)
+	}
+
+	assertFileExists(t, filepath.Join(
+		outputDir, cfgObsidian.DirEntries, "2026-02-14-clean-abc12345.md",
+	))
+	refused := filepath.Join(
+		outputDir, cfgObsidian.DirEntries, "2026-02-13-raw-def67890.md",
+	)
+	if _, statErr := os.Stat(refused); !os.IsNotExist(statErr) {
+		t.Errorf("unredacted entry exported: %s", refused)
+	}
+	if !strings.Contains(out.String(), "2026-02-13-raw-def67890.md") {
+		t.Errorf("refusal not reported:\n%s", out.String())
+	}
+}
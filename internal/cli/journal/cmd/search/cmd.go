@@ -0,0 +1,57 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the journal search subcommand.
+//
+// Returns:
+//   - *cobra.Command: Command for searching journal entries
+func Cmd() *cobra.Command {
+	var (
+		limit      int
+		context    int
+		jsonOutput bool
+	)
+
+	short, long := desc.Command(cmd.DescKeyJournalSearch)
+
+	c := &cobra.Command{
+		Use:     cmd.UseJournalSearch,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyJournalSearch),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args, limit, context, jsonOutput)
+		},
+	}
+
+	flagbind.IntFlag(
+		c, &limit, cFlag.Limit, cfgSearch.DefaultLimit,
+		flag.DescKeyJournalSearchLimit,
+	)
+	flagbind.IntFlag(
+		c, &context, cFlag.Context, cfgSearch.DefaultContext,
+		flag.DescKeyJournalSearchContext,
+	)
+	flagbind.BoolFlag(
+		c, &jsonOutput, cFlag.JSON, flag.DescKeyJournalSearchJSON,
+	)
+
+	return c
+}
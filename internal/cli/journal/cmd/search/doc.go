@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search implements the "ctx journal search" command.
+//
+// # Overview
+//
+// The search command finds journal turns that match a query
+// and prints them ranked, each with the matching line and a
+// few lines of context.
+//
+// # Query Syntax
+//
+// Plain words must all appear in the same turn. Quoted text
+// must appear as a phrase. Field filters narrow the sessions:
+// tool:, branch:, model:, since: (YYYY-MM-DD or Nd), file:
+// (a path read or edited in a tool use), and #tag for topics.
+// A query of filters alone lists the matching sessions, newest
+// first.
+//
+// # Flags
+//
+//	--limit    Maximum results (default 10, 0 for all)
+//	--context  Lines of context around a match (default 2)
+//	--json     Print results as JSON
+//
+// # Index
+//
+// [Run] delegates to internal/journal/search, which refreshes the
+// incremental index in .context/state/ before querying.
+package search
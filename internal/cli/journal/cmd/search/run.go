@@ -0,0 +1,56 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	journalSearch "github.com/ActiveMemory/ctx/internal/journal/search"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Run executes the journal search command.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: Query words, joined with spaces
+//   - limit: Maximum results (0 for all)
+//   - context: Lines of context around each matching line
+//   - jsonOutput: Print results as JSON
+//
+// Returns:
+//   - error: Non-nil on an invalid query or unreadable journal
+func Run(
+	cmd *cobra.Command, args []string,
+	limit, context int, jsonOutput bool,
+) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	journalDir := filepath.Join(ctxDir, dir.Journal)
+
+	hits, findErr := journalSearch.Find(
+		journalDir, strings.Join(args, token.Space), limit, context,
+	)
+	if findErr != nil {
+		cmd.SilenceUsage = true
+		return findErr
+	}
+	if jsonOutput {
+		return writeRecall.SearchJSON(cmd, hits)
+	}
+	writeRecall.SearchHits(cmd, hits)
+	return nil
+}
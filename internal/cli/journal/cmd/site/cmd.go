@@ -34,6 +34,7 @@ func Cmd() *cobra.Command {
 		output string
 		serve  bool
 		build  bool
+		stats  bool
 	)
 
 	short, long := desc.Command(cmd.DescKeyJournalSite)
@@ -43,7 +44,7 @@ func Cmd() *cobra.Command {
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyJournalSite),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Run(cmd, output, build, serve)
+			return Run(cmd, output, build, serve, stats)
 		},
 	}
 
@@ -53,6 +54,7 @@ func Cmd() *cobra.Command {
 	)
 	flagbind.BoolFlag(c, &build, cFlag.Build, flag.DescKeyJournalSiteBuild)
 	flagbind.BoolFlag(c, &serve, cFlag.Serve, flag.DescKeyJournalSiteServe)
+	flagbind.BoolFlag(c, &stats, cFlag.Stats, flag.DescKeyJournalSiteStats)
 
 	return c
 }
@@ -12,8 +12,9 @@
 // # Public Surface
 //
 //   - **[Cmd]**: cobra command with `--build` (also
-//     run zensical) and `--output` (override the
-//     destination directory).
+//     run zensical), `--output` (override the
+//     destination directory), and `--stats` (add the
+//     session analytics page).
 //   - **[Run]**: orchestrates the full generation;
 //     parse entries (parse), normalize each (normalize),
 //     build month-grouped pages and topic indexes
@@ -26,6 +27,8 @@
 //   - `<output>/README.md`: zensical config
 //   - `<output>/index.md`: chronological index
 //   - `<output>/topics/index.md`: topic overview MOC
+//   - `<output>/docs/stats.md`: session analytics
+//     (with `--stats`)
 //   - `<output>/topics/<slug>.md`: per-topic pages
 //   - `<output>/<YYYY>/<MM>/<slug>.md`: entries
 //
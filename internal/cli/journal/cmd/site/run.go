@@ -19,19 +19,24 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/generate"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/normalize"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/redacted"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/reduce"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/section"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/turn"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
 	"github.com/ActiveMemory/ctx/internal/config/zensical"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
 	"github.com/ActiveMemory/ctx/internal/err/journal"
+	errSession "github.com/ActiveMemory/ctx/internal/err/session"
 	execZensical "github.com/ActiveMemory/ctx/internal/exec/zensical"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
+	journalStats "github.com/ActiveMemory/ctx/internal/journal/stats"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	"github.com/ActiveMemory/ctx/internal/wrap"
 	"github.com/ActiveMemory/ctx/internal/write/err"
@@ -48,11 +53,12 @@ import (
 //   - output: Output directory for the generated site
 //   - build: If true, run zensical build after generating
 //   - serve: If true, run zensical serve after generating
+//   - stats: If true, add the session analytics page
 //
 // Returns:
 //   - error: Non-nil if generation fails
 func Run(
-	cmd *cobra.Command, output string, build, serve bool,
+	cmd *cobra.Command, output string, build, serve, stats bool,
 ) error {
 	ctxDir, ctxErr := rc.RequireContextDir()
 	if ctxErr != nil {
@@ -80,6 +86,7 @@ func Run(
 	if scanErr != nil {
 		return journal.Scan(scanErr)
 	}
+	entries = redacted.Filter(cmd, entries)
 
 	if len(entries) == 0 {
 		return journal.NoEntries(journalDir)
@@ -162,9 +169,29 @@ func Run(
 		}
 	}
 
+	// Generate the optional analytics page from the raw sessions
+	if stats {
+		sessions, findErr := query.FindSessions(false)
+		if findErr != nil {
+			return errSession.Find(findErr)
+		}
+		sessions = query.LoadMessages(sessions)
+		statsPath := filepath.Join(docsDir, cfgStats.FileJournalPage)
+		page := generate.StatsPage(
+			journalStats.Compute(sessions, cfgStats.ByWeek),
+			journalStats.Compute(sessions, cfgStats.ByModel),
+		)
+		if writeErr := ctxIo.SafeWriteFile(
+			statsPath, []byte(page), fs.PermFile,
+		); writeErr != nil {
+			return errFs.FileWrite(statsPath, writeErr)
+		}
+	}
+
 	// Remove orphan site files: entries whose source was renamed or deleted.
-	knownFiles := make(map[string]bool, len(entries)+1)
+	knownFiles := make(map[string]bool, len(entries)+2)
 	knownFiles[file.Index] = true
+	knownFiles[cfgStats.FileJournalPage] = stats
 	for _, e := range entries {
 		knownFiles[e.Filename] = true
 	}
@@ -295,7 +322,7 @@ func Run(
 
 	// Generate zensical.toml
 	tomlContent := generate.ZensicalToml(
-		entries, topics, keyFiles, sessionTypes,
+		entries, topics, keyFiles, sessionTypes, stats,
 	)
 	tomlPath := filepath.Join(output, zensical.Toml)
 	if writeErr := ctxIo.SafeWriteFile(
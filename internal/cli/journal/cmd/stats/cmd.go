@@ -0,0 +1,68 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/config/fmt"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the journal stats subcommand.
+//
+// Returns:
+//   - *cobra.Command: Command for session cost and usage analytics
+func Cmd() *cobra.Command {
+	var (
+		by          string
+		format      string
+		since       string
+		until       string
+		allProjects bool
+	)
+
+	short, long := desc.Command(cmd.DescKeyJournalStats)
+
+	c := &cobra.Command{
+		Use:     cmd.UseJournalStats,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyJournalStats),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, by, format, since, until, allProjects)
+		},
+	}
+
+	flagbind.StringFlagDefault(
+		c, &by, cFlag.By, cfgStats.ByDay, flag.DescKeyJournalStatsBy,
+	)
+	flagbind.StringFlagDefault(
+		c, &format, cFlag.Format, fmt.FormatTable,
+		flag.DescKeyJournalStatsFormat,
+	)
+	flagbind.BindStringFlags(c,
+		[]*string{&since, &until},
+		[]string{cFlag.Since, cFlag.Until},
+		[]string{
+			flag.DescKeyJournalStatsSince,
+			flag.DescKeyJournalStatsUntil,
+		},
+	)
+	flagbind.BoolFlag(
+		c, &allProjects, cFlag.AllProjects,
+		flag.DescKeyJournalStatsAllProjects,
+	)
+
+	return c
+}
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package stats implements the "ctx journal stats" command.
+//
+// # Overview
+//
+// The stats command answers "what do our agent sessions
+// cost?". It scans the same raw session sources as
+// "ctx journal source" and reports, per group, sessions,
+// turns, input and output tokens, estimated spend, error
+// rate, and average session length, followed by a totals
+// row and the most-used tools.
+//
+// # Flags
+//
+//	--by            day (default), week, branch, model, tool
+//	--format        table (default), json, csv
+//	--since         Keep sessions on or after YYYY-MM-DD
+//	--until         Keep sessions on or before YYYY-MM-DD
+//	--all-projects  Scan sessions from every project
+//
+// # Pricing
+//
+// Spend uses the prices table in .ctxrc (USD per million
+// tokens, keyed by model ID prefix). Sessions whose model has
+// no entry are reported as unpriced instead of free.
+//
+// # Behavior
+//
+// [Run] validates the flags, filters sessions by local start
+// date, aggregates them with internal/journal/stats, and
+// prints the report in the chosen format.
+package stats
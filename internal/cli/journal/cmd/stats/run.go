@@ -0,0 +1,93 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
+	"github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgFmt "github.com/ActiveMemory/ctx/internal/config/fmt"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/err/date"
+	errJournal "github.com/ActiveMemory/ctx/internal/err/journal"
+	errSession "github.com/ActiveMemory/ctx/internal/err/session"
+	"github.com/ActiveMemory/ctx/internal/journal/stats"
+	"github.com/ActiveMemory/ctx/internal/parse"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Run executes the journal stats command.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - by: Grouping dimension (day, week, branch, model, tool)
+//   - format: Output format (table, json, csv)
+//   - since: Keep sessions starting on or after this date
+//   - until: Keep sessions starting on or before this date
+//   - allProjects: Scan sessions from every project
+//
+// Returns:
+//   - error: Non-nil on invalid flags or a failed session scan
+func Run(
+	cmd *cobra.Command, by, format, since, until string,
+	allProjects bool,
+) error {
+	if !slices.Contains(cfgStats.By, by) {
+		return errJournal.StatsBy(
+			by, strings.Join(cfgStats.By, token.CommaSpace),
+		)
+	}
+	if !slices.Contains(cfgStats.Formats, format) {
+		return errJournal.StatsFormat(
+			format, strings.Join(cfgStats.Formats, token.CommaSpace),
+		)
+	}
+	if _, sinceErr := parse.Date(since); sinceErr != nil {
+		return date.Invalid(flag.PrefixLong+flag.Since, since, sinceErr)
+	}
+	if _, untilErr := parse.Date(until); untilErr != nil {
+		return date.Invalid(flag.PrefixLong+flag.Until, until, untilErr)
+	}
+
+	sessions, scanErr := query.FindSessions(allProjects)
+	if scanErr != nil {
+		return errSession.Find(scanErr)
+	}
+	var kept []*entity.Session
+	for _, s := range sessions {
+		day := s.StartTime.Local().Format(cfgTime.DateFormat)
+		if (since != "" && day < since) || (until != "" && day > until) {
+			continue
+		}
+		kept = append(kept, s)
+	}
+	if len(kept) == 0 && format == cfgFmt.FormatTable {
+		writeRecall.NoSessionsWithHint(cmd, allProjects)
+		return nil
+	}
+
+	// The session index carries metadata only; tool usage is
+	// counted from the messages.
+	kept = query.LoadMessages(kept)
+	report := stats.Compute(kept, by)
+	switch format {
+	case cfgFmt.FormatJSON:
+		return writeRecall.StatsJSON(cmd, report)
+	case cfgFmt.FormatCSV:
+		return writeRecall.StatsCSV(cmd, report)
+	default:
+		writeRecall.StatsTable(cmd, report)
+		return nil
+	}
+}
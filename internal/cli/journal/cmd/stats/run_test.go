@@ -0,0 +1,78 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// rollout is a minimal Codex session with two tool calls.
+var rollout = strings.Join([]string{
+	`{"timestamp":"2026-03-01T10:00:00.000Z","type":"session_meta",` +
+		`"payload":{"id":"7f3a2c10-1111-4e2a-9b1c-2d3e4f5a6b7c",` +
+		`"timestamp":"2026-03-01T10:00:00.000Z","cwd":"/home/dev/app"}}`,
+	`{"timestamp":"2026-03-01T10:00:01.000Z","type":"response_item",` +
+		`"payload":{"type":"message","role":"user","content":` +
+		`[{"type":"input_text","text":"Why does the build fail?"}]}}`,
+	`{"timestamp":"2026-03-01T10:00:02.000Z","type":"response_item",` +
+		`"payload":{"type":"function_call","name":"shell",` +
+		`"arguments":"{}","call_id":"call_1"}}`,
+	`{"timestamp":"2026-03-01T10:00:03.000Z","type":"response_item",` +
+		`"payload":{"type":"function_call","name":"shell",` +
+		`"arguments":"{}","call_id":"call_2"}}`,
+	`{"timestamp":"2026-03-01T10:00:04.000Z","type":"response_item",` +
+		`"payload":{"type":"message","role":"assistant","content":` +
+		`[{"type":"output_text","text":"Fixed."}]}}`,
+}, "\n") + "\n"
+
+func TestRun_CountsToolsFromIndexedSessions(t *testing.T) {
+	root := t.TempDir()
+	ctxDir := testctx.Declare(t, root)
+	if mkErr := os.MkdirAll(ctxDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	codexHome := filepath.Join(root, "codex")
+	t.Setenv("CODEX_HOME", codexHome)
+	day := filepath.Join(codexHome, "sessions", "2026", "03", "01")
+	if mkErr := os.MkdirAll(day, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if wErr := os.WriteFile(
+		filepath.Join(day, "rollout-2026-03-01T10-00-00-7f3a.jsonl"),
+		[]byte(rollout), 0o600,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+
+	// Both runs go through the session index, which stores
+	// metadata only; the second is served from its cache.
+	for run := range 2 {
+		cmd := Cmd()
+		buf := &bytes.Buffer{}
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{"--all-projects", "--format", "json"})
+		if execErr := cmd.Execute(); execErr != nil {
+			t.Fatalf("run %d: %v", run, execErr)
+		}
+		var report entity.JournalStats
+		if jsonErr := json.Unmarshal(buf.Bytes(), &report); jsonErr != nil {
+			t.Fatalf("run %d: decode: %v\n%s", run, jsonErr, buf)
+		}
+		if len(report.Tools) != 1 || report.Tools[0].Name != "shell" ||
+			report.Tools[0].Count != 2 {
+			t.Errorf("run %d: top_tools = %+v, want shell x2", run, report.Tools)
+		}
+	}
+}
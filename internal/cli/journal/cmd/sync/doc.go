@@ -34,12 +34,14 @@
 //  5. When frontmatter says unlocked but state says
 //     locked, clears the lock in state.
 //  6. Saves the updated .state.json.
+//  7. Re-indexes changed entries for "ctx journal search".
 //
 // # Output
 //
 // Prints one line per state change (locked or unlocked)
 // with the affected filename. Ends with a summary line
-// showing total locked and unlocked counts. If no
+// showing total locked and unlocked counts, then a search
+// index line when entries were re-indexed. If no
 // journal files are found, prints a "nothing to sync"
 // message.
 package sync
@@ -15,13 +15,14 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/dir"
 	"github.com/ActiveMemory/ctx/internal/config/journal"
 	errJournal "github.com/ActiveMemory/ctx/internal/err/journal"
+	"github.com/ActiveMemory/ctx/internal/journal/search"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
 )
 
-// Run scans all journal markdowns and syncs frontmatter lock state
-// to .state.json.
+// Run scans all journal markdowns, syncs frontmatter lock state
+// to .state.json, and refreshes the search index.
 //
 // Parameters:
 //   - cmd: Cobra command for output
@@ -75,5 +76,11 @@ func Run(cmd *cobra.Command) error {
 
 	writeRecall.SyncSummary(cmd, locked, unlocked)
 
+	updated, removed, indexErr := search.Update(journalDir)
+	if indexErr != nil {
+		return indexErr
+	}
+	writeRecall.SearchIndex(cmd, updated, removed)
+
 	return nil
 }
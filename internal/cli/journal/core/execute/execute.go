@@ -22,6 +22,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/journal/state"
+	"github.com/ActiveMemory/ctx/internal/secret"
 	"github.com/ActiveMemory/ctx/internal/write/err"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
 )
@@ -68,6 +69,19 @@ func Import(
 			token.Ellipsis,
 		)
 
+		// Strip anything that looks like a credential before it
+		// lands in the journal. This runs whatever the redact mode:
+		// with the pipeline on, secrets are already placeholders
+		// and nothing is left to find; with redact: off, this is
+		// the only thing between a pasted token and the journal.
+		content, findings := secret.Redact(content)
+		if len(findings) > 0 {
+			writeRecall.SecretsRedacted(cmd, fa.Filename, len(findings))
+		}
+		if fa.Part == 1 {
+			writeRecall.Redactions(cmd, fa.Filename, fa.Session.Redactions)
+		}
+
 		fileExists := fa.Action == entity.ActionRegenerate
 
 		// Preserve enriched YAML frontmatter from the existing file.
@@ -76,6 +90,11 @@ func Import(
 			existing, readErr := io.SafeReadUserFile(filepath.Clean(fa.Path))
 			if readErr == nil {
 				if fm := extract.Frontmatter(string(existing)); fm != "" {
+					// The redaction report always reflects this import.
+					fm = extract.ReplaceKeys(
+						fm, extract.Frontmatter(content),
+						session.FmKeyRedacted, session.FmKeyRedactions,
+					)
 					content = fm + token.NewlineLF + extract.StripFrontmatter(content)
 				}
 			}
@@ -0,0 +1,110 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package execute
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/journal/redact"
+	"github.com/ActiveMemory/ctx/internal/journal/state"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// ghToken is assembled at run time so the source file itself
+// does not trip secret scanners.
+var ghToken = "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+
+// importOne writes a single-part session and returns the file.
+func importOne(t *testing.T, s *entity.Session) string {
+	t.Helper()
+	journalDir := t.TempDir()
+	jstate, loadErr := state.Load(journalDir)
+	if loadErr != nil {
+		t.Fatal(loadErr)
+	}
+	path := filepath.Join(journalDir, "entry.md")
+	plan := entity.ImportPlan{Actions: []entity.FileAction{{
+		Session: s, Filename: "entry.md", Path: path,
+		Part: 1, TotalParts: 1, EndIdx: len(s.Messages),
+		Action: entity.ActionNew, Messages: s.Messages,
+		BaseName: "entry",
+	}}}
+	cmd := &cobra.Command{}
+	cmd.SetOut(&bytes.Buffer{})
+	Import(cmd, plan, jstate, entity.ImportOpts{})
+	data, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	return string(data)
+}
+
+// newSession returns a one-message session quoting a token.
+func newSession() *entity.Session {
+	start := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	return &entity.Session{
+		ID: "s1", Slug: "s1", Tool: "claude-code",
+		StartTime: start, EndTime: start.Add(time.Minute),
+		Messages: []entity.Message{{
+			Role: "user", Text: "token " + ghToken, Timestamp: start,
+		}},
+	}
+}
+
+func TestImport_SinglePipeline(t *testing.T) {
+	testctx.Declare(t, t.TempDir())
+	s := newSession()
+	redact.Session(s)
+
+	got := importOne(t, s)
+	if strings.Contains(got, ghToken) || strings.Contains(got, "[REDACTED") {
+		t.Errorf("want only pipeline placeholders:\n%s", got)
+	}
+	if !strings.Contains(got, "token <SECRET_1>") ||
+		!strings.Contains(got, `placeholder: "<SECRET_1>"`) {
+		t.Errorf("secret missing from body or report:\n%s", got)
+	}
+}
+
+func TestImport_RedactOffStillScrubsSecrets(t *testing.T) {
+	tmpDir := t.TempDir()
+	if wErr := os.WriteFile(
+		filepath.Join(tmpDir, ".ctxrc"), []byte("redact: off\n"),
+		fs.PermFile,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+	t.Chdir(tmpDir)
+	testctx.Declare(t, tmpDir)
+	if rc.RedactMode() != cfgRedact.ModeOff {
+		t.Fatalf("redact mode = %q, want off", rc.RedactMode())
+	}
+
+	// With redact: off the importer skips the pipeline, so the
+	// session reaches Import untouched.
+	got := importOne(t, newSession())
+	if strings.Contains(got, ghToken) {
+		t.Errorf("secret written with redact: off:\n%s", got)
+	}
+	if !strings.Contains(got, "[REDACTED:") {
+		t.Errorf("secret pass did not run:\n%s", got)
+	}
+	if strings.Contains(got, "redacted:") {
+		t.Errorf("unredacted session claims redaction:\n%s", got)
+	}
+}
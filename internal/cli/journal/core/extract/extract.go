@@ -7,6 +7,7 @@
 package extract
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/config/token"
@@ -35,6 +36,51 @@ func Frontmatter(content string) string {
 	return content[:len(fmOpen)+end+len(fmClose)]
 }
 
+// ReplaceKeys replaces top-level keys of a frontmatter block
+// with their entries from a fresh block. Keys missing from the
+// fresh block are removed, so stale values never survive a
+// regenerate.
+//
+// Parameters:
+//   - fm: Existing frontmatter block, including delimiters
+//   - fresh: Newly generated frontmatter block (may be empty)
+//   - keys: Top-level keys to take from fresh
+//
+// Returns:
+//   - string: fm with the keys replaced, including delimiters
+func ReplaceKeys(fm, fresh string, keys ...string) string {
+	nl := token.NewlineLF
+	fmOpen := token.Separator + nl
+	fmClose := nl + token.Separator + nl
+
+	// split divides a block's lines into those belonging to one
+	// of the keys (including nested and list lines) and the rest.
+	split := func(block string) (owned, rest []string) {
+		block = strings.TrimSuffix(strings.TrimPrefix(block, fmOpen), fmClose)
+		if block == "" {
+			return nil, nil
+		}
+		inKey := false
+		for _, line := range strings.Split(block, nl) {
+			if line != "" && !strings.HasPrefix(line, token.Space) &&
+				!strings.HasPrefix(line, token.PrefixListDash) {
+				key, _, _ := strings.Cut(line, token.Colon)
+				inKey = slices.Contains(keys, key)
+			}
+			if inKey {
+				owned = append(owned, line)
+			} else {
+				rest = append(rest, line)
+			}
+		}
+		return owned, rest
+	}
+
+	_, kept := split(fm)
+	added, _ := split(fresh)
+	return fmOpen + strings.Join(append(kept, added...), nl) + fmClose
+}
+
 // StripFrontmatter removes the YAML frontmatter block from content,
 // returning the remaining content. If no frontmatter is found, the
 // original content is returned unchanged.
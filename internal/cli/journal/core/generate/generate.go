@@ -20,6 +20,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/journal"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/config/zensical"
 	"github.com/ActiveMemory/ctx/internal/entity"
@@ -169,12 +170,14 @@ func InjectedSourceLink(content, sourcePath string) string {
 //   - topics: Topic index data for nav links
 //   - keyFiles: Key file index data for nav links
 //   - sessionTypes: Session type index data for nav links
+//   - stats: Whether the session analytics page was generated
 //
 // Returns:
 //   - string: Complete zensical.toml content
 func ZensicalToml(
 	entries []entity.JournalEntry, topics []entity.TopicData,
 	keyFiles []entity.KeyFileData, sessionTypes []entity.TypeData,
+	stats bool,
 ) string {
 	var sb strings.Builder
 	nl := token.NewlineLF
@@ -201,6 +204,10 @@ func ZensicalToml(
 			desc.Text(text.DescKeyLabelTypes),
 			filepath.Join(dir.JournalTypes, file.Index))
 	}
+	if stats {
+		io.SafeFprintf(&sb, tpl.JournalNavItem+nl,
+			desc.Text(text.DescKeyLabelStats), cfgStats.FileJournalPage)
+	}
 
 	// Filter out suggestion sessions and multi-part continuations from navigation
 	var regular []entity.JournalEntry
@@ -159,7 +159,7 @@ func TestGenerateZensicalToml_WithAllNav(t *testing.T) {
 	keyFiles := []entity.KeyFileData{{Path: "f.go", Entries: entries}}
 	sessionTypes := []entity.TypeData{{Name: "feature", Entries: entries}}
 
-	got := ZensicalToml(entries, topics, keyFiles, sessionTypes)
+	got := ZensicalToml(entries, topics, keyFiles, sessionTypes, true)
 
 	if !strings.Contains(got, "Topics") {
 		t.Error("missing Topics nav")
@@ -170,6 +170,9 @@ func TestGenerateZensicalToml_WithAllNav(t *testing.T) {
 	if !strings.Contains(got, "Types") {
 		t.Error("missing Types nav")
 	}
+	if !strings.Contains(got, `"Analytics" = "stats.md"`) {
+		t.Error("missing Analytics nav")
+	}
 }
 
 func TestGenerateZensicalToml_NoTopics(t *testing.T) {
@@ -177,9 +180,12 @@ func TestGenerateZensicalToml_NoTopics(t *testing.T) {
 		{Filename: "a.md", Title: "A", Date: "2026-01-01"},
 	}
 
-	got := ZensicalToml(entries, nil, nil, nil)
+	got := ZensicalToml(entries, nil, nil, nil, false)
 
 	if strings.Contains(got, "Topics") {
 		t.Error("should not have Topics nav when empty")
 	}
+	if strings.Contains(got, "stats.md") {
+		t.Error("should not have Analytics nav without stats")
+	}
 }
@@ -0,0 +1,67 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package generate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/marker"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/journal/stats"
+)
+
+// StatsPage renders the journal site analytics page: spend and
+// usage by week and by model, then the most-used tools.
+//
+// Parameters:
+//   - weekly: Report grouped by ISO week
+//   - models: Report grouped by model
+//
+// Returns:
+//   - string: Markdown page content
+func StatsPage(weekly, models entity.JournalStats) string {
+	table := func(report entity.JournalStats) string {
+		var sb strings.Builder
+		row := func(cells []string) {
+			joined := strings.Join(cells, tpl.JournalStatsMdSep)
+			sb.WriteString(
+				fmt.Sprintf(tpl.JournalStatsMdRow, joined) + token.NewlineLF,
+			)
+		}
+		headers := stats.Headers(report.By)
+		align := make([]string, len(headers))
+		for i := range align {
+			align[i] = tpl.JournalStatsMdAlignRight
+		}
+		align[0] = tpl.JournalStatsMdAlignLeft
+		row(headers)
+		row(align)
+		for _, g := range report.Groups {
+			row(stats.Cells(g))
+		}
+		total := stats.Cells(report.Total)
+		for i, cell := range total {
+			total[i] = marker.BoldWrap + cell + marker.BoldWrap
+		}
+		row(total)
+		return sb.String()
+	}
+
+	var tools strings.Builder
+	for _, t := range weekly.Tools {
+		tools.WriteString(
+			fmt.Sprintf(tpl.JournalStatsMdTool, t.Name, t.Count) +
+				token.NewlineLF,
+		)
+	}
+	return fmt.Sprintf(
+		tpl.JournalStatsPage, table(weekly), table(models), tools.String(),
+	)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/journal"
 	"github.com/ActiveMemory/ctx/internal/config/marker"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
 	"github.com/ActiveMemory/ctx/internal/config/regex"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 )
@@ -33,6 +34,7 @@ import (
 //   - Strips bold markers from tool-use lines (**Glob: *.md** -> Glob: *.md)
 //   - Escapes glob-like * characters outside code blocks
 //   - Replaces inline code spans containing angle brackets with quoted entities
+//   - Escapes redaction placeholders such as <EMAIL_1>
 //
 // Heavy formatting (metadata tables, proper fence reconstruction) is handled
 // programmatically. Edge cases may require parser-level fixes.
@@ -148,6 +150,12 @@ func Content(content string, fencesVerified bool) string {
 			line, replacer,
 		)
 
+		// Escape redaction placeholders (<EMAIL_1>) so they are
+		// shown rather than parsed as unknown HTML tags.
+		line = regex.RedactPlaceholder.ReplaceAllString(
+			line, cfgRedact.PlaceholderHTML,
+		)
+
 		out = append(out, line)
 	}
 
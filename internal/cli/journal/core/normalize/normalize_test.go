@@ -30,6 +30,17 @@ func TestNormalizeContent(t *testing.T) {
 				}
 			},
 		},
+		{
+			"escapes redaction placeholders",
+			"Mail <EMAIL_1> at <HOME_1>/notes",
+			false,
+			func(t *testing.T, got string) {
+				if !strings.Contains(got, "&lt;EMAIL_1&gt;") ||
+					!strings.Contains(got, "&lt;HOME_1&gt;/notes") {
+					t.Errorf("placeholders not escaped: %q", got)
+				}
+			},
+		},
 		{
 			"escapes glob stars",
 			"pattern: src/*/main.go",
@@ -19,6 +19,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/frontmatter"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/moc"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/redacted"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/reduce"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/section"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/core/turn"
@@ -54,6 +55,7 @@ func BuildVault(cmd *cobra.Command, journalDir, output string) error {
 	if scanErr != nil {
 		return errJournal.Scan(scanErr)
 	}
+	entries = redacted.Filter(cmd, entries)
 
 	if len(entries) == 0 {
 		return errJournal.NoEntries(journalDir)
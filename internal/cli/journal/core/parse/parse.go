@@ -50,6 +50,20 @@ func ScanJournalEntries(journalDir string) ([]entity.JournalEntry, error) {
 		entries = append(entries, entry)
 	}
 
+	// Continuation parts carry no frontmatter; they inherit the
+	// redaction marker of their first part.
+	redacted := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		redacted[e.Filename] = e.Redacted
+	}
+	for i, e := range entries {
+		if regex.MultiPart.MatchString(e.Filename) {
+			entries[i].Redacted = redacted[regex.MultiPart.ReplaceAllString(
+				e.Filename, file.ExtMarkdown,
+			)]
+		}
+	}
+
 	// Sort by datetime (newest first) - combine Date and Time
 	sort.Slice(entries, func(i, j int) bool {
 		// Compare Date+Time strings (YYYY-MM-DD + HH:MM:SS)
@@ -124,6 +138,7 @@ func JournalEntry(path, filename string) entity.JournalEntry {
 				entry.Outcome = fm.Outcome
 				entry.KeyFiles = fm.KeyFiles
 				entry.Summary = fm.Summary
+				entry.Redacted = fm.Redacted
 			}
 		}
 	}
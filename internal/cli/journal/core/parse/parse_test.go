@@ -9,6 +9,7 @@ package parse
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -220,3 +221,31 @@ func TestParseJournalEntry_TitleSanitization(t *testing.T) {
 		})
 	}
 }
+
+func TestScanJournalEntries_RedactedParts(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"2026-01-21-done-abc12345.md":    "---\nredacted: true\n---\n# Done\n",
+		"2026-01-21-done-abc12345-p2.md": "# Done (part 2)\n",
+		"2026-01-20-raw-def67890.md":     "---\ntitle: \"Raw\"\n---\n# Raw\n",
+		"2026-01-20-raw-def67890-p2.md":  "# Raw (part 2)\n",
+	}
+	for name, content := range files {
+		if writeErr := os.WriteFile(
+			filepath.Join(tmpDir, name), []byte(content), 0600,
+		); writeErr != nil {
+			t.Fatal(writeErr)
+		}
+	}
+
+	entries, scanErr := ScanJournalEntries(tmpDir)
+	if scanErr != nil {
+		t.Fatal(scanErr)
+	}
+	for _, e := range entries {
+		want := strings.HasPrefix(e.Filename, "2026-01-21-done")
+		if e.Redacted != want {
+			t.Errorf("%s: Redacted = %v, want %v", e.Filename, e.Redacted, want)
+		}
+	}
+}
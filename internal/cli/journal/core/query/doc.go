@@ -14,7 +14,7 @@
 //
 // # Session Discovery
 //
-// [FindSessions] is the sole exported function. When
+// [FindSessions] is the entry point. When
 // allProjects is false, it resolves the current working
 // directory and delegates to parser.FindSessionsForCWD,
 // returning only sessions whose project path matches.
@@ -23,9 +23,17 @@
 // directories regardless of project.
 //
 // The returned sessions are sorted by start time by the
-// underlying parser. The cmd/journal layer passes them
-// to the plan package for import planning or to the
-// write layer for listing.
+// underlying parser and carry metadata only: the parser
+// serves them from its session index and re-parses only
+// files that changed. Listing needs nothing more; stats,
+// site, and import call [LoadMessages] on the sessions they
+// selected, which skips any session that cannot be loaded
+// with a warning. Show and single-session import call
+// [LoadSession], which fails instead.
+//
+// [Match] selects sessions by ID prefix or slug fragment,
+// the lookup shared by "ctx journal source --show" and
+// "ctx journal harvest".
 //
 // # Error Handling
 //
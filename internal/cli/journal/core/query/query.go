@@ -8,6 +8,7 @@ package query
 
 import (
 	"os"
+	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/entity"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
@@ -34,3 +35,49 @@ func FindSessions(allProjects bool) ([]*entity.Session, error) {
 	}
 	return parser.FindSessionsForCWD(cwd)
 }
+
+// LoadMessages reads the messages of sessions returned by
+// FindSessions, which carry metadata only. Sessions that cannot
+// be loaded are skipped with a warning.
+//
+// Parameters:
+//   - sessions: sessions to complete (modified in place).
+//
+// Returns:
+//   - []*entity.Session: the sessions that were loaded.
+func LoadMessages(sessions []*entity.Session) []*entity.Session {
+	return parser.LoadMessages(sessions)
+}
+
+// LoadSession reads the messages of one session returned by
+// FindSessions.
+//
+// Parameters:
+//   - s: session to complete (modified in place).
+//
+// Returns:
+//   - error: non-nil if the session's source file cannot be parsed.
+func LoadSession(s *entity.Session) error {
+	return parser.LoadSession(s)
+}
+
+// Match returns the sessions a user-supplied query selects: an
+// ID prefix or a substring of the slug, case-insensitive.
+//
+// Parameters:
+//   - sessions: sessions to search.
+//   - q: session ID prefix or slug fragment.
+//
+// Returns:
+//   - []*entity.Session: matching sessions in input order.
+func Match(sessions []*entity.Session, q string) []*entity.Session {
+	q = strings.ToLower(q)
+	var matches []*entity.Session
+	for _, s := range sessions {
+		if strings.HasPrefix(strings.ToLower(s.ID), q) ||
+			strings.Contains(strings.ToLower(s.Slug), q) {
+			matches = append(matches, s)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redacted enforces **redact: required** for the
+// journal exporters.
+//
+// ctx journal site and ctx journal obsidian publish journal
+// entries outside the context directory. When .ctxrc sets
+// redact to required, only entries whose frontmatter carries
+// the redacted marker written by the import pipeline may be
+// published; continuation parts inherit the marker of their
+// first part (see parse.ScanJournalEntries).
+//
+// # Public Surface
+//
+//   - **[Filter](cmd, entries)**: drops refused entries,
+//     printing a skip line for each, and returns the rest
+//     unchanged when redaction is not required.
+package redacted
@@ -0,0 +1,47 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redacted
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeJournal "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Filter returns the entries an exporter may publish.
+//
+// When redact is required, entries without the redacted
+// marker are skipped with a message naming the file.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - entries: Scanned journal entries
+//
+// Returns:
+//   - []entity.JournalEntry: Entries allowed for export
+func Filter(
+	cmd *cobra.Command, entries []entity.JournalEntry,
+) []entity.JournalEntry {
+	if !rc.RedactRequired() {
+		return entries
+	}
+	kept := entries[:0:0]
+	for _, e := range entries {
+		if e.Redacted {
+			kept = append(kept, e)
+			continue
+		}
+		writeJournal.SkipFile(
+			cmd, e.Filename, desc.Text(text.DescKeyLabelReasonUnredacted),
+		)
+	}
+	return kept
+}
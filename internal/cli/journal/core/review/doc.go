@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package review runs the interactive accept, edit, reject
+// loop behind ctx journal harvest.
+//
+// # Behavior
+//
+// [Review] prints each candidate (type, source turn, why it
+// was proposed, title) and reads one answer from the command's
+// stdin:
+//
+//   - a: write the candidate as proposed
+//   - e: read a replacement title, then write
+//   - r: drop the candidate
+//   - q: drop this and every remaining candidate
+//
+// Unknown answers are asked again; end of input counts as
+// quit. Accepted candidates go through entry.ValidateAndWrite,
+// the same path as ctx add, so provenance and required fields
+// are enforced and the decision and learning indexes are
+// updated. With yes set, every candidate is accepted without
+// prompting.
+//
+// [Opts] carries the harvest command's flags.
+package review
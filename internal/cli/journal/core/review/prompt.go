@@ -0,0 +1,60 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/harvest"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// ask prompts until the user gives a known answer. Only the
+// first letter counts, so "accept" and "a" are the same.
+//
+// Parameters:
+//   - cmd: Cobra command for the prompt
+//   - in: Shared reader over the command's stdin
+//
+// Returns:
+//   - string: One of the harvest.Answer* constants;
+//     harvest.AnswerQuit once input is exhausted
+func ask(cmd *cobra.Command, in *bufio.Reader) string {
+	for {
+		writeRecall.HarvestPrompt(cmd)
+		line, readErr := in.ReadString(token.NewlineLF[0])
+		answer := strings.ToLower(strings.TrimSpace(line))
+		if answer != "" {
+			switch answer[:1] {
+			case harvest.AnswerAccept, harvest.AnswerEdit,
+				harvest.AnswerReject, harvest.AnswerQuit:
+				return answer[:1]
+			}
+		}
+		if readErr != nil {
+			return harvest.AnswerQuit
+		}
+	}
+}
+
+// edit reads a replacement title.
+//
+// Parameters:
+//   - cmd: Cobra command for the prompt
+//   - in: Shared reader over the command's stdin
+//
+// Returns:
+//   - string: Trimmed title; empty keeps the proposed one
+func edit(cmd *cobra.Command, in *bufio.Reader) string {
+	writeRecall.HarvestEdit(cmd)
+	line, _ := in.ReadString(token.NewlineLF[0])
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,76 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"bufio"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/add/core/scrub"
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/config/harvest"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	ctxEntry "github.com/ActiveMemory/ctx/internal/entry"
+	writeAdd "github.com/ActiveMemory/ctx/internal/write/add"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Review walks the candidates one by one and writes the ones
+// the user accepts, possibly with an edited title, through the
+// same secret scrub, validation and write path as ctx add.
+// Possible secrets are redacted, as with ctx add --redact, since
+// session text often quotes credentials.
+//
+// Quitting, or reaching the end of input, rejects the current
+// and all remaining candidates.
+//
+// Parameters:
+//   - cmd: Cobra command for prompts, output and stdin
+//   - candidates: Candidates with provenance filled in
+//   - yes: Accept every candidate without prompting
+//
+// Returns:
+//   - int: Candidates written
+//   - int: Candidates rejected
+//   - error: Non-nil if an accepted entry fails validation or
+//     cannot be written
+func Review(
+	cmd *cobra.Command, candidates []entity.HarvestCandidate, yes bool,
+) (int, int, error) {
+	in := bufio.NewReader(cmd.InOrStdin())
+	added, rejected := 0, 0
+	for i, c := range candidates {
+		writeRecall.HarvestCandidate(cmd, i+1, len(candidates), c)
+		choice := harvest.AnswerAccept
+		if !yes {
+			choice = ask(cmd, in)
+		}
+		switch choice {
+		case harvest.AnswerQuit:
+			return added, rejected + len(candidates) - i, nil
+		case harvest.AnswerReject:
+			rejected++
+			continue
+		case harvest.AnswerEdit:
+			if title := edit(cmd, in); title != "" {
+				c.Params.Content = title
+			}
+		}
+		// Redaction cannot fail; only the non-redacting mode errors.
+		redacted, _ := scrub.Params(&c.Params, true)
+		if redacted > 0 {
+			writeAdd.Redacted(cmd, redacted)
+		}
+		if writeErr := ctxEntry.ValidateAndWrite(c.Params); writeErr != nil {
+			return added, rejected, writeErr
+		}
+		writeRecall.HarvestAdded(cmd, entry.MustCtxFile(c.Params.Type))
+		added++
+	}
+	return added, rejected, nil
+}
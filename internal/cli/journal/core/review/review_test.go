@@ -0,0 +1,69 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// ghToken is assembled at run time so the source file itself
+// does not trip secret scanners.
+var ghToken = "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+
+func TestReview_RedactsSecrets(t *testing.T) {
+	tmpDir := t.TempDir()
+	ctxDir := testctx.Declare(t, tmpDir)
+	if mkErr := os.MkdirAll(ctxDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	learnings := filepath.Join(ctxDir, "LEARNINGS.md")
+	if wErr := os.WriteFile(
+		learnings, []byte("# Learnings\n"), 0o600,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+
+	cmd := &cobra.Command{}
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	added, _, reviewErr := Review(cmd, []entity.HarvestCandidate{{
+		Params: entity.EntryParams{
+			Type:        "learning",
+			Content:     "CI token " + ghToken + " leaked",
+			Context:     "Pasted into the session",
+			Lesson:      "Tokens end up in transcripts",
+			Application: "Use the secret store",
+			SessionID:   "abc12345",
+			Branch:      "main",
+			Commit:      "abc123",
+		},
+	}}, true)
+	if reviewErr != nil || added != 1 {
+		t.Fatalf("Review: added %d, %v", added, reviewErr)
+	}
+
+	data, readErr := os.ReadFile(learnings)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if strings.Contains(string(data), ghToken) ||
+		!strings.Contains(string(data), "[REDACTED:github_token]") {
+		t.Errorf("secret not redacted:\n%s", data)
+	}
+	if !strings.Contains(out.String(), "Redacted 1 possible secret") {
+		t.Errorf("redaction not reported:\n%s", out)
+	}
+}
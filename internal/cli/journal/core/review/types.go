@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package review
+
+// Opts holds all flags for the harvest subcommand.
+//
+// Fields:
+//   - Latest: Harvest the most recent session
+//   - AllProjects: Search sessions from every project
+//   - DryRun: List candidates without prompting or writing
+//   - Yes: Accept every candidate without prompting
+//   - Section: TASKS.md section for harvested tasks
+//   - Branch: Provenance branch (default: the session's)
+//   - Commit: Provenance commit (default: HEAD)
+type Opts struct {
+	Latest      bool
+	AllProjects bool
+	DryRun      bool
+	Yes         bool
+	Section     string
+	Branch      string
+	Commit      string
+}
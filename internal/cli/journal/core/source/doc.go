@@ -31,6 +31,10 @@
 //
 //   - **[Opts]**: flag-bag for source
 //     selection.
+//   - **[Select]**: resolves one session from
+//     --latest or an ID prefix / slug fragment and
+//     loads its messages; shared by show and
+//     `ctx journal harvest`.
 //   - **[format]**: see subpackage docs.
 //   - **[frontmatter]**: see subpackage docs.
 //
@@ -234,6 +234,9 @@ func JournalEntryPart(
 		if title != "" {
 			frontmatter.WriteFmQuoted(&sb, session.FrontmatterTitle, title)
 		}
+		if s.Redacted {
+			frontmatter.WriteFmRedactions(&sb, s.Redactions)
+		}
 		sb.WriteString(sep + nl + nl)
 
 		// Header: prefer title, fall back to slug, then baseName.
@@ -751,3 +751,51 @@ func TestFormatJournalEntryPart_Entrypoint(t *testing.T) {
 		t.Error("cli entrypoint should be omitted")
 	}
 }
+
+func TestFormatJournalEntryPart_Redactions(t *testing.T) {
+	t.Setenv("TZ", "UTC")
+
+	s := &entity.Session{
+		ID:        "redacted-session",
+		Slug:      "redact-test",
+		Tool:      "claude-code",
+		Project:   "myproject",
+		StartTime: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 15, 10, 5, 0, 0, time.UTC),
+		Duration:  5 * time.Minute,
+		TurnCount: 1,
+		Messages: []entity.Message{
+			{
+				Role: "user",
+				Text: "Mail <EMAIL_1>",
+				Timestamp: time.Date(
+					2026, 1, 15, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		Redacted: true,
+		Redactions: []entity.Redaction{
+			{Placeholder: "<EMAIL_1>", Kind: "email", Count: 2},
+		},
+	}
+
+	got := JournalEntryPart(
+		s, s.Messages, 0, 1, 1, "base", "")
+
+	fm := got[:strings.Index(got, "\n---\n")]
+	for _, want := range []string{
+		"redacted: true",
+		"redactions:\n  - placeholder: \"<EMAIL_1>\"\n" +
+			"    kind: \"email\"\n    count: 2",
+	} {
+		if !strings.Contains(fm, want) {
+			t.Errorf("frontmatter missing %q:\n%s", want, fm)
+		}
+	}
+
+	s.Redacted = false
+	got = JournalEntryPart(
+		s, s.Messages, 0, 1, 1, "base", "")
+	if strings.Contains(got, "redacted:") {
+		t.Error("unredacted session should not claim redaction")
+	}
+}
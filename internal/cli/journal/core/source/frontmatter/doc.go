@@ -23,8 +23,7 @@
 //
 // # YAML Field Writers
 //
-// Three functions write individual YAML fields to a
-// strings.Builder:
+// Four functions write YAML fields to a strings.Builder:
 //
 //   - [WriteFmQuoted] writes a quoted string field
 //     using the FmQuoted template, suitable for
@@ -35,6 +34,9 @@
 //   - [WriteFmInt] writes an integer field using the
 //     FmInt template, for numeric metadata such as
 //     message counts.
+//   - [WriteFmRedactions] writes the redacted marker and
+//     the list of placeholders written by the redaction
+//     pipeline.
 //
 // Each writer appends a newline after the field. Write
 // errors are silently discarded because the builder
@@ -11,7 +11,9 @@ import (
 	"strings"
 
 	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/session"
 	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
 )
 
 // ResolveHeading returns the first non-empty value among title, slug, baseName.
@@ -71,3 +73,33 @@ func WriteFmInt(sb *strings.Builder, key string, value int) {
 		return
 	}
 }
+
+// WriteFmRedactions writes the redacted marker and one list
+// item per placeholder the redaction pipeline wrote.
+//
+// Parameters:
+//   - sb: String builder to write to
+//   - redactions: Placeholders with their kind and count
+func WriteFmRedactions(sb *strings.Builder, redactions []entity.Redaction) {
+	_, writeErr := fmt.Fprintf(
+		sb, tpl.FmBool+token.NewlineLF, session.FmKeyRedacted, true,
+	)
+	if writeErr != nil || len(redactions) == 0 {
+		return
+	}
+	_, writeErr = fmt.Fprintf(
+		sb, tpl.FmListKey+token.NewlineLF, session.FmKeyRedactions,
+	)
+	if writeErr != nil {
+		return
+	}
+	for _, r := range redactions {
+		_, writeErr = fmt.Fprintf(
+			sb, tpl.FmRedaction+token.NewlineLF,
+			r.Placeholder, r.Kind, r.Count,
+		)
+		if writeErr != nil {
+			return
+		}
+	}
+}
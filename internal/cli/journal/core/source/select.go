@@ -0,0 +1,81 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
+	srcFmt "github.com/ActiveMemory/ctx/internal/cli/journal/core/source/format"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/journal"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errSession "github.com/ActiveMemory/ctx/internal/err/session"
+	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
+)
+
+// Select resolves the one session a command refers to, either
+// the most recent or the one matching an ID prefix or slug
+// fragment, and loads its messages.
+//
+// Parameters:
+//   - cmd: Cobra command for the ambiguous-match listing
+//   - args: positional arguments (session query, optional)
+//   - latest: pick the most recent session instead
+//   - allProjects: search sessions from every project
+//
+// Returns:
+//   - *entity.Session: the selected session with messages
+//   - error: non-nil if no session or several sessions match,
+//     or scanning fails
+func Select(
+	cmd *cobra.Command, args []string, latest, allProjects bool,
+) (*entity.Session, error) {
+	sessions, scanErr := query.FindSessions(allProjects)
+	if scanErr != nil {
+		return nil, errSession.Find(scanErr)
+	}
+
+	if len(sessions) == 0 {
+		if allProjects {
+			return nil, errSession.NoneFound("")
+		}
+		return nil, errSession.NoneFound(
+			desc.Text(
+				text.DescKeyLabelHintUseAllProjects,
+			),
+		)
+	}
+
+	var session *entity.Session
+
+	switch {
+	case latest:
+		session = sessions[0]
+	case len(args) == 0:
+		return nil, errSession.IDRequired()
+	default:
+		matches := query.Match(sessions, args[0])
+		if len(matches) == 0 {
+			return nil, errSession.NotFound(args[0])
+		}
+		if len(matches) > 1 {
+			lines := srcFmt.SessionMatchLines(matches)
+			writeRecall.AmbiguousSessionMatchWithHint(
+				cmd, args[0], lines,
+				matches[0].ID[:journal.SessionIDHintLen],
+			)
+			return nil, errSession.AmbiguousQuery()
+		}
+		session = matches[0]
+	}
+	if loadErr := query.LoadSession(session); loadErr != nil {
+		return nil, errSession.Find(loadErr)
+	}
+	return session, nil
+}
@@ -7,19 +7,14 @@
 package source
 
 import (
-	"strings"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
-	"github.com/ActiveMemory/ctx/internal/cli/journal/core/query"
 	srcFmt "github.com/ActiveMemory/ctx/internal/cli/journal/core/source/format"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/journal"
 	"github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/config/token"
-	"github.com/ActiveMemory/ctx/internal/entity"
-	errSession "github.com/ActiveMemory/ctx/internal/err/session"
 	sharedFmt "github.com/ActiveMemory/ctx/internal/format"
 	"github.com/ActiveMemory/ctx/internal/parse"
 	writeRecall "github.com/ActiveMemory/ctx/internal/write/journal"
@@ -46,55 +41,11 @@ func RunShow(
 		showArgs = []string{opts.ShowID}
 	}
 
-	sessions, scanErr := query.FindSessions(
-		opts.AllProjects,
+	session, selectErr := Select(
+		cmd, showArgs, opts.Latest, opts.AllProjects,
 	)
-	if scanErr != nil {
-		return errSession.Find(scanErr)
-	}
-
-	if len(sessions) == 0 {
-		if opts.AllProjects {
-			return errSession.NoneFound("")
-		}
-		return errSession.NoneFound(
-			desc.Text(
-				text.DescKeyLabelHintUseAllProjects,
-			),
-		)
-	}
-
-	var session *entity.Session
-
-	switch {
-	case opts.Latest:
-		session = sessions[0]
-	case len(showArgs) == 0:
-		return errSession.IDRequired()
-	default:
-		q := strings.ToLower(showArgs[0])
-		var matches []*entity.Session
-		for _, s := range sessions {
-			if strings.HasPrefix(
-				strings.ToLower(s.ID), q,
-			) || strings.Contains(
-				strings.ToLower(s.Slug), q,
-			) {
-				matches = append(matches, s)
-			}
-		}
-		if len(matches) == 0 {
-			return errSession.NotFound(showArgs[0])
-		}
-		if len(matches) > 1 {
-			lines := srcFmt.SessionMatchLines(matches)
-			writeRecall.AmbiguousSessionMatchWithHint(
-				cmd, showArgs[0], lines,
-				matches[0].ID[:journal.SessionIDHintLen],
-			)
-			return errSession.AmbiguousQuery()
-		}
-		session = matches[0]
+	if selectErr != nil {
+		return selectErr
 	}
 
 	// Print session details.
@@ -21,6 +21,12 @@
 //   - lock: mark a journal entry as finalized
 //   - unlock: revert a locked entry to editable state
 //   - sync: synchronize journal state with the source
+//   - search: full-text search over journal turns with
+//     field filters and ranked results
+//   - stats: token, cost, and tool usage analytics
+//     across sessions
+//   - harvest: propose decisions, learnings, and tasks
+//     from a session and add the accepted ones
 //   - site: generate a zensical-compatible static site
 //     with browsable history, indices, and search
 //   - obsidian: generate an Obsidian vault with
@@ -37,6 +43,9 @@
 //	cmd/schema: JSON Schema output
 //	cmd/lock, cmd/unlock: entry finalization
 //	cmd/sync: state synchronization
+//	cmd/search: journal full-text search
+//	cmd/stats: session cost and usage analytics
+//	cmd/harvest: context entry extraction from sessions
 //	cmd/site: static site generation
 //	cmd/obsidian: Obsidian vault generation
 //	core: scan, parse, index, and enrichment logic
@@ -9,12 +9,15 @@ package journal
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/harvest"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/importer"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/lock"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/obsidian"
 	journalSchema "github.com/ActiveMemory/ctx/internal/cli/journal/cmd/schema"
+	journalSearch "github.com/ActiveMemory/ctx/internal/cli/journal/cmd/search"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/site"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/source"
+	journalStats "github.com/ActiveMemory/ctx/internal/cli/journal/cmd/stats"
 	journalSync "github.com/ActiveMemory/ctx/internal/cli/journal/cmd/sync"
 	"github.com/ActiveMemory/ctx/internal/cli/journal/cmd/unlock"
 	"github.com/ActiveMemory/ctx/internal/cli/parent"
@@ -37,6 +40,9 @@ func Cmd() *cobra.Command {
 		lock.Cmd(),
 		unlock.Cmd(),
 		journalSync.Cmd(),
+		journalSearch.Cmd(),
+		journalStats.Cmd(),
+		harvest.Cmd(),
 		site.Cmd(),
 		obsidian.Cmd(),
 	)
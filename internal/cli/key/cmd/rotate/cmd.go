@@ -0,0 +1,52 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rotate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the key rotate subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured rotate subcommand
+func Cmd() *cobra.Command {
+	var resume, rollback bool
+	var projects []string
+
+	short, long := desc.Command(cmd.DescKeyKeyRotate)
+	c := &cobra.Command{
+		Use:     cmd.UseKeyRotate,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyKeyRotate),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, projects, resume, rollback)
+		},
+	}
+
+	flagbind.BoolFlag(
+		c, &resume, cFlag.Resume, flag.DescKeyKeyRotateResume,
+	)
+	flagbind.BoolFlag(
+		c, &rollback, cFlag.Rollback, flag.DescKeyKeyRotateRollback,
+	)
+	flagbind.StringArrayFlagP(
+		c, &projects, cFlag.Project, cFlag.ShortProject,
+		flag.DescKeyKeyRotateProject,
+	)
+	c.MarkFlagsMutuallyExclusive(cFlag.Resume, cFlag.Rollback)
+
+	return c
+}
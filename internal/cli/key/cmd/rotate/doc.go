@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package rotate implements the "ctx key rotate"
+// subcommand.
+//
+// # Behavior
+//
+// Without flags, the command lists the ciphertexts under
+// the current key, stages a new key, re-encrypts each
+// file, and swaps the keys, keeping the old one as a
+// decrypt-only grace key. If an earlier run was
+// interrupted it refuses to start a new one.
+//
+// A key outside the context directory is shared with
+// other projects, so the command refuses to rotate it
+// unless those projects are listed with --project.
+//
+// # Flags
+//
+//	--project    another project using the key (repeatable)
+//	--resume     finish an interrupted rotation
+//	--rollback   undo an interrupted rotation
+//
+// The two flags are mutually exclusive. Rollback is only
+// possible before the key swap has begun.
+//
+// # Output
+//
+// One line per re-encrypted file, then the active and
+// grace key paths.
+//
+// # Delegation
+//
+// The work is done by [internal/cli/key/core/rotate];
+// output goes through [internal/write/key].
+package rotate
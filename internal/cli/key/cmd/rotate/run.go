@@ -0,0 +1,82 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rotate
+
+import (
+	"github.com/spf13/cobra"
+
+	coreRotate "github.com/ActiveMemory/ctx/internal/cli/key/core/rotate"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeKey "github.com/ActiveMemory/ctx/internal/write/key"
+)
+
+// Run rotates the key, or resumes or rolls back an
+// interrupted rotation.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - projects: Other projects sharing the key; required when
+//     the key is not project-local
+//   - resume: Finish the pending rotation
+//   - rollback: Undo the pending rotation
+//
+// Returns:
+//   - error: Non-nil when the requested action is not possible
+//     or any step fails
+func Run(
+	cmd *cobra.Command, projects []string, resume, rollback bool,
+) error {
+	cmd.SilenceUsage = true
+
+	ctxDir, dirErr := rc.RequireContextDir()
+	if dirErr != nil {
+		return dirErr
+	}
+	kp, kpErr := rc.KeyPath()
+	if kpErr != nil {
+		return kpErr
+	}
+	j, pendingErr := coreRotate.Pending(kp)
+	if pendingErr != nil {
+		return pendingErr
+	}
+
+	switch {
+	case (resume || rollback) && j == nil:
+		return errCrypto.NoRotation()
+	case rollback:
+		if rbErr := coreRotate.Rollback(kp, j); rbErr != nil {
+			return rbErr
+		}
+		writeKey.RolledBack(cmd, kp)
+		return nil
+	case !resume:
+		if len(projects) == 0 && coreRotate.Shared(ctxDir, kp) {
+			return errCrypto.SharedKey(kp)
+		}
+		dirs, dirsErr := coreRotate.ContextDirs(ctxDir, projects)
+		if dirsErr != nil {
+			return dirsErr
+		}
+		var startErr error
+		j, startErr = coreRotate.Start(kp, coreRotate.TargetsIn(dirs, kp))
+		if startErr != nil {
+			return startErr
+		}
+	}
+
+	if resumeErr := coreRotate.Resume(kp, j); resumeErr != nil {
+		return resumeErr
+	}
+	for _, t := range j.Targets {
+		writeKey.Reencrypted(cmd, t.Path)
+	}
+	writeKey.Rotated(cmd, kp, crypto.GracePath(kp), rc.KeyGraceDays())
+	return nil
+}
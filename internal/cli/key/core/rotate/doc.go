@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package rotate implements the key rotation engine behind
+// `ctx key rotate`.
+//
+// A rotation replaces the scratchpad key without ever leaving
+// a ciphertext that no key on disk can open:
+//
+//  1. [Start] checks every target decrypts with the current
+//     key (or the grace key of an earlier rotation), stages a
+//     fresh key at <key>.next, and writes the journal.
+//  2. [Resume] re-encrypts each target under the staged key,
+//     one atomic rename per file, ticking it off in the
+//     journal. It then copies the old key to <key>.prev (the
+//     decrypt-only grace key) and renames the staged key over
+//     the active one.
+//  3. [Rollback] undoes a prepared rotation by re-encrypting
+//     migrated targets back under the active key and dropping
+//     the staged key.
+//
+// Every step is idempotent: a target already readable with
+// the destination key is skipped, so running [Resume] or
+// [Rollback] after a crash at any point converges.
+//
+// [Targets] finds the ciphertexts ctx owns: the scratchpad
+// (blobs included, as they live inside it), the notify
+// webhook, and the hub connection file when the global key
+// is the one rotating. Scratchpads shared with recipients are
+// skipped; they are sealed for identities, not the key.
+package rotate
@@ -0,0 +1,188 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rotate
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// load reads a rotation journal.
+//
+// Parameters:
+//   - path: Journal path
+//
+// Returns:
+//   - *Journal: Parsed journal, or nil when the file is missing
+//   - error: Non-nil on read or parse failure
+func load(path string) (*Journal, error) {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, readErr
+	}
+	var j Journal
+	if jsonErr := json.Unmarshal(data, &j); jsonErr != nil {
+		return nil, jsonErr
+	}
+	return &j, nil
+}
+
+// save writes a rotation journal atomically.
+//
+// Parameters:
+//   - path: Journal path
+//   - j: Journal to write
+//
+// Returns:
+//   - error: Non-nil on marshal or write failure
+func save(path string, j *Journal) error {
+	data, marshalErr := json.MarshalIndent(j, "", token.Indent2)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	data = append(data, token.NewlineLF[0])
+	return replace(path, data, fs.PermSecret)
+}
+
+// remove deletes a rotation journal; a missing file is fine.
+//
+// Parameters:
+//   - path: Journal path
+//
+// Returns:
+//   - error: Non-nil on any other removal failure
+func remove(path string) error {
+	if rmErr := os.Remove(path); rmErr != nil &&
+		!errors.Is(rmErr, os.ErrNotExist) {
+		return rmErr
+	}
+	return nil
+}
+
+// commit swaps the staged key in, keeping the old key as the
+// grace key. Safe to repeat: once the staged key is gone only
+// the journal is removed.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//
+// Returns:
+//   - error: Non-nil on key read, write, or rename failure
+func commit(keyPath string) error {
+	nextPath := keyPath + cfgCrypto.NextSuffix
+	if _, statErr := os.Stat(nextPath); statErr == nil {
+		old, loadErr := crypto.LoadKey(keyPath)
+		if loadErr != nil {
+			return errCrypto.LoadKey(loadErr, keyPath)
+		}
+		// Rewriting the grace key restarts its grace period.
+		saveErr := crypto.SaveKey(crypto.GracePath(keyPath), old)
+		if saveErr != nil {
+			return saveErr
+		}
+		if renameErr := os.Rename(nextPath, keyPath); renameErr != nil {
+			return renameErr
+		}
+	}
+	return remove(JournalPath(keyPath))
+}
+
+// readable checks that a target opens with one of the keys.
+//
+// Parameters:
+//   - path: Target file
+//   - keys: Candidate keys
+//
+// Returns:
+//   - error: Non-nil if the file cannot be read or decrypted
+func readable(path string, keys [][]byte) error {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return readErr
+	}
+	if _, decErr := crypto.DecryptAny(keys, data); decErr != nil {
+		return errCrypto.Undecryptable(path, decErr)
+	}
+	return nil
+}
+
+// recrypt re-encrypts a file under the destination key.
+//
+// A file that already opens with the destination key is left
+// untouched, which makes the step safe to repeat.
+//
+// Parameters:
+//   - path: Target file
+//   - from: Keys the file may currently be under
+//   - to: Destination key
+//
+// Returns:
+//   - error: Non-nil on read, decryption, or write failure
+func recrypt(path string, from [][]byte, to []byte) error {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return readErr
+	}
+	if _, done := crypto.Decrypt(to, data); done == nil {
+		return nil
+	}
+	plaintext, decErr := crypto.DecryptAny(from, data)
+	if decErr != nil {
+		return errCrypto.Undecryptable(path, decErr)
+	}
+	sealed, encErr := crypto.Encrypt(to, plaintext)
+	if encErr != nil {
+		return errCrypto.EncryptFailed(encErr)
+	}
+	info, statErr := os.Stat(path)
+	if statErr != nil {
+		return statErr
+	}
+	return replace(path, sealed, info.Mode().Perm())
+}
+
+// replace writes data to a temp file and renames it over path
+// so readers never see a partial file.
+//
+// Parameters:
+//   - path: Destination path
+//   - data: File content
+//   - perm: File mode
+//
+// Returns:
+//   - error: Non-nil on write or rename failure
+func replace(path string, data []byte, perm os.FileMode) error {
+	tmp := path + file.ExtTmp
+	if writeErr := io.SafeWriteFile(tmp, data, perm); writeErr != nil {
+		return writeErr
+	}
+	return os.Rename(tmp, path)
+}
+
+// isDir reports whether path is an existing directory.
+//
+// Parameters:
+//   - path: Path to check
+//
+// Returns:
+//   - bool: True for a directory
+func isDir(path string) bool {
+	info, statErr := os.Stat(path)
+	return statErr == nil && info.IsDir()
+}
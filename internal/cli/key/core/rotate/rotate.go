@@ -0,0 +1,286 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rotate
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgHub "github.com/ActiveMemory/ctx/internal/config/hub"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// JournalPath returns the rotation journal path for a key.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//
+// Returns:
+//   - string: keyPath with the journal suffix
+func JournalPath(keyPath string) string {
+	return keyPath + cfgCrypto.RotationJournalSuffix
+}
+
+// Targets lists the ciphertext files encrypted with the key
+// at keyPath.
+//
+// Missing and empty files are skipped, as are scratchpads
+// sealed for recipients.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//   - keyPath: Path of the key being rotated
+//
+// Returns:
+//   - []string: Existing target paths
+func Targets(ctxDir, keyPath string) []string {
+	candidates := []string{
+		filepath.Join(ctxDir, pad.Enc),
+		filepath.Join(ctxDir, cfgCrypto.NotifyEnc),
+		filepath.Join(ctxDir, cfgCrypto.NotifyChannelsEnc),
+	}
+	// The hub connection file is always under the global key.
+	if keyPath == crypto.GlobalKeyPath() {
+		candidates = append(
+			candidates, filepath.Join(ctxDir, cfgHub.FileConnect),
+		)
+	}
+
+	var paths []string
+	for _, p := range candidates {
+		data, readErr := io.SafeReadUserFile(p)
+		if readErr != nil || len(data) == 0 || crypto.IsEnvelope(data) {
+			continue
+		}
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+// Shared reports whether the key at keyPath lives outside ctxDir.
+//
+// The global key (and any key_path outside the project) is used by
+// every project that does not have its own key, so rotating it must
+// re-encrypt those projects too.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//   - keyPath: Path of the key being rotated
+//
+// Returns:
+//   - bool: True unless the key is the project-local one
+func Shared(ctxDir, keyPath string) bool {
+	return filepath.Dir(filepath.Clean(keyPath)) != filepath.Clean(ctxDir)
+}
+
+// ContextDirs resolves the context directories a rotation covers:
+// ctxDir first, then one per project, without duplicates.
+//
+// A project may be given as its root (holding .context/) or as
+// the context directory itself.
+//
+// Parameters:
+//   - ctxDir: Current context directory
+//   - projects: Other projects sharing the key
+//
+// Returns:
+//   - []string: Context directories
+//   - error: Non-nil if a project is not a directory
+func ContextDirs(ctxDir string, projects []string) ([]string, error) {
+	dirs := []string{filepath.Clean(ctxDir)}
+	seen := map[string]bool{dirs[0]: true}
+	for _, p := range projects {
+		abs, absErr := filepath.Abs(crypto.ExpandHome(p))
+		if absErr != nil {
+			return nil, errCrypto.NoProject(p)
+		}
+		d := filepath.Join(abs, dir.Context)
+		if !isDir(d) {
+			d = abs
+		}
+		if !isDir(d) {
+			return nil, errCrypto.NoProject(p)
+		}
+		if seen[d] {
+			continue
+		}
+		seen[d] = true
+		dirs = append(dirs, d)
+	}
+	return dirs, nil
+}
+
+// TargetsIn lists the ciphertext files under keyPath in every
+// context directory (see [Targets]).
+//
+// Parameters:
+//   - ctxDirs: Context directories, from [ContextDirs]
+//   - keyPath: Path of the key being rotated
+//
+// Returns:
+//   - []string: Existing target paths
+func TargetsIn(ctxDirs []string, keyPath string) []string {
+	var paths []string
+	for _, d := range ctxDirs {
+		paths = append(paths, Targets(d, keyPath)...)
+	}
+	return paths
+}
+
+// Pending loads the journal of an unfinished rotation.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//
+// Returns:
+//   - *Journal: The journal, or nil when no rotation is pending
+//   - error: Non-nil if the journal exists but cannot be read
+func Pending(keyPath string) (*Journal, error) {
+	return load(JournalPath(keyPath))
+}
+
+// Start prepares a rotation: it verifies every target,
+// stages a new key, and writes the journal.
+//
+// Nothing is re-encrypted yet; call [Resume] to do the work.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//   - targets: Files to re-encrypt (see [Targets])
+//
+// Returns:
+//   - *Journal: The new journal
+//   - error: Non-nil if a rotation is pending, the key is
+//     missing, a target cannot be decrypted, or a write fails
+func Start(keyPath string, targets []string) (*Journal, error) {
+	pending, pendingErr := Pending(keyPath)
+	if pendingErr != nil {
+		return nil, pendingErr
+	}
+	if pending != nil {
+		return nil, errCrypto.RotationPending(JournalPath(keyPath))
+	}
+
+	from, loadErr := crypto.LoadKeyring(keyPath, rc.KeyGraceDays())
+	if loadErr != nil {
+		return nil, errCrypto.LoadKey(loadErr, keyPath)
+	}
+	j := &Journal{
+		Started: time.Now().UTC(),
+		Phase:   cfgCrypto.PhasePrepared,
+	}
+	for _, p := range targets {
+		if checkErr := readable(p, from); checkErr != nil {
+			return nil, checkErr
+		}
+		j.Targets = append(j.Targets, Target{Path: p})
+	}
+
+	next, genErr := crypto.GenerateKey()
+	if genErr != nil {
+		return nil, errCrypto.GenerateKey(genErr)
+	}
+	// Stage the key before the journal: a journal must never
+	// point at a key that is not on disk.
+	nextPath := keyPath + cfgCrypto.NextSuffix
+	if saveErr := crypto.SaveKey(nextPath, next); saveErr != nil {
+		return nil, saveErr
+	}
+	if saveErr := save(JournalPath(keyPath), j); saveErr != nil {
+		return nil, saveErr
+	}
+	return j, nil
+}
+
+// Resume finishes a rotation: it re-encrypts the remaining
+// targets under the staged key and swaps the keys.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//   - j: Journal from [Start] or [Pending]; updated in place
+//
+// Returns:
+//   - error: Non-nil on key, decryption, or write failure; the
+//     journal stays on disk so the run can be resumed
+func Resume(keyPath string, j *Journal) error {
+	journal := JournalPath(keyPath)
+	if j.Phase == cfgCrypto.PhasePrepared {
+		from, loadErr := crypto.LoadKeyring(keyPath, rc.KeyGraceDays())
+		if loadErr != nil {
+			return errCrypto.LoadKey(loadErr, keyPath)
+		}
+		nextPath := keyPath + cfgCrypto.NextSuffix
+		to, nextErr := crypto.LoadKey(nextPath)
+		if nextErr != nil {
+			return errCrypto.LoadKey(nextErr, nextPath)
+		}
+		for i := range j.Targets {
+			t := &j.Targets[i]
+			if t.Done {
+				continue
+			}
+			if recryptErr := recrypt(t.Path, from, to); recryptErr != nil {
+				return recryptErr
+			}
+			t.Done = true
+			if saveErr := save(journal, j); saveErr != nil {
+				return saveErr
+			}
+		}
+		j.Phase = cfgCrypto.PhaseCommitted
+		if saveErr := save(journal, j); saveErr != nil {
+			return saveErr
+		}
+	}
+	return commit(keyPath)
+}
+
+// Rollback abandons a prepared rotation, moving any migrated
+// targets back under the active key.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//   - j: Journal from [Pending]
+//
+// Returns:
+//   - error: Non-nil if the rotation is already committed or a
+//     target cannot be restored
+func Rollback(keyPath string, j *Journal) error {
+	if j.Phase != cfgCrypto.PhasePrepared {
+		return errCrypto.RotationCommitted()
+	}
+	to, loadErr := crypto.LoadKey(keyPath)
+	if loadErr != nil {
+		return errCrypto.LoadKey(loadErr, keyPath)
+	}
+
+	nextPath := keyPath + cfgCrypto.NextSuffix
+	next, nextErr := crypto.LoadKey(nextPath)
+	switch {
+	case nextErr == nil:
+		for _, t := range j.Targets {
+			recryptErr := recrypt(t.Path, [][]byte{next}, to)
+			if recryptErr != nil {
+				return recryptErr
+			}
+		}
+		if rmErr := os.Remove(nextPath); rmErr != nil {
+			return rmErr
+		}
+	case !errors.Is(nextErr, os.ErrNotExist):
+		return errCrypto.LoadKey(nextErr, nextPath)
+	}
+	return remove(JournalPath(keyPath))
+}
@@ -0,0 +1,286 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rotate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// setup creates a context dir with a key, an encrypted pad and
+// an encrypted webhook, returning the key path, the old key,
+// and the two target paths.
+func setup(t *testing.T) (string, []byte, []string) {
+	t.Helper()
+	tmp := t.TempDir()
+	testctx.Declare(t, tmp)
+	t.Cleanup(rc.Reset)
+	ctxDir := filepath.Join(tmp, dir.Context)
+	if err := os.MkdirAll(ctxDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	kp, err := rc.KeyPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(kp), fs.PermKeyDir); err != nil {
+		t.Fatal(err)
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := crypto.SaveKey(kp, key); err != nil {
+		t.Fatal(err)
+	}
+
+	targets := []string{
+		filepath.Join(ctxDir, pad.Enc),
+		filepath.Join(ctxDir, cfgCrypto.NotifyEnc),
+	}
+	for _, p := range targets {
+		sealed, err := crypto.Encrypt(key, []byte(filepath.Base(p)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, sealed, fs.PermSecret); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := Targets(ctxDir, kp); len(got) != len(targets) {
+		t.Fatalf("Targets = %v, want %v", got, targets)
+	}
+	return kp, key, targets
+}
+
+// opens reports whether every target decrypts with key.
+func opens(t *testing.T, key []byte, targets []string) bool {
+	t.Helper()
+	for _, p := range targets {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plain, err := crypto.Decrypt(key, data)
+		if err != nil || string(plain) != filepath.Base(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// gone fails the test if any path exists.
+func gone(t *testing.T, paths ...string) {
+	t.Helper()
+	for _, p := range paths {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("%s should not exist", p)
+		}
+	}
+}
+
+func TestRotate_ReencryptsAndKeepsGrace(t *testing.T) {
+	kp, old, targets := setup(t)
+
+	j, err := Start(kp, targets)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := Resume(kp, j); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+
+	key, err := crypto.LoadKey(kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(key, old) {
+		t.Fatal("key was not replaced")
+	}
+	if !opens(t, key, targets) {
+		t.Error("targets should open with the new key")
+	}
+	grace, err := crypto.LoadKey(crypto.GracePath(kp))
+	if err != nil || !bytes.Equal(grace, old) {
+		t.Errorf("grace key = %x, %v; want the old key", grace, err)
+	}
+	keys, err := crypto.LoadKeyring(kp, rc.KeyGraceDays())
+	if err != nil || len(keys) != 2 {
+		t.Errorf("keyring = %d keys, %v; want 2", len(keys), err)
+	}
+	gone(t, JournalPath(kp), kp+cfgCrypto.NextSuffix)
+}
+
+func TestResume_AfterInterruption(t *testing.T) {
+	kp, _, targets := setup(t)
+
+	if _, err := Start(kp, targets); err != nil {
+		t.Fatal(err)
+	}
+	// Simulate a crash right after the first file was rewritten
+	// but before the journal recorded it.
+	next, err := crypto.LoadKey(kp + cfgCrypto.NextSuffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old, err := crypto.LoadKey(kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recrypt(targets[0], [][]byte{old}, next); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Start(kp, targets); err == nil {
+		t.Error("Start should refuse while a rotation is pending")
+	}
+	pending, err := Pending(kp)
+	if err != nil || pending == nil {
+		t.Fatalf("Pending = %v, %v; want the journal", pending, err)
+	}
+	if err := Resume(kp, pending); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	key, err := crypto.LoadKey(kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(key, next) || !opens(t, key, targets) {
+		t.Error("resume should finish with the staged key")
+	}
+}
+
+func TestRollback_RestoresOldKey(t *testing.T) {
+	kp, old, targets := setup(t)
+
+	if _, err := Start(kp, targets); err != nil {
+		t.Fatal(err)
+	}
+	next, err := crypto.LoadKey(kp + cfgCrypto.NextSuffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recrypt(targets[1], [][]byte{old}, next); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := Pending(kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Rollback(kp, pending); err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	key, err := crypto.LoadKey(kp)
+	if err != nil || !bytes.Equal(key, old) {
+		t.Fatal("rollback should keep the old key")
+	}
+	if !opens(t, old, targets) {
+		t.Error("targets should open with the old key again")
+	}
+	gone(t, JournalPath(kp), kp+cfgCrypto.NextSuffix, crypto.GracePath(kp))
+}
+
+func TestRollback_AfterCommitRefused(t *testing.T) {
+	kp, _, targets := setup(t)
+
+	j, err := Start(kp, targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	j.Phase = cfgCrypto.PhaseCommitted
+	if err := Rollback(kp, j); err == nil {
+		t.Error("Rollback should refuse a committed rotation")
+	}
+}
+
+func TestStart_UndecryptableTarget(t *testing.T) {
+	kp, _, targets := setup(t)
+
+	if err := os.WriteFile(
+		targets[0], []byte("not ciphertext at all, clearly"), fs.PermFile,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Start(kp, targets); err == nil {
+		t.Fatal("Start should fail on an undecryptable target")
+	}
+	gone(t, JournalPath(kp))
+}
+
+func TestShared(t *testing.T) {
+	kp, _, targets := setup(t)
+	ctxDir := filepath.Dir(targets[0])
+
+	if !Shared(ctxDir, kp) {
+		t.Errorf("global key %s should count as shared", kp)
+	}
+	local := filepath.Join(ctxDir, cfgCrypto.ContextKey)
+	if Shared(ctxDir, local) {
+		t.Errorf("project key %s should not count as shared", local)
+	}
+}
+
+func TestTargetsIn_OtherProjects(t *testing.T) {
+	kp, key, targets := setup(t)
+	ctxDir := filepath.Dir(targets[0])
+
+	other := t.TempDir()
+	otherCtx := filepath.Join(other, dir.Context)
+	if err := os.MkdirAll(otherCtx, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	otherPad := filepath.Join(otherCtx, pad.Enc)
+	sealed, err := crypto.Encrypt(key, []byte(filepath.Base(otherPad)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(otherPad, sealed, fs.PermSecret); err != nil {
+		t.Fatal(err)
+	}
+
+	// The root and the context dir name the same project.
+	dirs, err := ContextDirs(ctxDir, []string{other, otherCtx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 2 || dirs[1] != otherCtx {
+		t.Fatalf("ContextDirs = %v, want [%s %s]", dirs, ctxDir, otherCtx)
+	}
+	if _, err := ContextDirs(
+		ctxDir, []string{filepath.Join(other, "missing")},
+	); err == nil {
+		t.Error("ContextDirs should reject a missing project")
+	}
+
+	all := TargetsIn(dirs, kp)
+	j, err := Start(kp, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Resume(kp, j); err != nil {
+		t.Fatal(err)
+	}
+	next, err := crypto.LoadKey(kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !opens(t, next, append(targets, otherPad)) {
+		t.Error("every project should be under the new key")
+	}
+}
@@ -0,0 +1,31 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rotate
+
+import "time"
+
+// Journal records an in-flight key rotation.
+//
+// Fields:
+//   - Started: When the rotation began (UTC)
+//   - Phase: PhasePrepared or PhaseCommitted
+//   - Targets: Files being re-encrypted, in order
+type Journal struct {
+	Started time.Time `json:"started"`
+	Phase   string    `json:"phase"`
+	Targets []Target  `json:"targets"`
+}
+
+// Target is one ciphertext file covered by a rotation.
+//
+// Fields:
+//   - Path: Absolute file path
+//   - Done: Whether it is already under the new key
+type Target struct {
+	Path string `json:"path"`
+	Done bool   `json:"done"`
+}
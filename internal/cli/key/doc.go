@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package key implements the "ctx key" command group for
+// managing the encryption key.
+//
+// The key at ~/.ctx/.ctx.key (or the path resolved by
+// key_path) encrypts the scratchpad, the notify webhook,
+// and the hub connection file. The key_rotation_days
+// nudge reminds users to rotate it; this group does the
+// rotation.
+//
+// # Subcommands
+//
+//   - rotate: generate a new key, re-encrypt everything
+//     under it, and keep the old key for a grace period
+//
+// # Subpackages
+//
+//	cmd/rotate: the rotate subcommand
+//	core/rotate: the journaled rotation engine
+package key
@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/key/cmd/rotate"
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the key command with subcommands.
+//
+// Returns:
+//   - *cobra.Command: Configured key command with subcommands
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeyKey, cmd.UseKey,
+		rotate.Cmd(),
+	)
+}
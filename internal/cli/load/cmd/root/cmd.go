@@ -25,6 +25,8 @@ import (
 // Flags:
 //   - --budget: Token budget for assembly (default 8000)
 //   - --raw: Output raw file contents without headers or assembly
+//   - --at: Load a snapshot (ID, name, or date) instead of the
+//     live context
 //
 // Returns:
 //   - *cobra.Command: Configured load command with flags registered
@@ -32,6 +34,7 @@ func Cmd() *cobra.Command {
 	var (
 		budget int
 		raw    bool
+		at     string
 	)
 
 	short, long := desc.Command(cmd.DescKeyLoad)
@@ -45,7 +48,7 @@ func Cmd() *cobra.Command {
 			if !cmd.Flags().Changed(cFlag.Budget) {
 				budget = rc.TokenBudget()
 			}
-			return Run(cmd, budget, raw)
+			return Run(cmd, budget, raw, at)
 		},
 	}
 
@@ -58,6 +61,10 @@ func Cmd() *cobra.Command {
 		c, &raw,
 		cFlag.Raw, flag.DescKeyLoadRaw,
 	)
+	flagbind.StringFlag(
+		c, &at,
+		cFlag.At, flag.DescKeyLoadAt,
+	)
 
 	return c
 }
@@ -13,7 +13,9 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/cli/load/core/convert"
 	loadSort "github.com/ActiveMemory/ctx/internal/cli/load/core/sort"
-	"github.com/ActiveMemory/ctx/internal/context/load"
+	"github.com/ActiveMemory/ctx/internal/context/layer"
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
 	errInit "github.com/ActiveMemory/ctx/internal/err/initialize"
 	"github.com/ActiveMemory/ctx/internal/rc"
@@ -29,15 +31,24 @@ import (
 //   - cmd: Cobra command for output stream
 //   - budget: Token budget for assembled output
 //   - raw: If true, output raw file contents without assembly
+//   - at: Snapshot reference to load instead of the live context
+//     (empty for the live context)
 //
 // Returns:
 //   - error: Non-nil if context loading fails or .context/ is not found
-func Run(cmd *cobra.Command, budget int, raw bool) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+func Run(cmd *cobra.Command, budget int, raw bool, at string) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
-	ctx, err := load.Do("")
+	var ctx *entity.Context
+	var err error
+	if at != "" {
+		ctx, _, err = snapshot.Load(ctxDir, at)
+	} else {
+		ctx, err = layer.Load("")
+	}
 	if err != nil {
 		if _, ok := errors.AsType[*errCtx.NotFoundError](err); ok {
 			return errInit.NotInitialized()
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flush
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the "ctx hook notify flush" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured flush subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyNotifyFlush)
+	return &cobra.Command{
+		Use:     cmd.UseNotifyFlush,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyNotifyFlush),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd)
+		},
+	}
+}
@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package flush implements the "ctx hook notify flush"
+// command.
+//
+// # Overview
+//
+// When notify.outbox is enabled, deliveries that fail are
+// queued under .context/state/notify-outbox/ and retried
+// with exponential backoff on later notifications. The
+// flush command retries every queued delivery right away,
+// regardless of its backoff, and reports how many were
+// sent, failed again, were dead-lettered, or remain
+// pending.
+//
+// # Behavior
+//
+// [Cmd] builds the cobra.Command. [Run] calls
+// notify.Flush with force enabled and prints the counts,
+// or a short notice when the outbox is empty.
+package flush
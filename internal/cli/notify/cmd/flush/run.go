@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flush
+
+import (
+	"github.com/spf13/cobra"
+
+	iNotify "github.com/ActiveMemory/ctx/internal/notify"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeNotify "github.com/ActiveMemory/ctx/internal/write/notify"
+)
+
+// Run retries every queued delivery now, ignoring backoff.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: Non-nil when the outbox cannot be read
+func Run(cmd *cobra.Command) error {
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	r, flushErr := iNotify.Flush(true)
+	if flushErr != nil {
+		return flushErr
+	}
+	writeNotify.FlushResult(cmd, r)
+	return nil
+}
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the "ctx hook notify log" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured log subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyNotifyLog)
+	c := &cobra.Command{
+		Use:     cmd.UseNotifyLog,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyNotifyLog),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd)
+		},
+	}
+	flagbind.LastJSON(c, cfgNotify.DefaultLogLast,
+		flag.DescKeyNotifyLogLast,
+		flag.DescKeyNotifyLogJson,
+	)
+	return c
+}
@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package log implements the "ctx hook notify log"
+// command.
+//
+// # Overview
+//
+// When notify.outbox is enabled, every delivery attempt is
+// appended to .context/state/notify-log.jsonl. The log
+// command shows the most recent attempts with their
+// status (sent, queued, failed, dead), target channel,
+// event, and attempt number, followed by a count of
+// deliveries still waiting in the outbox.
+//
+// # Flags
+//
+//   - --last, -n N: number of entries to show.
+//   - --json, -j: print raw JSONL records instead.
+//
+// # Behavior
+//
+// [Cmd] builds the cobra.Command. [Run] reads the log via
+// notify.DeliveryLog and formats it through the delivery
+// core package.
+package log
@@ -0,0 +1,57 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/notify/core/delivery"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	iNotify "github.com/ActiveMemory/ctx/internal/notify"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeNotify "github.com/ActiveMemory/ctx/internal/write/notify"
+)
+
+// Run prints the most recent delivery attempts followed by the
+// outbox summary.
+//
+// Parameters:
+//   - cmd: Cobra command for flag access and output
+//
+// Returns:
+//   - error: Non-nil when the log or outbox cannot be read
+func Run(cmd *cobra.Command) error {
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	last, _ := cmd.Flags().GetInt(cFlag.Last)
+	jsonOut, _ := cmd.Flags().GetBool(cFlag.JSON)
+
+	records, logErr := iNotify.DeliveryLog(last)
+	if logErr != nil {
+		return logErr
+	}
+	if jsonOut {
+		writeNotify.LogLines(cmd, delivery.FormatJSON(records))
+		return nil
+	}
+
+	pending, dead, outboxErr := iNotify.Outbox()
+	if outboxErr != nil {
+		return outboxErr
+	}
+	if len(records) == 0 {
+		writeNotify.LogEmpty(cmd)
+	} else {
+		writeNotify.LogLines(cmd, delivery.FormatHuman(records))
+	}
+	if len(pending) > 0 || len(dead) > 0 {
+		writeNotify.LogOutbox(cmd, len(pending), len(dead))
+	}
+	return nil
+}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
 )
 
 // Cmd returns the "ctx hook notify setup" subcommand.
@@ -21,13 +23,21 @@ import (
 //   - *cobra.Command: Configured setup subcommand
 func Cmd() *cobra.Command {
 	short, long := desc.Command(cmd.DescKeyNotifySetup)
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:     cmd.UseNotifySetup,
 		Short:   short,
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyNotifySetup),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			channel, _ := cmd.Flags().GetString(cFlag.Channel)
+			if channel != "" {
+				return RunChannel(cmd, os.Stdin, channel)
+			}
 			return Run(cmd, os.Stdin)
 		},
 	}
+	c.Flags().String(cFlag.Channel, "",
+		desc.Flag(flag.DescKeyNotifySetupChannel),
+	)
+	return c
 }
@@ -17,11 +17,14 @@
 //
 // # Flags
 //
-// This command accepts no flags.
+//   - --channel NAME: configure the secrets of a channel
+//     declared under notify.channels in .ctxrc instead of
+//     the webhook URL.
 //
 // # Behavior
 //
-// [Cmd] builds a simple cobra.Command with no flags.
+// [Cmd] builds the cobra.Command and dispatches on
+// --channel.
 // [Run] is exported for testability and accepts an
 // *os.File for stdin injection. It performs these steps:
 //
@@ -36,6 +39,12 @@
 // If stdin is empty or the URL is blank, the command
 // returns an appropriate error.
 //
+// [RunChannel] looks the channel up in .ctxrc, prompts
+// for the secret fields its type needs (URL, token, SMTP
+// user and password), rejects blank required fields and
+// stores the result via iNotify.SaveSecret in
+// .context/.notify-channels.enc.
+//
 // # Output
 //
 // Prints a setup prompt, then a confirmation showing
@@ -9,11 +9,13 @@ package setup
 import (
 	"bufio"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/config/crypto"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
 	"github.com/ActiveMemory/ctx/internal/err/fs"
 	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
 	iNotify "github.com/ActiveMemory/ctx/internal/notify"
@@ -55,3 +57,64 @@ func Run(cmd *cobra.Command, stdin *os.File) error {
 
 	return nil
 }
+
+// RunChannel prompts for the secrets of a channel declared in
+// .ctxrc and saves them encrypted. The fields asked for depend
+// on the channel type; optional fields may be left blank.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - stdin: Input source (os.Stdin in production, temp file in tests)
+//   - name: Channel name from notify.channels
+//
+// Returns:
+//   - error: Non-nil on unknown channel, missing required input,
+//     or save failure
+func RunChannel(cmd *cobra.Command, stdin *os.File, name string) error {
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	// Flags are valid by now; errors are about configuration.
+	cmd.SilenceUsage = true
+	ch, found := iNotify.FindChannel(name)
+	if !found {
+		return errNotify.UnknownChannel(name)
+	}
+	fields, known := cfgNotify.Fields[ch.Type]
+	if !known {
+		return errNotify.UnknownType(ch.Name, ch.Type)
+	}
+
+	values := make(map[string]string, len(fields))
+	scanner := bufio.NewScanner(stdin)
+	for _, field := range fields {
+		required := slices.Contains(cfgNotify.Required[ch.Type], field)
+		notify.ChannelPrompt(cmd, field, name, required)
+		var value string
+		if scanner.Scan() {
+			value = strings.TrimSpace(scanner.Text())
+		} else if required {
+			return fs.NoInput()
+		}
+		if value == "" && required {
+			return errNotify.FieldEmpty(field)
+		}
+		values[field] = value
+	}
+
+	s := iNotify.Secret{
+		URL:      values[cfgNotify.FieldURL],
+		Token:    values[cfgNotify.FieldToken],
+		User:     values[cfgNotify.FieldUser],
+		Password: values[cfgNotify.FieldPassword],
+	}
+	if saveErr := iNotify.SaveSecret(name, s); saveErr != nil {
+		return saveErr
+	}
+
+	notify.ChannelDone(
+		cmd, name, ch.Type, iNotify.MaskURL(s.URL), crypto.NotifyChannelsEnc,
+	)
+	return nil
+}
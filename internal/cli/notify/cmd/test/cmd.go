@@ -11,6 +11,8 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
 )
 
 // Cmd returns the "ctx hook notify test" subcommand.
@@ -19,13 +21,21 @@ import (
 //   - *cobra.Command: Configured test subcommand
 func Cmd() *cobra.Command {
 	short, long := desc.Command(cmd.DescKeyNotifyTest)
-	return &cobra.Command{
+	c := &cobra.Command{
 		Use:     cmd.UseNotifyTest,
 		Short:   short,
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyNotifyTest),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			channel, _ := cmd.Flags().GetString(cFlag.Channel)
+			if channel != "" {
+				return RunChannel(cmd, channel)
+			}
 			return Run(cmd)
 		},
 	}
+	c.Flags().String(cFlag.Channel, "",
+		desc.Flag(flag.DescKeyNotifyTestChannel),
+	)
+	return c
 }
@@ -17,11 +17,13 @@
 //
 // # Flags
 //
-// This command accepts no flags.
+//   - --channel NAME: send the test to a named channel
+//     from .ctxrc instead of the webhook.
 //
 // # Behavior
 //
-// [Cmd] builds a simple cobra.Command with no flags.
+// [Cmd] builds the cobra.Command and dispatches on
+// --channel.
 // [Run] delegates to coreTest.Send which loads the
 // encrypted webhook URL, sends a test HTTP request,
 // and returns the result. Then it dispatches to the
@@ -35,6 +37,10 @@
 //     the HTTP status code and whether it was
 //     successful.
 //
+// [RunChannel] delegates to coreTest.SendChannel, which
+// delivers the test payload in the channel's native
+// format and returns any delivery error.
+//
 // # Output
 //
 // Prints the HTTP status code and a pass/fail
@@ -44,3 +44,31 @@ func Run(cmd *cobra.Command) error {
 	writeNotify.TestResult(cmd, r.StatusCode, coreTest.OK(r), crypto.NotifyEnc)
 	return nil
 }
+
+// RunChannel sends a test notification to one named channel in
+// its native format.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - name: Channel name from notify.channels
+//
+// Returns:
+//   - error: Non-nil on unknown or unconfigured channel, or
+//     delivery failure
+func RunChannel(cmd *cobra.Command, name string) error {
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	// Flags are valid by now; errors are about configuration.
+	cmd.SilenceUsage = true
+	r, sendErr := coreTest.SendChannel(name)
+	if r.Filtered {
+		writeNotify.ChannelFiltered(cmd, r.Name)
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+	writeNotify.ChannelSent(cmd, r.Name, r.Type)
+	return nil
+}
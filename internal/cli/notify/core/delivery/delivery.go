@@ -0,0 +1,65 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// FormatJSON formats delivery records as JSONL lines.
+//
+// Parameters:
+//   - records: delivery records to serialize
+//
+// Returns:
+//   - []string: JSON lines (marshal errors are silently skipped)
+func FormatJSON(records []entity.DeliveryRecord) []string {
+	var lines []string
+	for _, r := range records {
+		line, marshalErr := json.Marshal(r)
+		if marshalErr != nil {
+			continue
+		}
+		lines = append(lines, string(line))
+	}
+	return lines
+}
+
+// FormatHuman formats delivery records in aligned columns, with
+// the error indented under each unsuccessful attempt.
+//
+// Parameters:
+//   - records: delivery records to format
+//
+// Returns:
+//   - []string: formatted lines
+func FormatHuman(records []entity.DeliveryRecord) []string {
+	lineFmt := desc.Text(text.DescKeyWriteNotifyLogLine)
+	errFmt := desc.Text(text.DescKeyWriteNotifyLogError)
+	lines := make([]string, 0, len(records))
+	for _, r := range records {
+		target := r.Channel
+		if target == "" {
+			target = cfgNotify.TargetWebhook
+		}
+		lines = append(lines, fmt.Sprintf(lineFmt,
+			r.Time.Local().Format(cfgTime.DateTimePreciseFmt),
+			r.Status, target, r.Event, r.Attempt,
+		))
+		if r.Error != "" {
+			lines = append(lines, fmt.Sprintf(errFmt, r.Error))
+		}
+	}
+	return lines
+}
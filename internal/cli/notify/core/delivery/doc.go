@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package delivery formats the notification delivery log
+// for "ctx hook notify log".
+//
+// [FormatHuman] renders one aligned line per attempt
+// (time, status, channel, event, attempt number) with the
+// failure reason indented beneath it; the legacy webhook
+// is shown as "(webhook)". [FormatJSON] renders the raw
+// records as JSONL for scripting.
+package delivery
@@ -37,6 +37,13 @@
 //  7. Returns a Result containing the HTTP status code
 //     and filtered flag.
 //
+// # Channels
+//
+// [SendChannel] delivers the same payload to one named
+// channel through notify.Deliver, bypassing the
+// channel's event filter and reporting whether the
+// filter would have dropped it in a [ChannelResult].
+//
 // # Result Evaluation
 //
 // [OK] checks whether a Result indicates success by
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	cfgEvent "github.com/ActiveMemory/ctx/internal/config/event"
+	"github.com/ActiveMemory/ctx/internal/config/project"
+	"github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// buildPayload builds the test notification payload for the
+// current project.
+//
+// Returns:
+//   - entity.NotifyPayload: Payload with event type "test"
+func buildPayload() entity.NotifyPayload {
+	projectName := project.FallbackName
+	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
+		projectName = filepath.Base(cwd)
+	} else {
+		ctxLog.Warn(warn.Getwd, cwdErr)
+	}
+
+	return entity.NotifyPayload{
+		Event:     cfgEvent.TypeTest,
+		Message:   cfgEvent.TestMessage,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Project:   projectName,
+	}
+}
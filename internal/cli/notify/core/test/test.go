@@ -9,14 +9,9 @@ package test
 import (
 	"encoding/json"
 	"net/http"
-	"os"
-	"path/filepath"
-	"time"
 
 	cfgEvent "github.com/ActiveMemory/ctx/internal/config/event"
-	"github.com/ActiveMemory/ctx/internal/config/project"
 	"github.com/ActiveMemory/ctx/internal/config/warn"
-	"github.com/ActiveMemory/ctx/internal/entity"
 	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
 	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
 	"github.com/ActiveMemory/ctx/internal/notify"
@@ -38,19 +33,7 @@ func Send() (Result, error) {
 		return Result{NoWebhook: true}, nil
 	}
 
-	projectName := project.FallbackName
-	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
-		projectName = filepath.Base(cwd)
-	} else {
-		ctxLog.Warn(warn.Getwd, cwdErr)
-	}
-
-	payload := entity.NotifyPayload{
-		Event:     cfgEvent.TypeTest,
-		Message:   cfgEvent.TestMessage,
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
-		Project:   projectName,
-	}
+	payload := buildPayload()
 
 	body, marshalErr := json.Marshal(payload)
 	if marshalErr != nil {
@@ -77,6 +60,45 @@ func Send() (Result, error) {
 	}, nil
 }
 
+// SendChannel builds a test payload and delivers it to one
+// named channel, bypassing the channel's event filter.
+//
+// Parameters:
+//   - name: Channel name from notify.channels
+//
+// Returns:
+//   - ChannelResult: Channel identity and filter state, set even
+//     when delivery fails
+//   - error: Non-nil on unknown or unconfigured channel, or
+//     delivery failure
+func SendChannel(name string) (ChannelResult, error) {
+	ch, found := notify.FindChannel(name)
+	if !found {
+		return ChannelResult{}, errNotify.UnknownChannel(name)
+	}
+	r := ChannelResult{
+		Name:     ch.Name,
+		Type:     ch.Type,
+		Filtered: !notify.EventAllowed(cfgEvent.TypeTest, ch.Events),
+	}
+
+	secrets, loadErr := notify.LoadSecrets()
+	if loadErr != nil {
+		return r, loadErr
+	}
+	s, ok := secrets[name]
+	if !ok {
+		return r, errNotify.NoChannelSecret(name)
+	}
+
+	if deliverErr := notify.Deliver(
+		ch, s, buildPayload(),
+	); deliverErr != nil {
+		return r, errNotify.SendNotification(deliverErr)
+	}
+	return r, nil
+}
+
 // OK reports whether the HTTP response indicates success.
 //
 // Parameters:
@@ -17,3 +17,15 @@ type Result struct {
 	Filtered   bool
 	StatusCode int
 }
+
+// ChannelResult holds the outcome of a channel test notification.
+//
+// Fields:
+//   - Name: Channel name
+//   - Type: Channel provider type
+//   - Filtered: The channel does not subscribe to the test event
+type ChannelResult struct {
+	Name     string
+	Type     string
+	Filtered bool
+}
@@ -5,7 +5,8 @@
 //                 SPDX-License-Identifier: Apache-2.0
 
 // Package notify implements the **`ctx hook notify`**
-// command surface (webhook send, setup, and test)
+// command surface (webhook send, setup, test, outbox
+// flush, and delivery log)
 // that wraps the in-process [internal/notify] engine for
 // CLI use.
 //
@@ -28,9 +29,16 @@
 //     **bypassing** the event filter so users can
 //     verify connectivity without subscribing the test
 //     event first. See [internal/cli/notify/cmd/test].
+//   - **`ctx hook notify flush`**: retries every
+//     delivery queued in the outbox now, ignoring
+//     backoff. See [internal/cli/notify/cmd/flush].
+//   - **`ctx hook notify log`**: shows recent delivery
+//     attempts and the outbox size. See
+//     [internal/cli/notify/cmd/log].
 //
 // # Concurrency
 //
-// Stateless. The CLI command spawns one HTTP request
-// and exits.
+// Stateless unless notify.outbox is enabled. Outbox items
+// are claimed by atomic rename, so concurrent sends and
+// flushes never deliver the same item twice.
 package notify
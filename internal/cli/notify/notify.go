@@ -12,6 +12,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/notify/cmd/flush"
+	"github.com/ActiveMemory/ctx/internal/cli/notify/cmd/log"
 	"github.com/ActiveMemory/ctx/internal/cli/notify/cmd/setup"
 	"github.com/ActiveMemory/ctx/internal/cli/notify/cmd/test"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
@@ -70,6 +72,8 @@ func Cmd() *cobra.Command {
 
 	c.AddCommand(setup.Cmd())
 	c.AddCommand(test.Cmd())
+	c.AddCommand(flush.Cmd())
+	c.AddCommand(log.Cmd())
 
 	return c
 }
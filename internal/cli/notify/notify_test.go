@@ -270,3 +270,197 @@ func TestTest_WebhookServerError(t *testing.T) {
 		t.Errorf("output = %q, want mention of HTTP 500", output)
 	}
 }
+
+func writeChannelRC(t *testing.T, tempDir string) {
+	t.Helper()
+	rcContent := `notify:
+  channels:
+    - name: phone
+      type: ntfy
+      events: [loop]
+`
+	if err := os.WriteFile(
+		filepath.Join(tempDir, ".ctxrc"), []byte(rcContent), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	rc.Reset()
+}
+
+func TestSetupChannel_WithMockStdin(t *testing.T) {
+	tempDir, cleanup := setupCLITest(t)
+	defer cleanup()
+	writeChannelRC(t, tempDir)
+
+	tmpFile, err := os.CreateTemp("", "notify-stdin-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+	// URL, then a blank optional token.
+	_, _ = tmpFile.WriteString("https://ntfy.sh/ctx-secret-topic\n\n")
+	_, _ = tmpFile.Seek(0, 0)
+
+	cmd := Cmd()
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+
+	if runErr := setup.RunChannel(cmd, tmpFile, "phone"); runErr != nil {
+		t.Fatalf("setup.RunChannel() error = %v", runErr)
+	}
+	output := buf.String()
+	if !strings.Contains(output, `Channel "phone" (ntfy) configured`) {
+		t.Errorf("output = %q", output)
+	}
+	if strings.Contains(output, "secret-topic") {
+		t.Error("output should not contain the full URL")
+	}
+
+	secrets, loadErr := libNotify.LoadSecrets()
+	if loadErr != nil {
+		t.Fatalf("LoadSecrets() error = %v", loadErr)
+	}
+	if got := secrets["phone"].URL; got != "https://ntfy.sh/ctx-secret-topic" {
+		t.Errorf("saved URL = %q", got)
+	}
+}
+
+func TestSetupChannel_Unknown(t *testing.T) {
+	tempDir, cleanup := setupCLITest(t)
+	defer cleanup()
+	writeChannelRC(t, tempDir)
+
+	cmd := Cmd()
+	cmd.SetArgs([]string{"setup", "--channel", "pager"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	execErr := cmd.Execute()
+	if execErr == nil || !strings.Contains(execErr.Error(), "pager") {
+		t.Fatalf("Execute() error = %v, want unknown channel", execErr)
+	}
+}
+
+func TestTestChannel_Delivers(t *testing.T) {
+	tempDir, cleanup := setupCLITest(t)
+	defer cleanup()
+	writeChannelRC(t, tempDir)
+
+	var title string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			title = r.Header.Get("Title")
+			w.WriteHeader(http.StatusOK)
+		}))
+	defer server.Close()
+
+	if saveErr := libNotify.SaveSecret(
+		"phone", libNotify.Secret{URL: server.URL},
+	); saveErr != nil {
+		t.Fatalf("SaveSecret() error = %v", saveErr)
+	}
+
+	cmd := Cmd()
+	cmd.SetArgs([]string{"test", "--channel", "phone"})
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	if execErr := cmd.Execute(); execErr != nil {
+		t.Fatalf("Execute() error = %v", execErr)
+	}
+	if !strings.Contains(title, ": test") {
+		t.Errorf("Title header = %q", title)
+	}
+	output := buf.String()
+	if !strings.Contains(output, "delivered") {
+		t.Errorf("output = %q, want delivery confirmation", output)
+	}
+	if !strings.Contains(output, "does not subscribe") {
+		t.Errorf("output = %q, want filter notice", output)
+	}
+}
+
+func TestTestChannel_NotSetUp(t *testing.T) {
+	tempDir, cleanup := setupCLITest(t)
+	defer cleanup()
+	writeChannelRC(t, tempDir)
+
+	cmd := Cmd()
+	cmd.SetArgs([]string{"test", "--channel", "phone"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	execErr := cmd.Execute()
+	if execErr == nil || !strings.Contains(execErr.Error(), "not set up") {
+		t.Fatalf("Execute() error = %v, want not set up", execErr)
+	}
+}
+
+func TestFlushAndLog_Outbox(t *testing.T) {
+	tempDir, cleanup := setupCLITest(t)
+	defer cleanup()
+
+	status := http.StatusBadGateway
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+		}))
+	defer server.Close()
+
+	rcContent := `notify:
+  outbox: true
+  channels:
+    - name: phone
+      type: ntfy
+      events: [loop]
+`
+	if err := os.WriteFile(
+		filepath.Join(tempDir, ".ctxrc"), []byte(rcContent), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	rc.Reset()
+	if saveErr := libNotify.SaveSecret(
+		"phone", libNotify.Secret{URL: server.URL},
+	); saveErr != nil {
+		t.Fatalf("SaveSecret() error = %v", saveErr)
+	}
+	_ = libNotify.Send("loop", "done", "", nil)
+
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := Cmd()
+		cmd.SetArgs(args)
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+		cmd.SetErr(&buf)
+		if execErr := cmd.Execute(); execErr != nil {
+			t.Fatalf("%v: Execute() error = %v", args, execErr)
+		}
+		return buf.String()
+	}
+
+	out := run("log")
+	for _, want := range []string{"queued", "phone", "loop", "502",
+		"Outbox: 1 pending"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output = %q, want %q", out, want)
+		}
+	}
+
+	status = http.StatusOK
+	out = run("flush")
+	if !strings.Contains(out, "1 sent") {
+		t.Errorf("flush output = %q", out)
+	}
+	if out = run("flush"); !strings.Contains(out, "empty") {
+		t.Errorf("second flush output = %q, want empty notice", out)
+	}
+
+	out = run("log", "--json", "--last", "1")
+	if !strings.Contains(out, `"status":"sent"`) ||
+		strings.Contains(out, "queued") {
+		t.Errorf("log --json output = %q", out)
+	}
+}
@@ -22,15 +22,18 @@ import (
 //   - *cobra.Command: Configured add subcommand
 func Cmd() *cobra.Command {
 	var filePath string
+	var expires string
+	var category string
 
-	short, _ := desc.Command(cmd.DescKeyPadAdd)
+	short, long := desc.Command(cmd.DescKeyPadAdd)
 	c := &cobra.Command{
 		Use:     cmd.UsePadAdd,
 		Short:   short,
+		Long:    long,
 		Example: desc.Example(cmd.DescKeyPadAdd),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return Run(cmd, args[0], filePath)
+			return Run(cmd, args[0], filePath, expires, category)
 		},
 	}
 
@@ -38,6 +41,12 @@ func Cmd() *cobra.Command {
 		cFlag.File, cFlag.ShortFile,
 		flag.DescKeyPadAddFile,
 	)
+	flagbind.StringFlag(c, &expires,
+		cFlag.Expires, flag.DescKeyPadAddExpires,
+	)
+	flagbind.StringFlag(c, &category,
+		cFlag.Category, flag.DescKeyPadAddCategory,
+	)
 
 	return c
 }
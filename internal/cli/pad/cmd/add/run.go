@@ -7,10 +7,14 @@
 package add
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	coreAdd "github.com/ActiveMemory/ctx/internal/cli/pad/core/add"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/recipient"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
@@ -20,28 +24,56 @@ import (
 //
 // When filePath is non-empty, the entry is imported as a blob
 // with text as the label. Otherwise text is added as plain.
+// An expiry and category are stored in the entry metadata when
+// given. The author is recorded alongside them, and on every
+// entry of a shared scratchpad, where it tells teammates apart.
 //
 // Parameters:
 //   - cmd: Cobra command for output
 //   - text: Entry text or blob label
 //   - filePath: Blob file path (empty for plain text)
+//   - expires: Expiry as a date or day count (empty for none)
+//   - category: Entry category (empty for none)
 //
 // Returns:
-//   - error: Non-nil on read/write failure or too large
-func Run(cmd *cobra.Command, text, filePath string) error {
-	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+//   - error: Non-nil on invalid metadata, read/write failure
+//     or too large
+func Run(
+	cmd *cobra.Command, text, filePath, expires, category string,
+) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
 		cmd.SilenceUsage = true
 		return ctxErr
 	}
+	m := meta.Meta{Category: category}
+	if catErr := meta.ValidateCategory(category); catErr != nil {
+		return catErr
+	}
+	if expires != "" {
+		date, expErr := meta.ParseExpiry(expires, time.Now())
+		if expErr != nil {
+			return expErr
+		}
+		m.Expires = date
+	}
+	rs, rsErr := recipient.Load(ctxDir)
+	if rsErr != nil {
+		return rsErr
+	}
+	if m != (meta.Meta{}) || len(rs) > 0 {
+		m.CreatedBy = meta.Author()
+	}
+
 	var entries []parse.Entry
 	var id int
 	var addErr error
 
 	if filePath != "" {
 		entries, id, addErr = coreAdd.BlobWithID(
-			text, filePath)
+			text, filePath, m)
 	} else {
-		entries, id, addErr = coreAdd.EntryWithID(text)
+		entries, id, addErr = coreAdd.EntryWithID(text, m)
 	}
 	if addErr != nil {
 		return addErr
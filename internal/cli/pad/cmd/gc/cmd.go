@@ -0,0 +1,42 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package gc
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the pad gc subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured gc subcommand
+func Cmd() *cobra.Command {
+	var dryRun, mark bool
+
+	short, long := desc.Command(cmd.DescKeyPadGc)
+	c := &cobra.Command{
+		Use:     cmd.UsePadGc,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyPadGc),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, mark, dryRun)
+		},
+	}
+
+	flagbind.BoolFlag(c, &dryRun, cFlag.DryRun, flag.DescKeyPadGcDryRun)
+	flagbind.BoolFlag(c, &mark, cFlag.Mark, flag.DescKeyPadGcMark)
+
+	return c
+}
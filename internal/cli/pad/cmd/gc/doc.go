@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package gc implements the "ctx pad gc" subcommand for
+// clearing out expired scratchpad entries.
+//
+// # Behavior
+//
+// Entries added with --expires carry a last valid day
+// in their encrypted metadata. gc lists every entry
+// whose day has passed and removes it, keeping the
+// stable IDs of the remaining entries.
+//
+// # Flags
+//
+//	--mark      Keep expired entries and tag them
+//	            #expired instead of removing them.
+//	--dry-run   List expired entries without writing.
+//
+// # Output
+//
+// One line per expired entry (ID, display text and
+// expiry date), followed by a summary. When nothing
+// has expired, prints a single notice.
+//
+// # Delegation
+//
+// The sweep itself lives in [coreGc.Sweep]; this
+// package handles the CLI wiring, persistence via
+// [store.WriteEntriesWithIDs] and output via
+// [writePad].
+package gc
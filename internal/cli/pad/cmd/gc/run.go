@@ -0,0 +1,60 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package gc
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	coreGc "github.com/ActiveMemory/ctx/internal/cli/pad/core/gc"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Run removes (or tags) scratchpad entries whose expiry date
+// has passed.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - mark: Tag expired entries #expired instead of removing
+//   - dryRun: List expired entries without writing
+//
+// Returns:
+//   - error: Non-nil on read or write failure
+func Run(cmd *cobra.Command, mark, dryRun bool) error {
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+	entries, readErr := store.ReadEntriesWithIDs()
+	if readErr != nil {
+		return readErr
+	}
+
+	today := time.Now().Format(cfgTime.DateFormat)
+	kept, expired := coreGc.Sweep(entries, today, mark)
+	for _, e := range expired {
+		m, body := meta.Split(e.Content)
+		writePad.GcItem(cmd, e.ID, blob.DisplayEntry(body), m.Expires)
+	}
+
+	if len(expired) > 0 && !dryRun {
+		if writeErr := store.WriteEntriesWithIDs(
+			cmd, kept,
+		); writeErr != nil {
+			return writeErr
+		}
+	}
+
+	writePad.GcSummary(cmd, len(expired), mark, dryRun)
+	return nil
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git_merge_driver
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the pad git-merge-driver subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured git-merge-driver subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyPadGitMergeDriver)
+	return &cobra.Command{
+		Use:     cmd.UsePadGitMergeDriver,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyPadGitMergeDriver),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args[0], args[1], args[2])
+		},
+	}
+}
@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package git_merge_driver implements the "ctx pad
+// git-merge-driver" subcommand, a git merge driver for
+// the encrypted scratchpad.
+//
+// # Behavior
+//
+// Git cannot merge ciphertext line by line, so two
+// branches that both touched the pad always conflict.
+// `ctx setup git --write` registers this command as the
+// "ctx-pad" merge driver; git then calls it with the
+// ancestor, our and their versions of the pad (%O %A
+// %B). The command decrypts all three, merges them
+// entry by entry with [threeway.Merge], re-encrypts the
+// result the way the pad is stored (recipients or the
+// single key) and writes it over %A.
+//
+// # Conflicts
+//
+// Entries changed differently on both sides keep both
+// versions. Each is reported on stderr and the command
+// exits non-zero, so git leaves the pad marked as
+// conflicted for review; the file itself is already a
+// valid, merged pad.
+package git_merge_driver
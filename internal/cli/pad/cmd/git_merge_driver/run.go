@@ -0,0 +1,68 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git_merge_driver
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/threeway"
+	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Run merges three versions of the encrypted scratchpad and
+// writes the result over ours, as git expects of a merge
+// driver.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - base: Path of the common ancestor version (%O)
+//   - ours: Path of the current version; receives the result (%A)
+//   - theirs: Path of the version being merged (%B)
+//
+// Returns:
+//   - error: Non-nil on decryption or write failure, or when
+//     entries changed on both sides so git marks the pad as
+//     conflicted
+func Run(cmd *cobra.Command, base, ours, theirs string) error {
+	cmd.SilenceUsage = true
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		return ctxErr
+	}
+
+	baseEntries, baseErr := store.ReadFileWithIDs(base)
+	if baseErr != nil {
+		return baseErr
+	}
+	ourEntries, oursErr := store.ReadFileWithIDs(ours)
+	if oursErr != nil {
+		return oursErr
+	}
+	theirEntries, theirsErr := store.ReadFileWithIDs(theirs)
+	if theirsErr != nil {
+		return theirsErr
+	}
+
+	merged, conflicts := threeway.Merge(
+		baseEntries, ourEntries, theirEntries,
+	)
+	if writeErr := store.WriteFileWithIDs(
+		cmd, ours, merged,
+	); writeErr != nil {
+		return writeErr
+	}
+
+	for _, id := range conflicts {
+		writePad.MergeDriverConflict(cmd, id)
+	}
+	if len(conflicts) > 0 {
+		return errPad.MergeConflicts(len(conflicts))
+	}
+	return nil
+}
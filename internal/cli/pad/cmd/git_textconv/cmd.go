@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git_textconv
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the pad git-textconv subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured git-textconv subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyPadGitTextconv)
+	return &cobra.Command{
+		Use:     cmd.UsePadGitTextconv,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyPadGitTextconv),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args[0])
+		},
+	}
+}
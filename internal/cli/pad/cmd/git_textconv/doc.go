@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package git_textconv implements the "ctx pad
+// git-textconv" subcommand, a git diff text converter
+// for the encrypted scratchpad.
+//
+// # Behavior
+//
+// `ctx setup git --write` registers this command as the
+// textconv of the "ctx-pad" diff driver. git diff, git
+// log -p and git show then run it on each version of
+// the pad and compare the output instead of the
+// ciphertext: one "[ID] content" line per entry, with
+// blob payloads reduced to a short digest by
+// [resolve.DiffLines].
+package git_textconv
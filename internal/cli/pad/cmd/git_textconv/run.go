@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git_textconv
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/resolve"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Run decrypts a scratchpad file and prints one line per
+// entry for git diff.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - path: Scratchpad file git asks to convert
+//
+// Returns:
+//   - error: Non-nil on read or decryption failure
+func Run(cmd *cobra.Command, path string) error {
+	cmd.SilenceUsage = true
+	if _, ctxErr := rc.RequireContextDir(); ctxErr != nil {
+		return ctxErr
+	}
+	entries, readErr := store.ReadFileWithIDs(path)
+	if readErr != nil {
+		return readErr
+	}
+	writePad.Textconv(cmd, resolve.DiffLines(entries))
+	return nil
+}
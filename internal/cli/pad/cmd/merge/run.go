@@ -43,7 +43,7 @@ func Run(
 		return readErr
 	}
 
-	key, keyErr := merge.LoadKey(keyFile)
+	keys, keyErr := merge.LoadKeyring(keyFile)
 	if keyErr != nil {
 		return keyErr
 	}
@@ -59,7 +59,7 @@ func Run(
 	var newEntries []string
 
 	for _, file := range files {
-		entries, fileErr := merge.ReadFileEntries(file, key)
+		entries, fileErr := merge.ReadFileEntries(file, keys)
 		if fileErr != nil {
 			return errFs.OpenFile(file, fileErr)
 		}
@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package add
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the pad recipients add subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured add subcommand
+func Cmd() *cobra.Command {
+	var name string
+
+	short, long := desc.Command(cmd.DescKeyPadRecipientsAdd)
+	c := &cobra.Command{
+		Use:     cmd.UsePadRecipientsAdd,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyPadRecipientsAdd),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args[0], name)
+		},
+	}
+
+	flagbind.StringFlag(
+		c, &name, cFlag.Name, flag.DescKeyPadRecipientsAddName,
+	)
+
+	return c
+}
@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package add implements the "ctx pad recipients add"
+// subcommand.
+//
+// # Behavior
+//
+// The positional argument is a "ctxpub1..." public key,
+// validated before anything is written. Adding a key that
+// is already listed is an error. When the list is empty,
+// your own public key is added first (creating your
+// identity if needed) so you keep access to the pad.
+//
+// The new list is saved and the scratchpad, if it
+// exists, is re-encrypted for it via [store.Rewrap].
+// Recipients require scratchpad_encrypt to be on.
+//
+// # Flags
+//
+//	--name NAME   label shown in "recipients list"
+//
+// # Output
+//
+// A one-line confirmation with the recipient count.
+package add
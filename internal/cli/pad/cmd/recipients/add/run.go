@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package add
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/recipient"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Run adds a recipient and re-encrypts the scratchpad.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - key: Public key to add
+//   - name: Optional recipient label
+//
+// Returns:
+//   - error: Non-nil on an invalid or duplicate key, an
+//     unencrypted pad, or re-encryption failure
+func Run(cmd *cobra.Command, key, name string) error {
+	if !rc.ScratchpadEncrypt() {
+		return errPad.RecipientsNotEncrypted()
+	}
+	if _, keyErr := crypto.ParsePublicKey(key); keyErr != nil {
+		return keyErr
+	}
+	cmd.SilenceUsage = true
+
+	ctxDir, dirErr := rc.RequireContextDir()
+	if dirErr != nil {
+		return dirErr
+	}
+	rs, loadErr := recipient.Load(ctxDir)
+	if loadErr != nil {
+		return loadErr
+	}
+	for _, r := range rs {
+		if r.Key == key {
+			return errPad.RecipientExists(key)
+		}
+	}
+
+	if len(rs) == 0 {
+		self, selfErr := recipient.Self(cmd)
+		if selfErr != nil {
+			return selfErr
+		}
+		if self != key {
+			rs = append(rs, recipient.Recipient{Key: self})
+		}
+	}
+
+	added := recipient.Recipient{Key: key, Name: name}
+	rs = append(rs, added)
+	if rewrapErr := store.Rewrap(cmd, ctxDir, rs); rewrapErr != nil {
+		return rewrapErr
+	}
+
+	writePad.RecipientAdded(cmd, added.Label(), len(rs))
+	return nil
+}
@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package recipients
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/recipients/add"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/recipients/list"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/recipients/remove"
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the pad recipients parent command.
+//
+// Returns:
+//   - *cobra.Command: The recipients command with add, remove,
+//     and list subcommands
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeyPadRecipients, cmd.UsePadRecipients,
+		add.Cmd(),
+		remove.Cmd(),
+		list.Cmd(),
+	)
+}
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package recipients provides the "ctx pad recipients"
+// parent command.
+//
+// # Overview
+//
+// Recipients turn the single-key scratchpad into a
+// shared one. Each teammate owns an X25519 identity at
+// ~/.ctx/.ctx.identity; the project commits the list of
+// their public keys to .context/scratchpad.recipients.
+// While that list is non-empty every write seals the pad
+// as an envelope: a fresh data key encrypts the entries
+// and is wrapped once per recipient.
+//
+// The children are:
+//
+//   - add: adds a public key (and, for the first one,
+//     your own) and re-encrypts the pad.
+//   - remove: drops a recipient by key or name and
+//     re-encrypts; removing the last one reverts to the
+//     single shared key.
+//   - list: prints the recipients and your public key.
+//
+// # Usage
+//
+//	ctx pad recipients add PUBLIC-KEY [--name NAME]
+//	ctx pad recipients remove KEY|NAME
+//	ctx pad recipients list
+//
+// Running the parent without a subcommand prints the
+// help text.
+package recipients
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package list implements the "ctx pad recipients list"
+// subcommand.
+//
+// # Behavior
+//
+// Prints every recipient in the project's list, marking
+// the row that matches your own key, then prints your
+// public key so you can hand it to a teammate. Your
+// identity is created on first use.
+//
+// # Flags
+//
+// None. This command takes no flags.
+//
+// # Output
+//
+// One row per recipient ("<key>  <name>"), or a note that
+// the pad uses the single shared key, followed by
+// "Your public key: ctxpub1...".
+package list
@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/recipient"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Run prints the recipient list and the user's public key.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: Non-nil on list read or identity failure
+func Run(cmd *cobra.Command) error {
+	cmd.SilenceUsage = true
+
+	ctxDir, dirErr := rc.RequireContextDir()
+	if dirErr != nil {
+		return dirErr
+	}
+	rs, loadErr := recipient.Load(ctxDir)
+	if loadErr != nil {
+		return loadErr
+	}
+	self, selfErr := recipient.Self(cmd)
+	if selfErr != nil {
+		return selfErr
+	}
+
+	if len(rs) == 0 {
+		writePad.RecipientNone(cmd)
+	}
+	for _, r := range rs {
+		writePad.RecipientRow(cmd, r.Key, r.Name, r.Key == self)
+	}
+	writePad.RecipientSelf(cmd, self)
+	return nil
+}
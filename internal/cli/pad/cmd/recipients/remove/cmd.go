@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package remove
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the pad recipients remove subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured remove subcommand
+func Cmd() *cobra.Command {
+	var force bool
+
+	short, long := desc.Command(cmd.DescKeyPadRecipientsRemove)
+	c := &cobra.Command{
+		Use:     cmd.UsePadRecipientsRemove,
+		Aliases: []string{cmd.UsePadRecipientsRemoveAlias},
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyPadRecipientsRemove),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args[0], force)
+		},
+	}
+
+	flagbind.BoolFlag(
+		c, &force, cFlag.Force, flag.DescKeyPadRecipientsRemoveForce,
+	)
+
+	return c
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package remove implements the "ctx pad recipients
+// remove" subcommand.
+//
+// # Behavior
+//
+// The positional argument is a public key or a recipient
+// name. A name shared by several recipients is rejected;
+// pass the key instead. The recipient is dropped, the
+// list saved, and the scratchpad re-encrypted for the
+// remaining keys via [store.Rewrap]. Removing the last
+// recipient deletes the list and reverts the pad to the
+// single shared key.
+//
+// Re-encryption keeps the removed recipient out of future
+// versions only; copies they already fetched stay
+// readable to them.
+//
+// # Flags
+//
+// None. This command takes no flags.
+//
+// # Output
+//
+// A one-line confirmation with the remaining recipient
+// count, or a note that the pad uses the single key.
+package remove
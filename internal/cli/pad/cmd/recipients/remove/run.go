@@ -0,0 +1,70 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package remove
+
+import (
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/recipient"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Run removes a recipient and re-encrypts the scratchpad.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - query: Public key or name of the recipient
+//   - force: Allow removing your own key while other
+//     recipients remain
+//
+// Returns:
+//   - error: Non-nil when no single recipient matches, the
+//     match is your own key without force, or re-encryption
+//     fails
+func Run(cmd *cobra.Command, query string, force bool) error {
+	cmd.SilenceUsage = true
+
+	ctxDir, dirErr := rc.RequireContextDir()
+	if dirErr != nil {
+		return dirErr
+	}
+	rs, loadErr := recipient.Load(ctxDir)
+	if loadErr != nil {
+		return loadErr
+	}
+
+	idx := recipient.Match(rs, query)
+	switch {
+	case len(idx) == 0:
+		return errPad.RecipientNotFound(query)
+	case len(idx) > 1:
+		return errPad.RecipientAmbiguous(query, len(idx))
+	}
+
+	removed := rs[idx[0]]
+	if !force && len(rs) > 1 {
+		own, ownErr := recipient.Own()
+		if ownErr != nil {
+			return ownErr
+		}
+		if removed.Key == own {
+			return errPad.RecipientSelf(removed.Label())
+		}
+	}
+	rs = slices.Delete(rs, idx[0], idx[0]+1)
+	if rewrapErr := store.Rewrap(cmd, ctxDir, rs); rewrapErr != nil {
+		return rewrapErr
+	}
+
+	writePad.RecipientRemoved(cmd, removed.Label(), len(rs))
+	return nil
+}
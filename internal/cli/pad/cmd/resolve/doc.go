@@ -20,7 +20,8 @@
 // The command requires scratchpad encryption to be
 // enabled. If encryption is off, it returns an error
 // immediately. It loads the project encryption key
-// and attempts to decrypt each conflict file. If
+// and its grace key and attempts to decrypt each
+// conflict file. If
 // both files are missing, it reports that no conflict
 // exists.
 //
@@ -40,6 +41,6 @@
 //
 // Decryption is performed by [padCrypto.DecryptFile].
 // Display formatting uses [coreResolve.DisplayAll].
-// Key loading goes through [crypto.LoadKey]. Output
+// Key loading goes through [crypto.LoadKeyring]. Output
 // is routed through [writePad.ResolveSide].
 package resolve
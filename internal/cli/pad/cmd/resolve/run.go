@@ -7,6 +7,9 @@
 package resolve
 
 import (
+	"errors"
+	"os"
+
 	"github.com/spf13/cobra"
 
 	padCrypto "github.com/ActiveMemory/ctx/internal/cli/pad/core/crypto"
@@ -38,8 +41,10 @@ func Run(cmd *cobra.Command) error {
 		cmd.SilenceUsage = true
 		return kpErr
 	}
-	key, loadErr := crypto.LoadKey(kp)
-	if loadErr != nil {
+	// A missing key is tolerated: shared pads are sealed for
+	// recipients and open with the user's identity instead.
+	keys, loadErr := crypto.LoadKeyring(kp, rc.KeyGraceDays())
+	if loadErr != nil && !errors.Is(loadErr, os.ErrNotExist) {
 		return errCrypto.LoadKey(loadErr, kp)
 	}
 
@@ -50,13 +55,16 @@ func Run(cmd *cobra.Command) error {
 	}
 
 	ours, errOurs := padCrypto.DecryptFile(
-		key, dir, pad.EncOurs,
+		keys, dir, pad.EncOurs,
 	)
 	theirs, errTheirs := padCrypto.DecryptFile(
-		key, dir, pad.EncTheirs,
+		keys, dir, pad.EncTheirs,
 	)
 
 	if errOurs != nil && errTheirs != nil {
+		if loadErr != nil {
+			return errCrypto.LoadKey(loadErr, kp)
+		}
 		return errPad.NoConflictFiles(pad.Enc)
 	}
 
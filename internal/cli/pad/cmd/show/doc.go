@@ -25,6 +25,18 @@
 // entries; using it with a plain-text entry returns
 // an error.
 //
+// Any metadata prefix (expiry, category, author) is
+// stripped before printing, so piped output is just
+// the entry text.
+//
+// # Access Log
+//
+// Every successful lookup appends a record to
+// .context/state/pad-access.jsonl via [access.Append]
+// before the entry is printed: the time, entry ID,
+// kind, and the blob label and category when present.
+// The entry text itself is never logged.
+//
 // # Flags
 //
 //	--out <path>   Write blob data to a file instead
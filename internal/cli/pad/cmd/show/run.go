@@ -7,12 +7,17 @@
 package show
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/access"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgPad "github.com/ActiveMemory/ctx/internal/config/pad"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
 	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
@@ -22,6 +27,9 @@ import (
 
 // Run prints the raw text of entry by stable ID.
 //
+// The entry's metadata prefix is not printed. Each call is
+// recorded in the local access log before anything is shown.
+//
 // Parameters:
 //   - cmd: Cobra command for output
 //   - id: Stable entry ID
@@ -44,9 +52,21 @@ func Run(cmd *cobra.Command, id int, outPath string) error {
 		return errPad.EntryNotFound(id)
 	}
 
-	entry := entries[idx].Content
+	m, entry := meta.Split(entries[idx].Content)
+	rec := access.Record{
+		Time:     time.Now().UTC(),
+		ID:       id,
+		Kind:     cfgPad.AccessKindText,
+		Category: m.Category,
+	}
 
-	if _, data, ok := blob.Split(entry); ok {
+	if label, data, ok := blob.Split(entry); ok {
+		rec.Kind = cfgPad.AccessKindBlob
+		rec.Label = label
+		rec.Out = outPath
+		if logErr := access.Append(rec); logErr != nil {
+			return logErr
+		}
 		if outPath != "" {
 			if writeErr := ctxIo.SafeWriteFile(
 				outPath, data, fs.PermSecret,
@@ -63,6 +83,9 @@ func Run(cmd *cobra.Command, id int, outPath string) error {
 	if outPath != "" {
 		return errPad.OutFlagRequiresBlob()
 	}
+	if logErr := access.Append(rec); logErr != nil {
+		return logErr
+	}
 
 	pad.EntryShow(cmd, entry)
 	return nil
@@ -0,0 +1,92 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package access
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Path returns the access log path under the context state
+// directory.
+//
+// Returns:
+//   - string: Absolute path to pad-access.jsonl
+//   - error: Non-nil when the context directory is not declared
+func Path() (string, error) {
+	ctxDir, err := rc.ContextDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ctxDir, dir.State, pad.AccessLog), nil
+}
+
+// Append writes one record to the access log, creating the
+// state directory when needed.
+//
+// Parameters:
+//   - r: Record to append
+//
+// Returns:
+//   - error: Non-nil on path, marshal or write failure
+func Append(r Record) error {
+	logPath, pathErr := Path()
+	if pathErr != nil {
+		return pathErr
+	}
+	if mkErr := io.SafeMkdirAll(
+		filepath.Dir(logPath), fs.PermExec,
+	); mkErr != nil {
+		return mkErr
+	}
+	line, marshalErr := json.Marshal(r)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	line = append(line, token.NewlineLF[0])
+	return io.AppendBytes(logPath, line, fs.PermFile)
+}
+
+// Read returns every record in the access log. A missing log
+// yields no records. Malformed lines are skipped.
+//
+// Returns:
+//   - []Record: Records in write order
+//   - error: Non-nil on path or read failure
+func Read() ([]Record, error) {
+	logPath, pathErr := Path()
+	if pathErr != nil {
+		return nil, pathErr
+	}
+	data, readErr := io.SafeReadUserFile(logPath)
+	if errors.Is(readErr, os.ErrNotExist) {
+		return nil, nil
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	var records []Record
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		var r Record
+		if json.Unmarshal(sc.Bytes(), &r) == nil {
+			records = append(records, r)
+		}
+	}
+	return records, sc.Err()
+}
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package access records which scratchpad entries were
+// decrypted for display, and when.
+//
+// [Append] adds one JSON line per `ctx pad show` to
+// .context/state/pad-access.jsonl. The log lives in the
+// gitignored state directory, so it stays on the machine
+// that read the entry. Records hold the entry ID, its kind
+// (text or blob), the blob label and category when present,
+// but never the entry text itself.
+//
+// [Read] returns the records in the order they were
+// written.
+package access
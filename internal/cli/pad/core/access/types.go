@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package access
+
+import "time"
+
+// Record is one line of the scratchpad access log.
+//
+// Fields:
+//   - Time: When the entry was decrypted for display
+//   - ID: Stable entry ID
+//   - Kind: "text" or "blob"
+//   - Label: Blob label (blob entries only)
+//   - Category: Entry category, when set
+//   - Out: File the blob was written to, when --out was used
+type Record struct {
+	Time     time.Time `json:"time"`
+	ID       int       `json:"id"`
+	Kind     string    `json:"kind"`
+	Label    string    `json:"label,omitempty"`
+	Category string    `json:"category,omitempty"`
+	Out      string    `json:"out,omitempty"`
+}
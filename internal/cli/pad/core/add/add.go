@@ -8,6 +8,7 @@ package add
 
 import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/config/pad"
@@ -21,13 +22,14 @@ import (
 //
 // Parameters:
 //   - text: Entry text to add
+//   - m: Entry metadata (zero for none)
 //
 // Returns:
 //   - []parse.Entry: Updated entries
 //   - int: ID assigned to the new entry
 //   - error: Non-nil on entry load failure
 func EntryWithID(
-	text string,
+	text string, m meta.Meta,
 ) ([]parse.Entry, int, error) {
 	entries, loadErr := store.ReadEntriesWithIDs()
 	if loadErr != nil {
@@ -35,7 +37,7 @@ func EntryWithID(
 	}
 	id := parse.NextID(entries)
 	entries = append(entries, parse.Entry{
-		ID: id, Content: text,
+		ID: id, Content: meta.Join(m, text),
 	})
 	return entries, id, nil
 }
@@ -46,13 +48,14 @@ func EntryWithID(
 // Parameters:
 //   - label: Blob label (filename)
 //   - filePath: Path to the file to ingest
+//   - m: Entry metadata (zero for none)
 //
 // Returns:
 //   - []parse.Entry: Updated entries
 //   - int: ID assigned to the new entry
 //   - error: Non-nil on read failure or file too large
 func BlobWithID(
-	label, filePath string,
+	label, filePath string, m meta.Meta,
 ) ([]parse.Entry, int, error) {
 	data, readErr := internalIo.SafeReadUserFile(filePath)
 	if readErr != nil {
@@ -71,7 +74,7 @@ func BlobWithID(
 
 	id := parse.NextID(entries)
 	entries = append(entries, parse.Entry{
-		ID: id, Content: blob.Make(label, data),
+		ID: id, Content: meta.Join(m, blob.Make(label, data)),
 	})
 	return entries, id, nil
 }
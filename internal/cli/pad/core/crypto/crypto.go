@@ -7,6 +7,9 @@
 package crypto
 
 import (
+	"errors"
+	"os"
+
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
 	"github.com/ActiveMemory/ctx/internal/crypto"
 	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
@@ -16,23 +19,74 @@ import (
 // DecryptFile reads and decrypts a single file, returning its entries.
 //
 // Parameters:
-//   - key: AES-256 encryption key
+//   - keys: AES-256 keyring, active key first (see
+//     [crypto.LoadKeyring])
 //   - baseDir: Directory containing the encrypted file
 //   - filename: Name of the encrypted file
 //
 // Returns:
 //   - []string: Decrypted entries
 //   - error: Non-nil on read or decryption failure
-func DecryptFile(key []byte, baseDir, filename string) ([]string, error) {
+func DecryptFile(
+	keys [][]byte, baseDir, filename string,
+) ([]string, error) {
 	data, readErr := io.SafeReadFile(baseDir, filename)
 	if readErr != nil {
 		return nil, readErr
 	}
 
-	plaintext, decErr := crypto.Decrypt(key, data)
+	plaintext, decErr := Open(keys, data)
 	if decErr != nil {
-		return nil, errCrypto.DecryptFailed()
+		return nil, decErr
 	}
 
 	return parse.Entries(plaintext), nil
 }
+
+// Identity loads the current user's scratchpad identity.
+//
+// Returns:
+//   - []byte: X25519 private key
+//   - error: Non-nil when the identity file is missing or invalid
+func Identity() ([]byte, error) {
+	path := crypto.IdentityPath()
+	identity, loadErr := crypto.LoadKey(path)
+	if loadErr != nil {
+		if errors.Is(loadErr, os.ErrNotExist) {
+			return nil, errCrypto.NoIdentityAt(path)
+		}
+		return nil, errCrypto.LoadKey(loadErr, path)
+	}
+	return identity, nil
+}
+
+// Open decrypts scratchpad ciphertext in either format.
+//
+// Multi-recipient envelopes are opened with the user's identity;
+// anything else is treated as single-key ciphertext and tried
+// against each key, so copies sealed before a rotation still open
+// while the grace key lasts.
+//
+// Parameters:
+//   - keys: AES-256 keyring, active key first (unused for
+//     envelopes)
+//   - data: Ciphertext read from disk
+//
+// Returns:
+//   - []byte: Decrypted plaintext
+//   - error: Non-nil on missing identity or decryption failure
+func Open(keys [][]byte, data []byte) ([]byte, error) {
+	if !crypto.IsEnvelope(data) {
+		plaintext, decErr := crypto.DecryptAny(keys, data)
+		if decErr != nil {
+			return nil, errCrypto.DecryptFailed()
+		}
+		return plaintext, nil
+	}
+
+	identity, idErr := Identity()
+	if idErr != nil {
+		return nil, idErr
+	}
+	return crypto.Open(identity, data)
+}
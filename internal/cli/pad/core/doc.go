@@ -18,6 +18,8 @@
 // The core package itself serves as a namespace. All
 // logic lives in sub-packages:
 //
+//   - access: record which entries show decrypted
+//     in a local access log.
 //   - add: append new text or blob entries with
 //     stable ID assignment.
 //   - blob: base64 encode/decode binary content
@@ -27,19 +29,25 @@
 //     blob content of existing entries.
 //   - export: plan blob extraction to the
 //     filesystem with collision avoidance.
+//   - gc: find expired entries and drop or tag
+//     them.
 //   - imp: import entries from line-delimited
 //     files, stdin, or directory trees.
 //   - load: orchestrate import from files or
 //     directories with user output.
 //   - merge: read and merge external scratchpad
 //     files with conflict detection.
+//   - meta: read and write the expiry, category
+//     and author prefix of an entry.
 //   - parse: split raw bytes into entries and
 //     assign stable IDs.
 //   - resolve: convert raw entries to display
 //     form for listing.
-//   - store: read/write the default project
-//     scratchpad with encryption.
+//   - store: read/write the project scratchpad,
+//     or any pad file, with encryption.
 //   - tag: scan entries for hash-prefixed tags.
+//   - threeway: merge ancestor, ours and theirs
+//     entry by entry for the git merge driver.
 //   - validate: bounds-check entry indexes.
 //
 // # Data Flow
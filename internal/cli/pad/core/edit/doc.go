@@ -44,4 +44,8 @@
 // Blob-aware functions use blob.Split and blob.Make
 // to preserve the base64 payload while modifying only
 // the label.
+//
+// Entry metadata (expiry, category, author) is split
+// off with meta.Split before the mutation and joined
+// back afterwards, so edits never drop or corrupt it.
 package edit
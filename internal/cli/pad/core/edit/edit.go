@@ -8,6 +8,7 @@ package edit
 
 import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/validate"
 	"github.com/ActiveMemory/ctx/internal/config/pad"
@@ -34,7 +35,8 @@ func Replace(n int, text string) ([]string, error) {
 	if validErr := validate.Index(n, entries); validErr != nil {
 		return nil, validErr
 	}
-	entries[n-1] = text
+	m, _ := meta.Split(entries[n-1])
+	entries[n-1] = meta.Join(m, text)
 	return entries, nil
 }
 
@@ -57,11 +59,13 @@ func Append(n int, text string) ([]string, error) {
 	if validErr := validate.Index(n, entries); validErr != nil {
 		return nil, validErr
 	}
-	if label, data, ok := blob.Split(entries[n-1]); ok {
-		entries[n-1] = blob.Make(label+token.Space+text, data)
+	m, body := meta.Split(entries[n-1])
+	if label, data, ok := blob.Split(body); ok {
+		body = blob.Make(label+token.Space+text, data)
 	} else {
-		entries[n-1] = entries[n-1] + token.Space + text
+		body = body + token.Space + text
 	}
+	entries[n-1] = meta.Join(m, body)
 	return entries, nil
 }
 
@@ -84,11 +88,13 @@ func Prepend(n int, text string) ([]string, error) {
 	if validErr := validate.Index(n, entries); validErr != nil {
 		return nil, validErr
 	}
-	if label, data, ok := blob.Split(entries[n-1]); ok {
-		entries[n-1] = blob.Make(text+token.Space+label, data)
+	m, body := meta.Split(entries[n-1])
+	if label, data, ok := blob.Split(body); ok {
+		body = blob.Make(text+token.Space+label, data)
 	} else {
-		entries[n-1] = text + token.Space + entries[n-1]
+		body = text + token.Space + body
 	}
+	entries[n-1] = meta.Join(m, body)
 	return entries, nil
 }
 
@@ -112,7 +118,8 @@ func UpdateBlob(n int, filePath, labelText string) ([]string, error) {
 		return nil, validErr
 	}
 
-	oldLabel, oldData, ok := blob.Split(entries[n-1])
+	m, body := meta.Split(entries[n-1])
+	oldLabel, oldData, ok := blob.Split(body)
 	if !ok {
 		return nil, errPad.NotBlobEntry(n)
 	}
@@ -135,6 +142,6 @@ func UpdateBlob(n int, filePath, labelText string) ([]string, error) {
 		newData = data
 	}
 
-	entries[n-1] = blob.Make(newLabel, newData)
+	entries[n-1] = meta.Join(m, blob.Make(newLabel, newData))
 	return entries, nil
 }
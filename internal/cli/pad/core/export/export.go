@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 )
 
@@ -32,7 +33,7 @@ func Plan(dir string, force bool) ([]Item, error) {
 
 	var items []Item
 	for _, entry := range entries {
-		label, data, ok := blob.Split(entry)
+		label, data, ok := blob.Split(meta.Body(entry))
 		if !ok {
 			continue
 		}
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package gc finds scratchpad entries whose expiry date
+// has passed.
+//
+// [Sweep] splits the entry list into survivors and
+// expired entries. By default expired entries are
+// dropped; in mark mode they stay in place and gain an
+// "#expired" tag instead, so the user can review them
+// before removing them with `ctx pad rm`.
+//
+// [Due] selects entries that have expired or expire
+// within a look-ahead window; the session-start
+// check-pad-expiry hook uses it to warn ahead of time.
+package gc
@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package gc
+
+import (
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/tag"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+)
+
+// Sweep separates expired entries from the rest.
+//
+// When mark is false, expired entries are left out of the
+// returned list. When mark is true, they are kept and tagged
+// "#expired" (once). Entry IDs are never changed.
+//
+// Parameters:
+//   - entries: Current scratchpad entries
+//   - today: Reference day (YYYY-MM-DD)
+//   - mark: Tag expired entries instead of dropping them
+//
+// Returns:
+//   - []parse.Entry: Entries to write back
+//   - []parse.Entry: Expired entries, as they were before tagging
+func Sweep(
+	entries []parse.Entry, today string, mark bool,
+) ([]parse.Entry, []parse.Entry) {
+	kept := make([]parse.Entry, 0, len(entries))
+	var expired []parse.Entry
+	for _, e := range entries {
+		m, _ := meta.Split(e.Content)
+		if !meta.Expired(m, today) {
+			kept = append(kept, e)
+			continue
+		}
+		expired = append(expired, e)
+		if !mark {
+			continue
+		}
+		if !tag.Has(e.Content, pad.ExpiredTag) {
+			e.Content = meta.Join(m, markExpired(meta.Body(e.Content)))
+		}
+		kept = append(kept, e)
+	}
+	return kept, expired
+}
+
+// Due returns entries that have expired or expire on or before
+// horizon, in file order.
+//
+// Parameters:
+//   - entries: Current scratchpad entries
+//   - horizon: Last day of the look-ahead window (YYYY-MM-DD)
+//
+// Returns:
+//   - []parse.Entry: Entries with an expiry up to horizon
+func Due(entries []parse.Entry, horizon string) []parse.Entry {
+	var due []parse.Entry
+	for _, e := range entries {
+		m, _ := meta.Split(e.Content)
+		if m.Expires != "" && m.Expires <= horizon {
+			due = append(due, e)
+		}
+	}
+	return due
+}
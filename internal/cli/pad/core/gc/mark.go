@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package gc
+
+import (
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+)
+
+// markExpired appends the "#expired" tag to an entry body.
+// For blobs the tag goes on the label so the data stays
+// intact.
+//
+// Parameters:
+//   - body: Entry text without its metadata prefix
+//
+// Returns:
+//   - string: Tagged entry body
+func markExpired(body string) string {
+	suffix := pad.TagPrefixSpace + pad.ExpiredTag
+	if label, data, ok := blob.Split(body); ok {
+		return blob.Make(label+suffix, data)
+	}
+	return body + suffix
+}
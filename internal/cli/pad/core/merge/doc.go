@@ -15,8 +15,8 @@
 // # Reading External Files
 //
 // [ReadFileEntries] reads a scratchpad file and
-// attempts decryption first. If a non-nil key is
-// provided, it tries crypto.Decrypt on the raw bytes.
+// attempts decryption first. If keys are provided, it
+// tries each of them on the raw bytes.
 // On successful decryption, it parses the plaintext
 // into entries. If decryption fails (wrong key or
 // unencrypted file), it falls back to parsing the raw
@@ -24,8 +24,9 @@
 //
 // # Key Loading
 //
-// [LoadKey] loads an encryption key for merge input
-// decryption. When keyFile is non-empty, it loads from
+// [LoadKeyring] loads the keys for merge input
+// decryption: the key plus its grace key from the last
+// rotation. When keyFile is non-empty, it loads from
 // that path. Otherwise it uses store.KeyPath to find
 // the project key. If no key is available, it returns
 // nil, which causes ReadFileEntries to skip the
@@ -10,22 +10,28 @@ import (
 	"unicode/utf8"
 
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	padCrypto "github.com/ActiveMemory/ctx/internal/cli/pad/core/crypto"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/crypto"
 	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 // ReadFileEntries reads a scratchpad file, attempting decryption first.
 //
+// Multi-recipient envelopes are opened with the user's identity
+// even when keys is empty.
+//
 // Parameters:
 //   - path: path to the scratchpad file.
-//   - key: encryption key (nil to skip the decryption attempt).
+//   - keys: keyring, active key first (empty to skip the
+//     decryption attempt).
 //
 // Returns:
 //   - []string: parsed entries.
 //   - error: non-nil if the file cannot be read.
-func ReadFileEntries(path string, key []byte) ([]string, error) {
+func ReadFileEntries(path string, keys [][]byte) ([]string, error) {
 	data, readErr := io.SafeReadUserFile(path)
 	if readErr != nil {
 		return nil, readErr
@@ -35,8 +41,8 @@ func ReadFileEntries(path string, key []byte) ([]string, error) {
 		return nil, nil
 	}
 
-	if key != nil {
-		plaintext, decErr := crypto.Decrypt(key, data)
+	if len(keys) > 0 || crypto.IsEnvelope(data) {
+		plaintext, decErr := padCrypto.Open(keys, data)
 		if decErr == nil {
 			return parse.Entries(plaintext), nil
 		}
@@ -45,7 +51,9 @@ func ReadFileEntries(path string, key []byte) ([]string, error) {
 	return parse.Entries(data), nil
 }
 
-// LoadKey loads the encryption key for merge input decryption.
+// LoadKeyring loads the keys for merge input decryption: the key
+// followed by its grace key, so files encrypted before a rotation
+// still merge.
 //
 // When keyFile is empty the project key is used, which requires a
 // declared context directory; the resolver failure is propagated so
@@ -60,10 +68,10 @@ func ReadFileEntries(path string, key []byte) ([]string, error) {
 //   - keyFile: explicit key file path (empty string = use project key).
 //
 // Returns:
-//   - []byte: the loaded key, or nil if the key file is absent
+//   - [][]byte: the loaded keys, or nil if the key file is absent
 //   - error: propagated when the project key path cannot be
 //     resolved (e.g. no declared context directory)
-func LoadKey(keyFile string) ([]byte, error) {
+func LoadKeyring(keyFile string) ([][]byte, error) {
 	path := keyFile
 	if path == "" {
 		projectKey, kpErr := store.KeyPath()
@@ -73,11 +81,11 @@ func LoadKey(keyFile string) ([]byte, error) {
 		path = projectKey
 	}
 
-	key, loadErr := crypto.LoadKey(path)
+	keys, loadErr := crypto.LoadKeyring(path, rc.KeyGraceDays())
 	if loadErr != nil {
 		return nil, nil
 	}
-	return key, nil
+	return keys, nil
 }
 
 // BuildBlobLabelMap creates a map of blob labels to their full entry strings.
@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package meta
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Annotation renders metadata as a list-row suffix such as
+// " [creds] (expires 2026-11-01)". The author is not shown.
+//
+// Parameters:
+//   - m: Entry metadata
+//   - today: Reference day (YYYY-MM-DD)
+//
+// Returns:
+//   - string: Suffix, empty when there is nothing to show
+func Annotation(m Meta, today string) string {
+	var out string
+	if m.Category != "" {
+		out += fmt.Sprintf(
+			desc.Text(text.DescKeyWritePadMetaCategory), m.Category,
+		)
+	}
+	switch {
+	case Expired(m, today):
+		out += fmt.Sprintf(
+			desc.Text(text.DescKeyWritePadMetaExpired), m.Expires,
+		)
+	case m.Expires != "":
+		out += fmt.Sprintf(
+			desc.Text(text.DescKeyWritePadMetaExpires), m.Expires,
+		)
+	}
+	return out
+}
@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package meta reads and writes the optional metadata
+// carried by a scratchpad entry.
+//
+// Metadata lives inside the entry text, so it is encrypted
+// along with the rest of the scratchpad. It is stored as a
+// prefix of key=value pairs:
+//
+//	{ctx expires=2026-11-01 category=creds created_by=alice} token
+//
+// Entries without the prefix have no metadata, so existing
+// scratchpads keep working unchanged.
+//
+// [Split] separates the metadata from the entry body and
+// [Join] puts it back. [Expired] compares the expiry date
+// against a reference day, and [ParseExpiry] turns a
+// --expires flag value (a date or a day count like "7d")
+// into a date. [Annotation] renders the list-row suffix.
+package meta
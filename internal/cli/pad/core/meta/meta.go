@@ -0,0 +1,137 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package meta
+
+import (
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
+	"github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// Split separates the metadata prefix from an entry.
+//
+// Parameters:
+//   - entry: Raw entry text
+//
+// Returns:
+//   - Meta: Parsed metadata (zero when absent)
+//   - string: Entry text without the prefix
+func Split(entry string) (Meta, string) {
+	match := regex.PadMeta.FindStringSubmatch(entry)
+	if match == nil {
+		return Meta{}, entry
+	}
+	return parsePairs(match[1]), entry[len(match[0]):]
+}
+
+// Join prepends the metadata prefix to an entry body. A zero
+// Meta returns the body unchanged.
+//
+// Parameters:
+//   - m: Metadata to store
+//   - body: Entry text without a prefix
+//
+// Returns:
+//   - string: Entry text ready to persist
+func Join(m Meta, body string) string {
+	pairs := formatPairs(m)
+	if pairs == "" {
+		return body
+	}
+	return pad.MetaOpen + pairs + pad.MetaClose + body
+}
+
+// Body returns the entry text without its metadata prefix.
+//
+// Parameters:
+//   - entry: Raw entry text
+//
+// Returns:
+//   - string: Entry text without the prefix
+func Body(entry string) string {
+	_, body := Split(entry)
+	return body
+}
+
+// Expired reports whether the entry's last valid day is
+// before today.
+//
+// Parameters:
+//   - m: Entry metadata
+//   - today: Reference day (YYYY-MM-DD)
+//
+// Returns:
+//   - bool: True when an expiry is set and has passed
+func Expired(m Meta, today string) bool {
+	return m.Expires != "" && m.Expires < today
+}
+
+// ParseExpiry converts a --expires value into a date. The
+// value is either a date (YYYY-MM-DD) or a day count relative
+// to now such as "7d".
+//
+// Parameters:
+//   - value: Flag value
+//   - now: Reference time for relative values
+//
+// Returns:
+//   - string: Expiry date (YYYY-MM-DD)
+//   - error: Non-nil when the value is neither form
+func ParseExpiry(value string, now time.Time) (string, error) {
+	if days, ok := strings.CutSuffix(
+		value, pad.ExpiryDaySuffix,
+	); ok {
+		n, convErr := strconv.Atoi(days)
+		if convErr != nil || n < 0 {
+			return "", errPad.InvalidExpiry(value)
+		}
+		return now.AddDate(0, 0, n).Format(cfgTime.DateFormat), nil
+	}
+	t, parseErr := time.Parse(cfgTime.DateFormat, value)
+	if parseErr != nil {
+		return "", errPad.InvalidExpiry(value)
+	}
+	return t.Format(cfgTime.DateFormat), nil
+}
+
+// ValidateCategory rejects categories that cannot be stored
+// in the metadata prefix.
+//
+// Parameters:
+//   - category: Category name (empty is allowed)
+//
+// Returns:
+//   - error: Non-nil when the category has invalid characters
+func ValidateCategory(category string) error {
+	if category == "" || regex.PadCategory.MatchString(category) {
+		return nil
+	}
+	return errPad.InvalidCategory(category)
+}
+
+// Author returns the name recorded as created_by: the git
+// user.name, falling back to the OS login name.
+//
+// Returns:
+//   - string: Author name, or empty when neither is known
+func Author() string {
+	if name := git.UserName(); name != "" {
+		return name
+	}
+	u, userErr := user.Current()
+	if userErr != nil {
+		return ""
+	}
+	return u.Username
+}
@@ -0,0 +1,119 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package meta
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplitJoin(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry string
+		meta  Meta
+		body  string
+	}{
+		{"no prefix", "plain note", Meta{}, "plain note"},
+		{
+			"all fields",
+			"{ctx expires=2026-11-01 category=creds created_by=Jane%20Doe} tok",
+			Meta{"2026-11-01", "creds", "Jane Doe"},
+			"tok",
+		},
+		{
+			"blob body",
+			"{ctx category=certs} cert:::Y2VydA==",
+			Meta{Category: "certs"},
+			"cert:::Y2VydA==",
+		},
+		{
+			"braces escaped",
+			"{ctx created_by=%7Bbot%7D} x",
+			Meta{CreatedBy: "{bot}"},
+			"x",
+		},
+		{"unknown key kept out", "{ctx color=red} x", Meta{}, "x"},
+		{"look-alike text", "{ctx} not metadata", Meta{}, "{ctx} not metadata"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, body := Split(tt.entry)
+			if m != tt.meta || body != tt.body {
+				t.Errorf("Split(%q) = %+v, %q; want %+v, %q",
+					tt.entry, m, body, tt.meta, tt.body)
+			}
+			if again, _ := Split(Join(m, body)); again != m {
+				t.Errorf("round trip = %+v, want %+v", again, m)
+			}
+		})
+	}
+}
+
+func TestJoinZero(t *testing.T) {
+	if got := Join(Meta{}, "note"); got != "note" {
+		t.Errorf("Join(zero) = %q, want body unchanged", got)
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"2026-11-01", "2026-11-01", false},
+		{"7d", "2026-10-26", false},
+		{"0d", "2026-10-19", false},
+		{"-1d", "", true},
+		{"tomorrow", "", true},
+		{"2026-13-01", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseExpiry(tt.value, now)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseExpiry(%q) = %q, %v; want %q, err=%v",
+				tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	today := "2026-10-19"
+	tests := []struct {
+		expires string
+		expired bool
+	}{
+		{"", false},
+		{"2026-10-18", true},
+		{"2026-10-19", false},
+		{"2026-10-23", false},
+	}
+
+	for _, tt := range tests {
+		m := Meta{Expires: tt.expires}
+		if got := Expired(m, today); got != tt.expired {
+			t.Errorf("Expired(%q) = %v, want %v", tt.expires, got, tt.expired)
+		}
+	}
+}
+
+func TestValidateCategory(t *testing.T) {
+	for _, ok := range []string{"", "creds", "ops.v2", "to-revert"} {
+		if err := ValidateCategory(ok); err != nil {
+			t.Errorf("ValidateCategory(%q) = %v", ok, err)
+		}
+	}
+	for _, bad := range []string{"two words", "a}b", "#tag"} {
+		if err := ValidateCategory(bad); err == nil {
+			t.Errorf("ValidateCategory(%q) = nil, want error", bad)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package meta
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// parsePairs decodes space-separated key=value pairs. Unknown
+// keys are ignored so newer writers stay readable.
+//
+// Parameters:
+//   - s: Pairs captured from the metadata prefix
+//
+// Returns:
+//   - Meta: Decoded metadata
+func parsePairs(s string) Meta {
+	var m Meta
+	for _, pair := range strings.Fields(s) {
+		key, raw, ok := strings.Cut(pair, pad.MetaAssign)
+		if !ok {
+			continue
+		}
+		value, unescErr := url.PathUnescape(raw)
+		if unescErr != nil {
+			value = raw
+		}
+		switch key {
+		case pad.MetaExpires:
+			m.Expires = value
+		case pad.MetaCategory:
+			m.Category = value
+		case pad.MetaCreatedBy:
+			m.CreatedBy = value
+		}
+	}
+	return m
+}
+
+// formatPairs encodes non-empty fields as " key=value" pairs,
+// percent-encoding values so they hold no spaces or braces.
+//
+// Parameters:
+//   - m: Metadata to encode
+//
+// Returns:
+//   - string: Encoded pairs, empty for a zero Meta
+func formatPairs(m Meta) string {
+	var b strings.Builder
+	for _, kv := range [][2]string{
+		{pad.MetaExpires, m.Expires},
+		{pad.MetaCategory, m.Category},
+		{pad.MetaCreatedBy, m.CreatedBy},
+	} {
+		if kv[1] == "" {
+			continue
+		}
+		b.WriteString(token.Space + kv[0] + pad.MetaAssign +
+			url.PathEscape(kv[1]))
+	}
+	return b.String()
+}
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package meta
+
+// Meta is the optional metadata attached to a scratchpad
+// entry. Empty fields are not stored.
+//
+// Fields:
+//   - Expires: Last valid day (YYYY-MM-DD)
+//   - Category: Free-form grouping label
+//   - CreatedBy: Author recorded when the entry was added
+type Meta struct {
+	Expires   string
+	Category  string
+	CreatedBy string
+}
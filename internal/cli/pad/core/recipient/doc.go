@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package recipient manages the public keys a shared
+// scratchpad is encrypted for.
+//
+// The recipient list lives in the context directory as
+// scratchpad.recipients, one "ctxpub1..." key per line
+// with an optional name. Blank lines and "#" comments
+// are ignored. The file is committed alongside
+// scratchpad.enc so every teammate encrypts for the same
+// set of keys.
+//
+// [Load] parses and validates the list, [Save] writes it
+// back (removing the file when the list is empty), and
+// [Match] finds a recipient by public key or name.
+//
+// [Self] returns the current user's public key, creating
+// the identity at ~/.ctx/.ctx.identity on first use.
+package recipient
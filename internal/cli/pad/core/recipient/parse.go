@@ -0,0 +1,56 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package recipient
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+)
+
+// parse reads recipient lines, skipping blanks and comments.
+//
+// Parameters:
+//   - content: Raw recipients file content
+//
+// Returns:
+//   - []Recipient: Parsed recipients
+//   - error: Non-nil when a key fails to parse
+func parse(content string) ([]Recipient, error) {
+	var rs []Recipient
+	for _, line := range strings.Split(content, token.NewlineLF) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, token.Hash) {
+			continue
+		}
+		key, name, _ := strings.Cut(line, token.Space)
+		if _, keyErr := crypto.ParsePublicKey(key); keyErr != nil {
+			return nil, keyErr
+		}
+		rs = append(rs, Recipient{
+			Key: key, Name: strings.TrimSpace(name),
+		})
+	}
+	return rs, nil
+}
+
+// format renders recipients one per line.
+//
+// Parameters:
+//   - rs: Recipients to render
+//
+// Returns:
+//   - string: File content with a trailing newline
+func format(rs []Recipient) string {
+	var sb strings.Builder
+	for _, r := range rs {
+		sb.WriteString(r.String())
+		sb.WriteString(token.NewlineLF)
+	}
+	return sb.String()
+}
@@ -0,0 +1,177 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package recipient
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
+	"github.com/ActiveMemory/ctx/internal/io"
+	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
+)
+
+// Load reads the recipient list from the context directory.
+//
+// Parameters:
+//   - ctxDir: Context directory path
+//
+// Returns:
+//   - []Recipient: Recipients in file order; nil when the file
+//     does not exist
+//   - error: Non-nil on read failure or an invalid public key
+func Load(ctxDir string) ([]Recipient, error) {
+	data, readErr := io.SafeReadFile(ctxDir, pad.Recipients)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errPad.Read(readErr)
+	}
+	return parse(string(data))
+}
+
+// Save writes the recipient list to the context directory.
+//
+// An empty list removes the file, which returns the scratchpad
+// to single-key encryption on the next write.
+//
+// Parameters:
+//   - ctxDir: Context directory path
+//   - rs: Recipients to write
+//
+// Returns:
+//   - error: Non-nil on write or remove failure
+func Save(ctxDir string, rs []Recipient) error {
+	path := filepath.Join(ctxDir, pad.Recipients)
+	if len(rs) == 0 {
+		rmErr := os.Remove(path)
+		if rmErr != nil && !errors.Is(rmErr, os.ErrNotExist) {
+			return rmErr
+		}
+		return nil
+	}
+	return io.SafeWriteFile(path, []byte(format(rs)), fs.PermFile)
+}
+
+// Keys returns the public keys of rs in order.
+//
+// Parameters:
+//   - rs: Recipients
+//
+// Returns:
+//   - []string: Public keys
+func Keys(rs []Recipient) []string {
+	keys := make([]string, len(rs))
+	for i, r := range rs {
+		keys[i] = r.Key
+	}
+	return keys
+}
+
+// Match returns the indices of recipients whose public key or
+// name equals q.
+//
+// Parameters:
+//   - rs: Recipients to search
+//   - q: Public key or name
+//
+// Returns:
+//   - []int: Matching indices (empty when none match)
+func Match(rs []Recipient, q string) []int {
+	q = strings.TrimSpace(q)
+	var idx []int
+	for i, r := range rs {
+		if r.Key == q || (r.Name != "" && r.Name == q) {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// Self returns the current user's public key, generating an
+// identity at [crypto.IdentityPath] when none exists.
+//
+// Parameters:
+//   - cmd: Cobra command for the creation notice
+//
+// Returns:
+//   - string: "ctxpub1..." public key
+//   - error: Non-nil on identity read, generation, or save failure
+func Self(cmd *cobra.Command) (string, error) {
+	path := crypto.IdentityPath()
+	identity, loadErr := crypto.LoadKey(path)
+	if loadErr != nil && !errors.Is(loadErr, os.ErrNotExist) {
+		return "", errCrypto.LoadKey(loadErr, path)
+	}
+	if loadErr != nil {
+		generated, genErr := crypto.GenerateIdentity()
+		if genErr != nil {
+			return "", errCrypto.GenerateKey(genErr)
+		}
+		mkErr := io.SafeMkdirAll(filepath.Dir(path), fs.PermKeyDir)
+		if mkErr != nil {
+			return "", errCrypto.MkdirKeyDir(mkErr)
+		}
+		if saveErr := crypto.SaveKey(path, generated); saveErr != nil {
+			return "", errCrypto.SaveKey(saveErr)
+		}
+		writePad.IdentityCreated(cmd, path)
+		identity = generated
+	}
+	return crypto.PublicKey(identity)
+}
+
+// Own returns the current user's public key without creating an
+// identity.
+//
+// Returns:
+//   - string: "ctxpub1..." public key, or empty when no identity
+//     exists yet
+//   - error: Non-nil when the identity exists but cannot be read
+func Own() (string, error) {
+	path := crypto.IdentityPath()
+	identity, loadErr := crypto.LoadKey(path)
+	if errors.Is(loadErr, os.ErrNotExist) {
+		return "", nil
+	}
+	if loadErr != nil {
+		return "", errCrypto.LoadKey(loadErr, path)
+	}
+	return crypto.PublicKey(identity)
+}
+
+// String renders a recipient line as stored on disk.
+//
+// Returns:
+//   - string: "<key>" or "<key> <name>"
+func (r Recipient) String() string {
+	if r.Name == "" {
+		return r.Key
+	}
+	return r.Key + token.Space + r.Name
+}
+
+// Label returns the name when set, otherwise the public key.
+//
+// Returns:
+//   - string: Display label for confirmations
+func (r Recipient) Label() string {
+	if r.Name == "" {
+		return r.Key
+	}
+	return r.Name
+}
@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package recipient
+
+// Recipient is one public key a shared scratchpad is
+// encrypted for.
+//
+// Fields:
+//   - Key: "ctxpub1..." public key
+//   - Name: Optional human-readable label
+type Recipient struct {
+	Key  string
+	Name string
+}
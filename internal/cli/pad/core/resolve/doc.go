@@ -15,15 +15,23 @@
 //
 // # Display Conversion
 //
-// [DisplayAll] is the sole exported function. It
-// accepts a slice of raw entry strings (typically
-// from decryption) and returns a new slice of the
-// same length where each entry has been processed
-// through blob.DisplayEntry. Plain text entries pass
+// [DisplayAll] accepts a slice of raw entry strings
+// (typically from decryption) and returns a new slice
+// of the same length where each entry has been
+// processed through blob.DisplayEntry. Plain text entries pass
 // through unchanged. Blob entries are replaced with
 // "label [BLOB]" to indicate binary content without
 // dumping encoded data.
 //
+// # Diff Lines
+//
+// [DiffLines] renders entries for `ctx pad
+// git-textconv`, which git diff runs on the encrypted
+// pad. Each entry becomes an "[ID] content" line with
+// its metadata intact; blob payloads are replaced by a
+// short sha256 digest, so a changed file still shows
+// up as a changed line.
+//
 // # Data Flow
 //
 // The cmd/pad layer decrypts the scratchpad via the
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package resolve
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+)
+
+// DiffLines renders entries as the text git diff compares.
+//
+// Each entry becomes one "[ID] content" line. Metadata stays
+// visible so expiry and category changes show up; blob
+// payloads are replaced by a short digest of the data.
+//
+// Parameters:
+//   - entries: Decrypted entries with stable IDs
+//
+// Returns:
+//   - []string: One line per entry
+func DiffLines(entries []parse.Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		content := e.Content
+		m, body := meta.Split(content)
+		if label, data, ok := blob.Split(body); ok {
+			sum := sha256.Sum256(data)
+			digest := hex.EncodeToString(sum[:])[:pad.BlobDigestLen]
+			content = meta.Join(
+				m, label+fmt.Sprintf(pad.BlobDigestTag, digest),
+			)
+		}
+		out[i] = fmt.Sprintf(pad.FmtPadEntryID, e.ID, content)
+	}
+	return out
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/recipient"
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	"github.com/ActiveMemory/ctx/internal/config/pad"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/crypto"
 	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
 	"github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
 	writePad "github.com/ActiveMemory/ctx/internal/write/pad"
@@ -78,12 +80,14 @@ func EnsureKey(cmd *cobra.Command) error {
 	}
 
 	// Encrypted file already exists without a key - we can't generate a new
-	// one because it wouldn't decrypt the existing data.
+	// one because it wouldn't decrypt the existing data. Shared pads are
+	// sealed for recipients instead, so a fresh key is safe there.
 	padPath, padErr := ScratchpadPath()
 	if padErr != nil {
 		return padErr
 	}
-	if _, statErr := os.Stat(padPath); statErr == nil {
+	if data, readErr := io.SafeReadUserFile(padPath); readErr == nil &&
+		!crypto.IsEnvelope(data) {
 		return errCrypto.NoKeyAt(kp)
 	}
 
@@ -157,6 +161,10 @@ func ReadEntriesWithIDs() ([]parse.Entry, error) {
 // WriteEntriesWithIDs writes ID-prefixed entries to the
 // scratchpad file.
 //
+// When the context directory has a recipient list the pad is
+// sealed as a multi-recipient envelope; otherwise it is
+// encrypted with the single scratchpad key.
+//
 // Parameters:
 //   - cmd: Cobra command for diagnostic output
 //   - entries: Entries with stable IDs to write
@@ -170,31 +178,56 @@ func WriteEntriesWithIDs(
 	if pathErr != nil {
 		return pathErr
 	}
-	plaintext := parse.FormatEntriesWithIDs(entries)
-
-	if !rc.ScratchpadEncrypt() {
-		return io.SafeWriteFile(path, plaintext, fs.PermFile)
-	}
+	return WriteFileWithIDs(cmd, path, entries)
+}
 
-	if ensureErr := EnsureKey(cmd); ensureErr != nil {
-		return ensureErr
+// ReadFileWithIDs decrypts an arbitrary scratchpad file,
+// such as the base, ours and theirs versions git hands to a
+// merge driver, and returns its entries.
+//
+// Parameters:
+//   - path: Scratchpad file path
+//
+// Returns:
+//   - []parse.Entry: Entries with stable IDs; nil when the
+//     file is missing or empty
+//   - error: Non-nil on read, key or decryption errors
+func ReadFileWithIDs(path string) ([]parse.Entry, error) {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errPad.Read(readErr)
 	}
-
-	kp, kpErr := KeyPath()
-	if kpErr != nil {
-		return kpErr
+	if len(data) == 0 {
+		return nil, nil
 	}
-	key, loadErr := crypto.LoadKey(kp)
-	if loadErr != nil {
-		return errCrypto.LoadKey(loadErr, kp)
+	plaintext, decErr := decode(data)
+	if decErr != nil {
+		return nil, decErr
 	}
+	return parse.EntriesWithIDs(plaintext), nil
+}
 
-	ciphertext, encErr := crypto.Encrypt(key, plaintext)
+// WriteFileWithIDs encrypts entries the way the scratchpad
+// is stored and writes them to path.
+//
+// Parameters:
+//   - cmd: Cobra command for diagnostic output
+//   - path: Destination file path
+//   - entries: Entries with stable IDs to write
+//
+// Returns:
+//   - error: Non-nil on key, encryption, or write errors
+func WriteFileWithIDs(
+	cmd *cobra.Command, path string, entries []parse.Entry,
+) error {
+	data, encErr := encode(cmd, parse.FormatEntriesWithIDs(entries))
 	if encErr != nil {
-		return errCrypto.EncryptFailed(encErr)
+		return encErr
 	}
-
-	return io.SafeWriteFile(path, ciphertext, fs.PermFile)
+	return io.SafeWriteFile(path, data, fs.PermFile)
 }
 
 // ReadEntries reads the scratchpad and returns content
@@ -248,3 +281,53 @@ func WriteEntries(
 
 	return WriteEntriesWithIDs(cmd, idEntries)
 }
+
+// Rewrap replaces the recipient list and re-encrypts the
+// scratchpad for it.
+//
+// The entries are read with the current list before it is
+// replaced. If re-encryption fails the previous list is
+// restored so the pad and the list never disagree.
+//
+// Parameters:
+//   - cmd: Cobra command for diagnostic output
+//   - ctxDir: Context directory path
+//   - rs: New recipient list; empty reverts to the single key
+//
+// Returns:
+//   - error: Non-nil on read, save, or encryption failure
+func Rewrap(
+	cmd *cobra.Command, ctxDir string, rs []recipient.Recipient,
+) error {
+	prev, prevErr := recipient.Load(ctxDir)
+	if prevErr != nil {
+		return prevErr
+	}
+	path, pathErr := ScratchpadPath()
+	if pathErr != nil {
+		return pathErr
+	}
+	_, statErr := os.Stat(path)
+	exists := statErr == nil
+
+	var entries []parse.Entry
+	if exists {
+		var readErr error
+		entries, readErr = ReadEntriesWithIDs()
+		if readErr != nil {
+			return readErr
+		}
+	}
+
+	if saveErr := recipient.Save(ctxDir, rs); saveErr != nil {
+		return saveErr
+	}
+	if !exists {
+		return nil
+	}
+	if writeErr := WriteEntriesWithIDs(cmd, entries); writeErr != nil {
+		_ = recipient.Save(ctxDir, prev)
+		return writeErr
+	}
+	return nil
+}
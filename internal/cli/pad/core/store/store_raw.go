@@ -11,6 +11,10 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/cobra"
+
+	padCrypto "github.com/ActiveMemory/ctx/internal/cli/pad/core/crypto"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/recipient"
 	"github.com/ActiveMemory/ctx/internal/crypto"
 	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
 	errPad "github.com/ActiveMemory/ctx/internal/err/pad"
@@ -39,23 +43,90 @@ func readRaw() ([]byte, error) {
 		return nil, errPad.Read(readErr)
 	}
 
+	return decode(data)
+}
+
+// decode decrypts raw scratchpad bytes with the user's
+// identity, the keyring, or not at all when encryption is
+// disabled.
+//
+// Parameters:
+//   - data: Raw file content
+//
+// Returns:
+//   - []byte: Decrypted plaintext
+//   - error: Non-nil on key or decryption errors
+func decode(data []byte) ([]byte, error) {
 	if !rc.ScratchpadEncrypt() {
 		return data, nil
 	}
 
+	// Shared pads are opened with the user's identity; no
+	// symmetric key is needed.
+	if crypto.IsEnvelope(data) {
+		return padCrypto.Open(nil, data)
+	}
+
 	kp, kpErr := KeyPath()
 	if kpErr != nil {
 		return nil, kpErr
 	}
-	key, loadErr := crypto.LoadKey(kp)
+	keys, loadErr := crypto.LoadKeyring(kp, rc.KeyGraceDays())
 	if loadErr != nil {
 		return nil, errCrypto.LoadKey(loadErr, kp)
 	}
 
-	plaintext, decErr := crypto.Decrypt(key, data)
-	if decErr != nil {
-		return nil, errCrypto.DecryptFailed()
+	return padCrypto.Open(keys, data)
+}
+
+// encode encrypts scratchpad plaintext the way the pad is
+// stored: sealed for recipients when the context directory
+// lists any, otherwise with the single scratchpad key.
+//
+// Parameters:
+//   - cmd: Cobra command for diagnostic output
+//   - plaintext: Formatted entries
+//
+// Returns:
+//   - []byte: Bytes to write to disk
+//   - error: Non-nil on key or encryption errors
+func encode(cmd *cobra.Command, plaintext []byte) ([]byte, error) {
+	if !rc.ScratchpadEncrypt() {
+		return plaintext, nil
 	}
 
-	return plaintext, nil
+	ctxDir, dirErr := rc.ContextDir()
+	if dirErr != nil {
+		return nil, dirErr
+	}
+	rs, rsErr := recipient.Load(ctxDir)
+	if rsErr != nil {
+		return nil, rsErr
+	}
+	if len(rs) > 0 {
+		sealed, sealErr := crypto.Seal(recipient.Keys(rs), plaintext)
+		if sealErr != nil {
+			return nil, errCrypto.EncryptFailed(sealErr)
+		}
+		return sealed, nil
+	}
+
+	if ensureErr := EnsureKey(cmd); ensureErr != nil {
+		return nil, ensureErr
+	}
+
+	kp, kpErr := KeyPath()
+	if kpErr != nil {
+		return nil, kpErr
+	}
+	key, loadErr := crypto.LoadKey(kp)
+	if loadErr != nil {
+		return nil, errCrypto.LoadKey(loadErr, kp)
+	}
+
+	ciphertext, encErr := crypto.Encrypt(key, plaintext)
+	if encErr != nil {
+		return nil, errCrypto.EncryptFailed(encErr)
+	}
+	return ciphertext, nil
 }
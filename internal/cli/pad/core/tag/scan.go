@@ -6,11 +6,14 @@
 
 package tag
 
-import "github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+import (
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
+)
 
 // ScanText returns the portion of an entry to scan for tags.
-// For blob entries, only the label is returned. For plain entries,
-// the full text is returned.
+// Any metadata prefix is skipped. For blob entries, only the
+// label is returned. For plain entries, the full text is returned.
 //
 // Parameters:
 //   - entry: Scratchpad entry string
@@ -18,8 +21,9 @@ import "github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
 // Returns:
 //   - string: Text to scan for tags
 func ScanText(entry string) string {
-	if label, _, ok := blob.Split(entry); ok {
+	body := meta.Body(entry)
+	if label, _, ok := blob.Split(body); ok {
 		return label
 	}
-	return entry
+	return body
 }
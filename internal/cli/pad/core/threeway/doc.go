@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package threeway merges three versions of the
+// scratchpad entry by entry.
+//
+// Git hands a merge driver the common ancestor, our
+// version and their version of a file. Because the pad
+// is encrypted, git's line merge cannot help; the
+// `ctx pad git-merge-driver` command decrypts all three
+// and calls [Merge], which pairs entries by their stable
+// ID:
+//
+//   - an entry changed on one side only takes that side;
+//   - an entry deleted on one side and untouched on the
+//     other is deleted;
+//   - an entry deleted on one side and changed on the
+//     other is kept, so no edit is lost;
+//   - entries added on either side are kept, and an
+//     added entry whose ID is already taken is given a
+//     fresh one;
+//   - an entry changed differently on both sides is a
+//     conflict: both versions are kept, theirs under a
+//     fresh ID, and the ID is reported.
+//
+// Added entries whose content already appears in the
+// result are dropped, so the same note added on both
+// branches is kept once.
+package threeway
@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package threeway
+
+import (
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+)
+
+// byID indexes entry content by stable ID.
+//
+// Parameters:
+//   - entries: Entries to index
+//
+// Returns:
+//   - map[int]string: Content keyed by ID
+func byID(entries []parse.Entry) map[int]string {
+	m := make(map[int]string, len(entries))
+	for _, e := range entries {
+		m[e.ID] = e.Content
+	}
+	return m
+}
+
+// appendNew appends e unless an entry with the same
+// content is already present.
+//
+// Parameters:
+//   - merged: Entries collected so far
+//   - e: Candidate entry
+//
+// Returns:
+//   - []parse.Entry: merged, with e appended when new
+func appendNew(merged []parse.Entry, e parse.Entry) []parse.Entry {
+	for _, m := range merged {
+		if m.Content == e.Content {
+			return merged
+		}
+	}
+	return append(merged, e)
+}
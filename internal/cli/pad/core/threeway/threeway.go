@@ -0,0 +1,79 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package threeway
+
+import (
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+)
+
+// Merge combines our and their scratchpad entries against
+// the common ancestor.
+//
+// Our entries keep their order; entries that only exist on
+// their side follow, then entries that needed a fresh ID.
+//
+// Parameters:
+//   - base: Entries of the common ancestor (may be empty)
+//   - ours: Entries of the current branch
+//   - theirs: Entries of the branch being merged
+//
+// Returns:
+//   - []parse.Entry: Merged entries
+//   - []int: IDs of entries changed differently on both
+//     sides; both versions are kept in the result
+func Merge(base, ours, theirs []parse.Entry) ([]parse.Entry, []int) {
+	baseBy := byID(base)
+	oursBy := byID(ours)
+	theirsBy := byID(theirs)
+
+	merged := make([]parse.Entry, 0, len(ours)+len(theirs))
+	var renumber []parse.Entry
+	var conflicts []int
+
+	for _, o := range ours {
+		b, inBase := baseBy[o.ID]
+		t, inTheirs := theirsBy[o.ID]
+		switch {
+		case !inTheirs && inBase && o.Content == b:
+			// Deleted on their side, untouched on ours.
+		case !inTheirs, o.Content == t, inBase && t == b:
+			merged = append(merged, o)
+		case inBase && o.Content == b:
+			merged = append(merged, parse.Entry{ID: o.ID, Content: t})
+		default:
+			merged = append(merged, o)
+			renumber = append(renumber, parse.Entry{Content: t})
+			if inBase {
+				conflicts = append(conflicts, o.ID)
+			}
+		}
+	}
+
+	for _, t := range theirs {
+		if _, inOurs := oursBy[t.ID]; inOurs {
+			continue
+		}
+		if b, inBase := baseBy[t.ID]; inBase && b == t.Content {
+			// Deleted on our side, untouched on theirs.
+			continue
+		}
+		merged = appendNew(merged, t)
+	}
+
+	nextID := max(
+		parse.NextID(base), parse.NextID(ours), parse.NextID(theirs),
+	)
+	for _, e := range renumber {
+		before := len(merged)
+		merged = appendNew(merged, parse.Entry{ID: nextID, Content: e.Content})
+		if len(merged) > before {
+			nextID++
+		}
+	}
+
+	return merged, conflicts
+}
@@ -0,0 +1,110 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package threeway
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+)
+
+func e(id int, content string) parse.Entry {
+	return parse.Entry{ID: id, Content: content}
+}
+
+func TestMerge(t *testing.T) {
+	base := []parse.Entry{e(1, "a"), e(2, "b"), e(3, "c")}
+	tests := []struct {
+		name      string
+		ours      []parse.Entry
+		theirs    []parse.Entry
+		want      []parse.Entry
+		conflicts []int
+	}{
+		{
+			"unchanged",
+			base, base, base, nil,
+		},
+		{
+			"edit on their side",
+			base,
+			[]parse.Entry{e(1, "a"), e(2, "B"), e(3, "c")},
+			[]parse.Entry{e(1, "a"), e(2, "B"), e(3, "c")},
+			nil,
+		},
+		{
+			"delete on their side",
+			base,
+			[]parse.Entry{e(1, "a"), e(3, "c")},
+			[]parse.Entry{e(1, "a"), e(3, "c")},
+			nil,
+		},
+		{
+			"delete on our side",
+			[]parse.Entry{e(2, "b"), e(3, "c")},
+			base,
+			[]parse.Entry{e(2, "b"), e(3, "c")},
+			nil,
+		},
+		{
+			"edit against delete keeps the edit",
+			[]parse.Entry{e(1, "a"), e(3, "c")},
+			[]parse.Entry{e(1, "a"), e(2, "B"), e(3, "c")},
+			[]parse.Entry{e(1, "a"), e(3, "c"), e(2, "B")},
+			nil,
+		},
+		{
+			"both add under the same ID",
+			append(base[:3:3], e(4, "ours")),
+			append(base[:3:3], e(4, "theirs")),
+			append(base[:3:3], e(4, "ours"), e(5, "theirs")),
+			nil,
+		},
+		{
+			"both add the same note",
+			append(base[:3:3], e(4, "same")),
+			append(base[:3:3], e(4, "same")),
+			append(base[:3:3], e(4, "same")),
+			nil,
+		},
+		{
+			"edit on both sides",
+			[]parse.Entry{e(1, "a"), e(2, "ours"), e(3, "c")},
+			[]parse.Entry{e(1, "a"), e(2, "theirs"), e(3, "c")},
+			[]parse.Entry{
+				e(1, "a"), e(2, "ours"), e(3, "c"), e(4, "theirs"),
+			},
+			[]int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts := Merge(base, tt.ours, tt.theirs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("entries = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(conflicts, tt.conflicts) {
+				t.Errorf("conflicts = %v, want %v", conflicts, tt.conflicts)
+			}
+		})
+	}
+}
+
+func TestMerge_NoBase(t *testing.T) {
+	ours := []parse.Entry{e(1, "a")}
+	theirs := []parse.Entry{e(1, "b"), e(2, "a")}
+	got, conflicts := Merge(nil, ours, theirs)
+	want := []parse.Entry{e(1, "a"), e(3, "b")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("entries = %v, want %v", got, want)
+	}
+	if conflicts != nil {
+		t.Errorf("conflicts = %v, want none", conflicts)
+	}
+}
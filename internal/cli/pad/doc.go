@@ -17,6 +17,16 @@
 // and show auto-decodes blob entries. Blobs are subject to
 // a 64KB pre-encoding size limit.
 //
+// A team can share the pad by listing recipients: the pad
+// is then sealed with a fresh data key wrapped for each
+// recipient's X25519 public key, and each teammate opens it
+// with their own identity at ~/.ctx/.ctx.identity.
+//
+// Entries may carry metadata inside the encrypted text: an
+// expiry date, a category and the author. Expired entries are
+// flagged in the list and cleared by gc, and every show is
+// recorded in a local access log under .context/state/.
+//
 // A plaintext fallback (.context/scratchpad.md) is
 // available via the scratchpad_encrypt config option in
 // .ctxrc.
@@ -33,15 +43,25 @@
 //   - resolve: resolve merge conflicts in scratchpad
 //   - normalize: reassign entry IDs as 1..N
 //   - tag: list all tags with counts
+//   - recipients: share the pad with teammates' public keys
+//   - gc: remove or tag expired entries
+//   - git-merge-driver: merge encrypted pad versions for git
+//   - git-textconv: decrypt the pad for git diff
 //
 // # Subpackages
 //
 //	cmd/add, cmd/edit, cmd/rm, cmd/mv: entry mutation
 //	cmd/show, cmd/export: entry display and extraction
-//	cmd/merge, cmd/resolve, cmd/normalize: maintenance
+//	cmd/merge, cmd/resolve, cmd/normalize, cmd/gc: maintenance
+//	cmd/git_merge_driver, cmd/git_textconv: git drivers
 //	cmd/tag: tag listing and filtering
+//	cmd/recipients: multi-recipient sharing
 //	cmd/root: default list behavior
 //	core/store: encrypted file I/O
+//	core/recipient: recipient list and identity
 //	core/blob: blob encoding and decoding
 //	core/tag: tag extraction and counting
+//	core/meta: entry expiry, category and author
+//	core/access: show access log
+//	core/threeway: entry-level three-way merge
 package pad
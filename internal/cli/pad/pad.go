@@ -8,6 +8,7 @@ package pad
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,21 +16,27 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/add"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/edit"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/export"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/gc"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/git_merge_driver"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/git_textconv"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/merge"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/mv"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/normalize"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/recipients"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/resolve"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/rm"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/root"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/cmd/show"
 	tagCmd "github.com/ActiveMemory/ctx/internal/cli/pad/cmd/tag"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/tag"
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 	embedFlag "github.com/ActiveMemory/ctx/internal/config/embed/flag"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/flagbind"
 	"github.com/ActiveMemory/ctx/internal/write/pad"
 )
@@ -42,6 +49,7 @@ import (
 //   - *cobra.Command: Configured pad command with subcommands
 func Cmd() *cobra.Command {
 	var tagFilters []string
+	var category string
 
 	short, long := desc.Command(cmd.DescKeyPad)
 	c := &cobra.Command{
@@ -61,18 +69,24 @@ func Cmd() *cobra.Command {
 				return nil
 			}
 
+			today := time.Now().Format(cfgTime.DateFormat)
 			printed := 0
 			for _, entry := range entries {
 				if len(tagFilters) > 0 &&
 					!tag.MatchAll(entry.Content, tagFilters) {
 					continue
 				}
+				m, body := meta.Split(entry.Content)
+				if category != "" && m.Category != category {
+					continue
+				}
 				pad.EntryList(
 					cmd,
 					fmt.Sprintf(
 						desc.Text(text.DescKeyWritePadListItem),
 						entry.ID,
-						blob.DisplayEntry(entry.Content),
+						blob.DisplayEntry(body)+
+							meta.Annotation(m, today),
 					),
 				)
 				printed++
@@ -90,6 +104,9 @@ func Cmd() *cobra.Command {
 		cFlag.Tag, cFlag.ShortTag,
 		embedFlag.DescKeyPadTag,
 	)
+	flagbind.StringFlag(c, &category,
+		cFlag.Category, embedFlag.DescKeyPadCategory,
+	)
 
 	c.AddCommand(show.Cmd())
 	c.AddCommand(add.Cmd())
@@ -102,6 +119,10 @@ func Cmd() *cobra.Command {
 	c.AddCommand(merge.Cmd())
 	c.AddCommand(normalize.Cmd())
 	c.AddCommand(tagCmd.Cmd())
+	c.AddCommand(recipients.Cmd())
+	c.AddCommand(gc.Cmd())
+	c.AddCommand(git_merge_driver.Cmd())
+	c.AddCommand(git_textconv.Cmd())
 
 	return c
 }
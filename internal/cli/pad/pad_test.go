@@ -15,6 +15,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/access"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/blob"
 	padCrypto "github.com/ActiveMemory/ctx/internal/cli/pad/core/crypto"
 	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
@@ -664,6 +665,7 @@ func TestCmd_HasSubcommands(t *testing.T) {
 		"edit N [TEXT]", "mv N M", "resolve",
 		"normalize",
 		"import FILE", "export [DIR]", "tag",
+		"recipients",
 	} {
 		if !names[expected] {
 			t.Errorf("missing subcommand %q", expected)
@@ -1172,7 +1174,7 @@ func TestDecryptFile_BadData(t *testing.T) {
 		t.Fatal(writeErr)
 	}
 
-	_, err := padCrypto.DecryptFile(key, tmpDir, "bad.enc")
+	_, err := padCrypto.DecryptFile([][]byte{key}, tmpDir, "bad.enc")
 	if err == nil {
 		t.Fatal("expected decryption error for bad data")
 	}
@@ -1185,7 +1187,7 @@ func TestDecryptFile_MissingFile(t *testing.T) {
 	key, _ := crypto.GenerateKey()
 	tmpDir := t.TempDir()
 
-	_, err := padCrypto.DecryptFile(key, tmpDir, "nonexistent.enc")
+	_, err := padCrypto.DecryptFile([][]byte{key}, tmpDir, "nonexistent.enc")
 	if err == nil {
 		t.Fatal("expected error for missing file")
 	}
@@ -1205,7 +1207,7 @@ func TestDecryptFile_ValidData(t *testing.T) {
 		t.Fatal(writeErr)
 	}
 
-	entries, err := padCrypto.DecryptFile(key, tmpDir, "good.enc")
+	entries, err := padCrypto.DecryptFile([][]byte{key}, tmpDir, "good.enc")
 	if err != nil {
 		t.Fatalf("decryptFile error: %v", err)
 	}
@@ -2669,6 +2671,53 @@ func TestMerge_EncryptedInput(t *testing.T) {
 	}
 }
 
+func TestGraceKey_ReadsPreRotationCopies(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+
+	kp, kpErr := rc.KeyPath()
+	if kpErr != nil {
+		t.Fatal(kpErr)
+	}
+	old, err := crypto.LoadKey(kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeEncryptedPad(
+		t, filepath.Join(dir.Context, pad.EncOurs), old,
+		[]string{"ours-before-rotation"},
+	)
+	encFile := filepath.Join(tmpDir, "other.enc")
+	writeEncryptedPad(t, encFile, old, []string{"merged-before-rotation"})
+
+	// Rotate: the old key becomes the grace key.
+	if err := crypto.SaveKey(crypto.GracePath(kp), old); err != nil {
+		t.Fatal(err)
+	}
+	next, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := crypto.SaveKey(kp, next); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCmd(newPadCmd("resolve"))
+	if err != nil {
+		t.Fatalf("resolve error: %v", err)
+	}
+	if !strings.Contains(out, "ours-before-rotation") {
+		t.Errorf("resolve output = %q, want the pre-rotation entry", out)
+	}
+
+	out, err = runCmd(newPadCmd("merge", encFile))
+	if err != nil {
+		t.Fatalf("merge error: %v", err)
+	}
+	if !strings.Contains(out, "Merged 1 new entry") {
+		t.Errorf("merge output = %q, want the pre-rotation entry", out)
+	}
+}
+
 func TestMerge_PlaintextFallback(t *testing.T) {
 	tmpDir := setupPlaintext(t)
 
@@ -3346,5 +3395,556 @@ func TestEdit_TagConflictsWithFile(t *testing.T) {
 	}
 }
 
+// newIdentity generates a recipient identity and its public key.
+func newIdentity(t *testing.T) ([]byte, string) {
+	t.Helper()
+	id, err := crypto.GenerateIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := crypto.PublicKey(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id, pub
+}
+
+func TestRecipients_AddSealsForEveryone(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+	if _, err := runCmd(newPadCmd("add", "shared secret")); err != nil {
+		t.Fatal(err)
+	}
+
+	bob, bobPub := newIdentity(t)
+	out, err := runCmd(newPadCmd(
+		"recipients", "add", bobPub, "--name", "bob",
+	))
+	if err != nil {
+		t.Fatalf("recipients add: %v", err)
+	}
+	if !strings.Contains(out, "for 2 recipients") {
+		t.Errorf("output = %q, want recipient count", out)
+	}
+
+	encPath := filepath.Join(tmpDir, dir.Context, pad.Enc)
+	data, err := os.ReadFile(encPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !crypto.IsEnvelope(data) {
+		t.Fatal("scratchpad should be sealed as an envelope")
+	}
+	plain, err := crypto.Open(bob, data)
+	if err != nil {
+		t.Fatalf("bob cannot open the pad: %v", err)
+	}
+	if !strings.Contains(string(plain), "shared secret") {
+		t.Errorf("bob sees %q, want the entry", plain)
+	}
+
+	// The owner still reads through their own identity, even
+	// without the single shared key.
+	if err := os.Remove(crypto.GlobalKeyPath()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd("add", "second")); err != nil {
+		t.Fatalf("add after sharing: %v", err)
+	}
+	out, err = runCmd(newPadCmd())
+	if err != nil {
+		t.Fatalf("list after sharing: %v", err)
+	}
+	if !strings.Contains(out, "shared secret") ||
+		!strings.Contains(out, "second") {
+		t.Errorf("list output = %q, want both entries", out)
+	}
+
+	out, err = runCmd(newPadCmd("recipients", "list"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "(you)") || !strings.Contains(out, "bob") {
+		t.Errorf("list output = %q, want self marker and bob", out)
+	}
+}
+
+func TestRecipients_RemoveRewrapsAndReverts(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+	if _, err := runCmd(newPadCmd("add", "note")); err != nil {
+		t.Fatal(err)
+	}
+	bob, bobPub := newIdentity(t)
+	if _, err := runCmd(newPadCmd(
+		"recipients", "add", bobPub, "--name", "bob",
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := runCmd(newPadCmd("recipients", "rm", "bob")); err != nil {
+		t.Fatalf("remove bob: %v", err)
+	}
+	encPath := filepath.Join(tmpDir, dir.Context, pad.Enc)
+	data, err := os.ReadFile(encPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := crypto.Open(bob, data); err == nil {
+		t.Error("removed recipient can still open the pad")
+	}
+
+	self, err := crypto.LoadKey(crypto.IdentityPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	selfPub, err := crypto.PublicKey(self)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := runCmd(newPadCmd("recipients", "remove", selfPub))
+	if err != nil {
+		t.Fatalf("remove self: %v", err)
+	}
+	if !strings.Contains(out, "single shared key") {
+		t.Errorf("output = %q, want single-key notice", out)
+	}
+
+	data, err = os.ReadFile(encPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.IsEnvelope(data) {
+		t.Error("pad should revert to single-key ciphertext")
+	}
+	recPath := filepath.Join(tmpDir, dir.Context, pad.Recipients)
+	if _, err := os.Stat(recPath); !os.IsNotExist(err) {
+		t.Error("recipients file should be removed")
+	}
+	out, err = runCmd(newPadCmd())
+	if err != nil || !strings.Contains(out, "note") {
+		t.Errorf("list = %q, %v; want entry", out, err)
+	}
+}
+
+func TestRecipients_RemoveSelfNeedsForce(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+	if _, err := runCmd(newPadCmd("add", "note")); err != nil {
+		t.Fatal(err)
+	}
+	bob, bobPub := newIdentity(t)
+	if _, err := runCmd(newPadCmd(
+		"recipients", "add", bobPub, "--name", "bob",
+	)); err != nil {
+		t.Fatal(err)
+	}
+	self, err := crypto.LoadKey(crypto.IdentityPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	selfPub, err := crypto.PublicKey(self)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCmd(newPadCmd("recipients", "remove", selfPub))
+	if err == nil {
+		t.Fatal("expected error removing own key with others left")
+	}
+	if !strings.Contains(err.Error(), "--force") {
+		t.Errorf("error = %q, want --force hint", err)
+	}
+	if strings.Contains(out, "Usage:") {
+		t.Errorf("output = %q, want no usage dump", out)
+	}
+	encPath := filepath.Join(tmpDir, dir.Context, pad.Enc)
+	data, err := os.ReadFile(encPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := crypto.Open(self, data); err != nil {
+		t.Fatalf("refused removal still changed the pad: %v", err)
+	}
+
+	if _, err := runCmd(newPadCmd(
+		"recipients", "remove", selfPub, "--force",
+	)); err != nil {
+		t.Fatalf("remove self --force: %v", err)
+	}
+	data, err = os.ReadFile(encPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := crypto.Open(self, data); err == nil {
+		t.Error("forced removal should drop own access")
+	}
+	if _, err := crypto.Open(bob, data); err != nil {
+		t.Errorf("bob lost access: %v", err)
+	}
+}
+
+func TestRecipients_Errors(t *testing.T) {
+	setupEncrypted(t)
+	_, bobPub := newIdentity(t)
+
+	if _, err := runCmd(newPadCmd(
+		"recipients", "add", "ctxpub1bogus",
+	)); err == nil {
+		t.Error("expected error for an invalid public key")
+	}
+	if _, err := runCmd(newPadCmd("recipients", "add", bobPub)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd(
+		"recipients", "add", bobPub,
+	)); err == nil {
+		t.Error("expected error for a duplicate recipient")
+	}
+	if _, err := runCmd(newPadCmd(
+		"recipients", "remove", "nobody",
+	)); err == nil {
+		t.Error("expected error for an unknown recipient")
+	}
+}
+
+func TestRecipients_PlaintextMode(t *testing.T) {
+	setupPlaintext(t)
+	_, bobPub := newIdentity(t)
+
+	_, err := runCmd(newPadCmd("recipients", "add", bobPub))
+	if err == nil {
+		t.Fatal("expected error in plaintext mode")
+	}
+	if !strings.Contains(err.Error(), "encrypted scratchpad") {
+		t.Errorf("error = %q, want encryption hint", err)
+	}
+}
+
+func TestMeta_ExpiresAndCategory(t *testing.T) {
+	setupEncrypted(t)
+
+	if _, err := runCmd(newPadCmd(
+		"add", "temp token", "--expires", "2999-11-01",
+		"--category", "creds",
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd("add", "plain note")); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCmd(newPadCmd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "temp token [creds] (expires 2999-11-01)") {
+		t.Errorf("list = %q, want category and expiry", out)
+	}
+
+	out, err = runCmd(newPadCmd("--category", "creds"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "plain note") ||
+		!strings.Contains(out, "temp token") {
+		t.Errorf("category filter = %q", out)
+	}
+
+	out, err = runCmd(newPadCmd("show", "1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "temp token\n" {
+		t.Errorf("show = %q, want metadata stripped", out)
+	}
+
+	entries, err := store.ReadEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(entries[0], "{ctx expires=2999-11-01 category=creds") {
+		t.Errorf("stored entry = %q, want metadata prefix", entries[0])
+	}
+	if entries[1] != "plain note" {
+		t.Errorf("entry without flags = %q, want no metadata", entries[1])
+	}
+}
+
+func TestMeta_InvalidFlags(t *testing.T) {
+	setupEncrypted(t)
+
+	if _, err := runCmd(newPadCmd(
+		"add", "x", "--expires", "next week",
+	)); err == nil {
+		t.Error("expected error for an invalid expiry")
+	}
+	if _, err := runCmd(newPadCmd(
+		"add", "x", "--category", "two words",
+	)); err == nil {
+		t.Error("expected error for an invalid category")
+	}
+}
+
+func TestMeta_EditPreservesMetadata(t *testing.T) {
+	setupEncrypted(t)
+
+	if _, err := runCmd(newPadCmd(
+		"add", "token", "--category", "creds",
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd(
+		"edit", "1", "--prepend", "staging",
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd(
+		"edit", "1", "--tag", "later",
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCmd(newPadCmd("show", "1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "staging token #later\n" {
+		t.Errorf("show = %q", out)
+	}
+	out, err = runCmd(newPadCmd("--category", "creds"))
+	if err != nil || !strings.Contains(out, "staging token #later [creds]") {
+		t.Errorf("list = %q, %v; want category kept", out, err)
+	}
+
+	if _, err := runCmd(newPadCmd("edit", "1", "rotated")); err != nil {
+		t.Fatal(err)
+	}
+	out, err = runCmd(newPadCmd("--category", "creds"))
+	if err != nil || !strings.Contains(out, "rotated [creds]") {
+		t.Errorf("list after replace = %q, %v", out, err)
+	}
+}
+
+func TestGc_RemovesExpired(t *testing.T) {
+	setupEncrypted(t)
+
+	if _, err := runCmd(newPadCmd(
+		"add", "old token", "--expires", "2000-01-01",
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd(
+		"add", "fresh", "--expires", "30d",
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCmd(newPadCmd())
+	if err != nil || !strings.Contains(out, "(expired 2000-01-01)") {
+		t.Errorf("list = %q, %v; want expired marker", out, err)
+	}
+
+	out, err = runCmd(newPadCmd("gc", "--dry-run"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "[1] old token") ||
+		!strings.Contains(out, "Would remove 1") {
+		t.Errorf("dry run = %q", out)
+	}
+	if entries, _ := store.ReadEntries(); len(entries) != 2 {
+		t.Fatalf("dry run wrote %d entries, want 2", len(entries))
+	}
+
+	out, err = runCmd(newPadCmd("gc"))
+	if err != nil || !strings.Contains(out, "Removed 1") {
+		t.Fatalf("gc = %q, %v", out, err)
+	}
+	entries, err := store.ReadEntriesWithIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].ID != 2 {
+		t.Errorf("entries = %+v, want only ID 2", entries)
+	}
+
+	out, err = runCmd(newPadCmd("gc"))
+	if err != nil || !strings.Contains(out, "No expired entries") {
+		t.Errorf("second gc = %q, %v", out, err)
+	}
+}
+
+func TestGc_MarkBlob(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+
+	content := "cert body\n"
+	testFile := filepath.Join(tmpDir, "cert.pem")
+	if err := os.WriteFile(testFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCmd(newPadCmd(
+		"add", "--file", testFile, "--expires", "2000-01-01", "cert",
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 2 {
+		if _, err := runCmd(newPadCmd("gc", "--mark")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out, err := runCmd(newPadCmd("--tag", "expired"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "cert #expired [BLOB] (expired 2000-01-01)") {
+		t.Errorf("list = %q, want tagged blob", out)
+	}
+	out, err = runCmd(newPadCmd("show", "1"))
+	if err != nil || out != content {
+		t.Errorf("show = %q, %v; want blob data intact", out, err)
+	}
+}
+
+func TestShow_AccessLog(t *testing.T) {
+	setupEncrypted(t)
+
+	if _, err := runCmd(newPadCmd(
+		"add", "secret value", "--category", "creds",
+	)); err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if _, err := runCmd(newPadCmd("show", "1")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := runCmd(newPadCmd("show", "9")); err == nil {
+		t.Fatal("expected error for a missing entry")
+	}
+
+	records, err := access.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	r := records[0]
+	if r.ID != 1 || r.Kind != pad.AccessKindText ||
+		r.Category != "creds" || r.Time.IsZero() {
+		t.Errorf("record = %+v", r)
+	}
+
+	logPath, err := access.Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "secret value") {
+		t.Error("access log must not contain entry text")
+	}
+}
+
+// writePadFiles encrypts each version of the pad to its own
+// file and returns the paths, as git hands them to the driver.
+func writePadFiles(
+	t *testing.T, dir string, versions ...[]parse.Entry,
+) []string {
+	t.Helper()
+	paths := make([]string, len(versions))
+	for i, entries := range versions {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("pad-%d.enc", i))
+		if err := store.WriteFileWithIDs(
+			newPadCmd(), paths[i], entries,
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return paths
+}
+
+func TestGitMergeDriver_Clean(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+
+	base := []parse.Entry{{ID: 1, Content: "a"}, {ID: 2, Content: "b"}}
+	ours := []parse.Entry{{ID: 1, Content: "A"}, {ID: 2, Content: "b"}}
+	theirs := []parse.Entry{
+		{ID: 1, Content: "a"}, {ID: 2, Content: "b"}, {ID: 3, Content: "c"},
+	}
+	paths := writePadFiles(t, tmpDir, base, ours, theirs)
+
+	if _, err := runCmd(newPadCmd(
+		"git-merge-driver", paths[0], paths[1], paths[2],
+	)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := store.ReadFileWithIDs(paths[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "[1] A\n[2] b\n[3] c\n"
+	if string(parse.FormatEntriesWithIDs(got)) != want {
+		t.Errorf("merged = %q, want %q",
+			parse.FormatEntriesWithIDs(got), want)
+	}
+	data, _ := os.ReadFile(paths[1])
+	if strings.Contains(string(data), "[3] c") {
+		t.Error("merged pad was written in plaintext")
+	}
+}
+
+func TestGitMergeDriver_Conflict(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+
+	paths := writePadFiles(t, tmpDir,
+		[]parse.Entry{{ID: 1, Content: "a"}},
+		[]parse.Entry{{ID: 1, Content: "ours"}},
+		[]parse.Entry{{ID: 1, Content: "theirs"}},
+	)
+
+	out, err := runCmd(newPadCmd(
+		"git-merge-driver", paths[0], paths[1], paths[2],
+	))
+	if err == nil {
+		t.Fatal("expected an error for a conflicting edit")
+	}
+	if !strings.Contains(out, "entry 1 changed on both sides") {
+		t.Errorf("output = %q, want conflict note", out)
+	}
+
+	got, readErr := store.ReadFileWithIDs(paths[1])
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	want := "[1] ours\n[2] theirs\n"
+	if string(parse.FormatEntriesWithIDs(got)) != want {
+		t.Errorf("merged = %q, want %q",
+			parse.FormatEntriesWithIDs(got), want)
+	}
+}
+
+func TestGitTextconv(t *testing.T) {
+	tmpDir := setupEncrypted(t)
+
+	paths := writePadFiles(t, tmpDir, []parse.Entry{
+		{ID: 1, Content: "{ctx category=ops} rotate keys"},
+		{ID: 4, Content: blob.Make("cert", []byte("data"))},
+	})
+
+	out, err := runCmd(newPadCmd("git-textconv", paths[0]))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "[1] {ctx category=ops} rotate keys\n" +
+		"[4] cert [BLOB sha256:3a6eb0790f39]\n"
+	if out != want {
+		t.Errorf("textconv = %q, want %q", out, want)
+	}
+}
+
 // Verify unused import doesn't cause issues.
 var _ = base64.StdEncoding
@@ -19,6 +19,7 @@ import (
 	coreCopilot "github.com/ActiveMemory/ctx/internal/cli/setup/core/copilot"
 	coreCopCLI "github.com/ActiveMemory/ctx/internal/cli/setup/core/copilot_cli"
 	coreCursor "github.com/ActiveMemory/ctx/internal/cli/setup/core/cursor"
+	coreGit "github.com/ActiveMemory/ctx/internal/cli/setup/core/git"
 	coreKiro "github.com/ActiveMemory/ctx/internal/cli/setup/core/kiro"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	cfgHook "github.com/ActiveMemory/ctx/internal/config/hook"
@@ -64,6 +65,12 @@ func Run(cmd *cobra.Command, args []string, writeFile bool) error {
 		}
 		writeSetup.InfoCursorIntegration(cmd)
 
+	case cfgHook.ToolGit:
+		if writeFile {
+			return coreGit.Deploy(cmd)
+		}
+		writeSetup.InfoGitIntegration(cmd)
+
 	case cfgHook.ToolKiro:
 		if writeFile {
 			return coreKiro.Deploy(cmd)
@@ -0,0 +1,112 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	cfgSetup "github.com/ActiveMemory/ctx/internal/config/setup"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errSetup "github.com/ActiveMemory/ctx/internal/err/setup"
+	"github.com/ActiveMemory/ctx/internal/io"
+	writeSetup "github.com/ActiveMemory/ctx/internal/write/setup"
+)
+
+// padPath returns the encrypted pad path relative to the
+// repository root, in the slash form .gitattributes expects.
+//
+// Parameters:
+//   - root: Repository root reported by git
+//   - ctxDir: Absolute context directory
+//
+// Returns:
+//   - string: Relative pad path
+func padPath(root, ctxDir string) string {
+	// git reports the root with symlinks resolved; resolve
+	// the context directory too so the paths line up.
+	if resolved, evalErr := filepath.EvalSymlinks(ctxDir); evalErr == nil {
+		ctxDir = resolved
+	}
+	abs := filepath.Join(ctxDir, pad.Enc)
+	rel, relErr := filepath.Rel(root, abs)
+	if relErr != nil {
+		return filepath.ToSlash(abs)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// attrPattern renders path as a .gitattributes pattern that
+// matches only that path: wildmatch metacharacters are escaped,
+// and a path with whitespace or quotes is C-style quoted.
+//
+// Parameters:
+//   - path: Pad path relative to the repository root
+//
+// Returns:
+//   - string: Pattern for the attributes line
+func attrPattern(path string) string {
+	pattern := strings.NewReplacer(
+		cfgSetup.GitAttrGlobEscapes...,
+	).Replace(path)
+	if !strings.ContainsAny(path, cfgSetup.GitAttrQuoteTrigger) {
+		return pattern
+	}
+	return token.DoubleQuote + strings.NewReplacer(
+		cfgSetup.GitAttrQuoteEscapes...,
+	).Replace(pattern) + token.DoubleQuote
+}
+
+// ensureAttribute appends the ctx-pad attributes line for
+// path to .gitattributes unless it is already there.
+//
+// Parameters:
+//   - cmd: Cobra command for status output
+//   - root: Repository root
+//   - path: Pad path relative to root
+//
+// Returns:
+//   - error: Non-nil on read or write failure
+func ensureAttribute(cmd *cobra.Command, root, path string) error {
+	target := filepath.Join(root, cfgSetup.FileGitattributes)
+	line := fmt.Sprintf(cfgSetup.GitAttrLine, attrPattern(path))
+
+	content, readErr := io.SafeReadUserFile(target)
+	if readErr != nil && !errors.Is(readErr, os.ErrNotExist) {
+		return readErr
+	}
+	for _, existing := range strings.Split(
+		string(content), token.NewlineLF,
+	) {
+		if strings.TrimSpace(existing) == line {
+			writeSetup.GitAttrExists(cmd, path)
+			return nil
+		}
+	}
+
+	sep := ""
+	if len(content) > 0 &&
+		!strings.HasSuffix(string(content), token.NewlineLF) {
+		sep = token.NewlineLF
+	}
+	if writeErr := io.SafeWriteFile(
+		target,
+		[]byte(string(content)+sep+line+token.NewlineLF),
+		fs.PermFile,
+	); writeErr != nil {
+		return errSetup.WriteFile(target, writeErr)
+	}
+	writeSetup.GitAttrAdded(cmd, line)
+	return nil
+}
@@ -0,0 +1,72 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/lookup"
+	cfgSetup "github.com/ActiveMemory/ctx/internal/config/setup"
+)
+
+func init() { lookup.Init() }
+
+func TestEnsureAttribute_QuotesPath(t *testing.T) {
+	tests := []string{
+		".context/.scratchpad.enc",
+		"my project/.context/.scratchpad.enc",
+		`odd "dir"/.context/.scratchpad.enc`,
+		"glob*[x]/.context/.scratchpad.enc",
+	}
+	for _, padRel := range tests {
+		t.Run(padRel, func(t *testing.T) {
+			root := t.TempDir()
+			if out, initErr := exec.Command(
+				"git", "init", "-q", root,
+			).CombinedOutput(); initErr != nil {
+				t.Fatalf("git init: %v\n%s", initErr, out)
+			}
+			cmd := &cobra.Command{}
+			cmd.SetOut(&bytes.Buffer{})
+			for range 2 {
+				if attrErr := ensureAttribute(
+					cmd, root, padRel,
+				); attrErr != nil {
+					t.Fatalf("ensureAttribute: %v", attrErr)
+				}
+			}
+
+			check := exec.Command(
+				"git", "check-attr", "merge", "diff", "--", padRel,
+			)
+			check.Dir = root
+			out, checkErr := check.Output()
+			if checkErr != nil {
+				t.Fatalf("git check-attr: %v", checkErr)
+			}
+			if strings.Count(string(out), ": ctx-pad") != 2 {
+				t.Errorf("check-attr = %q, want merge and diff ctx-pad", out)
+			}
+			attrs, readErr := os.ReadFile(
+				filepath.Join(root, cfgSetup.FileGitattributes),
+			)
+			if readErr != nil {
+				t.Fatalf("read: %v", readErr)
+			}
+			if n := strings.Count(string(attrs), "\n"); n != 1 {
+				t.Errorf("%d attribute lines, want 1:\n%s", n, attrs)
+			}
+		})
+	}
+}
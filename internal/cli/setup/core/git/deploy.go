@@ -0,0 +1,76 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	cfgSetup "github.com/ActiveMemory/ctx/internal/config/setup"
+	errSetup "github.com/ActiveMemory/ctx/internal/err/setup"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+	"github.com/ActiveMemory/ctx/internal/format"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeSetup "github.com/ActiveMemory/ctx/internal/write/setup"
+)
+
+// Deploy registers the encrypted scratchpad merge driver and
+// diff text converter with git:
+//  1. .gitattributes: routes the pad to the ctx-pad drivers
+//  2. .git/config: defines the merge driver and textconv
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: Non-nil outside a git repository, or when the
+//     attributes file or git config cannot be written
+func Deploy(cmd *cobra.Command) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	root, rootErr := execGit.Root()
+	if rootErr != nil {
+		return rootErr
+	}
+
+	if attrErr := ensureAttribute(
+		cmd, root, padPath(root, ctxDir),
+	); attrErr != nil {
+		return attrErr
+	}
+
+	settings := [][2]string{
+		{cfgSetup.GitMergeNameKey, cfgSetup.GitMergeName},
+		{
+			cfgSetup.GitMergeDriverKey,
+			fmt.Sprintf(
+				cfgSetup.GitMergeDriver, format.ShellQuote(ctxDir),
+			),
+		},
+		{
+			cfgSetup.GitTextconvKey,
+			fmt.Sprintf(
+				cfgSetup.GitTextconv, format.ShellQuote(ctxDir),
+			),
+		},
+	}
+	for _, kv := range settings {
+		if _, runErr := execGit.Run(
+			cfgGit.FlagChangeDir, root, cfgGit.Config, kv[0], kv[1],
+		); runErr != nil {
+			return errSetup.GitConfig(kv[0], runErr)
+		}
+		writeSetup.GitConfigSet(cmd, kv[0])
+	}
+
+	writeSetup.GitComplete(cmd, cfgSetup.DisplayGit)
+	return nil
+}
@@ -0,0 +1,31 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package git registers the encrypted scratchpad with git
+// during project setup.
+//
+// Git cannot merge or diff ciphertext, so two branches
+// that both touched .context/scratchpad.enc always
+// conflict and `git diff` shows only "Binary files
+// differ". This package wires in the `ctx pad
+// git-merge-driver` and `ctx pad git-textconv`
+// subcommands that decrypt the pad for git.
+//
+// # Deployment Steps
+//
+// [Deploy] performs two operations in sequence:
+//
+//  1. Attributes: appends "<pad> merge=ctx-pad
+//     diff=ctx-pad" to .gitattributes at the
+//     repository root. Skips if the line exists. The
+//     file is committed, so teammates share it.
+//  2. Git config: sets merge.ctx-pad.name,
+//     merge.ctx-pad.driver and diff.ctx-pad.textconv
+//     in the repository's .git/config. The commands
+//     carry CTX_DIR because git is often run from
+//     editors and GUIs that do not export it. Each
+//     clone runs `ctx setup git --write` once.
+package git
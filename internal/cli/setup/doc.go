@@ -21,6 +21,7 @@
 //	Aider: .aider.conf.yml and conventions file
 //	GitHub Copilot: .github/copilot-instructions.md
 //	Windsurf: .windsurfrules configuration
+//	Git: scratchpad merge driver and diff textconv
 //
 // # Subpackages
 //
@@ -9,11 +9,14 @@ package setup
 import (
 	"bytes"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	coreCopilot "github.com/ActiveMemory/ctx/internal/cli/setup/core/copilot"
+	coreGit "github.com/ActiveMemory/ctx/internal/cli/setup/core/git"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
 	"github.com/spf13/cobra"
 )
 
@@ -25,6 +28,7 @@ func TestSetupCommand(t *testing.T) {
 	}{
 		{"claude-code", "Claude Code Integration"},
 		{"cursor", "Cursor IDE Integration"},
+		{"git", "Git scratchpad integration"},
 		{"aider", "Aider Integration"},
 		{"copilot", "GitHub Copilot Integration"},
 		{"windsurf", "Windsurf Integration"},
@@ -202,3 +206,55 @@ func TestDeployInstructions_ExistingWithoutMarker(t *testing.T) {
 		t.Errorf("output should mention merged, got: %s", out)
 	}
 }
+
+// TestDeployGit registers the scratchpad drivers once, even
+// when run twice.
+func TestDeployGit(t *testing.T) {
+	tmpDir := t.TempDir()
+	if out, gitErr := exec.Command(
+		"git", "-C", tmpDir, "init", "-q",
+	).CombinedOutput(); gitErr != nil {
+		t.Fatalf("git init: %v\n%s", gitErr, out)
+	}
+	ctxDir := testctx.Declare(t, tmpDir)
+	if mkErr := os.MkdirAll(ctxDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+
+	origDir, wdErr := os.Getwd()
+	if wdErr != nil {
+		t.Fatal(wdErr)
+	}
+	if chdirErr := os.Chdir(tmpDir); chdirErr != nil {
+		t.Fatal(chdirErr)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(origDir)
+	})
+
+	for range 2 {
+		if deployErr := coreGit.Deploy(newHookTestCmd()); deployErr != nil {
+			t.Fatalf("Deploy: %v", deployErr)
+		}
+	}
+
+	attrs, readErr := os.ReadFile(filepath.Join(tmpDir, ".gitattributes"))
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	want := ".context/scratchpad.enc merge=ctx-pad diff=ctx-pad\n"
+	if string(attrs) != want {
+		t.Errorf(".gitattributes = %q, want %q", attrs, want)
+	}
+
+	driver, cfgErr := exec.Command(
+		"git", "-C", tmpDir, "config", "merge.ctx-pad.driver",
+	).Output()
+	if cfgErr != nil {
+		t.Fatalf("git config: %v", cfgErr)
+	}
+	if !strings.Contains(string(driver), ctxDir) ||
+		!strings.Contains(string(driver), "git-merge-driver %O %A %B") {
+		t.Errorf("merge driver = %q", driver)
+	}
+}
@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the snapshot create subcommand.
+//
+// Arguments:
+//   - [name]: Optional label for the snapshot
+//
+// Returns:
+//   - *cobra.Command: Configured create subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySnapshotCreate)
+
+	return &cobra.Command{
+		Use:     cmd.UseSnapshotCreate,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySnapshotCreate),
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    Run,
+	}
+}
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package create implements the "ctx snapshot create"
+// subcommand for capturing the .context/ tree.
+//
+// # Behavior
+//
+// Hashes every capturable file under .context/, stores
+// objects the store does not have yet, and records a
+// manifest. The optional name argument is sanitized
+// like task snapshot names.
+//
+// # Output
+//
+// Prints the new snapshot ID, the number of files
+// captured, and how many objects were new.
+//
+// # Delegation
+//
+// Capture is handled by [snapshot.Create]; output goes
+// through [writeSnap.Created].
+package create
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/sanitize"
+	writeSnap "github.com/ActiveMemory/ctx/internal/write/snapshot"
+)
+
+// Run executes the snapshot create subcommand.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: Optional snapshot name as first argument
+//
+// Returns:
+//   - error: Non-nil if the context directory is not declared or
+//     the store cannot be written
+func Run(cmd *cobra.Command, args []string) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+
+	var name string
+	if len(args) > 0 {
+		name = sanitize.Filename(args[0])
+	}
+	s, written, createErr := snapshot.Create(ctxDir, name, time.Now())
+	if createErr != nil {
+		return createErr
+	}
+	writeSnap.Created(cmd, s.ID, len(s.Files), written)
+	return nil
+}
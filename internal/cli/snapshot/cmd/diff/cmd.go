@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the snapshot diff subcommand.
+//
+// Arguments:
+//   - snapshot: Old side (ID, name, or date)
+//   - [snapshot]: New side; the working tree when omitted
+//
+// Returns:
+//   - *cobra.Command: Configured diff subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySnapshotDiff)
+
+	return &cobra.Command{
+		Use:     cmd.UseSnapshotDiff,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySnapshotDiff),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE:    Run,
+	}
+}
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package diff implements the "ctx snapshot diff"
+// subcommand for comparing context trees.
+//
+// # Behavior
+//
+// Compares a snapshot with a second snapshot, or with
+// the current .context/ tree when only one reference is
+// given. Files are matched by path and compared by hash.
+//
+// # Output
+//
+// One status line per changed file (A added, D removed,
+// M modified), then a unified diff for each modified
+// file. Identical trees print a notice.
+//
+// # Delegation
+//
+// File matching is done by [snapshot.Diff]; line diffs
+// by [format.Unified].
+package diff
@@ -0,0 +1,98 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/format"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeSnap "github.com/ActiveMemory/ctx/internal/write/snapshot"
+)
+
+// Run compares two snapshots, or a snapshot with the working
+// tree.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: Old snapshot reference, then an optional new one
+//
+// Returns:
+//   - error: Non-nil if a snapshot does not resolve or content
+//     cannot be read
+func Run(cmd *cobra.Command, args []string) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+
+	older, oldErr := snapshot.Resolve(ctxDir, args[0])
+	if oldErr != nil {
+		return oldErr
+	}
+
+	// The new side is either a second snapshot or the live tree.
+	newLabel := cfgSnap.WorkingTree
+	var newFiles []entity.SnapshotFile
+	readNew := func(f entity.SnapshotFile) ([]byte, error) {
+		return ctxIo.SafeReadUserFile(
+			filepath.Join(ctxDir, filepath.FromSlash(f.Path)),
+		)
+	}
+	if len(args) > 1 {
+		newer, newErr := snapshot.Resolve(ctxDir, args[1])
+		if newErr != nil {
+			return newErr
+		}
+		newLabel = newer.ID
+		newFiles = newer.Files
+		readNew = func(f entity.SnapshotFile) ([]byte, error) {
+			return snapshot.ReadFile(ctxDir, f)
+		}
+	} else {
+		scanned, scanErr := snapshot.Scan(ctxDir)
+		if scanErr != nil {
+			return scanErr
+		}
+		newFiles = scanned
+	}
+
+	changes := snapshot.Diff(older.Files, newFiles)
+	if len(changes) == 0 {
+		writeSnap.Identical(cmd)
+		return nil
+	}
+	for _, c := range changes {
+		writeSnap.Change(cmd, c.Status, c.Path)
+	}
+	for _, c := range changes {
+		if c.Status != cfgSnap.StatusModified {
+			continue
+		}
+		a, aErr := snapshot.ReadFile(ctxDir, *c.Old)
+		if aErr != nil {
+			return aErr
+		}
+		b, bErr := readNew(*c.New)
+		if bErr != nil {
+			return bErr
+		}
+		writeSnap.Diff(cmd, format.Unified(
+			path.Join(older.ID, c.Path), path.Join(newLabel, c.Path),
+			string(a), string(b),
+		))
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the snapshot list subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured list subcommand
+func Cmd() *cobra.Command {
+	short, _ := desc.Command(cmd.DescKeySnapshotList)
+
+	return &cobra.Command{
+		Use:     cmd.UseSnapshotList,
+		Aliases: []string{cmd.UseSnapshotListAlias},
+		Short:   short,
+		Example: desc.Example(cmd.DescKeySnapshotList),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd)
+		},
+	}
+}
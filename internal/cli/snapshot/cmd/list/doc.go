@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package list implements the "ctx snapshot list"
+// subcommand for displaying all snapshots.
+//
+// # Behavior
+//
+// Reads every manifest in the store and prints one line
+// per snapshot, oldest first: ID, creation time, file
+// count, and name when set. An empty store prints a
+// notice.
+//
+// # Delegation
+//
+// Manifests are read by [snapshot.List]; lines are
+// rendered by [writeSnap.Item] and [writeSnap.None].
+package list
@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeSnap "github.com/ActiveMemory/ctx/internal/write/snapshot"
+)
+
+// Run prints every snapshot, oldest first.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: Non-nil if the store cannot be read
+func Run(cmd *cobra.Command) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+
+	all, listErr := snapshot.List(ctxDir)
+	if listErr != nil {
+		return listErr
+	}
+	if len(all) == 0 {
+		writeSnap.None(cmd)
+		return nil
+	}
+	for _, s := range all {
+		writeSnap.Item(
+			cmd, s.ID,
+			s.Created.Local().Format(cfgTime.DateTimeFmt),
+			len(s.Files), s.Name,
+		)
+	}
+	return nil
+}
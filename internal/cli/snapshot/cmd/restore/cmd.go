@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the snapshot restore subcommand.
+//
+// Arguments:
+//   - snapshot: Snapshot ID, name, or date to restore
+//
+// Returns:
+//   - *cobra.Command: Configured restore subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySnapshotRestore)
+
+	return &cobra.Command{
+		Use:     cmd.UseSnapshotRestore,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySnapshotRestore),
+		Args:    cobra.ExactArgs(1),
+		RunE:    Run,
+	}
+}
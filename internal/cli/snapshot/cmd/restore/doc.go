@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package restore implements the "ctx snapshot restore"
+// subcommand for rolling .context/ back to a snapshot.
+//
+// # Behavior
+//
+// Resolves the snapshot, captures the current tree as a
+// "pre-restore" snapshot, then rewrites every file whose
+// content differs from the snapshot. Files created after
+// the snapshot are kept.
+//
+// # Output
+//
+// Prints the safety snapshot ID (with the command to
+// undo), each rewritten path, and a summary count.
+//
+// # Delegation
+//
+// Capture is handled by [snapshot.Create]; rewriting by
+// [snapshot.Restore].
+package restore
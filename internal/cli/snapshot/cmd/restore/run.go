@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeSnap "github.com/ActiveMemory/ctx/internal/write/snapshot"
+)
+
+// Run restores a snapshot into the context directory.
+//
+// The current tree is captured first so the restore can be
+// undone.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: Snapshot reference
+//
+// Returns:
+//   - error: Non-nil if the snapshot does not resolve or files
+//     cannot be written
+func Run(cmd *cobra.Command, args []string) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+
+	target, resolveErr := snapshot.Resolve(ctxDir, args[0])
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	safety, _, safetyErr := snapshot.Create(
+		ctxDir, cfgSnap.PreRestoreName, time.Now(),
+	)
+	if safetyErr != nil {
+		return safetyErr
+	}
+	writeSnap.Safety(cmd, safety.ID)
+
+	restored, restoreErr := snapshot.Restore(ctxDir, target)
+	for _, c := range restored {
+		writeSnap.Change(cmd, c.Status, c.Path)
+	}
+	if restoreErr != nil {
+		return restoreErr
+	}
+	writeSnap.Restored(cmd, len(restored), target.ID)
+	return nil
+}
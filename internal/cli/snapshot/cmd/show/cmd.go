@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package show
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the snapshot show subcommand.
+//
+// Arguments:
+//   - snapshot: Snapshot ID, name, or date
+//   - [file]: Optional path relative to .context/
+//
+// Returns:
+//   - *cobra.Command: Configured show subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySnapshotShow)
+
+	return &cobra.Command{
+		Use:     cmd.UseSnapshotShow,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySnapshotShow),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE:    Run,
+	}
+}
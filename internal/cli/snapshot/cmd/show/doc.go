@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package show implements the "ctx snapshot show"
+// subcommand for inspecting one snapshot.
+//
+// # Behavior
+//
+// With only a snapshot reference, prints the snapshot
+// header followed by every captured file and its size.
+// With a file path as well, prints that file's content
+// as captured, after verifying it against its hash.
+//
+// # Delegation
+//
+// The reference is resolved by [snapshot.Resolve];
+// content is read by [snapshot.ReadFile].
+package show
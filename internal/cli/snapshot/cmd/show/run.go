@@ -0,0 +1,63 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package show
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/context/snapshot"
+	errSnap "github.com/ActiveMemory/ctx/internal/err/snapshot"
+	"github.com/ActiveMemory/ctx/internal/format"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	writeSnap "github.com/ActiveMemory/ctx/internal/write/snapshot"
+)
+
+// Run prints a snapshot's manifest or one captured file.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: Snapshot reference, then an optional file path
+//
+// Returns:
+//   - error: Non-nil if the snapshot or file does not exist
+func Run(cmd *cobra.Command, args []string) error {
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		cmd.SilenceUsage = true
+		return ctxErr
+	}
+
+	s, resolveErr := snapshot.Resolve(ctxDir, args[0])
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	if len(args) > 1 {
+		path := filepath.ToSlash(filepath.Clean(args[1]))
+		f := snapshot.Find(s, path)
+		if f == nil {
+			return errSnap.FileNotFound(s.ID, path)
+		}
+		content, readErr := snapshot.ReadFile(ctxDir, *f)
+		if readErr != nil {
+			return readErr
+		}
+		writeSnap.Content(cmd, content)
+		return nil
+	}
+
+	writeSnap.Header(
+		cmd, s.ID, s.Created.Local().Format(cfgTime.DateTimeFmt), s.Name,
+	)
+	for _, f := range s.Files {
+		writeSnap.File(cmd, format.Bytes(f.Size), f.Path)
+	}
+	return nil
+}
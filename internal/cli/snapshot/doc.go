@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot implements the **`ctx snapshot`** command
+// group: versioned captures of the whole `.context/` tree.
+//
+// `ctx task snapshot` copies TASKS.md alone. This group
+// captures every context file into a content-addressed store
+// (see [internal/context/snapshot]) so past states can be
+// listed, inspected, compared, and restored, and so
+// `ctx load --at` and `ctx agent --at` can reproduce what an
+// agent saw on a given day.
+//
+// # Subcommands
+//
+//   - **`ctx snapshot create [name]`**: captures the tree.
+//     See [internal/cli/snapshot/cmd/create].
+//   - **`ctx snapshot list`**: lists snapshots, oldest
+//     first. See [internal/cli/snapshot/cmd/list].
+//   - **`ctx snapshot show <snapshot> [file]`**: lists a
+//     snapshot's files or prints one. See
+//     [internal/cli/snapshot/cmd/show].
+//   - **`ctx snapshot diff <snapshot> [snapshot]`**:
+//     compares two snapshots, or one with the working
+//     tree. See [internal/cli/snapshot/cmd/diff].
+//   - **`ctx snapshot restore <snapshot>`**: writes a
+//     snapshot back, after capturing a pre-restore
+//     snapshot. See [internal/cli/snapshot/cmd/restore].
+package snapshot
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/cli/snapshot/cmd/create"
+	"github.com/ActiveMemory/ctx/internal/cli/snapshot/cmd/diff"
+	"github.com/ActiveMemory/ctx/internal/cli/snapshot/cmd/list"
+	"github.com/ActiveMemory/ctx/internal/cli/snapshot/cmd/restore"
+	"github.com/ActiveMemory/ctx/internal/cli/snapshot/cmd/show"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the snapshot command with subcommands.
+//
+// The snapshot command versions the .context/ tree:
+//   - create: Capture the current tree
+//   - list: Show all snapshots
+//   - show: Show a snapshot's files or one file
+//   - diff: Compare snapshots or a snapshot with the tree
+//   - restore: Write a snapshot back into .context/
+//
+// Returns:
+//   - *cobra.Command: Configured snapshot command with subcommands
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeySnapshot, cmd.UseSnapshot,
+		create.Cmd(),
+		list.Cmd(),
+		show.Cmd(),
+		diff.Cmd(),
+		restore.Cmd(),
+	)
+}
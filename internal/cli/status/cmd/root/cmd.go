@@ -1,6 +1,6 @@
 //   /    ctx:                         https://ctx.ist
 // ,'`./    do you remember?
-// `.,'\
+// `.,'\\
 //   \    Copyright 2026-present Context contributors.
 //                 SPDX-License-Identifier: Apache-2.0
 
@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
 	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
 	"github.com/ActiveMemory/ctx/internal/flagbind"
 )
 
@@ -21,6 +22,8 @@ import (
 // Flags:
 //   - --json: Output as JSON for machine parsing
 //   - --verbose, -v: Include file content previews
+//   - --trend: Show health score sparklines instead of the summary
+//   - --weeks: Number of weeks covered by --trend
 //
 // Returns:
 //   - *cobra.Command: Configured status command with flags registered
@@ -28,6 +31,8 @@ func Cmd() *cobra.Command {
 	var (
 		jsonOutput bool
 		verbose    bool
+		trend      bool
+		weeks      int
 	)
 
 	short, long := desc.Command(cmd.DescKeyStatus)
@@ -38,7 +43,7 @@ func Cmd() *cobra.Command {
 		Long:    long,
 		Example: desc.Example(cmd.DescKeyStatus),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return Run(cmd, jsonOutput, verbose)
+			return Run(cmd, jsonOutput, verbose, trend, weeks)
 		},
 	}
 
@@ -49,6 +54,13 @@ func Cmd() *cobra.Command {
 		cFlag.Verbose, cFlag.ShortVerbose,
 		flag.DescKeyStatusVerbose,
 	)
+	flagbind.BoolFlag(c, &trend,
+		cFlag.Trend, flag.DescKeyStatusTrend,
+	)
+	flagbind.IntFlag(c, &weeks,
+		cFlag.Weeks, cfgHealth.DefaultTrendWeeks,
+		flag.DescKeyStatusWeeks,
+	)
 
 	return c
 }
@@ -16,6 +16,10 @@
 // and last-modified timestamps so the user can quickly
 // gauge how fresh and complete their context is.
 //
+// Each run also computes a 0-100 health score and
+// appends it to .context/state/health.jsonl, building
+// the history that --trend renders.
+//
 // # Flags
 //
 //   - **--json**: emit the status report as a single
@@ -24,6 +28,11 @@
 //   - **--verbose, -v**: include inline previews of
 //     each file's opening lines so the user can skim
 //     content without opening individual files.
+//   - **--trend**: replace the summary with sparklines
+//     of the health score and its components, one
+//     character per week. Combines with --json.
+//   - **--weeks**: number of weeks --trend covers
+//     (default 8).
 //
 // # Output
 //
@@ -1,6 +1,6 @@
 //   /    ctx:                         https://ctx.ist
 // ,'`./    do you remember?
-// `.,'\
+// `.,'\\
 //   \    Copyright 2026-present Context contributors.
 //                 SPDX-License-Identifier: Apache-2.0
 
@@ -8,25 +8,38 @@ package root
 
 import (
 	"errors"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/status/core/health"
 	"github.com/ActiveMemory/ctx/internal/cli/status/core/out"
+	"github.com/ActiveMemory/ctx/internal/config/warn"
 	"github.com/ActiveMemory/ctx/internal/context/load"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
 	errInit "github.com/ActiveMemory/ctx/internal/err/initialize"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 // Run executes the status command logic.
 //
+// Every run computes the health score and appends it to the
+// history file; a failed append is warned about, not fatal.
+//
 // Parameters:
 //   - cmd: Cobra command for output stream
 //   - jsonOutput: If true, output as JSON
 //   - verbose: If true, include file content previews
+//   - trend: If true, show the health trend instead of the summary
+//   - weeks: Number of weeks covered by the trend
 //
 // Returns:
 //   - error: Non-nil if context loading fails
-func Run(cmd *cobra.Command, jsonOutput, verbose bool) error {
+func Run(
+	cmd *cobra.Command, jsonOutput, verbose, trend bool, weeks int,
+) error {
 	ctx, err := load.Do("")
 	if err != nil {
 		if _, ok := errors.AsType[*errCtx.NotFoundError](err); ok {
@@ -35,9 +48,32 @@ func Run(cmd *cobra.Command, jsonOutput, verbose bool) error {
 		return err
 	}
 
+	now := time.Now()
+	history, historyErr := health.History(ctx.Dir)
+	if historyErr != nil {
+		logWarn.Warn(warn.HealthHistory, historyErr)
+	}
+	rec := health.Compute(ctx, history, rc.TokenBudget(), now)
+	if appendErr := health.Append(ctx.Dir, rec); appendErr != nil {
+		logWarn.Warn(warn.HealthHistory, appendErr)
+	}
+
+	if trend {
+		t := entity.HealthTrend{
+			Weeks:   weeks,
+			Current: rec,
+			History: health.Weekly(append(history, rec), weeks, now),
+		}
+		if jsonOutput {
+			return out.PersistTrendJSON(cmd, t)
+		}
+		out.PersistTrendText(cmd, t)
+		return nil
+	}
+
 	if jsonOutput {
-		return out.PersistStatusJSON(cmd, ctx, verbose)
+		return out.PersistStatusJSON(cmd, ctx, rec, verbose)
 	}
 
-	return out.PersistStatusText(cmd, ctx, verbose)
+	return out.PersistStatusText(cmd, ctx, rec, verbose)
 }
@@ -8,13 +8,20 @@
 // command, which displays context directory health and
 // file information.
 //
-// The core package is a namespace that groups three
-// subpackages: out, preview, and sort. Together they
+// The core package is a namespace that groups four
+// subpackages: health, out, preview, and sort. Together they
 // transform a loaded context entity into formatted
 // output for the user.
 //
 // # Subpackages
 //
+// The health subpackage scores the context 0-100 and
+// keeps a history of scores. [health.Compute] weighs
+// drift, staleness, task velocity, index coverage, and
+// token pressure; [health.Append] and [health.History]
+// manage .context/state/health.jsonl; [health.Weekly]
+// buckets history for --trend.
+//
 // The out subpackage renders the full status display in
 // either JSON or text format. [out.PersistStatusJSON]
 // encodes context metadata and file status as indented
@@ -36,8 +43,10 @@
 //
 // # Data Flow
 //
-// The cmd/ layer loads a context entity and passes it
-// to out.PersistStatusJSON or out.PersistStatusText.
+// The cmd/ layer loads a context entity, computes and
+// records its health, and passes both to
+// out.PersistStatusJSON or out.PersistStatusText (or the
+// trend renderers with --trend).
 // These call into sort and preview for ordering and
 // content extraction. The write/status package handles
 // final rendering.
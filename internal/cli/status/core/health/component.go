@@ -0,0 +1,87 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// driftScore deducts per violation and warning from a full score.
+//
+// Parameters:
+//   - s: Collected signals
+//
+// Returns:
+//   - int: Component score, 0-100
+func driftScore(s entity.HealthSignals) int {
+	penalty := s.Violations*cfgHealth.ViolationPenalty +
+		s.Warnings*cfgHealth.WarningPenalty
+	return max(cfgHealth.MaxScore-penalty, 0)
+}
+
+// ratioScore scales good/total onto 0-100. An empty total scores
+// full marks: there is nothing to be unhealthy about.
+//
+// Parameters:
+//   - good: Count of items in a healthy state
+//   - total: Count of all items
+//
+// Returns:
+//   - int: Component score, 0-100
+func ratioScore(good, total int) int {
+	if total <= 0 {
+		return cfgHealth.MaxScore
+	}
+	return max(good, 0) * cfgHealth.MaxScore / total
+}
+
+// velocityScore compares last week's completions with the target.
+// A project with no pending work scores full marks.
+//
+// Parameters:
+//   - s: Collected signals
+//
+// Returns:
+//   - int: Component score, 0-100
+func velocityScore(s entity.HealthSignals) int {
+	if s.TasksPending == 0 {
+		return cfgHealth.MaxScore
+	}
+	return min(s.CompletedWeek, cfgHealth.VelocityTarget) *
+		cfgHealth.MaxScore / cfgHealth.VelocityTarget
+}
+
+// tokenScore penalizes context that outgrows the token budget.
+//
+// Parameters:
+//   - s: Collected signals
+//
+// Returns:
+//   - int: 100 within budget, scaled down proportionally above it
+func tokenScore(s entity.HealthSignals) int {
+	if s.Budget <= 0 || s.Tokens <= s.Budget {
+		return cfgHealth.MaxScore
+	}
+	return s.Budget * cfgHealth.MaxScore / s.Tokens
+}
+
+// weighted combines component scores into the overall score.
+//
+// Parameters:
+//   - c: Component scores
+//
+// Returns:
+//   - int: Overall score, 0-100
+func weighted(c entity.HealthComponents) int {
+	sum := c.Drift*cfgHealth.WeightDrift +
+		c.Staleness*cfgHealth.WeightStaleness +
+		c.Velocity*cfgHealth.WeightVelocity +
+		c.Index*cfgHealth.WeightIndex +
+		c.Tokens*cfgHealth.WeightTokens
+	return sum / cfgHealth.MaxScore
+}
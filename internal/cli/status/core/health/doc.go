@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package health computes the context health score shown by
+// `ctx status` and keeps its history for `ctx status --trend`.
+//
+// # Score
+//
+// [Compute] gathers signals from a loaded context (drift
+// report, file staleness, task counts, index coverage,
+// token totals) and weights five 0-100 components into one
+// 0-100 score. Weights and thresholds live in
+// [internal/config/health].
+//
+// Task velocity is measured from history: every rise in
+// the checked-task count between consecutive records in
+// the last week counts as completions. With no history
+// yet, the current checked count stands in.
+//
+// # History
+//
+// [Append] adds a record to .context/state/health.jsonl
+// and trims the file to the configured maximum. [History]
+// reads it back, skipping malformed lines.
+//
+// # Trend
+//
+// [Weekly] buckets history into Monday-based weeks,
+// keeping each week's last record, for sparkline and JSON
+// rendering.
+package health
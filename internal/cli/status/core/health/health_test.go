@@ -0,0 +1,130 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+func TestTaskCounts(t *testing.T) {
+	content := "# Tasks\n\n- [x] Done\n- [ ] Open\n  - [ ] Sub\n"
+	done, pending := taskCounts(content)
+	if done != 1 || pending != 2 {
+		t.Errorf("taskCounts = %d, %d; want 1, 2", done, pending)
+	}
+}
+
+func TestIndexCounts(t *testing.T) {
+	content := "# Decisions\n\n<!-- INDEX:START -->\n" +
+		"| Date | Decision |\n|---|---|\n| 2026-01-01 | Use Go |\n" +
+		"<!-- INDEX:END -->\n\n" +
+		"## [2026-01-01-120000] Use Go\n\nBody.\n\n" +
+		"## [2026-01-02-120000] Use SQLite\n\nBody.\n"
+	entries, unindexed := indexCounts(content)
+	if entries != 2 || unindexed != 1 {
+		t.Errorf("indexCounts = %d, %d; want 2, 1", entries, unindexed)
+	}
+}
+
+func TestCompletions(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	rec := func(daysAgo, completed int) entity.HealthRecord {
+		return entity.HealthRecord{
+			Time:    now.AddDate(0, 0, -daysAgo),
+			Signals: entity.HealthSignals{TasksCompleted: completed},
+		}
+	}
+
+	if got := completions(nil, 4, now); got != 4 {
+		t.Errorf("no history = %d, want 4", got)
+	}
+
+	// 2 before the window, +3 inside it, archive drop to 1, then +1 now.
+	history := []entity.HealthRecord{
+		rec(20, 2), rec(5, 5), rec(3, 1),
+	}
+	if got := completions(history, 2, now); got != 4 {
+		t.Errorf("completions = %d, want 4", got)
+	}
+}
+
+func TestComponentScores(t *testing.T) {
+	s := entity.HealthSignals{
+		Violations: 1, Warnings: 2,
+		TasksPending: 3, CompletedWeek: 1,
+		Tokens: 16000, Budget: 8000,
+	}
+	if got := driftScore(s); got != 65 {
+		t.Errorf("driftScore = %d, want 65", got)
+	}
+	if got := velocityScore(s); got != 33 {
+		t.Errorf("velocityScore = %d, want 33", got)
+	}
+	if got := tokenScore(s); got != 50 {
+		t.Errorf("tokenScore = %d, want 50", got)
+	}
+	if got := ratioScore(0, 0); got != 100 {
+		t.Errorf("ratioScore(0, 0) = %d, want 100", got)
+	}
+
+	full := entity.HealthComponents{
+		Drift: 100, Staleness: 100, Velocity: 100, Index: 100, Tokens: 100,
+	}
+	if got := weighted(full); got != 100 {
+		t.Errorf("weighted(full) = %d, want 100", got)
+	}
+}
+
+func TestHistory_AppendAndRead(t *testing.T) {
+	ctxDir := t.TempDir()
+	if got, _ := History(ctxDir); got != nil {
+		t.Fatalf("History on empty dir = %v, want nil", got)
+	}
+	for i := range 3 {
+		rec := entity.HealthRecord{Time: time.Now(), Score: 50 + i}
+		if appendErr := Append(ctxDir, rec); appendErr != nil {
+			t.Fatalf("Append: %v", appendErr)
+		}
+	}
+	got, readErr := History(ctxDir)
+	if readErr != nil {
+		t.Fatalf("History: %v", readErr)
+	}
+	if len(got) != 3 || got[2].Score != 52 {
+		t.Errorf("History = %+v, want 3 records ending at 52", got)
+	}
+}
+
+func TestWeekly(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2026, 3, 11, 12, 0, 0, 0, time.Local)
+	history := []entity.HealthRecord{
+		{Time: now.AddDate(0, 0, -30), Score: 10}, // before range
+		{Time: now.AddDate(0, 0, -8), Score: 40},  // previous week
+		{Time: now.AddDate(0, 0, -7), Score: 50},  // previous week, later
+		{Time: now.AddDate(0, 0, -1), Score: 80},  // this week
+	}
+	weeks := Weekly(history, 3, now)
+	if len(weeks) != 3 {
+		t.Fatalf("len = %d, want 3", len(weeks))
+	}
+	if weeks[0].Start != "2026-02-23" || weeks[2].Start != "2026-03-09" {
+		t.Errorf("starts = %s..%s", weeks[0].Start, weeks[2].Start)
+	}
+	if weeks[0].Last != nil {
+		t.Errorf("week 0 = %+v, want empty", weeks[0])
+	}
+	if weeks[1].Runs != 2 || weeks[1].Last.Score != 50 {
+		t.Errorf("week 1 = runs %d, want 2 ending at 50", weeks[1].Runs)
+	}
+	if weeks[2].Runs != 1 || weeks[2].Last.Score != 80 {
+		t.Errorf("week 2 = runs %d, want 1 at 80", weeks[2].Runs)
+	}
+}
@@ -0,0 +1,101 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Append records a health score in the history file.
+//
+// Creates the state directory if needed. When the file holds more
+// than the configured maximum, the oldest records are dropped.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//   - rec: Record to append
+//
+// Returns:
+//   - error: Non-nil if the history cannot be read or written
+func Append(ctxDir string, rec entity.HealthRecord) error {
+	p := historyPath(ctxDir)
+	if mkErr := io.SafeMkdirAll(filepath.Dir(p), fs.PermExec); mkErr != nil {
+		return mkErr
+	}
+
+	line, marshalErr := json.Marshal(rec)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	line = append(line, token.NewlineLF[0])
+	if appendErr := io.AppendBytes(p, line, fs.PermFile); appendErr != nil {
+		return appendErr
+	}
+
+	all, readErr := History(ctxDir)
+	if readErr != nil {
+		return readErr
+	}
+	if len(all) <= cfgHealth.HistoryMax {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, r := range all[len(all)-cfgHealth.HistoryMax:] {
+		data, encErr := json.Marshal(r)
+		if encErr != nil {
+			return encErr
+		}
+		buf.Write(data)
+		buf.WriteString(token.NewlineLF)
+	}
+	return io.SafeWriteFile(p, buf.Bytes(), fs.PermFile)
+}
+
+// History reads all recorded health scores.
+//
+// Malformed lines are skipped.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//
+// Returns:
+//   - []entity.HealthRecord: Records in file order (oldest first);
+//     nil when no history exists
+//   - error: Non-nil if the file exists but cannot be read
+func History(ctxDir string) ([]entity.HealthRecord, error) {
+	data, readErr := io.SafeReadUserFile(historyPath(ctxDir))
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, readErr
+	}
+
+	var records []entity.HealthRecord
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var r entity.HealthRecord
+		if unmarshalErr := json.Unmarshal(
+			scanner.Bytes(), &r,
+		); unmarshalErr != nil {
+			continue // skip malformed lines
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
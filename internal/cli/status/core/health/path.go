@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+)
+
+// historyPath returns the health history file path.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//
+// Returns:
+//   - string: Path to .context/state/health.jsonl
+func historyPath(ctxDir string) string {
+	return filepath.Join(ctxDir, dir.State, cfgHealth.HistoryFile)
+}
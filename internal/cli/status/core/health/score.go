@@ -0,0 +1,83 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/ctx"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Compute scores the health of a loaded context.
+//
+// Parameters:
+//   - c: Loaded context
+//   - history: Earlier records, oldest first (may be nil)
+//   - budget: Configured token budget
+//   - now: Time to stamp the record with
+//
+// Returns:
+//   - entity.HealthRecord: Score, components, and signals
+func Compute(
+	c *entity.Context, history []entity.HealthRecord,
+	budget int, now time.Time,
+) entity.HealthRecord {
+	var s entity.HealthSignals
+
+	report := drift.Detect(c)
+	s.Violations = len(report.Violations)
+	stale := map[string]bool{}
+	for _, w := range report.Warnings {
+		if w.Type == cfgDrift.IssueStaleAge ||
+			w.Type == cfgDrift.IssueStaleness {
+			stale[w.File] = true
+			continue
+		}
+		s.Warnings++
+	}
+	s.StaleFiles = len(stale)
+
+	for _, f := range c.Files {
+		if !f.IsEmpty {
+			s.Files++
+		}
+	}
+
+	if f := c.File(ctx.Task); f != nil {
+		s.TasksCompleted, s.TasksPending = taskCounts(string(f.Content))
+	}
+	s.CompletedWeek = completions(history, s.TasksCompleted, now)
+
+	for _, name := range []string{ctx.Decision, ctx.Learning} {
+		if f := c.File(name); f != nil {
+			entries, unindexed := indexCounts(string(f.Content))
+			s.Entries += entries
+			s.Unindexed += unindexed
+		}
+	}
+
+	s.Tokens = c.TotalTokens
+	s.Budget = budget
+
+	comp := entity.HealthComponents{
+		Drift:     driftScore(s),
+		Staleness: ratioScore(s.Files-s.StaleFiles, s.Files),
+		Velocity:  velocityScore(s),
+		Index:     ratioScore(s.Entries-s.Unindexed, s.Entries),
+		Tokens:    tokenScore(s),
+	}
+
+	return entity.HealthRecord{
+		Time:       now,
+		Score:      weighted(comp),
+		Components: comp,
+		Signals:    s,
+	}
+}
@@ -0,0 +1,100 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"strings"
+	"time"
+
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+	"github.com/ActiveMemory/ctx/internal/config/marker"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/index"
+	"github.com/ActiveMemory/ctx/internal/task"
+)
+
+// taskCounts counts checked and unchecked tasks.
+//
+// Parameters:
+//   - content: TASKS.md content
+//
+// Returns:
+//   - int: Checked tasks
+//   - int: Unchecked tasks
+func taskCounts(content string) (int, int) {
+	var completed, pending int
+	for _, m := range regex.TaskMultiline.FindAllStringSubmatch(content, -1) {
+		if task.Completed(m) {
+			completed++
+		} else {
+			pending++
+		}
+	}
+	return completed, pending
+}
+
+// indexCounts counts entries and the entries missing from the
+// file's index table.
+//
+// Parameters:
+//   - content: DECISIONS.md or LEARNINGS.md content
+//
+// Returns:
+//   - int: Entries in the file
+//   - int: Entries whose title is absent from the index block
+func indexCounts(content string) (int, int) {
+	entries := index.ParseHeaders(content)
+	var table string
+	start := strings.Index(content, marker.IndexStart)
+	end := strings.Index(content, marker.IndexEnd)
+	if start != -1 && end > start {
+		table = content[start:end]
+	}
+	var unindexed int
+	for _, e := range entries {
+		if !strings.Contains(table, e.Title) {
+			unindexed++
+		}
+	}
+	return len(entries), unindexed
+}
+
+// completions counts tasks checked off within the velocity window.
+//
+// Each rise in the checked-task count between consecutive records
+// is a completion; falls (archiving) are ignored. With no history
+// at all, the current checked count is used.
+//
+// Parameters:
+//   - history: Earlier records, oldest first
+//   - current: Checked tasks now
+//   - now: Reference time
+//
+// Returns:
+//   - int: Completions in the window
+func completions(
+	history []entity.HealthRecord, current int, now time.Time,
+) int {
+	since := now.Add(-cfgHealth.VelocityWindow)
+	prev := -1
+	var done int
+	for _, r := range history {
+		n := r.Signals.TasksCompleted
+		if !r.Time.Before(since) && prev >= 0 && n > prev {
+			done += n - prev
+		}
+		prev = n
+	}
+	if prev < 0 {
+		return current
+	}
+	if current > prev {
+		done += current - prev
+	}
+	return done
+}
@@ -0,0 +1,56 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"time"
+
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Weekly buckets history into the last n weeks.
+//
+// Weeks start on Monday in local time; the current week is the
+// last bucket. Each bucket keeps its final record.
+//
+// Parameters:
+//   - history: Records, oldest first
+//   - n: Number of weeks
+//   - now: Reference time
+//
+// Returns:
+//   - []entity.HealthWeek: n buckets, oldest first
+func Weekly(
+	history []entity.HealthRecord, n int, now time.Time,
+) []entity.HealthWeek {
+	if n <= 0 {
+		return nil
+	}
+	first := weekStart(now).AddDate(0, 0, -cfgHealth.WeekDays*(n-1))
+	weeks := make([]entity.HealthWeek, n)
+	for i := range weeks {
+		start := first.AddDate(0, 0, cfgHealth.WeekDays*i)
+		weeks[i].Start = start.Format(cfgTime.DateFormat)
+	}
+	for i := range history {
+		r := history[i]
+		t := r.Time.Local()
+		if t.Before(first) || t.After(now) {
+			continue
+		}
+		idx := int(weekStart(t).Sub(first).Round(cfgHealth.Week) /
+			cfgHealth.Week)
+		if idx < 0 || idx >= n {
+			continue
+		}
+		weeks[idx].Runs++
+		weeks[idx].Last = &r
+	}
+	return weeks
+}
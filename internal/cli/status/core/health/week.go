@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"time"
+
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+)
+
+// weekStart returns midnight on the Monday of t's week, in t's
+// location.
+//
+// Parameters:
+//   - t: Any time
+//
+// Returns:
+//   - time.Time: Start of the week
+func weekStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	// Weekday counts from Sunday; shift so Monday is 0.
+	offset := (int(t.Weekday()) + cfgHealth.WeekDays - 1) %
+		cfgHealth.WeekDays
+	return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+}
@@ -25,7 +25,16 @@
 // size, and summary. In verbose mode, content previews
 // are appended. A recent activity section shows the
 // most recently modified files with relative
-// timestamps.
+// timestamps. The health score and its components are
+// printed between the file list and recent activity.
+//
+// # Trend Output
+//
+// [PersistTrendText] renders one sparkline row for the
+// score and one per component, one character per week,
+// with weeks that have no recorded run left blank.
+// [PersistTrendJSON] encodes the same data as an
+// entity.HealthTrend.
 //
 // # Types
 //
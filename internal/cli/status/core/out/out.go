@@ -31,18 +31,21 @@ import (
 // Parameters:
 //   - cmd: Cobra command for output stream
 //   - ctx: Loaded context to display
+//   - health: Health score computed for this run
 //   - verbose: If true, include file content previews
 //
 // Returns:
 //   - error: Non-nil if JSON encoding fails
 func PersistStatusJSON(
-	cmd *cobra.Command, ctx *entity.Context, verbose bool,
+	cmd *cobra.Command, ctx *entity.Context,
+	health entity.HealthRecord, verbose bool,
 ) error {
 	output := Output{
 		ContextDir:  ctx.Dir,
 		TotalFiles:  len(ctx.Files),
 		TotalTokens: ctx.TotalTokens,
 		TotalSize:   ctx.TotalSize,
+		Health:      health,
 		Files:       make([]FileStatus, 0, len(ctx.Files)),
 	}
 
@@ -72,12 +75,14 @@ func PersistStatusJSON(
 // Parameters:
 //   - cmd: Cobra command for output stream
 //   - ctx: Loaded context to display
+//   - health: Health score computed for this run
 //   - verbose: If true, include detailed info and content previews
 //
 // Returns:
 //   - error: Always nil (included for interface consistency)
 func PersistStatusText(
-	cmd *cobra.Command, ctx *entity.Context, verbose bool,
+	cmd *cobra.Command, ctx *entity.Context,
+	health entity.HealthRecord, verbose bool,
 ) error {
 	status.Header(cmd, ctx.Dir, len(ctx.Files), ctx.TotalTokens)
 
@@ -104,6 +109,8 @@ func PersistStatusText(
 		status.FileItem(cmd, fi, verbose)
 	}
 
+	status.Health(cmd, health.Score, health.Components)
+
 	recentFiles := sort.RecentFiles(ctx.Files, cfgFmt.StatusRecentFiles)
 	entries := make([]status.ActivityInfo, len(recentFiles))
 	for i, f := range recentFiles {
@@ -0,0 +1,90 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package out
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	cfgHealth "github.com/ActiveMemory/ctx/internal/config/health"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/format"
+	"github.com/ActiveMemory/ctx/internal/write/status"
+)
+
+// PersistTrendJSON writes the health trend as JSON to the command
+// output.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - trend: Current record and weekly history
+//
+// Returns:
+//   - error: Non-nil if JSON encoding fails
+func PersistTrendJSON(cmd *cobra.Command, trend entity.HealthTrend) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", token.Indent2)
+	return enc.Encode(trend)
+}
+
+// PersistTrendText writes the health trend as sparklines, one row
+// for the score and one per component.
+//
+// Each character is one week; weeks with no recorded run are
+// blank. The trailing number is the current run's value.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - trend: Current record and weekly history
+func PersistTrendText(cmd *cobra.Command, trend entity.HealthTrend) {
+	var start string
+	if len(trend.History) > 0 {
+		start = trend.History[0].Start
+	}
+	status.TrendHeader(cmd, trend.Weeks, start)
+
+	rows := []struct {
+		label   string
+		pick    func(r *entity.HealthRecord) int
+		current int
+	}{
+		{cfgHealth.LabelScore,
+			func(r *entity.HealthRecord) int { return r.Score },
+			trend.Current.Score},
+		{cfgHealth.LabelDrift,
+			func(r *entity.HealthRecord) int { return r.Components.Drift },
+			trend.Current.Components.Drift},
+		{cfgHealth.LabelStaleness,
+			func(r *entity.HealthRecord) int { return r.Components.Staleness },
+			trend.Current.Components.Staleness},
+		{cfgHealth.LabelVelocity,
+			func(r *entity.HealthRecord) int { return r.Components.Velocity },
+			trend.Current.Components.Velocity},
+		{cfgHealth.LabelIndex,
+			func(r *entity.HealthRecord) int { return r.Components.Index },
+			trend.Current.Components.Index},
+		{cfgHealth.LabelTokens,
+			func(r *entity.HealthRecord) int { return r.Components.Tokens },
+			trend.Current.Components.Tokens},
+	}
+	for _, row := range rows {
+		values := make([]int, len(trend.History))
+		for i, w := range trend.History {
+			values[i] = -1
+			if w.Last != nil {
+				values[i] = row.pick(w.Last)
+			}
+		}
+		status.TrendRow(
+			cmd, row.label,
+			format.Sparkline(values, cfgHealth.MaxScore),
+			row.current,
+		)
+	}
+}
@@ -6,6 +6,8 @@
 
 package out
 
+import "github.com/ActiveMemory/ctx/internal/entity"
+
 // Output represents the JSON output format for the status
 // command.
 //
@@ -14,13 +16,15 @@ package out
 //   - TotalFiles: Number of context files found
 //   - TotalTokens: Estimated total token count
 //   - TotalSize: Total size in bytes
+//   - Health: Health score computed for this run
 //   - Files: Individual file status entries
 type Output struct {
-	ContextDir  string       `json:"context_dir"`
-	TotalFiles  int          `json:"total_files"`
-	TotalTokens int          `json:"total_tokens"`
-	TotalSize   int64        `json:"total_size"`
-	Files       []FileStatus `json:"files"`
+	ContextDir  string              `json:"context_dir"`
+	TotalFiles  int                 `json:"total_files"`
+	TotalTokens int                 `json:"total_tokens"`
+	TotalSize   int64               `json:"total_size"`
+	Health      entity.HealthRecord `json:"health"`
+	Files       []FileStatus        `json:"files"`
 }
 
 // FileStatus represents a single file's status in JSON
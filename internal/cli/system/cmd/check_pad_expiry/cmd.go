@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package check_pad_expiry
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the "ctx system check-pad-expiry" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured check-pad-expiry subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySystemCheckPadExpiry)
+
+	return &cobra.Command{
+		Use:     cmd.UseSystemCheckPadExpiry,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySystemCheckPadExpiry),
+		Hidden:  true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, os.Stdin)
+		},
+	}
+}
@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package check_pad_expiry implements the
+// **`ctx system check-pad-expiry`** hidden hook,
+// which warns about scratchpad entries that are
+// about to go stale.
+//
+// # What It Does
+//
+// The hook decrypts the scratchpad and collects every
+// entry whose expiry date (set with `ctx pad add
+// --expires`) has passed or falls within the next
+// pad.ExpirySoonDays days. When any are found it
+// emits a nudge listing them and pointing at
+// `ctx pad gc`.
+//
+// A missing scratchpad, a missing key, or a pad with
+// no expiring entries produces no output.
+//
+// # Input
+//
+// A JSON hook envelope on stdin with session metadata.
+//
+// # Output
+//
+// A nudge box with one line per entry: ID, category
+// and expiry annotation, and a `ctx pad show N` hint.
+// The entry text is never included: the nudge lands
+// in the agent's context and expiring entries are
+// often credentials. Otherwise no output.
+//
+// # Throttling
+//
+// Once per session, via a tombstone file keyed by
+// session ID, so the nudge shows at session start and
+// not on every prompt.
+//
+// # Delegation
+//
+// [Cmd] builds the hidden cobra command. [Run] reads
+// stdin via [core/check.FullPreamble], loads entries
+// via [store.ReadEntriesWithIDs], selects them with
+// [gc.Due], and emits the nudge through
+// [core/nudge.LoadAndEmit].
+package check_pad_expiry
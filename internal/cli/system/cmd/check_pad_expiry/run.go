@@ -0,0 +1,99 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package check_pad_expiry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/gc"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	coreCheck "github.com/ActiveMemory/ctx/internal/cli/system/core/check"
+	"github.com/ActiveMemory/ctx/internal/cli/system/core/nudge"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/hook"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	internalIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Run executes the check-pad-expiry hook logic.
+//
+// Once per session, lists scratchpad entries that have expired
+// or expire within the next few days by ID, category and expiry
+// only; the entry text is never included. Non-fatal when the
+// scratchpad is missing or cannot be decrypted.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - stdin: standard input for hook JSON
+//
+// Returns:
+//   - error: Non-nil only when the nudge relay fails
+func Run(cmd *cobra.Command, stdin *os.File) error {
+	input, sessionID, _, stateDir, ok := coreCheck.FullPreamble(stdin)
+	bailSilently := !ok
+	if bailSilently {
+		return nil
+	}
+
+	// Session tombstone: check once per session, per session ID
+	tombstone := filepath.Join(
+		stateDir, hook.PrefixPadExpiryThrottle+sessionID,
+	)
+	if _, statErr := os.Stat(tombstone); statErr == nil {
+		return nil
+	}
+
+	entries, readErr := store.ReadEntriesWithIDs()
+	if readErr != nil {
+		return nil
+	}
+
+	now := time.Now()
+	today := now.Format(cfgTime.DateFormat)
+	horizon := now.AddDate(0, 0, pad.ExpirySoonDays).
+		Format(cfgTime.DateFormat)
+	due := gc.Due(entries, horizon)
+	if len(due) == 0 {
+		internalIo.TouchFile(tombstone)
+		return nil
+	}
+
+	// Entry bodies stay out of the nudge: it is relayed into
+	// the agent's context, and expiring entries are often
+	// secrets. The user opens one with ctx pad show N.
+	var list string
+	for _, e := range due {
+		m, _ := meta.Split(e.Content)
+		list += fmt.Sprintf(
+			desc.Text(text.DescKeyCheckPadExpiryItemFormat)+
+				token.NewlineLF,
+			e.ID, meta.Annotation(m, today), e.ID,
+		)
+	}
+
+	fallback := list + desc.Text(text.DescKeyCheckPadExpiryGcHint)
+	vars := map[string]any{pad.VarExpiringList: list}
+	relayMsg := fmt.Sprintf(
+		desc.Text(text.DescKeyCheckPadExpiryRelayFormat), len(due),
+	)
+	return nudge.LoadAndEmit(cmd,
+		hook.CheckPadExpiry, hook.VariantNudge,
+		vars, fallback,
+		desc.Text(text.DescKeyCheckPadExpiryRelayPrefix),
+		desc.Text(text.DescKeyCheckPadExpiryBoxTitle),
+		relayMsg, input.SessionID, tombstone,
+	)
+}
@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package check_pad_expiry
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/meta"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/parse"
+	"github.com/ActiveMemory/ctx/internal/cli/pad/core/store"
+	"github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+func TestRun_ListsExpiringWithoutBody(t *testing.T) {
+	ctxDir := testctx.Declare(t, t.TempDir())
+	if err := os.MkdirAll(ctxDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range ctx.FilesRequired {
+		p := filepath.Join(ctxDir, f)
+		if err := os.WriteFile(p, []byte("# "+f+"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const secret = "ASIA-temp-token-value"
+	c := &cobra.Command{}
+	var out bytes.Buffer
+	c.SetOut(&out)
+	c.SetErr(&out)
+	entry := meta.Join(
+		meta.Meta{Expires: "2020-01-01", Category: "creds"}, secret,
+	)
+	if err := store.WriteEntriesWithIDs(
+		c, []parse.Entry{{ID: 7, Content: entry}},
+	); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+
+	stdinPath := filepath.Join(t.TempDir(), "stdin")
+	body := []byte(`{"session_id":"expiry-test"}`)
+	if err := os.WriteFile(stdinPath, body, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stdin, err := os.Open(stdinPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = stdin.Close() })
+
+	if err := Run(c, stdin); err != nil {
+		t.Fatalf("Run() err = %v", err)
+	}
+	got := out.String()
+	if strings.Contains(got, secret) {
+		t.Errorf("nudge leaks the entry text: %q", got)
+	}
+	for _, want := range []string{
+		"[7]", "[creds]", "2020-01-01", "ctx pad show 7",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("nudge = %q, want %q", got, want)
+		}
+	}
+}
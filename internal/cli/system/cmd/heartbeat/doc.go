@@ -26,6 +26,10 @@
 //     with prompt count, context-modified flag, and
 //     optional token usage percentage.
 //   - Appends an event log entry with the same data.
+//   - Retries due deliveries from the notification
+//     outbox, when notify.outbox is on, at most once
+//     every few minutes (notify.ClaimFlush), in a
+//     detached ctx system notify-flush process.
 //   - Writes a timestamped line to the heartbeat log
 //     file inside the context directory.
 //
@@ -37,7 +37,8 @@ import (
 //
 // Increments a per-session prompt counter, detects context file
 // modifications since the last heartbeat, reads token usage, and
-// emits a notification plus event log entry. Produces no stdout
+// emits a notification plus event log entry. It also gives the
+// notification outbox a rate-limited chance to flush. Produces no stdout
 // output; the agent never sees this hook.
 //
 // Parameters:
@@ -135,6 +136,12 @@ func Run(_ *cobra.Command, stdin *os.File) error {
 	if sendErr != nil {
 		return sendErr
 	}
+	// Retry deliveries queued while offline, even when the
+	// heartbeat itself is not subscribed to any target. The
+	// flush runs detached so the prompt never waits on it.
+	if notify.ClaimFlush() {
+		coreHeartbeat.StartFlush()
+	}
 
 	var logLine string
 	if tokens > 0 {
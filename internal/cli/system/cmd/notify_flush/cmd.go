@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify_flush
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the "ctx system notify-flush" subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured notify-flush subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeySystemNotifyFlush)
+
+	return &cobra.Command{
+		Use:     cmd.UseSystemNotifyFlush,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeySystemNotifyFlush),
+		Hidden:  true,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return Run()
+		},
+	}
+}
@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package notify_flush implements the
+// **`ctx system notify-flush`** hidden command, which
+// retries due deliveries from the notification outbox.
+//
+// # What It Does
+//
+// The heartbeat hook claims the flush interval with
+// notify.ClaimFlush and starts this command as a
+// detached process, so a slow or unreachable target
+// never holds up the prompt. The command runs
+// notify.Flush without force: only items whose backoff
+// has elapsed, a few at a time.
+//
+// # Output
+//
+// None. Results land in the delivery log.
+//
+// # Delegation
+//
+// [Cmd] builds the hidden cobra command. [Run] resolves
+// the context directory and calls notify.Flush.
+package notify_flush
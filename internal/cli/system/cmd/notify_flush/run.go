@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify_flush
+
+import (
+	"github.com/ActiveMemory/ctx/internal/notify"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Run retries the outbox deliveries that are due.
+//
+// Returns:
+//   - error: Always nil (the command runs detached and has no
+//     one to report to)
+func Run() error {
+	if _, ctxErr := rc.ContextDir(); ctxErr != nil {
+		return nil
+	}
+	_, _ = notify.Flush(false)
+	return nil
+}
@@ -7,11 +7,14 @@
 package heartbeat
 
 import (
+	"os"
 	"strconv"
 	"strings"
 
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
 	"github.com/ActiveMemory/ctx/internal/config/fs"
 	"github.com/ActiveMemory/ctx/internal/config/warn"
+	execDaemon "github.com/ActiveMemory/ctx/internal/exec/daemon"
 	"github.com/ActiveMemory/ctx/internal/io"
 	ctxLog "github.com/ActiveMemory/ctx/internal/log/warn"
 )
@@ -49,3 +52,20 @@ func WriteMtime(path string, mtime int64) {
 		ctxLog.Warn(warn.Write, path, writeErr)
 	}
 }
+
+// StartFlush retries due outbox deliveries in a detached
+// ctx system notify-flush process, so the prompt never waits on a
+// slow or unreachable target. Failures are logged and otherwise
+// ignored; the queue is left for the next due heartbeat.
+func StartFlush() {
+	binPath, lookErr := os.Executable()
+	if lookErr != nil {
+		ctxLog.Warn(warn.NotifyFlushStart, lookErr)
+		return
+	}
+	if _, startErr := execDaemon.Start(
+		binPath, []string{cmd.UseSystem, cmd.UseSystemNotifyFlush},
+	); startErr != nil {
+		ctxLog.Warn(warn.NotifyFlushStart, startErr)
+	}
+}
@@ -102,8 +102,17 @@ func CheckKeyAge(sessionID string) (string, error) {
 			desc.Text(text.DescKeyCheckVersionKeyRelayFormat), ageDays,
 		),
 	)
-	if err := nudge.EmitAndRelay(keyNotifyMsg, sessionID, keyRef); err != nil {
-		return "", err
+	if relayErr := nudge.EmitAndRelay(
+		keyNotifyMsg, sessionID, keyRef,
+	); relayErr != nil {
+		return "", relayErr
+	}
+	// Channels can subscribe to rotation reminders on their own,
+	// apart from the general nudge stream.
+	if sendErr := notify.Send(
+		hook.NotifyChannelKeyRotation, keyNotifyMsg, sessionID, keyRef,
+	); sendErr != nil {
+		return "", sendErr
 	}
 	return box, nil
 }
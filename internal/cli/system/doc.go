@@ -29,6 +29,8 @@
 //   - session-event: record session lifecycle events
 //   - pause: session-scoped hook suppression
 //   - resume: session-scoped hook re-enable
+//   - notify-flush: retry due outbox deliveries; started
+//     detached by heartbeat (hidden)
 //
 // # Hook Subcommands
 //
@@ -47,6 +49,7 @@
 //   - check-knowledge: knowledge file growth nudge
 //   - check-map-staleness: architecture map nudge
 //   - check-memory-drift: memory bridge drift detection
+//   - check-pad-expiry: expiring scratchpad entries
 //   - check-reminder: session reminder surfacing
 //   - check-freshness: constant staleness check
 //   - check-hub-sync: auto-sync Hub entries
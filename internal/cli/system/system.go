@@ -21,6 +21,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_knowledge"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_map_staleness"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_memory_drift"
+	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_pad_expiry"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_persistence"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_reminder"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/check_resource"
@@ -31,6 +32,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/heartbeat"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/mark_journal"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/mark_wrapped_up"
+	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/notify_flush"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/pause"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/post_commit"
 	"github.com/ActiveMemory/ctx/internal/cli/system/cmd/qa_reminder"
@@ -67,6 +69,7 @@ func Cmd() *cobra.Command {
 		check_knowledge.Cmd(),
 		check_map_staleness.Cmd(),
 		check_memory_drift.Cmd(),
+		check_pad_expiry.Cmd(),
 		check_persistence.Cmd(),
 		check_skill_discovery.Cmd(),
 		check_reminder.Cmd(),
@@ -77,6 +80,7 @@ func Cmd() *cobra.Command {
 		heartbeat.Cmd(),
 		mark_journal.Cmd(),
 		mark_wrapped_up.Cmd(),
+		notify_flush.Cmd(),
 		pause.Cmd(),
 		post_commit.Cmd(),
 		qa_reminder.Cmd(),
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/config/fmt"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the task graph subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured task graph command with flags
+//     registered
+func Cmd() *cobra.Command {
+	var format string
+	short, long := desc.Command(cmd.DescKeyTaskGraph)
+
+	c := &cobra.Command{
+		Use:     cmd.UseTaskGraph,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTaskGraph),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return Run(cobraCmd, format)
+		},
+	}
+
+	flagbind.StringFlagDefault(
+		c, &format, cFlag.Format, fmt.FormatText,
+		flag.DescKeyTaskGraphFormat,
+	)
+
+	return c
+}
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package graph implements the "ctx task graph" cobra
+// subcommand.
+//
+// This command renders the dependency graph declared
+// by #id:, #after: and #blocks: tags in TASKS.md and
+// validates it.
+//
+// # Usage
+//
+//	ctx task graph [--format text|dot|mermaid]
+//
+// # Flags
+//
+//	--format   Output format. "text" (default) lists
+//	           ready and blocked tasks and the
+//	           critical path; "dot" and "mermaid"
+//	           print diagram source.
+//
+// # Behavior
+//
+// Only tasks that carry dependency metadata are
+// shown. After rendering, every duplicate ID,
+// reference to an unknown ID and dependency cycle is
+// printed to stderr and the command exits non-zero,
+// so it can gate CI.
+//
+// # Delegation
+//
+// Parsing and analysis live in [internal/task/graph];
+// output goes through [internal/write/task].
+package graph
@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/task/core/path"
+	cfgFmt "github.com/ActiveMemory/ctx/internal/config/fmt"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errTask "github.com/ActiveMemory/ctx/internal/err/task"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/task/graph"
+	writeTask "github.com/ActiveMemory/ctx/internal/write/task"
+)
+
+// Run executes the task graph command logic.
+//
+// Renders the dependency graph of TASKS.md, then reports
+// duplicate IDs, unknown references and cycles.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - format: Output format (text, dot, mermaid)
+//
+// Returns:
+//   - error: Non-nil on an unknown format, a missing or unreadable
+//     TASKS.md, or when dependency problems were reported
+func Run(cmd *cobra.Command, format string) error {
+	if !slices.Contains(cfgTask.GraphFormats, format) {
+		return errTask.GraphFormat(
+			format, strings.Join(cfgTask.GraphFormats, token.CommaSpace),
+		)
+	}
+	cmd.SilenceUsage = true
+
+	tasksPath, pathErr := path.File()
+	if pathErr != nil {
+		return pathErr
+	}
+	if _, statErr := os.Stat(tasksPath); os.IsNotExist(statErr) {
+		return errTask.FileNotFound()
+	}
+	content, readErr := io.SafeReadUserFile(filepath.Clean(tasksPath))
+	if readErr != nil {
+		return errTask.FileRead(readErr)
+	}
+
+	g := graph.Parse(string(content))
+	switch format {
+	case cfgFmt.FormatDOT:
+		writeTask.GraphDOT(cmd, g)
+	case cfgFmt.FormatMermaid:
+		writeTask.GraphMermaid(cmd, g)
+	default:
+		writeTask.GraphText(cmd, g)
+	}
+
+	problems := graph.Check(g)
+	for _, p := range problems {
+		writeTask.GraphProblem(cmd, p)
+	}
+	if len(problems) > 0 {
+		return errTask.GraphProblems(len(problems))
+	}
+	return nil
+}
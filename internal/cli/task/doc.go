@@ -32,6 +32,12 @@
 //     preserving phase structure. See
 //     [internal/cli/task/cmd/archive] (delegates to
 //     [internal/tidy.WriteArchive]).
+//   - **`ctx task graph [--format text|dot|mermaid]`**:
+//     renders the dependency graph declared by
+//     `#id:`, `#after:` and `#blocks:` tags, with the
+//     critical path, and fails on duplicate IDs,
+//     unknown references or cycles. See
+//     [internal/cli/task/cmd/graph].
 //   - **`ctx task snapshot [name]`**: copies the
 //     current TASKS.md verbatim to
 //     `.context/archive/snapshots/<ts>-<name>.md`. No
@@ -41,6 +47,10 @@
 //
 // # Constitutional Invariants
 //
+// `ctx task add` rejects a task whose dependency
+// tags would introduce a duplicate ID, an unknown
+// reference or a cycle.
+//
 // The CONSTITUTION.md rules:
 //
 //   - **Tasks stay in their Phase section permanently**.
@@ -378,6 +378,9 @@ func TestCmd_HasSubcommands(t *testing.T) {
 	if !names["snapshot"] {
 		t.Error("missing snapshot subcommand")
 	}
+	if !names["graph"] {
+		t.Error("missing graph subcommand")
+	}
 }
 
 func TestArchiveCommand_DryRunFlag(t *testing.T) {
@@ -432,3 +435,111 @@ func TestSnapshotCommand_SnapshotContentFormat(t *testing.T) {
 	}
 	t.Error("snapshot file not found")
 }
+
+func TestGraphCommand(t *testing.T) {
+	tmpDir := setupTaskDir(t)
+	tasksPath := filepath.Join(tmpDir, dir.Context, ctx.Task)
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(tasksPath, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(`# Tasks
+
+- [x] Design schema #id:db-schema
+- [ ] Build auth API #id:auth-api #after:db-schema
+- [ ] Login "screen" #id:login #after:auth-api
+- [ ] Unrelated task
+`)
+
+	out, err := runTaskCmd("graph")
+	if err != nil {
+		t.Fatalf("graph error: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"Ready:\n  [auth-api] Build auth API\n",
+		"Blocked:\n  [login] Login \"screen\" (after auth-api)",
+		"Critical path (2 tasks): auth-api → login",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("graph output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Unrelated") || strings.Contains(out, "Design") {
+		t.Errorf("graph should list only pending linked tasks:\n%s", out)
+	}
+
+	out, err = runTaskCmd("graph", "--format", "dot")
+	if err != nil {
+		t.Fatalf("graph dot error: %v", err)
+	}
+	for _, want := range []string{
+		"digraph tasks {",
+		`t3 [label="db-schema: Design schema", style=dashed];`,
+		"t3 -> t4;", "t4 -> t5;",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dot output missing %q:\n%s", want, out)
+		}
+	}
+
+	out, err = runTaskCmd("graph", "--format", "mermaid")
+	if err != nil {
+		t.Fatalf("graph mermaid error: %v", err)
+	}
+	for _, want := range []string{
+		"flowchart LR", `t5["login: Login #quot;screen#quot;"]`, "t4 --> t5",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("mermaid output missing %q:\n%s", want, out)
+		}
+	}
+
+	if _, err = runTaskCmd("graph", "--format", "svg"); err == nil {
+		t.Error("graph --format svg should fail")
+	}
+
+	write("- [ ] A #id:a #after:b\n- [ ] B #id:b #after:a\n")
+	out, err = runTaskCmd("graph")
+	if err == nil {
+		t.Fatal("graph with a cycle should fail")
+	}
+	if !strings.Contains(out, "dependency cycle: b → a → b") {
+		t.Errorf("graph output missing cycle:\n%s", out)
+	}
+	if strings.Contains(out, "Critical path") {
+		t.Errorf("graph with a cycle should not show a critical path:\n%s", out)
+	}
+}
+
+func TestAddRejectsBadDependency(t *testing.T) {
+	setupTaskDir(t)
+
+	add := func(content string) error {
+		addCmd := taskAdd.Cmd()
+		addCmd.SetArgs([]string{
+			content, "--section", "Misc",
+			"--session-id", "test1234", "--branch", "main", "--commit", "abc123",
+		})
+		addCmd.SetOut(&bytes.Buffer{})
+		addCmd.SetErr(&bytes.Buffer{})
+		return addCmd.Execute()
+	}
+
+	if err := add("Design schema #id:db-schema"); err != nil {
+		t.Fatalf("add error: %v", err)
+	}
+	if err := add("Build API #after:db-schema"); err != nil {
+		t.Fatalf("add with known dependency error: %v", err)
+	}
+	if err := add("Build UI #after:nope"); err == nil ||
+		!strings.Contains(err.Error(), "unknown task id") {
+		t.Errorf("add with unknown dependency = %v", err)
+	}
+	if err := add("Other #id:db-schema"); err == nil ||
+		!strings.Contains(err.Error(), "duplicate task id") {
+		t.Errorf("add with duplicate id = %v", err)
+	}
+}
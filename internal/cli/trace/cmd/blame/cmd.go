@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package blame
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the trace blame subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured trace blame command with flags registered
+func Cmd() *cobra.Command {
+	var jsonOutput bool
+	short, long := desc.Command(cmd.DescKeyTraceBlame)
+	c := &cobra.Command{
+		Use:     cmd.UseTraceBlame,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTraceBlame),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return Run(cobraCmd, args[0], jsonOutput)
+		},
+	}
+	flagbind.BoolFlag(
+		c, &jsonOutput, cFlag.JSON, flag.DescKeyTraceBlameJSON,
+	)
+	return c
+}
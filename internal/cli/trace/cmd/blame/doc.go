@@ -0,0 +1,47 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package blame implements the "ctx trace blame" cobra
+// subcommand.
+//
+// This command annotates a file with the context behind
+// each line. Where git blame answers "who changed this
+// line?", ctx trace blame answers "why?" by listing the
+// decisions, tasks, and learnings linked to the commit
+// that last changed it.
+//
+// # Usage
+//
+//	ctx trace blame <path[:line-range]> [--json]
+//
+// # Arguments
+//
+// Exactly one positional argument is required:
+//
+//   - path: the file to blame. An optional line-range
+//     suffix (e.g. "src/auth.go:42-60" or
+//     "src/auth.go:42") limits the output to those
+//     lines.
+//
+// # Flags
+//
+//	--json   Print the commits (keyed by full hash,
+//	         with resolved refs) and lines as JSON
+//	         for editor integrations.
+//
+// # Output
+//
+// Consecutive lines from the same commit are grouped
+// under a header with the short hash, date, and
+// subject, followed by the commit's refs by title and
+// then the numbered lines.
+//
+// # Delegation
+//
+// Blaming, ref collection, and rendering are handled by
+// trace/core/blame. Path resolution uses
+// rc.ContextDir and config/dir.
+package blame
@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package blame
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	coreBlame "github.com/ActiveMemory/ctx/internal/cli/trace/core/blame"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Run executes the trace blame command logic.
+//
+// Blames the file named by pathArg (optionally limited to a
+// line range) and prints each block of lines under the commit
+// that last changed it, with that commit's resolved context refs.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - pathArg: file path with optional line range suffix
+//     (e.g. "src/auth.go:42-60")
+//   - jsonOutput: whether to format output as JSON
+//
+// Returns:
+//   - error: non-nil on execution failure
+func Run(cmd *cobra.Command, pathArg string, jsonOutput bool) error {
+	cmd.SilenceUsage = true
+	contextDir, err := rc.RequireContextDir()
+	if err != nil {
+		return err
+	}
+	traceDir := filepath.Join(contextDir, dir.Trace)
+
+	return coreBlame.Annotate(
+		cmd, pathArg, contextDir, traceDir, jsonOutput,
+	)
+}
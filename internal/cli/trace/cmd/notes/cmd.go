@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notes
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/cli/parent"
+	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/notes/fetch"
+	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/notes/migrate"
+	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/notes/push"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the trace notes parent command.
+//
+// Returns:
+//   - *cobra.Command: The notes command with push, fetch, and
+//     migrate subcommands
+func Cmd() *cobra.Command {
+	return parent.Cmd(cmd.DescKeyTraceNotes, cmd.UseTraceNotes,
+		push.Cmd(),
+		fetch.Cmd(),
+		migrate.Cmd(),
+	)
+}
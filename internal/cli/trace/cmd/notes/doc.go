@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package notes provides the "ctx trace notes" parent
+// command.
+//
+// # Overview
+//
+// By default trace keeps the link from a commit to its
+// context in .context/trace/history.jsonl, which never
+// leaves the machine that made the commit. With
+// trace.notes set in .ctxrc those records are written as
+// git notes under refs/notes/ctx instead, and these
+// subcommands move them between clones:
+//
+//   - push: pushes refs/notes/ctx to a remote.
+//   - fetch: fetches the remote notes and merges them
+//     line by line into the local ref.
+//   - migrate: copies existing JSONL history and
+//     overrides into notes, once.
+//
+// # Usage
+//
+//	ctx trace notes push [remote]
+//	ctx trace notes fetch [remote]
+//	ctx trace notes migrate
+//
+// The remote defaults to origin. Running the parent
+// without a subcommand prints the help text.
+package notes
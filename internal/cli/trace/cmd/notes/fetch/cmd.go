@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fetch
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the trace notes fetch subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured fetch subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyTraceNotesFetch)
+	return &cobra.Command{
+		Use:     cmd.UseTraceNotesFetch,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTraceNotesFetch),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args)
+		},
+	}
+}
@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package fetch implements the "ctx trace notes fetch"
+// command.
+//
+// [Cmd] builds the cobra.Command. [Run] calls
+// trace.FetchNotes, which fetches refs/notes/ctx from the
+// given remote (origin by default) into a staging ref and
+// merges it into the local notes with the cat_sort_uniq
+// strategy, so records from every clone are kept.
+package fetch
@@ -0,0 +1,36 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package fetch
+
+import (
+	"github.com/spf13/cobra"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/trace"
+	writeTrace "github.com/ActiveMemory/ctx/internal/write/trace"
+)
+
+// Run fetches refs/notes/ctx from a remote.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - args: optional remote name; defaults to origin
+//
+// Returns:
+//   - error: non-nil when the fetch or merge fails
+func Run(cmd *cobra.Command, args []string) error {
+	remote := cfgGit.RemoteOrigin
+	if len(args) > 0 {
+		remote = args[0]
+	}
+	cmd.SilenceUsage = true
+	if syncErr := trace.FetchNotes(remote); syncErr != nil {
+		return syncErr
+	}
+	writeTrace.NotesFetched(cmd, remote)
+	return nil
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the trace notes migrate subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured migrate subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyTraceNotesMigrate)
+	return &cobra.Command{
+		Use:     cmd.UseTraceNotesMigrate,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTraceNotesMigrate),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd)
+		},
+	}
+}
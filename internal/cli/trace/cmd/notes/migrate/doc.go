@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package migrate implements the "ctx trace notes
+// migrate" command.
+//
+// [Cmd] builds the cobra.Command. [Run] calls
+// trace.MigrateNotes on .context/trace/ and prints how
+// many records were copied into refs/notes/ctx, how many
+// were already there, and how many belong to commits this
+// repository does not have. The JSONL files are left in
+// place. When trace.notes is not yet enabled, it also
+// prints a hint to set it.
+package migrate
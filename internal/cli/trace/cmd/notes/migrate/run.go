@@ -0,0 +1,41 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/trace"
+	writeTrace "github.com/ActiveMemory/ctx/internal/write/trace"
+)
+
+// Run copies the JSONL trace history and overrides into git
+// notes and prints the counts.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//
+// Returns:
+//   - error: non-nil when the history cannot be read or a note
+//     cannot be written
+func Run(cmd *cobra.Command) error {
+	cmd.SilenceUsage = true
+	ctxDir, ctxErr := rc.RequireContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+	r, migrateErr := trace.MigrateNotes(filepath.Join(ctxDir, dir.Trace))
+	if migrateErr != nil {
+		return migrateErr
+	}
+	writeTrace.NotesMigrated(cmd, r, rc.TraceNotes())
+	return nil
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package push
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+)
+
+// Cmd returns the trace notes push subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured push subcommand
+func Cmd() *cobra.Command {
+	short, long := desc.Command(cmd.DescKeyTraceNotesPush)
+	return &cobra.Command{
+		Use:     cmd.UseTraceNotesPush,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTraceNotesPush),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd, args)
+		},
+	}
+}
@@ -0,0 +1,15 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package push implements the "ctx trace notes push"
+// command.
+//
+// [Cmd] builds the cobra.Command. [Run] pushes
+// refs/notes/ctx to the given remote (origin by default)
+// through trace.PushNotes and confirms it. A push the
+// remote rejects because it has notes this clone lacks
+// fails with git's own message ("fetch first").
+package push
@@ -0,0 +1,47 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package sync
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/cmd"
+	"github.com/ActiveMemory/ctx/internal/config/embed/flag"
+	cFlag "github.com/ActiveMemory/ctx/internal/config/flag"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	"github.com/ActiveMemory/ctx/internal/flagbind"
+)
+
+// Cmd returns the trace sync subcommand.
+//
+// Returns:
+//   - *cobra.Command: Configured trace sync command with flags registered
+func Cmd() *cobra.Command {
+	var (
+		last    int
+		refresh bool
+	)
+	short, long := desc.Command(cmd.DescKeyTraceSync)
+	c := &cobra.Command{
+		Use:     cmd.UseTraceSync,
+		Short:   short,
+		Long:    long,
+		Example: desc.Example(cmd.DescKeyTraceSync),
+		Args:    cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			return Run(cobraCmd, last, refresh)
+		},
+	}
+	flagbind.IntFlagP(
+		c, &last,
+		cFlag.Last, cFlag.ShortLast,
+		cfgTrace.DefaultSyncLast, flag.DescKeyTraceSyncLast,
+	)
+	flagbind.BoolFlag(c, &refresh, cFlag.Refresh, flag.DescKeyTraceSyncRefresh)
+	return c
+}
@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package sync implements the "ctx trace sync" cobra
+// subcommand.
+//
+// This command caches the titles of external work items
+// (GitHub issues, Jira keys, PR numbers) so ctx trace can
+// show them next to their links without network access.
+//
+// # Usage
+//
+//	ctx trace sync [--last N] [--refresh]
+//
+// # Flags
+//
+//	--last, -n   Number of recent commits to scan.
+//	             Defaults to 200.
+//	--refresh    Re-fetch titles that are already
+//	             cached.
+//
+// # Behavior
+//
+// The command:
+//
+//   - Reports and exits when .ctxrc configures no
+//     trace.resolvers.
+//   - Collects the external refs of the last N commits
+//     from their messages and recorded context.
+//   - Fetches each uncached title from the resolver's
+//     api endpoint and appends it to
+//     .context/trace/titles.jsonl.
+//   - Prints a summary and one line per ref whose
+//     title could not be fetched.
+//
+// # Delegation
+//
+// Ref gathering and fetching are handled by
+// internal/trace; output goes through write/trace.
+package sync
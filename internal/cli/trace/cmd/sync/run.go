@@ -0,0 +1,55 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package sync
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/trace"
+	writeTrace "github.com/ActiveMemory/ctx/internal/write/trace"
+)
+
+// Run executes the trace sync command logic.
+//
+// Gathers the external refs of the last N commits and fetches
+// their titles into the offline title cache.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - last: number of recent commits to scan
+//   - refresh: re-fetch titles that are already cached
+//
+// Returns:
+//   - error: non-nil when git log fails or the cache cannot be
+//     written; individual fetch failures are reported, not returned
+func Run(cmd *cobra.Command, last int, refresh bool) error {
+	cmd.SilenceUsage = true
+	contextDir, err := rc.RequireContextDir()
+	if err != nil {
+		return err
+	}
+	if len(rc.TraceResolvers()) == 0 {
+		writeTrace.SyncNoResolvers(cmd)
+		return nil
+	}
+	traceDir := filepath.Join(contextDir, dir.Trace)
+
+	refs, refsErr := trace.RecentExternalRefs(traceDir, last)
+	if refsErr != nil {
+		return refsErr
+	}
+	result, syncErr := trace.SyncTitles(traceDir, refs, refresh)
+	if syncErr != nil {
+		return syncErr
+	}
+	writeTrace.Synced(cmd, result)
+	return nil
+}
@@ -40,7 +40,8 @@
 //   - Builds an OverrideEntry with the hash and
 //     the quoted note as a ref.
 //   - Writes the entry to the trace directory via
-//     trace.WriteOverride.
+//     trace.WriteOverride, or as a git note via
+//     trace.WriteOverrideNote when trace.notes is set.
 //   - Prints a confirmation with the short hash and
 //     the attached note.
 //
@@ -22,7 +22,8 @@ import (
 // Run executes the trace tag command logic.
 //
 // Resolves commitRef to a full hash, attaches the note as an override entry,
-// and writes it to the trace directory via trace.WriteOverride.
+// and writes it to the trace directory via trace.WriteOverride, or to
+// refs/notes/ctx via trace.WriteOverrideNote when trace.notes is set.
 //
 // Parameters:
 //   - cmd: Cobra command for output stream
@@ -53,7 +54,11 @@ func Run(cmd *cobra.Command, commitRef, note string) error {
 		Refs:   []string{strconv.Quote(note)},
 	}
 
-	if writeErr := trace.WriteOverride(entry, traceDir); writeErr != nil {
+	if rc.TraceNotes() {
+		if noteErr := trace.WriteOverrideNote(entry); noteErr != nil {
+			return errTrace.WriteOverride(noteErr)
+		}
+	} else if writeErr := trace.WriteOverride(entry, traceDir); writeErr != nil {
 		return errTrace.WriteOverride(writeErr)
 	}
 
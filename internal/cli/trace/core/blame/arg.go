@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package blame
+
+import (
+	"strconv"
+	"strings"
+
+	coreFile "github.com/ActiveMemory/ctx/internal/cli/trace/core/file"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// ParseArg splits a path argument into the file path and an
+// optional line range.
+//
+// Examples:
+//
+//	"src/auth.go:42-60" → "src/auth.go", 42, 60
+//	"src/auth.go:42"    → "src/auth.go", 42, 42
+//	"src/auth.go"       → "src/auth.go", 0, 0
+//
+// Parameters:
+//   - arg: combined path and optional line range argument
+//
+// Returns:
+//   - string: file path with the line range stripped
+//   - int: first line of the range, 0 for the whole file
+//   - int: last line of the range, 0 for the whole file
+func ParseArg(arg string) (string, int, int) {
+	path := coreFile.ParsePathArg(arg)
+	if path == arg {
+		return path, 0, 0
+	}
+	first, last, found := strings.Cut(arg[len(path)+1:], token.Dash)
+	start, _ := strconv.Atoi(first)
+	end := start
+	if found {
+		end, _ = strconv.Atoi(last)
+	}
+	return path, start, end
+}
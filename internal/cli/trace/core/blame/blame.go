@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package blame
+
+import (
+	"github.com/spf13/cobra"
+
+	errTrace "github.com/ActiveMemory/ctx/internal/err/trace"
+	"github.com/ActiveMemory/ctx/internal/trace"
+)
+
+// Annotate blames a file and prints each block of lines under
+// the commit that last changed it, together with the decisions,
+// tasks and learnings that commit was linked to.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - pathArg: file path with optional line range suffix
+//   - contextDir: absolute path to the context directory
+//   - traceDir: absolute path to the trace directory
+//   - jsonOutput: whether to format output as JSON
+//
+// Returns:
+//   - error: non-nil when git blame fails
+func Annotate(
+	cmd *cobra.Command,
+	pathArg, contextDir, traceDir string,
+	jsonOutput bool,
+) error {
+	path, start, end := ParseArg(pathArg)
+	result, blameErr := trace.Blame(path, start, end)
+	if blameErr != nil {
+		return errTrace.Blame(path, blameErr)
+	}
+
+	refs := commitRefs(result, traceDir)
+	if jsonOutput {
+		return writeJSON(cmd, path, result, refs, contextDir)
+	}
+	writeText(cmd, result, refs, contextDir)
+	return nil
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package blame annotates a file with the context behind
+// each of its lines: for every line, the commit that last
+// changed it and the decisions, tasks and learnings that
+// commit was linked to.
+//
+// # Annotation
+//
+// [Annotate] runs git blame --porcelain on the file (or a
+// line range, parsed by [ParseArg]), collects the refs of
+// each distinct commit once from git notes, the trace
+// history and commit trailers, and resolves them against
+// the context directory.
+//
+// In text mode consecutive lines from the same commit are
+// grouped under a header with the short hash, author date
+// and subject, followed by the commit's refs by title.
+// Lines that are not committed yet get their own header.
+//
+// # JSON Output
+//
+// JSON mode encodes a [JSONBlame]: the file path, a map
+// of [JSONCommit] entries keyed by full hash, and a list
+// of [JSONLine] entries pointing into that map. Editor
+// extensions use it to answer "why is this line like
+// this?" on hover without calling ctx per line.
+package blame
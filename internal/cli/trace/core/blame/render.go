@@ -0,0 +1,126 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package blame
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	coreShow "github.com/ActiveMemory/ctx/internal/cli/trace/core/show"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/trace"
+	writeTrace "github.com/ActiveMemory/ctx/internal/write/trace"
+)
+
+// commitRefs collects the context refs of every committed hash
+// in a blame result, once per commit.
+//
+// Parameters:
+//   - result: parsed blame output
+//   - traceDir: absolute path to the trace directory
+//
+// Returns:
+//   - map[string][]string: raw refs keyed by full hash
+func commitRefs(
+	result trace.BlameResult, traceDir string,
+) map[string][]string {
+	refs := make(map[string][]string, len(result.Commits))
+	notes := trace.LoadNoteIndex()
+	for hash, c := range result.Commits {
+		if !c.Committed {
+			continue
+		}
+		refs[hash] = trace.CollectRefsForCommit(hash, traceDir, notes, true)
+	}
+	return refs
+}
+
+// writeText prints the blamed lines grouped into runs that share
+// a commit, each run under a header listing the commit's refs.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - result: parsed blame output
+//   - refs: raw refs keyed by full hash
+//   - contextDir: absolute path to the context directory
+func writeText(
+	cmd *cobra.Command,
+	result trace.BlameResult,
+	refs map[string][]string,
+	contextDir string,
+) {
+	current := ""
+	for _, l := range result.Lines {
+		if l.Commit != current {
+			current = l.Commit
+			c := result.Commits[current]
+			if !c.Committed {
+				writeTrace.BlameUncommitted(cmd)
+			} else {
+				writeTrace.BlameHeader(
+					cmd, trace.ShortHash(c.Hash),
+					c.Time.Format(cfgTime.DateFormat), c.Summary,
+				)
+			}
+			for _, r := range refs[current] {
+				writeTrace.BlameRef(cmd, trace.Resolve(r, contextDir))
+			}
+		}
+		writeTrace.BlameLine(cmd, l.Line, l.Content)
+	}
+}
+
+// writeJSON encodes a blame result with resolved refs for
+// editor integrations.
+//
+// Parameters:
+//   - cmd: Cobra command for output stream
+//   - path: blamed file path
+//   - result: parsed blame output
+//   - refs: raw refs keyed by full hash
+//   - contextDir: absolute path to the context directory
+//
+// Returns:
+//   - error: non-nil if encoding fails
+func writeJSON(
+	cmd *cobra.Command,
+	path string,
+	result trace.BlameResult,
+	refs map[string][]string,
+	contextDir string,
+) error {
+	out := JSONBlame{
+		File:    path,
+		Commits: make(map[string]JSONCommit, len(result.Commits)),
+		Lines:   make([]JSONLine, 0, len(result.Lines)),
+	}
+	for hash, c := range result.Commits {
+		jc := JSONCommit{
+			Commit:    hash,
+			Short:     trace.ShortHash(hash),
+			Committed: c.Committed,
+			Refs:      coreShow.ResolveToJSON(refs[hash], contextDir),
+		}
+		if c.Committed {
+			jc.Author = c.Author
+			jc.Date = c.Time.Format(time.RFC3339)
+			jc.Summary = c.Summary
+		}
+		out.Commits[hash] = jc
+	}
+	for _, l := range result.Lines {
+		out.Lines = append(out.Lines, JSONLine{
+			Line: l.Line, Commit: l.Commit, Content: l.Content,
+		})
+	}
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", token.Indent2)
+	return enc.Encode(out)
+}
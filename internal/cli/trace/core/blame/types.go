@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package blame
+
+import coreShow "github.com/ActiveMemory/ctx/internal/cli/trace/core/show"
+
+// JSONBlame is the JSON form of a blamed file. Commits are
+// keyed by full hash so an editor can look up the commit behind
+// any line without repeating its refs per line.
+type JSONBlame struct {
+	File    string                `json:"file"`
+	Commits map[string]JSONCommit `json:"commits"`
+	Lines   []JSONLine            `json:"lines"`
+}
+
+// JSONCommit describes a commit that last changed blamed lines,
+// with its resolved context refs.
+type JSONCommit struct {
+	Commit    string             `json:"commit"`
+	Short     string             `json:"short"`
+	Author    string             `json:"author,omitempty"`
+	Date      string             `json:"date,omitempty"`
+	Summary   string             `json:"summary,omitempty"`
+	Committed bool               `json:"committed"`
+	Refs      []coreShow.JSONRef `json:"refs"`
+}
+
+// JSONLine is one line of the blamed file.
+type JSONLine struct {
+	Line    int    `json:"line"`
+	Commit  string `json:"commit"`
+	Content string `json:"content"`
+}
@@ -19,7 +19,8 @@ import (
 //
 // Called from the post-commit hook after a commit is made. Reads refs from
 // the commit trailer (not re-collected; the trailer is the single source
-// of truth), writes a history entry, and truncates pending state.
+// of truth), writes a history entry (a git note when trace.notes is set),
+// and truncates pending state.
 //
 // Pending context is always consumed (truncated) per commit, even when no
 // hook ran and the trailer is empty. This prevents stale refs from leaking
@@ -61,7 +62,11 @@ func RecordCommit(commitHash string) error {
 		Refs:    refs,
 		Message: message,
 	}
-	if histErr := trace.WriteHistory(entry, traceDir); histErr != nil {
+	if rc.TraceNotes() {
+		if noteErr := trace.WriteHistoryNote(entry); noteErr != nil {
+			return errTrace.WriteHistory(noteErr)
+		}
+	} else if histErr := trace.WriteHistory(entry, traceDir); histErr != nil {
 		return errTrace.WriteHistory(histErr)
 	}
 
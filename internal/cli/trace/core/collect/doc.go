@@ -17,7 +17,9 @@
 //     trailer is the single source of truth, set by the
 //     prepare-commit-msg hook during commit creation.
 //  2. Write a history entry to .context/trace/ containing
-//     the commit hash, refs, and commit message.
+//     the commit hash, refs, and commit message; with
+//     trace.notes set it becomes a git note under
+//     refs/notes/ctx instead.
 //  3. Truncate the pending refs file in .context/state/
 //     so stale refs never leak into future commits.
 //
@@ -85,6 +85,7 @@ func Trace(
 	}
 
 	lines := strings.Split(strings.TrimSpace(string(out)), token.NewlineLF)
+	notes := trace.LoadNoteIndex()
 
 	for _, line := range lines {
 		if line == "" {
@@ -105,7 +106,7 @@ func Trace(
 			subject = parts[2]
 		}
 
-		refs := trace.CollectRefsForCommit(hash, traceDir, false)
+		refs := trace.CollectRefsForCommit(hash, traceDir, notes, false)
 		refStr := desc.Text(text.DescKeyWriteTraceNoRefs)
 		if len(refs) > 0 {
 			refStr = desc.Text(text.DescKeyWriteTraceRefsPrefix) +
@@ -42,7 +42,15 @@ func Commit(
 		fullHash = hash
 	}
 
-	refs := trace.CollectRefsForCommit(fullHash, traceDir, true)
+	// Issue keys in the message (#1234, PAY-567) count as refs
+	// alongside the recorded context.
+	body, _ := trace.CommitBody(fullHash)
+	refs := trace.Deduplicate(append(
+		trace.CollectRefsForCommit(
+			fullHash, traceDir, trace.LoadNoteIndex(), true,
+		),
+		trace.ExternalRefs(body)...,
+	))
 
 	if jsonOutput {
 		msg, _ := trace.CommitMessage(fullHash)
@@ -96,6 +104,7 @@ func Last(
 	}
 
 	lines := strings.Split(strings.TrimSpace(string(out)), token.NewlineLF)
+	notes := trace.LoadNoteIndex()
 
 	// Bulk listing uses includeTrailers=false: history.jsonl already
 	// contains the same refs the post-commit hook read from the trailer,
@@ -112,7 +121,10 @@ func Last(
 			if len(parts) > 1 {
 				msg = parts[1]
 			}
-			refs := trace.CollectRefsForCommit(hash, traceDir, false)
+			refs := trace.Deduplicate(append(
+				trace.CollectRefsForCommit(hash, traceDir, notes, false),
+				trace.ExternalRefs(msg)...,
+			))
 			commits = append(commits, JSONCommit{
 				Commit:  trace.ShortHash(hash),
 				Message: msg,
@@ -134,7 +146,10 @@ func Last(
 		if len(parts) > 1 {
 			msg = parts[1]
 		}
-		refs := trace.CollectRefsForCommit(hash, traceDir, false)
+		refs := trace.Deduplicate(append(
+			trace.CollectRefsForCommit(hash, traceDir, notes, false),
+			trace.ExternalRefs(msg)...,
+		))
 		refSummary := desc.Text(text.DescKeyWriteTraceNoRefs)
 		if len(refs) > 0 {
 			refSummary = strings.Join(refs, token.CommaSpace)
@@ -163,6 +178,7 @@ func ResolveToJSON(refs []string, contextDir string) []JSONRef {
 			Number: rr.Number,
 			Title:  rr.Title,
 			Detail: rr.Detail,
+			URL:    rr.URL,
 			Found:  rr.Found,
 		})
 	}
@@ -13,6 +13,7 @@ type JSONRef struct {
 	Number int    `json:"number,omitempty"`
 	Title  string `json:"title,omitempty"`
 	Detail string `json:"detail,omitempty"`
+	URL    string `json:"url,omitempty"`
 	Found  bool   `json:"found"`
 }
 
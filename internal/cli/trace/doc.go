@@ -21,18 +21,27 @@
 //     associate it with the latest commit
 //   - file: show trace entries that reference a specific
 //     file path
+//   - blame: annotate each line of a file with the
+//     context linked to the commit that last changed it
 //   - hook: post-commit hook entry point that
 //     automatically collects trace data
 //   - tag: annotate a trace entry with additional
 //     metadata tags
+//   - notes: push, fetch, and migrate commit context
+//     stored as git notes (refs/notes/ctx)
+//   - sync: cache the titles of external work items
+//     matched by trace.resolvers
 //
 // # Subpackages
 //
 //	cmd/show: trace display and formatting
 //	cmd/collect: context snapshot collection
 //	cmd/file: file-scoped trace lookup
+//	cmd/blame: line-level context annotation
 //	cmd/hook: post-commit automation
 //	cmd/tag: trace annotation
+//	cmd/notes: git notes sync and migration
+//	cmd/sync: external issue title cache
 //	core: trace storage, git integration, and
 //	  context linking
 package trace
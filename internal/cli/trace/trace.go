@@ -9,10 +9,13 @@ package trace
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/blame"
 	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/collect"
 	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/file"
 	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/hook"
+	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/notes"
 	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/show"
+	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/sync"
 	"github.com/ActiveMemory/ctx/internal/cli/trace/cmd/tag"
 )
 
@@ -22,9 +25,12 @@ import (
 //   - *cobra.Command: The trace command
 func Cmd() *cobra.Command {
 	c := show.Cmd()
+	c.AddCommand(blame.Cmd())
 	c.AddCommand(collect.Cmd())
 	c.AddCommand(file.Cmd())
 	c.AddCommand(hook.Cmd())
+	c.AddCommand(notes.Cmd())
+	c.AddCommand(sync.Cmd())
 	c.AddCommand(tag.Cmd())
 	return c
 }
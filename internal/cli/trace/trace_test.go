@@ -7,6 +7,9 @@
 package trace
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -14,6 +17,8 @@ import (
 	"testing"
 
 	"github.com/ActiveMemory/ctx/internal/cli/initialize"
+	coreBlame "github.com/ActiveMemory/ctx/internal/cli/trace/core/blame"
+	"github.com/ActiveMemory/ctx/internal/rc"
 	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
 	"github.com/ActiveMemory/ctx/internal/trace"
 )
@@ -99,6 +104,263 @@ func TestTraceTagAndShow(t *testing.T) {
 	}
 }
 
+func TestTraceNotes_MigratePushFetch(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	defer func() { _ = os.Chdir(origDir) }()
+
+	remote := filepath.Join(tmpDir, "remote.git")
+	run(t, "git", "init", "--bare", remote)
+
+	repo := filepath.Join(tmpDir, "a")
+	run(t, "git", "init", repo)
+	if err := os.Chdir(repo); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	testctx.Declare(t, repo)
+	run(t, "git", "config", "user.email", "test@test.com")
+	run(t, "git", "config", "user.name", "Test")
+	run(t, "git", "remote", "add", "origin", remote)
+	run(t, "git", "commit", "--allow-empty", "-m", "first")
+	hash := strings.TrimSpace(runOutput(t, "git", "rev-parse", "HEAD"))
+
+	// Existing JSONL history, plus one record for a commit that
+	// is not in this repository.
+	traceDir := filepath.Join(repo, ".context", "trace")
+	for _, e := range []trace.HistoryEntry{
+		{Commit: hash, Refs: []string{"decision:1"}, Message: "first"},
+		{Commit: strings.Repeat("0", 40), Refs: []string{"task:9"}},
+	} {
+		if err := trace.WriteHistory(e, traceDir); err != nil {
+			t.Fatalf("WriteHistory: %v", err)
+		}
+	}
+
+	execute := func(args ...string) string {
+		t.Helper()
+		c := Cmd()
+		c.SetArgs(args)
+		var out strings.Builder
+		c.SetOut(&out)
+		c.SetErr(&out)
+		if err := c.Execute(); err != nil {
+			t.Fatalf("trace %v: %v\n%s", args, err, out.String())
+		}
+		return out.String()
+	}
+
+	out := execute("notes", "migrate")
+	if !strings.Contains(out, "Migrated 1 records") ||
+		!strings.Contains(out, "1 for commits not in this repository") {
+		t.Errorf("migrate output = %q", out)
+	}
+	if !strings.Contains(out, "trace.notes") {
+		t.Errorf("migrate output = %q, want enable hint", out)
+	}
+	if out = execute("notes", "migrate"); !strings.Contains(
+		out, "Migrated 0 records to refs/notes/ctx (1 already present",
+	) {
+		t.Errorf("second migrate output = %q", out)
+	}
+
+	// With trace.notes set, tag writes a note instead of JSONL.
+	if err := os.WriteFile(
+		".ctxrc", []byte("trace:\n  notes: true\n"), 0o600,
+	); err != nil {
+		t.Fatal(err)
+	}
+	rc.Reset()
+	defer rc.Reset()
+	execute("tag", "HEAD", "--note", "shared")
+	overrides, _ := trace.ReadOverrides(traceDir)
+	if len(overrides) != 0 {
+		t.Errorf("overrides.jsonl has %d entries, want 0", len(overrides))
+	}
+
+	execute("notes", "push")
+
+	// A fresh clone sees the context once it fetches the notes.
+	clone := filepath.Join(tmpDir, "b")
+	run(t, "git", "clone", "-q", remote, clone)
+	if err := os.Chdir(clone); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	cloneTrace := filepath.Join(clone, ".context", "trace")
+	if refs := trace.CollectRefsForCommit(
+		hash, cloneTrace, trace.LoadNoteIndex(), false,
+	); len(refs) != 0 {
+		t.Fatalf("refs before fetch = %v, want none", refs)
+	}
+	execute("notes", "fetch")
+	refs := trace.CollectRefsForCommit(
+		hash, cloneTrace, trace.LoadNoteIndex(), false,
+	)
+	if strings.Join(refs, ",") != `decision:1,"shared"` {
+		t.Errorf("refs after fetch = %v", refs)
+	}
+}
+
+func TestTraceBlame(t *testing.T) {
+	repo := t.TempDir()
+	origDir, _ := os.Getwd()
+	defer func() { _ = os.Chdir(origDir) }()
+	if err := os.Chdir(repo); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	testctx.Declare(t, repo)
+	run(t, "git", "init")
+	run(t, "git", "config", "user.email", "test@test.com")
+	run(t, "git", "config", "user.name", "Test")
+
+	contextDir := filepath.Join(repo, ".context")
+	if err := os.MkdirAll(contextDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	decisions := "# Decisions\n\n## [2026-01-10-120000] Use JWT for auth\n"
+	if err := os.WriteFile(
+		filepath.Join(contextDir, "DECISIONS.md"), []byte(decisions), 0600,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile("auth.go", []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("package auth\n\n")
+	run(t, "git", "add", "auth.go")
+	run(t, "git", "commit", "-m", "Add auth")
+	first := strings.TrimSpace(runOutput(t, "git", "rev-parse", "HEAD"))
+	if err := trace.WriteHistory(trace.HistoryEntry{
+		Commit: first, Refs: []string{"decision:1"},
+	}, filepath.Join(contextDir, "trace")); err != nil {
+		t.Fatalf("WriteHistory: %v", err)
+	}
+
+	write("package auth\n\nfunc Verify() {}\n")
+	run(t, "git", "commit", "-am", "Add Verify")
+	second := strings.TrimSpace(runOutput(t, "git", "rev-parse", "HEAD"))
+	write("package auth\n\nfunc Verify() {}\n// wip\n")
+
+	execute := func(args ...string) string {
+		t.Helper()
+		c := Cmd()
+		c.SetArgs(args)
+		var out strings.Builder
+		c.SetOut(&out)
+		c.SetErr(&out)
+		if err := c.Execute(); err != nil {
+			t.Fatalf("trace %v: %v\n%s", args, err, out.String())
+		}
+		return out.String()
+	}
+
+	out := execute("blame", "auth.go")
+	for _, want := range []string{
+		first[:7] + "  ", "Add auth",
+		"[Decision] decision:1: Use JWT for auth",
+		"     1 │ package auth",
+		second[:7], "     3 │ func Verify() {}",
+		"(not committed yet)", "     4 │ // wip",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("blame output missing %q:\n%s", want, out)
+		}
+	}
+
+	var got coreBlame.JSONBlame
+	if err := json.Unmarshal(
+		[]byte(execute("blame", "auth.go:1-3", "--json")), &got,
+	); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(got.Lines) != 3 || got.Lines[2].Commit != second {
+		t.Fatalf("lines = %+v", got.Lines)
+	}
+	refs := got.Commits[first].Refs
+	if len(refs) != 1 || refs[0].Title != "Use JWT for auth" {
+		t.Errorf("refs of %s = %+v", first, refs)
+	}
+	if len(got.Commits[second].Refs) != 0 {
+		t.Errorf("refs of %s = %+v", second, got.Commits[second].Refs)
+	}
+}
+
+func TestTraceSync(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"title":"Checkout fails"}`))
+		},
+	))
+	defer srv.Close()
+
+	repo := t.TempDir()
+	origDir, _ := os.Getwd()
+	defer func() { _ = os.Chdir(origDir) }()
+	if err := os.Chdir(repo); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	testctx.Declare(t, repo)
+	run(t, "git", "init")
+	run(t, "git", "config", "user.email", "test@test.com")
+	run(t, "git", "config", "user.name", "Test")
+	if err := os.MkdirAll(filepath.Join(repo, ".context"), 0750); err != nil {
+		t.Fatal(err)
+	}
+
+	execute := func(args ...string) string {
+		t.Helper()
+		c := Cmd()
+		c.SetArgs(args)
+		var out strings.Builder
+		c.SetOut(&out)
+		c.SetErr(&out)
+		if err := c.Execute(); err != nil {
+			t.Fatalf("trace %v: %v\n%s", args, err, out.String())
+		}
+		return out.String()
+	}
+
+	if out := execute("sync"); !strings.Contains(out, "No trace.resolvers") {
+		t.Errorf("sync without resolvers = %q", out)
+	}
+
+	rcContent := "trace:\n  resolvers:\n    - name: jira\n" +
+		"      pattern: '\\b([A-Z]+-[0-9]+)\\b'\n" +
+		"      url: https://jira.example.com/browse/{id}\n" +
+		"      api: " + srv.URL + "/issue/{id}\n"
+	if err := os.WriteFile(".ctxrc", []byte(rcContent), 0600); err != nil {
+		t.Fatal(err)
+	}
+	rc.Reset()
+
+	if err := os.WriteFile("pay.go", []byte("package pay\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	run(t, "git", "add", "pay.go")
+	run(t, "git", "commit", "-m", "PAY-7: fix checkout")
+	hash := strings.TrimSpace(runOutput(t, "git", "rev-parse", "HEAD"))
+
+	if out := execute("sync"); !strings.Contains(out, "Fetched 1 titles") {
+		t.Errorf("sync output = %q", out)
+	}
+	if out := execute("sync"); !strings.Contains(out, "1 already cached") {
+		t.Errorf("second sync output = %q", out)
+	}
+
+	out := execute(hash[:7])
+	for _, want := range []string{
+		"jira:PAY-7", "Checkout fails",
+		"https://jira.example.com/browse/PAY-7",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("show output missing %q:\n%s", want, out)
+		}
+	}
+}
+
 func run(t *testing.T, name string, args ...string) {
 	t.Helper()
 	//nolint:gosec // test helper, name is always "git" from test code
@@ -58,3 +58,36 @@ const (
 	// maximum relevance (1.0).
 	RelevanceMatchCap = 3
 )
+
+// Scoring overrides (.ctxrc scoring block).
+const (
+	// DefaultTypeWeight is the score multiplier for entry
+	// types without a configured weight.
+	DefaultTypeWeight = 1.0
+	// HalfLifeBase is the decay factor applied once per
+	// elapsed half-life.
+	HalfLifeBase = 0.5
+	// PctMax is the upper bound for tier percentages.
+	PctMax = 100
+	// TagPrefix marks a tag token in entry text (e.g. #infra).
+	TagPrefix = "#"
+	// TagTrim is the trailing punctuation stripped from a tag
+	// token before comparison.
+	TagTrim = ".,;:!?)]"
+)
+
+// Scoring field names reported by rc validation warnings.
+const (
+	// FieldHalfLife is the recency half-life key.
+	FieldHalfLife = "recency_half_life_days"
+	// FieldMatchCap is the relevance match cap key.
+	FieldMatchCap = "relevance_match_cap"
+	// FieldTasksPct is the task tier percentage key.
+	FieldTasksPct = "tasks_pct"
+	// FieldConventionsPct is the convention tier percentage key.
+	FieldConventionsPct = "conventions_pct"
+	// FieldSplitMinPct is the decision/learning split key.
+	FieldSplitMinPct = "split_min_pct"
+	// FieldFullEntryPct is the full-entry share key.
+	FieldFullEntryPct = "full_entry_pct"
+)
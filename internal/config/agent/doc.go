@@ -54,6 +54,17 @@
 // hits, preventing a single heavily-tagged entry from
 // consuming the entire budget.
 //
+// # Per-Project Overrides
+//
+// Every constant above is a default. The `scoring:` block
+// in .ctxrc can replace the recency buckets with a
+// continuous half-life decay ([HalfLifeBase] per elapsed
+// half-life), change the tier percentages (bounded by
+// [PctMax]), weight entry types (default
+// [DefaultTypeWeight]), and add boost/penalty rules keyed
+// on #tags ([TagPrefix]) or entry headings. The Field*
+// constants name the keys reported by rc validation.
+//
 // # Why Centralized
 //
 // Budget ratios and scoring thresholds are tuned together.
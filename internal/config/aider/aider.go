@@ -0,0 +1,65 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package aider
+
+// FileHistory is the transcript file Aider writes in the
+// project root.
+const FileHistory = ".aider.chat.history.md"
+
+// Transcript line markers.
+const (
+	// HeaderPrefix starts a session.
+	HeaderPrefix = "# aider chat started at "
+	// TimeLayout is the layout of the header timestamp.
+	TimeLayout = "2006-01-02 15:04:05"
+	// UserPrefix starts a line of user input.
+	UserPrefix = "####"
+	// OutputPrefix quotes a line of Aider output.
+	OutputPrefix = "> "
+	// OutputEmpty is a quoted blank line of Aider output.
+	OutputEmpty = ">"
+	// Fence opens and closes a code block in a reply; lines
+	// inside it are never output or input markers.
+	Fence = "```"
+	// ModelPrefix starts the model banner line.
+	ModelPrefix = "Model: "
+	// ModelSep ends the model name in the banner.
+	ModelSep = " with "
+	// EditPrefix reports an applied edit.
+	EditPrefix = "Applied edit to "
+	// CommitPrefix reports a commit.
+	CommitPrefix = "Commit "
+	// RunPrefix reports a shell command.
+	RunPrefix = "Running "
+)
+
+// Tool names reported for Aider actions.
+const (
+	ToolEdit   = "edit"
+	ToolCommit = "commit"
+	ToolRun    = "run"
+)
+
+// Token count multipliers for the "k" and "M" suffixes in
+// token reports.
+const (
+	SuffixThousand = "k"
+	SuffixMillion  = "M"
+	Thousand       = 1_000
+	Million        = 1_000_000
+)
+
+// Session ID layout: "<start>-<path hash>".
+const (
+	// IDTimeLayout formats the session start in the ID.
+	IDTimeLayout = "20060102-150405"
+	// IDSep joins the start time and the path hash.
+	IDSep = "-"
+	// IDHashLen is the number of hex characters of the source
+	// path hash.
+	IDHashLen = 8
+)
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package aider centralizes constants for parsing Aider's
+// .aider.chat.history.md transcript.
+//
+// Aider appends every session to one Markdown file in the
+// project root. A [HeaderPrefix] line starts a session; user
+// input is written as [UserPrefix] lines; Aider's own output
+// (model banner, applied edits, commits, shell commands, and
+// token reports) is quoted with [OutputPrefix]; everything
+// else is the assistant's reply.
+package aider
@@ -24,6 +24,7 @@ const (
 	DirIntegrationsCopilotSkill = "integrations/copilot-cli/skills"
 	DirHooksMessages            = "hooks/messages"
 	DirHooksTrace               = "hooks/trace"
+	DirHooksDrift               = "hooks/drift"
 	DirJournal                  = "journal"
 	DirPermissions              = "permissions"
 	DirProject                  = "project"
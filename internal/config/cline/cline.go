@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cline
+
+// Cline storage locations.
+const (
+	// ExtensionID is Cline's VS Code extension identifier, the
+	// name of its globalStorage directory.
+	ExtensionID = "saoudrizwan.claude-dev"
+	// DirTasks holds one directory per task.
+	DirTasks = "tasks"
+	// FileAPIHistory is the conversation file of a task.
+	FileAPIHistory = "api_conversation_history.json"
+	// FileUIMessages is the UI event file of a task.
+	FileUIMessages = "ui_messages.json"
+	// FileMetadata is the task metadata file.
+	FileMetadata = "task_metadata.json"
+)
+
+// SayAPIReqStarted is the UI event that reports a model
+// request and its token usage.
+const SayAPIReqStarted = "api_req_started"
@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package cline centralizes constants for parsing Cline task
+// directories.
+//
+// Cline (the saoudrizwan.claude-dev VS Code extension) keeps
+// one directory per task under the editor's globalStorage:
+//
+//   - [FileAPIHistory]: the conversation in Anthropic
+//     message format (text, tool_use, tool_result blocks)
+//   - [FileUIMessages]: timestamped UI events; the
+//     [SayAPIReqStarted] events carry per-request token
+//     counts
+//   - [FileMetadata]: models used during the task
+//
+// The task directory name is the task's creation time in
+// milliseconds and serves as the session ID.
+package cline
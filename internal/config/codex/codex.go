@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package codex
+
+// Codex CLI storage locations.
+const (
+	// DirHome is the Codex home directory under the user's home.
+	DirHome = ".codex"
+	// EnvHome overrides the Codex home directory.
+	EnvHome = "CODEX_HOME"
+	// DirSessions holds the dated rollout directories.
+	DirSessions = "sessions"
+	// FilePrefix starts every rollout file name.
+	FilePrefix = "rollout-"
+)
+
+// Rollout line types.
+const (
+	LineSessionMeta  = "session_meta"
+	LineTurnContext  = "turn_context"
+	LineResponseItem = "response_item"
+	LineEventMsg     = "event_msg"
+)
+
+// Response item payload types.
+const (
+	ItemMessage              = "message"
+	ItemReasoning            = "reasoning"
+	ItemFunctionCall         = "function_call"
+	ItemFunctionCallOutput   = "function_call_output"
+	ItemCustomToolCall       = "custom_tool_call"
+	ItemCustomToolCallOutput = "custom_tool_call_output"
+)
+
+// EventTokenCount is the event message type carrying token
+// usage.
+const EventTokenCount = "token_count"
+
+// InjectedPrefixes mark user messages that Codex writes on the
+// user's behalf.
+var InjectedPrefixes = []string{
+	"<environment_context>",
+	"<user_instructions>",
+}
@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package codex centralizes constants for parsing OpenAI Codex
+// CLI session logs.
+//
+// Codex CLI records each session as a "rollout" JSONL file
+// under ~/.codex/sessions/YYYY/MM/DD/ (or $CODEX_HOME). Every
+// line is an envelope with a timestamp, a line type, and a
+// payload:
+//
+//   - [LineSessionMeta]: session id, cwd, git branch
+//   - [LineTurnContext]: cwd and model for the next turn
+//   - [LineResponseItem]: a model-visible item; its payload
+//     type is one of [ItemMessage], [ItemReasoning],
+//     [ItemFunctionCall], [ItemFunctionCallOutput],
+//     [ItemCustomToolCall], or [ItemCustomToolCallOutput]
+//   - [LineEventMsg]: UI events; [EventTokenCount] carries
+//     cumulative token usage
+//
+// User messages that Codex injects itself (environment and
+// instruction blocks) start with one of [InjectedPrefixes]
+// and are not treated as user turns.
+package codex
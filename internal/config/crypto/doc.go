@@ -29,9 +29,31 @@
 //     webhook URL used by the notification system. The
 //     URL is encrypted so it can be committed to version
 //     control without exposing the endpoint.
+//   - [NotifyChannelsEnc] (".notify-channels.enc")
+//     stores the URLs, tokens and passwords of named
+//     notification channels, encrypted the same way.
 //   - [ContextKey] (".ctx.key") is the encryption key
 //     file. It lives in .context/ and is excluded from
 //     version control via .gitignore.
+//   - [Identity] (".ctx.identity") is a user's X25519
+//     private key in ~/.ctx/. It opens scratchpads
+//     shared with several recipients.
+//
+// # Multi-Recipient Envelopes
+//
+// [EnvelopeMagic], [StanzaX25519], [HeaderEnd] and
+// [WrapInfo] define the envelope a shared scratchpad is
+// stored in: a random data key seals the content and is
+// wrapped once per recipient public key
+// ([PublicKeyPrefix]).
+//
+// # Key Rotation
+//
+// `ctx key rotate` stages the new key at [NextSuffix],
+// keeps the old one at [GraceSuffix] as a decrypt-only
+// fallback, and journals progress at
+// [RotationJournalSuffix] through [PhasePrepared] and
+// [PhaseCommitted].
 //
 // # Why Centralized
 //
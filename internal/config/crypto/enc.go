@@ -9,5 +9,13 @@ package crypto
 // NotifyEnc is the encrypted webhook URL file.
 const NotifyEnc = ".notify.enc"
 
+// NotifyChannelsEnc is the encrypted file holding the secrets
+// (URLs, tokens, passwords) of named notification channels.
+const NotifyChannelsEnc = ".notify-channels.enc"
+
 // ContextKey is the context encryption key file.
 const ContextKey = ".ctx.key"
+
+// Identity is the per-user X25519 private key file that opens
+// multi-recipient envelopes.
+const Identity = ".ctx.identity"
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+// Multi-recipient envelope format.
+//
+// An envelope is a text header followed by the sealed payload:
+//
+//	ctx-envelope/v1
+//	-> X25519 <ephemeral public key> <wrapped data key>
+//	---
+//	<nonce + AES-256-GCM ciphertext>
+//
+// One stanza per recipient. Header fields are unpadded standard
+// base64.
+const (
+	// EnvelopeMagic is the first header line of an envelope.
+	EnvelopeMagic = "ctx-envelope/v1"
+	// StanzaX25519 starts a recipient stanza.
+	StanzaX25519 = "-> X25519"
+	// HeaderEnd closes the header; the payload follows its newline.
+	HeaderEnd = "---"
+	// WrapInfo is the HKDF info string for deriving a stanza's
+	// wrap key from the X25519 shared secret.
+	WrapInfo = "ctx-envelope/v1 X25519 wrap"
+	// PublicKeyPrefix starts a printable recipient public key.
+	PublicKeyPrefix = "ctxpub1"
+)
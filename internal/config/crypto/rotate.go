@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+// Key rotation files, kept next to the active key.
+//
+// A rotation stages the new key at <key>.next, re-encrypts every
+// owned ciphertext, then moves the old key to <key>.prev (the
+// decrypt-only grace key) and the new key into place. Progress is
+// recorded in <key>.rotate.json so an interrupted run can resume
+// or roll back.
+const (
+	// GraceSuffix names the previous key kept for decryption only.
+	GraceSuffix = ".prev"
+	// NextSuffix names the staged key during a rotation.
+	NextSuffix = ".next"
+	// RotationJournalSuffix names the rotation journal.
+	RotationJournalSuffix = ".rotate.json"
+)
+
+// Rotation journal phases.
+const (
+	// PhasePrepared means the new key is staged and targets are
+	// being re-encrypted; resume or roll back are both safe.
+	PhasePrepared = "prepared"
+	// PhaseCommitted means every target uses the new key and the
+	// key swap has begun; only resume is possible.
+	PhaseCommitted = "committed"
+)
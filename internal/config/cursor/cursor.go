@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cursor
+
+// Cursor storage locations.
+const (
+	// AppName is Cursor's application data directory name.
+	AppName = "Cursor"
+	// DirGlobalStorage holds the global state database.
+	DirGlobalStorage = "globalStorage"
+	// FileStateDB is the state database file name.
+	FileStateDB = "state.vscdb"
+)
+
+// Key layout of the cursorDiskKV and ItemTable tables.
+const (
+	// KeyBubblePrefix prefixes a message key
+	// ("bubbleId:<composer>:<bubble>").
+	KeyBubblePrefix = "bubbleId:"
+	// KeySep separates the parts of a bubble key.
+	KeySep = ":"
+)
+
+// SQL run against the state databases.
+const (
+	// QueryComposers selects every conversation header.
+	QueryComposers = "SELECT key, CAST(value AS TEXT) AS value " +
+		"FROM cursorDiskKV WHERE key LIKE 'composerData:%'"
+	// QueryBubbles selects every conversation message.
+	QueryBubbles = "SELECT key, CAST(value AS TEXT) AS value " +
+		"FROM cursorDiskKV WHERE key LIKE 'bubbleId:%'"
+	// QueryWorkspaceComposers selects a workspace's
+	// conversation list.
+	QueryWorkspaceComposers = "SELECT key, CAST(value AS TEXT) AS value " +
+		"FROM ItemTable WHERE key = 'composer.composerData'"
+)
+
+// Bubble types.
+const (
+	BubbleUser      = 1
+	BubbleAssistant = 2
+)
+
+// ToolStatusError marks a failed tool call.
+const ToolStatusError = "error"
@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package cursor centralizes constants for reading Cursor's
+// SQLite chat store.
+//
+// Cursor keeps agent (composer) conversations in the global
+// state.vscdb database under User/globalStorage/. The
+// cursorDiskKV table maps "composerData:" keys to a
+// conversation header ([QueryComposers]) and [KeyBubblePrefix]
+// keys to the individual messages, "bubbles" ([QueryBubbles]).
+// Which workspace a conversation belongs to is recorded per
+// workspace, in the "composer.composerData" entry of each
+// workspaceStorage database next to its workspace.json
+// ([QueryWorkspaceComposers]).
+//
+// The database is read through the sqlite3 command-line tool
+// with the queries defined here.
+package cursor
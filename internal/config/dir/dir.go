@@ -43,6 +43,12 @@ const (
 	Skills = "skills"
 	// Steering is the subdirectory for steering files within .context/.
 	Steering = "steering"
+	// Checks is the subdirectory for external drift check
+	// executables within .context/.
+	Checks = "checks"
+	// Snapshots is the subdirectory for the context snapshot store
+	// within .context/.
+	Snapshots = "snapshots"
 	// Specs is the project-root directory for formalized plans and feature specs.
 	Specs = "specs"
 	// State is the subdirectory for project-scoped runtime state within .context/.
@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import "time"
+
+// External check settings.
+const (
+	// DefaultCheckTimeout bounds one external check run when
+	// drift.timeout is unset.
+	DefaultCheckTimeout = 10 * time.Second
+)
+
+// Check sources shown by `ctx drift --list-checks`.
+const (
+	// SourceBuiltin marks a check compiled into ctx.
+	SourceBuiltin = "builtin"
+	// SourceExternal marks an executable in ChecksDir.
+	SourceExternal = "external"
+)
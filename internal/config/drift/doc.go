@@ -40,6 +40,18 @@
 //     executable permission bit
 //   - IssueStaleSyncFile: a synced tool-native file
 //     that is out of date versus its source
+//   - IssueUnresolvedLayer: an inherit entry in .ctxrc
+//     that could not be loaded
+//   - IssueCheckFailed: an external check that could
+//     not run or returned invalid output
+//   - IssueUnknownSymbol: a quoted code identifier that
+//     no longer exists in the repository
+//   - IssueSecretContent: a possible credential inside a
+//     context, journal, or scratchpad file
+//   - IssueConstitutionRule: a staged change that breaks
+//     a structured CONSTITUTION.md rule
+//   - IssueInvalidRules: a ctx-rules block that cannot
+//     be parsed
 //
 // # Status Types
 //
@@ -56,13 +68,46 @@
 // CheckConstitution, CheckRequiredFiles, CheckFileAge,
 // CheckEntryCount, CheckMissingPackages,
 // CheckTemplateHeaders, CheckSteeringTools,
-// CheckHookPerms, CheckSyncStaleness, and CheckRCTool.
+// CheckHookPerms, CheckSyncStaleness, CheckRCTool,
+// CheckLayers, CheckSymbols, CheckSecretContent,
+// CheckSecretArchive, and CheckConstitutionRules. These
+// are also the keys for per-check overrides under
+// drift.checks in .ctxrc.
+//
+// # Symbol Indexing
+//
+// The symbol_references check builds a repository
+// symbol table: Go via go/packages when [GoModFile]
+// exists, TypeScript and JavaScript ([SymbolExtTS]) and
+// Python ([SymbolExtPy]) via line matching, skipping
+// [SymbolSkipDirs]. Names ending in one of
+// [SymbolFileExts] are file names and never judged.
+// Suggestions are limited by [SuggestDivisor]. The
+// check is opt-in and only runs from ctx drift.
+//
+// # External Checks
+//
+// Executables in .context/checks/ run after the
+// built-in checks, only from an explicit ctx drift and
+// only when drift.external is set. Each is named by its file
+// name without extension, receives the context as JSON
+// on stdin, and prints a JSON array of issues. Runs are
+// bounded by [DefaultCheckTimeout] unless drift.timeout
+// is set. [SourceBuiltin] and [SourceExternal] label
+// where a check comes from in `ctx drift --list-checks`.
 //
 // # Constitution Rules
 //
 // RuleNoSecrets is the constitution rule name referenced
 // when a potential secret file triggers a violation.
 //
+// Structured rules live in a fenced [RulesFence] block in
+// CONSTITUTION.md and are evaluated against the staged
+// diff by ctx drift --staged. Violations carry
+// [RuleForbiddenImport], [RuleForbiddenPath],
+// [RuleRequireTests], [RuleMaxFileSize], or the banned
+// pattern's own name ([RuleBannedPattern] when unnamed).
+//
 // # Why Centralized
 //
 // These constants are shared between the drift scanner,
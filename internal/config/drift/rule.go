@@ -0,0 +1,65 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+// Structured constitution rules evaluated by ctx drift --staged.
+const (
+	// RulesFence is the info string of the fenced block in
+	// CONSTITUTION.md that holds the machine-checked rules.
+	RulesFence = "ctx-rules"
+	// GlobAny matches any number of path segments in a rule
+	// glob.
+	GlobAny = "**"
+	// GoTestSuffix marks a Go test file.
+	GoTestSuffix = "_test.go"
+	// StagedPathPrefix resolves an index path relative to the
+	// directory git runs in (":./path").
+	StagedPathPrefix = "./"
+)
+
+// Pre-commit hook installed by ctx drift hook enable.
+const (
+	// ScriptPreCommit is the embedded pre-commit script name.
+	ScriptPreCommit = "pre-commit.sh"
+	// HookMarker identifies a pre-commit hook installed by ctx;
+	// only hooks containing it are replaced or removed.
+	HookMarker = "ctx drift --staged"
+	// ActionEnable installs the pre-commit hook.
+	ActionEnable = "enable"
+	// ActionDisable removes the pre-commit hook.
+	ActionDisable = "disable"
+)
+
+// Rule names reported on staged-change violations. Banned
+// patterns report their own name, or RuleBannedPattern when
+// unnamed.
+const (
+	// RuleForbiddenImport flags a newly added import that a
+	// forbidden_imports entry disallows.
+	RuleForbiddenImport = "forbidden_import"
+	// RuleForbiddenPath flags a staged file under a
+	// forbidden_paths glob.
+	RuleForbiddenPath = "forbidden_path"
+	// RuleRequireTests flags a new Go package staged without a
+	// test file.
+	RuleRequireTests = "require_tests"
+	// RuleMaxFileSize flags a staged file larger than
+	// max_file_size bytes.
+	RuleMaxFileSize = "max_file_size"
+	// RuleBannedPattern names an unnamed banned_patterns match.
+	RuleBannedPattern = "banned_pattern"
+)
+
+// Unified diff markers read from git diff --cached -U0.
+const (
+	// DiffFile starts the header of each file in the diff.
+	DiffFile = "diff --git "
+	// DiffNewFile prefixes the post-image path line.
+	DiffNewFile = "+++ b/"
+	// DiffAdded prefixes an added line.
+	DiffAdded = "+"
+)
@@ -0,0 +1,68 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+// Symbol indexing settings for the symbol_references check.
+const (
+	// GoModFile marks a Go module root; the Go indexer only runs
+	// when the project root holds one.
+	GoModFile = "go.mod"
+	// GoPackagesPattern loads every package under the project root.
+	GoPackagesPattern = "./..."
+	// StdPattern loads the standard library package names, which
+	// are skipped as qualifiers because they shadow repo packages.
+	StdPattern = "std"
+	// SymbolSep joins a qualifier and a member name.
+	SymbolSep = "."
+	// SuggestDivisor bounds how far a suggestion may be from the
+	// unresolved name: edit distance at most the longer length
+	// divided by this value.
+	SuggestDivisor = 2
+	// ClassEnd is the closing line of a top-level TypeScript or
+	// JavaScript class body.
+	ClassEnd = "}"
+	// ModuleIndex is the TypeScript and JavaScript directory
+	// entry file stem; its module takes the directory name.
+	ModuleIndex = "index"
+	// ModuleInit is the Python package file stem; its module
+	// takes the directory name.
+	ModuleInit = "__init__"
+)
+
+// SymbolExtTS lists the file extensions scanned by the
+// TypeScript and JavaScript indexer.
+var SymbolExtTS = []string{".ts", ".tsx", ".js", ".jsx", ".mjs"}
+
+// SymbolExtPy lists the file extensions scanned by the Python
+// indexer.
+var SymbolExtPy = []string{".py"}
+
+// SymbolSkipDirs lists directories the text indexers never
+// descend into, in addition to hidden directories.
+var SymbolSkipDirs = map[string]bool{
+	"build": true, "dist": true, "node_modules": true,
+	"vendor": true, "__pycache__": true, "testdata": true,
+}
+
+// SymbolFileExts lists file extensions that, as the last part of
+// a dotted name, mark a file name (`zensical.toml`) rather than a
+// code identifier.
+var SymbolFileExts = map[string]bool{
+	"cfg": true, "conf": true, "css": true, "csv": true, "enc": true,
+	"env": true, "go": true, "html": true, "ini": true, "js": true,
+	"json": true, "key": true, "lock": true, "md": true, "mod": true,
+	"py": true, "sh": true, "sql": true, "sum": true, "toml": true,
+	"ts": true, "txt": true, "xml": true, "yaml": true, "yml": true,
+}
+
+// SymbolKeywords lists words that look like method declarations
+// in the TypeScript indexer but are control-flow keywords.
+var SymbolKeywords = map[string]bool{
+	"if": true, "for": true, "while": true, "switch": true,
+	"catch": true, "return": true, "function": true,
+	"constructor": true,
+}
@@ -45,6 +45,24 @@ const (
 	// IssueStaleSyncFile indicates a synced tool-native
 	// file that is out of date compared to its source.
 	IssueStaleSyncFile IssueType = "stale_sync_file"
+	// IssueUnresolvedLayer indicates an inherit entry in
+	// .ctxrc that could not be loaded.
+	IssueUnresolvedLayer IssueType = "unresolved_layer"
+	// IssueCheckFailed indicates an external check that
+	// could not run or returned invalid output.
+	IssueCheckFailed IssueType = "check_failed"
+	// IssueUnknownSymbol indicates a code identifier in a
+	// context file that no longer exists in the repository.
+	IssueUnknownSymbol IssueType = "unknown_symbol"
+	// IssueSecretContent indicates a possible credential
+	// inside a context, journal, or scratchpad file.
+	IssueSecretContent IssueType = "secret_content"
+	// IssueConstitutionRule indicates a staged change that
+	// breaks a structured rule in CONSTITUTION.md.
+	IssueConstitutionRule IssueType = "constitution_rule"
+	// IssueInvalidRules indicates a ctx-rules block in
+	// CONSTITUTION.md that cannot be parsed.
+	IssueInvalidRules IssueType = "invalid_rules"
 )
 
 // StatusType represents the overall status of a drift
@@ -102,6 +120,22 @@ const (
 	// CheckRCTool validates the .ctxrc tool field against
 	// supported identifiers.
 	CheckRCTool CheckName = "rc_tool_field"
+	// CheckLayers verifies every inherited context layer
+	// could be loaded.
+	CheckLayers CheckName = "context_layers"
+	// CheckSymbols resolves code identifiers in context
+	// files against the repository's symbol table.
+	CheckSymbols CheckName = "symbol_references"
+	// CheckSecretContent scans context file content for
+	// credentials.
+	CheckSecretContent CheckName = "secret_content"
+	// CheckSecretArchive scans exported journal entries and
+	// the plaintext scratchpad for credentials.
+	CheckSecretArchive CheckName = "secret_archive"
+	// CheckConstitutionRules validates the structured rule
+	// block in CONSTITUTION.md; with --staged the rules are
+	// evaluated against the staged diff.
+	CheckConstitutionRules CheckName = "constitution_rules"
 )
 
 // Constitution rule names referenced in drift violations.
@@ -22,6 +22,9 @@ const (
 	UseDoctor = "doctor"
 	// UseDrift is the cobra Use string for the drift command.
 	UseDrift = "drift"
+	// UseDriftHook is the cobra Use string for the drift hook
+	// command.
+	UseDriftHook = "hook <enable|disable>"
 	// UseComplete is the cobra Use string for the complete command.
 	UseComplete = "complete <task-id-or-text>"
 	// UseFmt is the cobra Use string for the fmt command.
@@ -56,6 +59,8 @@ const (
 	UseResume = "resume"
 	// UseServe is the cobra Use string for the serve command.
 	UseServe = "serve [directory]"
+	// UseSnapshot is the cobra Use string for the snapshot command.
+	UseSnapshot = "snapshot"
 	// UseStatus is the cobra Use string for the status command.
 	UseStatus = "status"
 	// UseSync is the cobra Use string for the sync command.
@@ -90,6 +95,9 @@ const (
 	DescKeyDoctor = "doctor"
 	// DescKeyDrift is the description key for the drift command.
 	DescKeyDrift = "drift"
+	// DescKeyDriftHook is the description key for the drift hook
+	// command.
+	DescKeyDriftHook = "drift.hook"
 	// DescKeyFmt is the description key for the fmt command.
 	DescKeyFmt = "fmt"
 	// DescKeySetup is the description key for the setup command.
@@ -8,10 +8,19 @@ package cmd
 
 // Use strings for journal source subcommands.
 const (
+	// UseJournalHarvest is the cobra Use string for the journal harvest
+	// command.
+	UseJournalHarvest = "harvest [session-id]"
 	// UseJournalImport is the cobra Use string for the journal import command.
 	UseJournalImport = "import [session-id]"
 	// UseJournalLock is the cobra Use string for the journal lock command.
 	UseJournalLock = "lock <pattern>"
+	// UseJournalSearch is the cobra Use string for the journal search
+	// command.
+	UseJournalSearch = "search <query>"
+	// UseJournalStats is the cobra Use string for the journal stats
+	// command.
+	UseJournalStats = "stats"
 	// UseJournalSync is the cobra Use string for the journal sync command.
 	UseJournalSync = "sync"
 	// UseJournalUnlock is the cobra Use string for the journal unlock command.
@@ -20,10 +29,19 @@ const (
 
 // DescKeys for journal source subcommands.
 const (
+	// DescKeyJournalHarvest is the description key for the journal
+	// harvest command.
+	DescKeyJournalHarvest = "journal.harvest"
 	// DescKeyJournalImport is the description key for the journal import command.
 	DescKeyJournalImport = "journal.import"
 	// DescKeyJournalLock is the description key for the journal lock command.
 	DescKeyJournalLock = "journal.lock"
+	// DescKeyJournalSearch is the description key for the journal search
+	// command.
+	DescKeyJournalSearch = "journal.search"
+	// DescKeyJournalStats is the description key for the journal stats
+	// command.
+	DescKeyJournalStats = "journal.stats"
 	// DescKeyJournalSync is the description key for the journal sync command.
 	DescKeyJournalSync = "journal.sync"
 	// DescKeyJournalUnlock is the description key for the journal unlock command.
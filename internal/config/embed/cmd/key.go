@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for key management commands.
+const (
+	// UseKey is the cobra Use string for the key command.
+	UseKey = "key"
+	// UseKeyRotate is the cobra Use string for the key rotate command.
+	UseKeyRotate = "rotate"
+)
+
+// DescKeys for key management commands.
+const (
+	// DescKeyKey is the description key for the key command.
+	DescKeyKey = "key"
+	// DescKeyKeyRotate is the description key for the key rotate command.
+	DescKeyKeyRotate = "key.rotate"
+)
@@ -12,6 +12,10 @@ const (
 	UseNotifySetup = "setup"
 	// UseNotifyTest is the cobra Use string for the notify test command.
 	UseNotifyTest = "test"
+	// UseNotifyFlush is the cobra Use string for the notify flush command.
+	UseNotifyFlush = "flush"
+	// UseNotifyLog is the cobra Use string for the notify log command.
+	UseNotifyLog = "log"
 )
 
 // DescKeys for notify subcommands.
@@ -22,4 +26,8 @@ const (
 	DescKeyNotifySetup = "notify.setup"
 	// DescKeyNotifyTest is the description key for the notify test command.
 	DescKeyNotifyTest = "notify.test"
+	// DescKeyNotifyFlush is the description key for the notify flush command.
+	DescKeyNotifyFlush = "notify.flush"
+	// DescKeyNotifyLog is the description key for the notify log command.
+	DescKeyNotifyLog = "notify.log"
 )
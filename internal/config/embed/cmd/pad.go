@@ -14,6 +14,14 @@ const (
 	UsePadEdit = "edit N [TEXT]"
 	// UsePadExport is the cobra Use string for the pad export command.
 	UsePadExport = "export [DIR]"
+	// UsePadGc is the cobra Use string for the pad gc command.
+	UsePadGc = "gc"
+	// UsePadGitMergeDriver is the cobra Use string for the pad
+	// git-merge-driver command.
+	UsePadGitMergeDriver = "git-merge-driver BASE OURS THEIRS"
+	// UsePadGitTextconv is the cobra Use string for the pad
+	// git-textconv command.
+	UsePadGitTextconv = "git-textconv FILE"
 	// UsePadImport is the cobra Use string for the pad import command.
 	UsePadImport = "import FILE"
 	// UsePadMerge is the cobra Use string for the pad merge command.
@@ -22,6 +30,21 @@ const (
 	UsePadMv = "mv N M"
 	// UsePadNormalize is the cobra Use string for pad normalize.
 	UsePadNormalize = "normalize"
+	// UsePadRecipients is the cobra Use string for the pad recipients
+	// command.
+	UsePadRecipients = "recipients"
+	// UsePadRecipientsAdd is the cobra Use string for the pad recipients
+	// add command.
+	UsePadRecipientsAdd = "add PUBLIC-KEY"
+	// UsePadRecipientsList is the cobra Use string for the pad recipients
+	// list command.
+	UsePadRecipientsList = "list"
+	// UsePadRecipientsRemove is the cobra Use string for the pad
+	// recipients remove command.
+	UsePadRecipientsRemove = "remove KEY|NAME"
+	// UsePadRecipientsRemoveAlias is the cobra alias for the pad
+	// recipients remove command.
+	UsePadRecipientsRemoveAlias = "rm"
 	// UsePadResolve is the cobra Use string for the pad resolve command.
 	UsePadResolve = "resolve"
 	// UsePadRm is the cobra Use string for the pad rm command.
@@ -46,10 +69,30 @@ const (
 	DescKeyPadImp = "pad.root"
 	// DescKeyPadMerge is the description key for the pad merge command.
 	DescKeyPadMerge = "pad.merge"
+	// DescKeyPadGc is the description key for the pad gc command.
+	DescKeyPadGc = "pad.gc"
+	// DescKeyPadGitMergeDriver is the description key for the pad
+	// git-merge-driver command.
+	DescKeyPadGitMergeDriver = "pad.git-merge-driver"
+	// DescKeyPadGitTextconv is the description key for the pad
+	// git-textconv command.
+	DescKeyPadGitTextconv = "pad.git-textconv"
 	// DescKeyPadMv is the description key for the pad mv command.
 	DescKeyPadMv = "pad.mv"
 	// DescKeyPadNormalize is the description key for pad normalize.
 	DescKeyPadNormalize = "pad.normalize"
+	// DescKeyPadRecipients is the description key for the pad recipients
+	// command.
+	DescKeyPadRecipients = "pad.recipients"
+	// DescKeyPadRecipientsAdd is the description key for the pad
+	// recipients add command.
+	DescKeyPadRecipientsAdd = "pad.recipients.add"
+	// DescKeyPadRecipientsList is the description key for the pad
+	// recipients list command.
+	DescKeyPadRecipientsList = "pad.recipients.list"
+	// DescKeyPadRecipientsRemove is the description key for the pad
+	// recipients remove command.
+	DescKeyPadRecipientsRemove = "pad.recipients.remove"
 	// DescKeyPadResolve is the description key for the pad resolve command.
 	DescKeyPadResolve = "pad.resolve"
 	// DescKeyPadRm is the description key for the pad rm command.
@@ -0,0 +1,47 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+// Use strings for snapshot subcommands.
+const (
+	// UseSnapshotCreate is the cobra Use string for the snapshot create
+	// command.
+	UseSnapshotCreate = "create [NAME]"
+	// UseSnapshotDiff is the cobra Use string for the snapshot diff command.
+	UseSnapshotDiff = "diff SNAPSHOT [SNAPSHOT]"
+	// UseSnapshotList is the cobra Use string for the snapshot list command.
+	UseSnapshotList = "list"
+	// UseSnapshotListAlias is the cobra Use string for the snapshot list
+	// alias.
+	UseSnapshotListAlias = "ls"
+	// UseSnapshotRestore is the cobra Use string for the snapshot restore
+	// command.
+	UseSnapshotRestore = "restore SNAPSHOT"
+	// UseSnapshotShow is the cobra Use string for the snapshot show command.
+	UseSnapshotShow = "show SNAPSHOT [FILE]"
+)
+
+// DescKeys for snapshot subcommands.
+const (
+	// DescKeySnapshot is the description key for the snapshot command.
+	DescKeySnapshot = "snapshot"
+	// DescKeySnapshotCreate is the description key for the snapshot create
+	// command.
+	DescKeySnapshotCreate = "snapshot.create"
+	// DescKeySnapshotDiff is the description key for the snapshot diff
+	// command.
+	DescKeySnapshotDiff = "snapshot.diff"
+	// DescKeySnapshotList is the description key for the snapshot list
+	// command.
+	DescKeySnapshotList = "snapshot.list"
+	// DescKeySnapshotRestore is the description key for the snapshot restore
+	// command.
+	DescKeySnapshotRestore = "snapshot.restore"
+	// DescKeySnapshotShow is the description key for the snapshot show
+	// command.
+	DescKeySnapshotShow = "snapshot.show"
+)
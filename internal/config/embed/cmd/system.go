@@ -49,6 +49,9 @@ const (
 	// UseSystemCheckMemoryDrift is the cobra Use string for the system check
 	// memory drift command.
 	UseSystemCheckMemoryDrift = "check-memory-drift"
+	// UseSystemCheckPadExpiry is the cobra Use string for the system check
+	// pad expiry command.
+	UseSystemCheckPadExpiry = "check-pad-expiry"
 	// UseSystemCheckPersistence is the cobra Use string for the system check
 	// persistence command.
 	UseSystemCheckPersistence = "check-persistence"
@@ -72,6 +75,9 @@ const (
 	UseSystemContextLoadGate = "context-load-gate"
 	// UseSystemHeartbeat is the cobra Use string for the system heartbeat command.
 	UseSystemHeartbeat = "heartbeat"
+	// UseSystemNotifyFlush is the cobra Use string for the system notify
+	// flush command.
+	UseSystemNotifyFlush = "notify-flush"
 	// UseSystemMarkJournal is the cobra Use string for the system mark journal
 	// command.
 	UseSystemMarkJournal = "mark-journal <filename> <stage>"
@@ -133,6 +139,9 @@ const (
 	// DescKeySystemCheckMemoryDrift is the description key for the system check
 	// memory drift command.
 	DescKeySystemCheckMemoryDrift = "system.checkmemorydrift"
+	// DescKeySystemCheckPadExpiry is the description key for the system check
+	// pad expiry command.
+	DescKeySystemCheckPadExpiry = "system.checkpadexpiry"
 	// DescKeySystemCheckPersistence is the description key for the system check
 	// persistence command.
 	DescKeySystemCheckPersistence = "system.checkpersistence"
@@ -157,6 +166,9 @@ const (
 	// DescKeySystemHeartbeat is the description key for the system heartbeat
 	// command.
 	DescKeySystemHeartbeat = "system.heartbeat"
+	// DescKeySystemNotifyFlush is the description key for the system notify
+	// flush command.
+	DescKeySystemNotifyFlush = "system.notifyflush"
 	// DescKeySystemMarkJournal is the description key for the system mark journal
 	// command.
 	DescKeySystemMarkJournal = "system.markjournal"
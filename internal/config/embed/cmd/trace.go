@@ -12,12 +12,27 @@ const (
 	UseTrace = "trace [commit]"
 	// UseTraceFile is the cobra Use string for the trace file command.
 	UseTraceFile = "file <path[:line-range]>"
+	// UseTraceBlame is the cobra Use string for the trace blame command.
+	UseTraceBlame = "blame <path[:line-range]>"
+	// UseTraceSync is the cobra Use string for the trace sync command.
+	UseTraceSync = "sync"
 	// UseTraceTag is the cobra Use string for the trace tag command.
 	UseTraceTag = "tag <commit>"
 	// UseTraceCollect is the cobra Use string for the trace collect command.
 	UseTraceCollect = "collect"
 	// UseTraceHook is the cobra Use string for the trace hook command.
 	UseTraceHook = "hook <enable|disable>"
+	// UseTraceNotes is the cobra Use string for the trace notes command.
+	UseTraceNotes = "notes"
+	// UseTraceNotesPush is the cobra Use string for the trace notes push
+	// command.
+	UseTraceNotesPush = "push [remote]"
+	// UseTraceNotesFetch is the cobra Use string for the trace notes fetch
+	// command.
+	UseTraceNotesFetch = "fetch [remote]"
+	// UseTraceNotesMigrate is the cobra Use string for the trace notes
+	// migrate command.
+	UseTraceNotesMigrate = "migrate"
 )
 
 // DescKeys for trace subcommands.
@@ -26,10 +41,25 @@ const (
 	DescKeyTrace = "trace"
 	// DescKeyTraceFile is the description key for the trace file command.
 	DescKeyTraceFile = "trace.file"
+	// DescKeyTraceBlame is the description key for the trace blame command.
+	DescKeyTraceBlame = "trace.blame"
+	// DescKeyTraceSync is the description key for the trace sync command.
+	DescKeyTraceSync = "trace.sync"
 	// DescKeyTraceTag is the description key for the trace tag command.
 	DescKeyTraceTag = "trace.tag"
 	// DescKeyTraceCollect is the description key for the trace collect command.
 	DescKeyTraceCollect = "trace.collect"
 	// DescKeyTraceHook is the description key for the trace hook command.
 	DescKeyTraceHook = "trace.hook"
+	// DescKeyTraceNotes is the description key for the trace notes command.
+	DescKeyTraceNotes = "trace.notes"
+	// DescKeyTraceNotesPush is the description key for the trace notes
+	// push command.
+	DescKeyTraceNotesPush = "trace.notes.push"
+	// DescKeyTraceNotesFetch is the description key for the trace notes
+	// fetch command.
+	DescKeyTraceNotesFetch = "trace.notes.fetch"
+	// DescKeyTraceNotesMigrate is the description key for the trace notes
+	// migrate command.
+	DescKeyTraceNotesMigrate = "trace.notes.migrate"
 )
@@ -26,6 +26,8 @@ const (
 	DescKeyAddPriority = "add.priority"
 	// DescKeyAddRationale is the description key for the add rationale flag.
 	DescKeyAddRationale = "add.rationale"
+	// DescKeyAddRedact is the description key for the add redact flag.
+	DescKeyAddRedact = "add.redact"
 	// DescKeyAddSection is the description key for the add section flag.
 	DescKeyAddSection = "add.section"
 	// DescKeyAddSessionID is the description key for the add session-id flag.
@@ -8,6 +8,8 @@ package flag
 
 // DescKeys for agent command flags.
 const (
+	// DescKeyAgentAt is the description key for the agent at flag.
+	DescKeyAgentAt = "agent.at"
 	// DescKeyAgentBudget is the description key for the agent budget flag.
 	DescKeyAgentBudget = "agent.budget"
 	// DescKeyAgentCooldown is the description key for the agent cooldown flag.
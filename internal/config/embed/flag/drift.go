@@ -8,8 +8,17 @@ package flag
 
 // DescKeys for drift command flags.
 const (
+	// DescKeyDriftDryRun is the description key for the drift dry-run
+	// flag.
+	DescKeyDriftDryRun = "drift.dry-run"
 	// DescKeyDriftFix is the description key for the drift fix flag.
 	DescKeyDriftFix = "drift.fix"
 	// DescKeyDriftJson is the description key for the drift json flag.
 	DescKeyDriftJson = "drift.json"
+	// DescKeyDriftListChecks is the description key for the drift
+	// list-checks flag.
+	DescKeyDriftListChecks = "drift.list-checks"
+	// DescKeyDriftStaged is the description key for the drift staged
+	// flag.
+	DescKeyDriftStaged = "drift.staged"
 )
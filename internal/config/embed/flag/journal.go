@@ -22,6 +22,66 @@ const (
 	DescKeyJournalSiteServe = "journal.site.serve"
 )
 
+// DescKeys for journal harvest flags.
+const (
+	// DescKeyJournalHarvestAllProjects is the description key for the
+	// journal harvest all-projects flag.
+	DescKeyJournalHarvestAllProjects = "journal.harvest.all-projects"
+	// DescKeyJournalHarvestBranch is the description key for the
+	// journal harvest branch flag.
+	DescKeyJournalHarvestBranch = "journal.harvest.branch"
+	// DescKeyJournalHarvestCommit is the description key for the
+	// journal harvest commit flag.
+	DescKeyJournalHarvestCommit = "journal.harvest.commit"
+	// DescKeyJournalHarvestDryRun is the description key for the
+	// journal harvest dry-run flag.
+	DescKeyJournalHarvestDryRun = "journal.harvest.dry-run"
+	// DescKeyJournalHarvestLatest is the description key for the
+	// journal harvest latest flag.
+	DescKeyJournalHarvestLatest = "journal.harvest.latest"
+	// DescKeyJournalHarvestSection is the description key for the
+	// journal harvest section flag.
+	DescKeyJournalHarvestSection = "journal.harvest.section"
+	// DescKeyJournalHarvestYes is the description key for the
+	// journal harvest yes flag.
+	DescKeyJournalHarvestYes = "journal.harvest.yes"
+)
+
+// DescKeys for journal search flags.
+const (
+	// DescKeyJournalSearchContext is the description key for the journal
+	// search context flag.
+	DescKeyJournalSearchContext = "journal.search.context"
+	// DescKeyJournalSearchJSON is the description key for the journal
+	// search json flag.
+	DescKeyJournalSearchJSON = "journal.search.json"
+	// DescKeyJournalSearchLimit is the description key for the journal
+	// search limit flag.
+	DescKeyJournalSearchLimit = "journal.search.limit"
+)
+
+// DescKeys for journal stats flags.
+const (
+	// DescKeyJournalStatsAllProjects is the description key for the
+	// journal stats all-projects flag.
+	DescKeyJournalStatsAllProjects = "journal.stats.all-projects"
+	// DescKeyJournalStatsBy is the description key for the journal
+	// stats by flag.
+	DescKeyJournalStatsBy = "journal.stats.by"
+	// DescKeyJournalStatsFormat is the description key for the journal
+	// stats format flag.
+	DescKeyJournalStatsFormat = "journal.stats.format"
+	// DescKeyJournalStatsSince is the description key for the journal
+	// stats since flag.
+	DescKeyJournalStatsSince = "journal.stats.since"
+	// DescKeyJournalStatsUntil is the description key for the journal
+	// stats until flag.
+	DescKeyJournalStatsUntil = "journal.stats.until"
+	// DescKeyJournalSiteStats is the description key for the journal
+	// site stats flag.
+	DescKeyJournalSiteStats = "journal.site.stats"
+)
+
 // DescKeys for journal source flags.
 const (
 	// DescKeyJournalSourceAllProjects is the description key for the journal
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for key command flags.
+const (
+	// DescKeyKeyRotateProject is the description key for the key rotate
+	// project flag.
+	DescKeyKeyRotateProject = "key.rotate.project"
+	// DescKeyKeyRotateResume is the description key for the key rotate
+	// resume flag.
+	DescKeyKeyRotateResume = "key.rotate.resume"
+	// DescKeyKeyRotateRollback is the description key for the key rotate
+	// rollback flag.
+	DescKeyKeyRotateRollback = "key.rotate.rollback"
+)
@@ -8,6 +8,8 @@ package flag
 
 // DescKeys for load command flags.
 const (
+	// DescKeyLoadAt is the description key for the load at flag.
+	DescKeyLoadAt = "load.at"
 	// DescKeyLoadBudget is the description key for the load budget flag.
 	DescKeyLoadBudget = "load.budget"
 	// DescKeyLoadRaw is the description key for the load raw flag.
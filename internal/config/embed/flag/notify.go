@@ -17,4 +17,16 @@ const (
 	DescKeyNotifySessionId = "notify.session-id"
 	// DescKeyNotifyVariant is the description key for the notify variant flag.
 	DescKeyNotifyVariant = "notify.variant"
+	// DescKeyNotifySetupChannel is the description key for the notify setup
+	// channel flag.
+	DescKeyNotifySetupChannel = "notify.setup.channel"
+	// DescKeyNotifyTestChannel is the description key for the notify test
+	// channel flag.
+	DescKeyNotifyTestChannel = "notify.test.channel"
+	// DescKeyNotifyLogLast is the description key for the notify log last
+	// flag.
+	DescKeyNotifyLogLast = "notify.log.last"
+	// DescKeyNotifyLogJson is the description key for the notify log json
+	// flag.
+	DescKeyNotifyLogJson = "notify.log.json"
 )
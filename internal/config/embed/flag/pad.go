@@ -10,6 +10,15 @@ package flag
 const (
 	// DescKeyPadAddFile is the description key for the pad add file flag.
 	DescKeyPadAddFile = "pad.add.file"
+	// DescKeyPadAddCategory is the description key for the pad add
+	// category flag.
+	DescKeyPadAddCategory = "pad.add.category"
+	// DescKeyPadAddExpires is the description key for the pad add
+	// expires flag.
+	DescKeyPadAddExpires = "pad.add.expires"
+	// DescKeyPadCategory is the description key for the pad category
+	// filter flag.
+	DescKeyPadCategory = "pad.category"
 	// DescKeyPadEditAppend is the description key for the pad edit append flag.
 	DescKeyPadEditAppend = "pad.edit.append"
 	// DescKeyPadEditFile is the description key for the pad edit file flag.
@@ -23,12 +32,23 @@ const (
 	DescKeyPadExportDryRun = "pad.export.dry-run"
 	// DescKeyPadExportForce is the description key for the pad export force flag.
 	DescKeyPadExportForce = "pad.export.force"
+	// DescKeyPadGcDryRun is the description key for the pad gc dry run
+	// flag.
+	DescKeyPadGcDryRun = "pad.gc.dry-run"
+	// DescKeyPadGcMark is the description key for the pad gc mark flag.
+	DescKeyPadGcMark = "pad.gc.mark"
 	// DescKeyPadImpBlob is the description key for the pad imp blob flag.
 	DescKeyPadImpBlob = "pad.root.blob"
 	// DescKeyPadMergeDryRun is the description key for the pad merge dry run flag.
 	DescKeyPadMergeDryRun = "pad.merge.dry-run"
 	// DescKeyPadMergeKey is the description key for the pad merge key flag.
 	DescKeyPadMergeKey = "pad.merge.key"
+	// DescKeyPadRecipientsAddName is the description key for the pad
+	// recipients add name flag.
+	DescKeyPadRecipientsAddName = "pad.recipients.add.name"
+	// DescKeyPadRecipientsRemoveForce is the description key for the
+	// pad recipients remove force flag.
+	DescKeyPadRecipientsRemoveForce = "pad.recipients.remove.force"
 	// DescKeyPadShowOut is the description key for the pad show out flag.
 	DescKeyPadShowOut = "pad.show.out"
 	// DescKeyPadEditTag is the description key for the pad edit tag flag.
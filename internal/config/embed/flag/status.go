@@ -10,6 +10,10 @@ package flag
 const (
 	// DescKeyStatusJson is the description key for the status json flag.
 	DescKeyStatusJson = "status.json"
+	// DescKeyStatusTrend is the description key for the status trend flag.
+	DescKeyStatusTrend = "status.trend"
 	// DescKeyStatusVerbose is the description key for the status verbose flag.
 	DescKeyStatusVerbose = "status.verbose"
+	// DescKeyStatusWeeks is the description key for the status weeks flag.
+	DescKeyStatusWeeks = "status.weeks"
 )
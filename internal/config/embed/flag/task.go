@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package flag
+
+// DescKeys for task command flags.
+const (
+	// DescKeyTaskGraphFormat is the description key for the task graph
+	// format flag.
+	DescKeyTaskGraphFormat = "task.graph.format"
+)
@@ -14,8 +14,17 @@ const (
 	DescKeyTraceJSON = "trace.json"
 	// DescKeyTraceFileLast is the description key for the trace file last flag.
 	DescKeyTraceFileLast = "trace.file.last"
+	// DescKeyTraceSyncLast is the description key for the trace sync last
+	// flag.
+	DescKeyTraceSyncLast = "trace.sync.last"
+	// DescKeyTraceSyncRefresh is the description key for the trace sync
+	// refresh flag.
+	DescKeyTraceSyncRefresh = "trace.sync.refresh"
 	// DescKeyTraceTagNote is the description key for the trace tag note flag.
 	DescKeyTraceTagNote = "trace.tag.note"
+	// DescKeyTraceBlameJSON is the description key for the trace blame json
+	// flag.
+	DescKeyTraceBlameJSON = "trace.blame.json"
 	// DescKeyTraceCollectRecord is the description key for the trace collect
 	// record flag.
 	DescKeyTraceCollectRecord = "trace.collect.record"
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for scratchpad expiry checks.
+const (
+	// DescKeyCheckPadExpiryBoxTitle is the text key for check pad expiry
+	// box title messages.
+	DescKeyCheckPadExpiryBoxTitle = "check-pad-expiry.box-title"
+	// DescKeyCheckPadExpiryGcHint is the text key for check pad expiry
+	// gc hint messages.
+	DescKeyCheckPadExpiryGcHint = "check-pad-expiry.gc-hint"
+	// DescKeyCheckPadExpiryItemFormat is the text key for check pad
+	// expiry item format messages.
+	DescKeyCheckPadExpiryItemFormat = "check-pad-expiry.item-format"
+	// DescKeyCheckPadExpiryRelayFormat is the text key for check pad
+	// expiry relay format messages.
+	DescKeyCheckPadExpiryRelayFormat = "check-pad-expiry.relay-format"
+	// DescKeyCheckPadExpiryRelayPrefix is the text key for check pad
+	// expiry relay prefix messages.
+	DescKeyCheckPadExpiryRelayPrefix = "check-pad-expiry.relay-prefix"
+)
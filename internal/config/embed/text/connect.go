@@ -20,6 +20,9 @@ const (
 	// DescKeyWriteConnectPublished is the format string for
 	// publish entry count.
 	DescKeyWriteConnectPublished = "write.connect-published"
+	// DescKeyWriteConnectRedacted is the format string for the
+	// count of values redacted before publishing.
+	DescKeyWriteConnectRedacted = "write.connect-redacted"
 	// DescKeyWriteConnectListening is the message shown when
 	// entering listen mode.
 	DescKeyWriteConnectListening = "write.connect-listening"
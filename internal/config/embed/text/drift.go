@@ -21,6 +21,63 @@ const (
 	DescKeyDriftMissingPackage = "drift.missing-package"
 	// DescKeyDriftSecret is the text key for drift secret messages.
 	DescKeyDriftSecret = "drift.secret"
+	// DescKeyDriftCheckFailed is the text key for external drift
+	// check failure messages.
+	DescKeyDriftCheckFailed = "drift.check-failed"
+	// DescKeyDriftChecksHeading is the text key for the drift
+	// --list-checks heading.
+	DescKeyDriftChecksHeading = "drift.checks-heading"
+	// DescKeyDriftChecksLine is the text key for one drift
+	// --list-checks row.
+	DescKeyDriftChecksLine = "drift.checks-line"
+	// DescKeyDriftChecksEnabled is the text key for an enabled
+	// check state.
+	DescKeyDriftChecksEnabled = "drift.checks-enabled"
+	// DescKeyDriftChecksDisabled is the text key for a disabled
+	// check state.
+	DescKeyDriftChecksDisabled = "drift.checks-disabled"
+	// DescKeyDriftChecksSeverity is the text key for a check's
+	// severity override suffix.
+	DescKeyDriftChecksSeverity = "drift.checks-severity"
+	// DescKeyDriftUnknownSymbol is the text key for unresolved
+	// code identifier messages.
+	DescKeyDriftUnknownSymbol = "drift.unknown-symbol"
+	// DescKeyDriftUnknownSymbolSuggest is the text key for
+	// unresolved code identifier messages with a suggested rename.
+	DescKeyDriftUnknownSymbolSuggest = "drift.unknown-symbol-suggest"
+	// DescKeyDriftSecretContent is the text key for credential
+	// findings inside file content.
+	DescKeyDriftSecretContent = "drift.secret-content"
+	// DescKeyDriftCheckSecretContent is the text key for the
+	// secret content check label.
+	DescKeyDriftCheckSecretContent = "drift.check-secret-content"
+	// DescKeyDriftCheckSecretArchive is the text key for the
+	// journal and scratchpad secret check label.
+	DescKeyDriftCheckSecretArchive = "drift.check-secret-archive"
+	// DescKeyDriftCheckConstitutionRules is the text key for the
+	// constitution rule block check label.
+	DescKeyDriftCheckConstitutionRules = "drift.check-constitution-rules"
+	// DescKeyDriftRuleForbiddenPath is the text key for a staged
+	// file under a forbidden path.
+	DescKeyDriftRuleForbiddenPath = "drift.rule-forbidden-path"
+	// DescKeyDriftRuleForbiddenImport is the text key for an added
+	// forbidden import.
+	DescKeyDriftRuleForbiddenImport = "drift.rule-forbidden-import"
+	// DescKeyDriftRuleMaxFileSize is the text key for a staged file
+	// over the size limit.
+	DescKeyDriftRuleMaxFileSize = "drift.rule-max-file-size"
+	// DescKeyDriftRuleRequireTests is the text key for a new Go
+	// package staged without tests.
+	DescKeyDriftRuleRequireTests = "drift.rule-require-tests"
+	// DescKeyDriftRuleBannedPattern is the text key for an added
+	// line matching a banned pattern.
+	DescKeyDriftRuleBannedPattern = "drift.rule-banned-pattern"
+	// DescKeyDriftHookEnabled is the text key for the pre-commit
+	// hook install confirmation.
+	DescKeyDriftHookEnabled = "drift.hook-enabled"
+	// DescKeyDriftHookDisabled is the text key for the pre-commit
+	// hook removal confirmation.
+	DescKeyDriftHookDisabled = "drift.hook-disabled"
 	// DescKeyDriftStaleAge is the text key for drift stale age messages.
 	DescKeyDriftStaleAge = "drift.stale-age"
 	// DescKeyDriftStaleness is the text key for drift staleness messages.
@@ -49,6 +106,27 @@ const (
 	DescKeyDriftFixMissingErr = "drift.fix-missing-err"
 	// DescKeyDriftSkipDeadPath is the text key for drift skip dead path messages.
 	DescKeyDriftSkipDeadPath = "drift.skip-dead-path"
+	// DescKeyDriftSkipDeadPathAmbiguous is the text key for drift skip dead
+	// path ambiguous messages.
+	DescKeyDriftSkipDeadPathAmbiguous = "drift.skip-dead-path-ambiguous"
+	// DescKeyDriftSkipDryRun is the text key for drift skip dry run
+	// messages.
+	DescKeyDriftSkipDryRun = "drift.skip-dry-run"
+	// DescKeyDriftFixDeadPath is the text key for drift fix dead path
+	// messages.
+	DescKeyDriftFixDeadPath = "drift.fix-dead-path"
+	// DescKeyDriftFixDeadPathErr is the text key for drift fix dead path
+	// err messages.
+	DescKeyDriftFixDeadPathErr = "drift.fix-dead-path-err"
+	// DescKeyDriftRenamePrompt is the text key for the drift rename
+	// choice prompt header.
+	DescKeyDriftRenamePrompt = "drift.rename-prompt"
+	// DescKeyDriftRenameOption is the text key for one numbered rename
+	// candidate.
+	DescKeyDriftRenameOption = "drift.rename-option"
+	// DescKeyDriftRenameChoose is the text key for the rename choice
+	// input prompt.
+	DescKeyDriftRenameChoose = "drift.rename-choose"
 	// DescKeyDriftSkipStaleAge is the text key for drift skip stale age messages.
 	DescKeyDriftSkipStaleAge = "drift.skip-stale-age"
 	// DescKeyDriftSkipSensitiveFile is the text key for drift skip sensitive file
@@ -124,6 +202,18 @@ const (
 	// DescKeyDriftStaleSyncFile is the text key for drift stale sync file
 	// messages.
 	DescKeyDriftStaleSyncFile = "drift.stale-sync-file"
+	// DescKeyDriftLayerUnresolved is the text key for drift unresolved
+	// layer messages.
+	DescKeyDriftLayerUnresolved = "drift.layer-unresolved"
+	// DescKeyDriftPathRefLayerLine is the text key for drift path ref
+	// lines in inherited content.
+	DescKeyDriftPathRefLayerLine = "drift.path-ref-line-layer"
+	// DescKeyDriftCheckLayers is the text key for drift check layers
+	// messages.
+	DescKeyDriftCheckLayers = "drift.check-layers"
+	// DescKeyDriftCheckSymbols is the text key for the symbol
+	// references check label.
+	DescKeyDriftCheckSymbols = "drift.check-symbols"
 	// DescKeyDriftToolSuffix is the text key for drift tool suffix messages.
 	DescKeyDriftToolSuffix = "drift.tool-suffix"
 	// DescKeyVersionDriftRelayMessage is the text key for version drift relay
@@ -21,6 +21,9 @@ const (
 	// DescKeyErrAddNoContentProvided is the text key for err add no content
 	// provided messages.
 	DescKeyErrAddNoContentProvided = "err.add.no-content-provided"
+	// DescKeyErrAddSecretFound is the text key for err add secret found
+	// messages.
+	DescKeyErrAddSecretFound = "err.add.secret-found"
 	// DescKeyErrAddSectionRequired is the text key for err add section required
 	// messages.
 	DescKeyErrAddSectionRequired = "err.add.section-required"
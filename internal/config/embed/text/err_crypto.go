@@ -34,17 +34,50 @@ const (
 	// DescKeyErrCryptoInvalidKeySize is the text key for err crypto invalid key
 	// size messages.
 	DescKeyErrCryptoInvalidKeySize = "err.crypto.invalid-key-size"
+	// DescKeyErrCryptoInvalidPublicKey is the text key for err crypto
+	// invalid public key messages.
+	DescKeyErrCryptoInvalidPublicKey = "err.crypto.invalid-public-key"
 	// DescKeyErrCryptoLoadKey is the text key for err crypto load key messages.
 	DescKeyErrCryptoLoadKey = "err.crypto.load-key"
+	// DescKeyErrCryptoMalformedEnvelope is the text key for err crypto
+	// malformed envelope messages.
+	DescKeyErrCryptoMalformedEnvelope = "err.crypto.malformed-envelope"
 	// DescKeyErrCryptoMkdirKeyDir is the text key for err crypto mkdir key dir
 	// messages.
 	DescKeyErrCryptoMkdirKeyDir = "err.crypto.mkdir-key-dir"
+	// DescKeyErrCryptoNoIdentityAt is the text key for err crypto no
+	// identity at messages.
+	DescKeyErrCryptoNoIdentityAt = "err.crypto.no-identity-at"
 	// DescKeyErrCryptoNoKeyAt is the text key for err crypto no key at messages.
 	DescKeyErrCryptoNoKeyAt = "err.crypto.no-key-at"
+	// DescKeyErrCryptoNoProject is the text key for err crypto no
+	// project messages.
+	DescKeyErrCryptoNoProject = "err.crypto.no-project"
+	// DescKeyErrCryptoNoRotation is the text key for err crypto no
+	// rotation messages.
+	DescKeyErrCryptoNoRotation = "err.crypto.no-rotation"
+	// DescKeyErrCryptoNoRecipients is the text key for err crypto no
+	// recipients messages.
+	DescKeyErrCryptoNoRecipients = "err.crypto.no-recipients"
+	// DescKeyErrCryptoNotRecipient is the text key for err crypto not
+	// recipient messages.
+	DescKeyErrCryptoNotRecipient = "err.crypto.not-recipient"
 	// DescKeyErrCryptoReadKey is the text key for err crypto read key messages.
 	DescKeyErrCryptoReadKey = "err.crypto.read-key"
+	// DescKeyErrCryptoRotationCommitted is the text key for err crypto
+	// rotation committed messages.
+	DescKeyErrCryptoRotationCommitted = "err.crypto.rotation-committed"
+	// DescKeyErrCryptoRotationPending is the text key for err crypto
+	// rotation pending messages.
+	DescKeyErrCryptoRotationPending = "err.crypto.rotation-pending"
 	// DescKeyErrCryptoSaveKey is the text key for err crypto save key messages.
 	DescKeyErrCryptoSaveKey = "err.crypto.save-key"
+	// DescKeyErrCryptoSharedKey is the text key for err crypto shared
+	// key messages.
+	DescKeyErrCryptoSharedKey = "err.crypto.shared-key"
+	// DescKeyErrCryptoUndecryptable is the text key for err crypto
+	// undecryptable messages.
+	DescKeyErrCryptoUndecryptable = "err.crypto.undecryptable"
 	// DescKeyErrCryptoWriteKey is the text key for err crypto write key messages.
 	DescKeyErrCryptoWriteKey = "err.crypto.write-key"
 )
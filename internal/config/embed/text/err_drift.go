@@ -0,0 +1,23 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for drift hook errors.
+const (
+	// DescKeyErrDriftGitDir is the text key for err drift git dir
+	// messages.
+	DescKeyErrDriftGitDir = "err.drift.git-dir"
+	// DescKeyErrDriftHookExists is the text key for err drift hook
+	// exists messages.
+	DescKeyErrDriftHookExists = "err.drift.hook-exists"
+	// DescKeyErrDriftHookWrite is the text key for err drift hook
+	// write messages.
+	DescKeyErrDriftHookWrite = "err.drift.hook-write"
+	// DescKeyErrDriftUnknownAction is the text key for err drift
+	// unknown action messages.
+	DescKeyErrDriftUnknownAction = "err.drift.unknown-action"
+)
@@ -92,6 +92,12 @@ const (
 	// DescKeyErrContextDirStat is the text key for stat failures
 	// other than not-exist (permission denied, I/O error).
 	DescKeyErrContextDirStat = "err.context.dir-stat"
+	// DescKeyErrContextLayerSelf is the text key for an inherit entry
+	// that points back at the project's own context directory.
+	DescKeyErrContextLayerSelf = "err.context.layer-self"
+	// DescKeyErrContextLayerEmpty is the text key for an inherit entry
+	// that yields no context files.
+	DescKeyErrContextLayerEmpty = "err.context.layer-empty"
 )
 
 // DescKeys for filesystem write output.
@@ -32,6 +32,15 @@ const (
 	// DescKeyErrJournalScanJournal is the text key for err journal scan journal
 	// messages.
 	DescKeyErrJournalScanJournal = "err.journal.scan-journal"
+	// DescKeyErrJournalSearchSince is the text key for err journal search
+	// since messages.
+	DescKeyErrJournalSearchSince = "err.journal.search-since"
+	// DescKeyErrJournalStatsBy is the text key for err journal stats
+	// by messages.
+	DescKeyErrJournalStatsBy = "err.journal.stats-by"
+	// DescKeyErrJournalStatsFormat is the text key for err journal stats
+	// format messages.
+	DescKeyErrJournalStatsFormat = "err.journal.stats-format"
 	// DescKeyErrJournalStageNotSet is the text key for err journal stage not set
 	// messages.
 	DescKeyErrJournalStageNotSet = "err.journal.stage-not-set"
@@ -8,6 +8,30 @@ package text
 
 // DescKeys for notifications errors.
 const (
+	// DescKeyErrNotifyUnknownChannel is the text key for err notify
+	// unknown channel messages.
+	DescKeyErrNotifyUnknownChannel = "err.notify.unknown-channel"
+	// DescKeyErrNotifyUnknownType is the text key for err notify
+	// unknown type messages.
+	DescKeyErrNotifyUnknownType = "err.notify.unknown-type"
+	// DescKeyErrNotifyNoChannelSecret is the text key for err notify
+	// no channel secret messages.
+	DescKeyErrNotifyNoChannelSecret = "err.notify.no-channel-secret"
+	// DescKeyErrNotifyFieldEmpty is the text key for err notify
+	// field empty messages.
+	DescKeyErrNotifyFieldEmpty = "err.notify.field-empty"
+	// DescKeyErrNotifyDeliveryStatus is the text key for err notify
+	// delivery status messages.
+	DescKeyErrNotifyDeliveryStatus = "err.notify.delivery-status"
+	// DescKeyErrNotifyEmailAddresses is the text key for err notify
+	// email addresses messages.
+	DescKeyErrNotifyEmailAddresses = "err.notify.email-addresses"
+	// DescKeyErrNotifyLoadChannels is the text key for err notify
+	// load channels messages.
+	DescKeyErrNotifyLoadChannels = "err.notify.load-channels"
+	// DescKeyErrNotifySaveChannel is the text key for err notify
+	// save channel messages.
+	DescKeyErrNotifySaveChannel = "err.notify.save-channel"
 	// DescKeyErrNotifyLoadWebhook is the text key for err notify load webhook
 	// messages.
 	DescKeyErrNotifyLoadWebhook = "err.notify.load-webhook"
@@ -24,6 +24,15 @@ const (
 	// DescKeyErrPadFileTooLarge is the text key for err pad file too large
 	// messages.
 	DescKeyErrPadFileTooLarge = "err.pad.file-too-large"
+	// DescKeyErrPadMergeConflicts is the text key for err pad merge
+	// conflicts messages.
+	DescKeyErrPadMergeConflicts = "err.pad.merge-conflicts"
+	// DescKeyErrPadInvalidCategory is the text key for err pad invalid
+	// category messages.
+	DescKeyErrPadInvalidCategory = "err.pad.invalid-category"
+	// DescKeyErrPadInvalidExpiry is the text key for err pad invalid
+	// expiry messages.
+	DescKeyErrPadInvalidExpiry = "err.pad.invalid-expiry"
 	// DescKeyErrPadInvalidIndex is the text key for err pad invalid index
 	// messages.
 	DescKeyErrPadInvalidIndex = "err.pad.invalid-index"
@@ -39,6 +48,21 @@ const (
 	// DescKeyErrPadReadScratchpad is the text key for err pad read scratchpad
 	// messages.
 	DescKeyErrPadReadScratchpad = "err.pad.read-scratchpad"
+	// DescKeyErrPadRecipientAmbiguous is the text key for err pad
+	// recipient ambiguous messages.
+	DescKeyErrPadRecipientAmbiguous = "err.pad.recipient-ambiguous"
+	// DescKeyErrPadRecipientExists is the text key for err pad recipient
+	// exists messages.
+	DescKeyErrPadRecipientExists = "err.pad.recipient-exists"
+	// DescKeyErrPadRecipientNotFound is the text key for err pad
+	// recipient not found messages.
+	DescKeyErrPadRecipientNotFound = "err.pad.recipient-not-found"
+	// DescKeyErrPadRecipientSelf is the text key for err pad recipient
+	// self messages.
+	DescKeyErrPadRecipientSelf = "err.pad.recipient-self"
+	// DescKeyErrPadRecipientsNotEncrypted is the text key for err pad
+	// recipients not encrypted messages.
+	DescKeyErrPadRecipientsNotEncrypted = "err.pad.recipients-not-encrypted"
 	// DescKeyErrPadResolveNotEncrypted is the text key for err pad resolve not
 	// encrypted messages.
 	DescKeyErrPadResolveNotEncrypted = "err.pad.resolve-not-encrypted"
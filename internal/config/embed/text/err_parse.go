@@ -21,6 +21,15 @@ const (
 	DescKeyErrParserScanFile = "err.parser.scan-file"
 	// DescKeyErrParserUnmarshal is the text key for err parser unmarshal messages.
 	DescKeyErrParserUnmarshal = "err.parser.unmarshal"
+	// DescKeyErrParserSQLiteNotFound is the text key for err parser
+	// sqlite not found messages.
+	DescKeyErrParserSQLiteNotFound = "err.parser.sqlite-not-found"
+	// DescKeyErrParserQuery is the text key for err parser query
+	// messages.
+	DescKeyErrParserQuery = "err.parser.query"
+	// DescKeyErrParserSessionNotInFile is the text key for err parser
+	// session not in file messages.
+	DescKeyErrParserSessionNotInFile = "err.parser.session-not-in-file"
 	// DescKeyErrParserWalkDir is the text key for err parser walk dir messages.
 	DescKeyErrParserWalkDir = "err.parser.walk-dir"
 	// DescKeyErrParserMissingOpenDelim is the text key for
@@ -18,4 +18,7 @@ const (
 	// DescKeyErrSetupSyncSteering is the text key for err setup sync steering
 	// messages.
 	DescKeyErrSetupSyncSteering = "err.setup.sync-steering"
+	// DescKeyErrSetupGitConfig is the text key for err setup git config
+	// messages.
+	DescKeyErrSetupGitConfig = "err.setup.git-config"
 )
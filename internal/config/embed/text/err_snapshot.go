@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for context snapshot errors.
+const (
+	// DescKeyErrSnapshotCorrupt is the text key for err snapshot corrupt
+	// messages.
+	DescKeyErrSnapshotCorrupt = "err.snapshot.corrupt"
+	// DescKeyErrSnapshotFileNotFound is the text key for err snapshot file
+	// not found messages.
+	DescKeyErrSnapshotFileNotFound = "err.snapshot.file-not-found"
+	// DescKeyErrSnapshotNone is the text key for err snapshot none messages.
+	DescKeyErrSnapshotNone = "err.snapshot.none"
+	// DescKeyErrSnapshotNotFound is the text key for err snapshot not found
+	// messages.
+	DescKeyErrSnapshotNotFound = "err.snapshot.not-found"
+	// DescKeyErrSnapshotParse is the text key for err snapshot parse
+	// messages.
+	DescKeyErrSnapshotParse = "err.snapshot.parse"
+	// DescKeyErrSnapshotRead is the text key for err snapshot read messages.
+	DescKeyErrSnapshotRead = "err.snapshot.read"
+	// DescKeyErrSnapshotWrite is the text key for err snapshot write
+	// messages.
+	DescKeyErrSnapshotWrite = "err.snapshot.write"
+)
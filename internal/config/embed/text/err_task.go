@@ -33,3 +33,20 @@ const (
 	// DescKeyErrTaskNotFound is the text key for err task not found messages.
 	DescKeyErrTaskNotFound = "err.task.task-not-found"
 )
+
+// DescKeys for task dependency errors.
+const (
+	// DescKeyErrTaskDuplicateID is the text key for a repeated #id: tag.
+	DescKeyErrTaskDuplicateID = "err.task.duplicate-id"
+	// DescKeyErrTaskUnknownDep is the text key for a dependency on a task
+	// ID that does not exist.
+	DescKeyErrTaskUnknownDep = "err.task.unknown-dep"
+	// DescKeyErrTaskCycle is the text key for a dependency cycle.
+	DescKeyErrTaskCycle = "err.task.cycle"
+	// DescKeyErrTaskGraphFormat is the text key for an unsupported task
+	// graph format.
+	DescKeyErrTaskGraphFormat = "err.task.graph-format"
+	// DescKeyErrTaskGraphProblems is the text key for the task graph
+	// problem summary.
+	DescKeyErrTaskGraphProblems = "err.task.graph-problems"
+)
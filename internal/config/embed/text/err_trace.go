@@ -8,6 +8,20 @@ package text
 
 // DescKeys for trace operations errors.
 const (
+	// DescKeyErrTraceBlame is the text key for a failed git blame.
+	DescKeyErrTraceBlame = "err.trace.blame"
+	// DescKeyErrTraceTitleDecode is the text key for an unreadable
+	// title API response.
+	DescKeyErrTraceTitleDecode = "err.trace.title-decode"
+	// DescKeyErrTraceTitleField is the text key for a title API
+	// response without the configured title field.
+	DescKeyErrTraceTitleField = "err.trace.title-field"
+	// DescKeyErrTraceTitleStatus is the text key for a non-2xx title
+	// API response.
+	DescKeyErrTraceTitleStatus = "err.trace.title-status"
+	// DescKeyErrTraceTitlesWrite is the text key for a failed title
+	// cache write.
+	DescKeyErrTraceTitlesWrite = "err.trace.titles-write"
 	// DescKeyErrTraceGitDir is the text key for err trace git dir messages.
 	DescKeyErrTraceGitDir = "err.trace.git-dir"
 	// DescKeyErrTraceGitLog is the text key for err trace git log messages.
@@ -32,4 +46,14 @@ const (
 	// DescKeyErrTraceWriteOverride is the text key for err trace write override
 	// messages.
 	DescKeyErrTraceWriteOverride = "err.trace.write-override"
+	// DescKeyErrTraceNoteWrite is the text key for a failed git note
+	// write.
+	DescKeyErrTraceNoteWrite = "err.trace.note-write"
+	// DescKeyErrTraceNotesPush is the text key for a failed notes push.
+	DescKeyErrTraceNotesPush = "err.trace.notes-push"
+	// DescKeyErrTraceNotesFetch is the text key for a failed notes fetch.
+	DescKeyErrTraceNotesFetch = "err.trace.notes-fetch"
+	// DescKeyErrTraceNotesMerge is the text key for a failed merge of
+	// fetched notes.
+	DescKeyErrTraceNotesMerge = "err.trace.notes-merge"
 )
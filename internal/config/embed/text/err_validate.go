@@ -29,6 +29,9 @@ const (
 	// DescKeyErrValidateDriftViolations is the text key for err validate drift
 	// violations messages.
 	DescKeyErrValidateDriftViolations = "err.validation.drift-violations"
+	// DescKeyErrValidateDriftInvalidRules is the text key for an unparsable
+	// CONSTITUTION.md ctx-rules block.
+	DescKeyErrValidateDriftInvalidRules = "err.validation.drift-invalid-rules"
 	// DescKeyErrValidateFlagRequired is the text key for err validate flag
 	// required messages.
 	DescKeyErrValidateFlagRequired = "err.validation.flag-required"
@@ -13,6 +13,9 @@ const (
 	// DescKeyErrGitNotInGitRepo is the text key for err git not in git repo
 	// messages.
 	DescKeyErrGitNotInGitRepo = "err.git.not-in-git-repo"
+	// DescKeyErrGitCommand is the text key for a failed git command
+	// reported with git's own output.
+	DescKeyErrGitCommand = "err.git.command"
 	// DescKeyErrParserGitNotFound is the text key for err parser git not found
 	// messages.
 	DescKeyErrParserGitNotFound = "err.parser.git-not-found"
@@ -117,6 +117,27 @@ const (
 	// DescKeyWriteJournalOrphanRemoved is the text key for write journal orphan
 	// removed messages.
 	DescKeyWriteJournalOrphanRemoved = "write.journal-orphan-removed"
+	// DescKeyWriteJournalSearchHit is the text key for write journal search
+	// hit header messages.
+	DescKeyWriteJournalSearchHit = "write.journal-search-hit"
+	// DescKeyWriteJournalSearchIndex is the text key for write journal search
+	// index summary messages.
+	DescKeyWriteJournalSearchIndex = "write.journal-search-index"
+	// DescKeyWriteJournalSearchLine is the text key for write journal search
+	// context line messages.
+	DescKeyWriteJournalSearchLine = "write.journal-search-line"
+	// DescKeyWriteJournalSearchMatch is the text key for write journal search
+	// matching line messages.
+	DescKeyWriteJournalSearchMatch = "write.journal-search-match"
+	// DescKeyWriteJournalSearchNone is the text key for write journal search
+	// no results messages.
+	DescKeyWriteJournalSearchNone = "write.journal-search-none"
+	// DescKeyWriteJournalSearchTool is the text key for write journal search
+	// tool suffix messages.
+	DescKeyWriteJournalSearchTool = "write.journal-search-tool"
+	// DescKeyWriteJournalSearchWhere is the text key for write journal search
+	// hit location messages.
+	DescKeyWriteJournalSearchWhere = "write.journal-search-where"
 	// DescKeyWriteJournalSiteBuilding is the text key for write journal site
 	// building messages.
 	DescKeyWriteJournalSiteBuilding = "write.journal-site-building"
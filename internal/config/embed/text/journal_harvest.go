@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for ctx journal harvest entry placeholders.
+const (
+	// DescKeyJournalHarvestSource is the text key for the context
+	// placeholder naming the harvested session.
+	DescKeyJournalHarvestSource = "journal.harvest-source"
+	// DescKeyJournalHarvestReview is the text key for the
+	// consequence and application placeholder.
+	DescKeyJournalHarvestReview = "journal.harvest-review"
+)
+
+// DescKeys for ctx journal harvest output.
+const (
+	// DescKeyWriteJournalHarvestCandidate is the text key for a
+	// candidate shown for review.
+	DescKeyWriteJournalHarvestCandidate = "write.journal-harvest-candidate"
+	// DescKeyWriteJournalHarvestKeywords is the text key for the
+	// classifier keywords that proposed a candidate.
+	DescKeyWriteJournalHarvestKeywords = "write.journal-harvest-keywords"
+	// DescKeyWriteJournalHarvestMarked is the text key shown for a
+	// candidate marked explicitly in the session.
+	DescKeyWriteJournalHarvestMarked = "write.journal-harvest-marked"
+	// DescKeyWriteJournalHarvestPrompt is the text key for the
+	// accept, edit, reject prompt.
+	DescKeyWriteJournalHarvestPrompt = "write.journal-harvest-prompt"
+	// DescKeyWriteJournalHarvestEdit is the text key for the
+	// replacement title prompt.
+	DescKeyWriteJournalHarvestEdit = "write.journal-harvest-edit"
+	// DescKeyWriteJournalHarvestAdded is the text key for a written
+	// candidate.
+	DescKeyWriteJournalHarvestAdded = "write.journal-harvest-added"
+	// DescKeyWriteJournalHarvestSummary is the text key for the
+	// closing counts.
+	DescKeyWriteJournalHarvestSummary = "write.journal-harvest-summary"
+	// DescKeyWriteJournalHarvestDryRun is the text key for the
+	// closing line of a dry run.
+	DescKeyWriteJournalHarvestDryRun = "write.journal-harvest-dry-run"
+	// DescKeyWriteJournalHarvestNone is the text key shown when a
+	// session has no new candidates.
+	DescKeyWriteJournalHarvestNone = "write.journal-harvest-none"
+)
@@ -48,6 +48,12 @@ const (
 	// DescKeyWriteJournalSourceImportedOK is the text key for write journal
 	// source imported ok messages.
 	DescKeyWriteJournalSourceImportedOK = "write.journal-source-imported-ok"
+	// DescKeyWriteJournalSourceRedacted is the text key for write journal
+	// source redacted messages.
+	DescKeyWriteJournalSourceRedacted = "write.journal-source-redacted"
+	// DescKeyWriteJournalSourceRedactions is the text key for the
+	// per-file redaction pipeline report.
+	DescKeyWriteJournalSourceRedactions = "write.journal-source-redactions"
 	// DescKeyWriteJournalSourceImportedOKSuffix is the text key for write journal
 	// source imported ok suffix messages.
 	DescKeyWriteJournalSourceImportedOKSuffix = "write.journal-source-imported-ok-suffix"
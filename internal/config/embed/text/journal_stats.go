@@ -0,0 +1,72 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for ctx journal stats labels.
+const (
+	// DescKeyLabelStatsNone is the text key for the label shown for
+	// an empty group value.
+	DescKeyLabelStatsNone = "label.stats-none"
+	// DescKeyLabelStatsTotal is the text key for the totals row label.
+	DescKeyLabelStatsTotal = "label.stats-total"
+	// DescKeyLabelStatsByDay is the text key for the day column header.
+	DescKeyLabelStatsByDay = "label.stats-by-day"
+	// DescKeyLabelStatsByWeek is the text key for the week column header.
+	DescKeyLabelStatsByWeek = "label.stats-by-week"
+	// DescKeyLabelStatsByBranch is the text key for the branch column header.
+	DescKeyLabelStatsByBranch = "label.stats-by-branch"
+	// DescKeyLabelStatsByModel is the text key for the model column header.
+	DescKeyLabelStatsByModel = "label.stats-by-model"
+	// DescKeyLabelStatsByTool is the text key for the tool column header.
+	DescKeyLabelStatsByTool = "label.stats-by-tool"
+	// DescKeyLabelStatsColSessions is the text key for the sessions column
+	// header.
+	DescKeyLabelStatsColSessions = "label.stats-col-sessions"
+	// DescKeyLabelStatsColTurns is the text key for the turns column header.
+	DescKeyLabelStatsColTurns = "label.stats-col-turns"
+	// DescKeyLabelStatsColTokensIn is the text key for the input tokens column
+	// header.
+	DescKeyLabelStatsColTokensIn = "label.stats-col-tokens-in"
+	// DescKeyLabelStatsColTokensOut is the text key for the output tokens
+	// column header.
+	DescKeyLabelStatsColTokensOut = "label.stats-col-tokens-out"
+	// DescKeyLabelStatsColCost is the text key for the cost column header.
+	DescKeyLabelStatsColCost = "label.stats-col-cost"
+	// DescKeyLabelStatsColErrors is the text key for the error rate column
+	// header.
+	DescKeyLabelStatsColErrors = "label.stats-col-errors"
+	// DescKeyLabelStatsColAvgTurns is the text key for the average turns
+	// column header.
+	DescKeyLabelStatsColAvgTurns = "label.stats-col-avg-turns"
+	// DescKeyLabelStatsColAvgMinutes is the text key for the average minutes
+	// column header.
+	DescKeyLabelStatsColAvgMinutes = "label.stats-col-avg-minutes"
+)
+
+// DescKeys for ctx journal stats output.
+const (
+	// DescKeyWriteJournalStatsTools is the text key for the
+	// most-used tools heading.
+	DescKeyWriteJournalStatsTools = "write.journal-stats-tools"
+	// DescKeyWriteJournalStatsTool is the text key for a
+	// most-used tools row.
+	DescKeyWriteJournalStatsTool = "write.journal-stats-tool"
+	// DescKeyWriteJournalStatsUnpriced is the text key for the
+	// missing price-table entries note.
+	DescKeyWriteJournalStatsUnpriced = "write.journal-stats-unpriced"
+	// DescKeyWriteFormatUSD is the text key for a dollar amount.
+	DescKeyWriteFormatUSD = "write.format-usd"
+	// DescKeyWriteFormatPercent is the text key for a
+	// percentage.
+	DescKeyWriteFormatPercent = "write.format-percent"
+	// DescKeyWriteFormatDecimal is the text key for a number
+	// with one decimal place.
+	DescKeyWriteFormatDecimal = "write.format-decimal"
+	// DescKeyWriteFormatWhole is the text key for a rounded
+	// whole number.
+	DescKeyWriteFormatWhole = "write.format-whole"
+)
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for key rotation output.
+const (
+	// DescKeyWriteKeyReencrypted is the text key for write key
+	// re-encrypted messages.
+	DescKeyWriteKeyReencrypted = "write.key-reencrypted"
+	// DescKeyWriteKeyRolledBack is the text key for write key rolled
+	// back messages.
+	DescKeyWriteKeyRolledBack = "write.key-rolled-back"
+	// DescKeyWriteKeyRotated is the text key for write key rotated
+	// messages.
+	DescKeyWriteKeyRotated = "write.key-rotated"
+)
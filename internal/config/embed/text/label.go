@@ -14,6 +14,9 @@ const (
 	DescKeyLabelTopics = "label.topics"
 	// DescKeyLabelFiles is the text key for label files messages.
 	DescKeyLabelFiles = "label.files"
+	// DescKeyLabelStats is the text key for the journal site
+	// analytics nav label.
+	DescKeyLabelStats = "label.stats"
 	// DescKeyLabelTypes is the text key for label types messages.
 	DescKeyLabelTypes = "label.types"
 )
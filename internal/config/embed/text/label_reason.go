@@ -12,4 +12,7 @@ const (
 	DescKeyLabelReasonExists = "label.reason-exists"
 	// DescKeyLabelReasonUpdated is the text key for label reason updated messages.
 	DescKeyLabelReasonUpdated = "label.reason-updated"
+	// DescKeyLabelReasonUnredacted is the text key for entries an
+	// exporter refuses because they were not redacted.
+	DescKeyLabelReasonUnredacted = "label.reason-unredacted"
 )
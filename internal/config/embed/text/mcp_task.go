@@ -15,6 +15,9 @@ const (
 	// DescKeyMCPAllTasksComplete is the text key for mcp all tasks complete
 	// messages.
 	DescKeyMCPAllTasksComplete = "mcp.all-tasks-complete"
+	// DescKeyMCPAllTasksBlocked is the text key for pending tasks that
+	// all wait for other pending tasks.
+	DescKeyMCPAllTasksBlocked = "mcp.all-tasks-blocked"
 	// DescKeyMCPCheckTaskFormat is the text key for mcp check task format
 	// messages.
 	DescKeyMCPCheckTaskFormat = "mcp.check-task-format"
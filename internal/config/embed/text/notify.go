@@ -5,3 +5,41 @@
 //                 SPDX-License-Identifier: Apache-2.0
 
 package text
+
+// DescKeys for notify channel write output.
+const (
+	// DescKeyWriteNotifyChannelPrompt is the text key for the prompt
+	// of a required channel secret.
+	DescKeyWriteNotifyChannelPrompt = "write.notify-channel-prompt"
+	// DescKeyWriteNotifyChannelOptional is the text key for the prompt
+	// of an optional channel secret.
+	DescKeyWriteNotifyChannelOptional = "write.notify-channel-optional"
+	// DescKeyWriteNotifyChannelDone is the text key for the channel
+	// setup success message.
+	DescKeyWriteNotifyChannelDone = "write.notify-channel-done"
+	// DescKeyWriteNotifyChannelFiltered is the text key for the notice
+	// that a channel does not subscribe to test events.
+	DescKeyWriteNotifyChannelFiltered = "write.notify-channel-filtered"
+	// DescKeyWriteNotifyChannelSent is the text key for a delivered
+	// channel test notification.
+	DescKeyWriteNotifyChannelSent = "write.notify-channel-sent"
+)
+
+// DescKeys for notify outbox write output.
+const (
+	// DescKeyWriteNotifyFlushEmpty is the text key for flushing an empty
+	// outbox.
+	DescKeyWriteNotifyFlushEmpty = "write.notify-flush-empty"
+	// DescKeyWriteNotifyFlushResult is the text key for the flush summary.
+	DescKeyWriteNotifyFlushResult = "write.notify-flush-result"
+	// DescKeyWriteNotifyLogEmpty is the text key for an empty delivery log.
+	DescKeyWriteNotifyLogEmpty = "write.notify-log-empty"
+	// DescKeyWriteNotifyLogLine is the text key for one delivery log line.
+	DescKeyWriteNotifyLogLine = "write.notify-log-line"
+	// DescKeyWriteNotifyLogError is the text key for the error line under
+	// a failed delivery.
+	DescKeyWriteNotifyLogError = "write.notify-log-error"
+	// DescKeyWriteNotifyLogOutbox is the text key for the outbox summary
+	// under the delivery log.
+	DescKeyWriteNotifyLogOutbox = "write.notify-log-outbox"
+)
@@ -121,14 +121,47 @@ const (
 	// DescKeyWritePadBlobWritten is the text key for write pad blob written
 	// messages.
 	DescKeyWritePadBlobWritten = "write.pad-blob-written"
+	// DescKeyWritePadMetaCategory is the text key for the category
+	// annotation in pad list rows.
+	DescKeyWritePadMetaCategory = "write.pad-meta-category"
+	// DescKeyWritePadMetaExpired is the text key for the expired
+	// annotation in pad list rows.
+	DescKeyWritePadMetaExpired = "write.pad-meta-expired"
+	// DescKeyWritePadMetaExpires is the text key for the expiry
+	// annotation in pad list rows.
+	DescKeyWritePadMetaExpires = "write.pad-meta-expires"
 	// DescKeyWritePadEmpty is the text key for write pad empty messages.
 	DescKeyWritePadEmpty = "write.pad-empty"
+	// DescKeyWritePadGcItem is the text key for write pad gc item
+	// messages.
+	DescKeyWritePadGcItem = "write.pad-gc-item"
+	// DescKeyWritePadGcNone is the text key for write pad gc none
+	// messages.
+	DescKeyWritePadGcNone = "write.pad-gc-none"
+	// DescKeyWritePadGcSummary is the text key for write pad gc
+	// summary messages.
+	DescKeyWritePadGcSummary = "write.pad-gc-summary"
+	// DescKeyWritePadGcVerbMark is the text key for write pad gc verb
+	// mark messages.
+	DescKeyWritePadGcVerbMark = "write.pad-gc-verb-mark"
+	// DescKeyWritePadGcVerbMarkDryRun is the text key for write pad gc
+	// verb mark dry run messages.
+	DescKeyWritePadGcVerbMarkDryRun = "write.pad-gc-verb-mark-dry-run"
+	// DescKeyWritePadGcVerbRemove is the text key for write pad gc verb
+	// remove messages.
+	DescKeyWritePadGcVerbRemove = "write.pad-gc-verb-remove"
+	// DescKeyWritePadGcVerbRemoveDryRun is the text key for write pad
+	// gc verb remove dry run messages.
+	DescKeyWritePadGcVerbRemoveDryRun = "write.pad-gc-verb-remove-dry-run"
 	// DescKeyWritePadListItem is the text key for write pad list item messages.
 	DescKeyWritePadListItem = "write.pad-list-item"
 )
 
 // DescKeys for scratchpad conflict resolution.
 const (
+	// DescKeyWritePadMergeDriverConflict is the text key for a
+	// merge driver conflict line.
+	DescKeyWritePadMergeDriverConflict = "write.pad-merge-driver-conflict"
 	// DescKeyWritePadResolveEntry is the text key for write pad resolve entry
 	// messages.
 	DescKeyWritePadResolveEntry = "write.pad-resolve-entry"
@@ -153,3 +186,31 @@ const (
 	// messages.
 	DescKeyWritePadKeyCreated = "write.pad-key-created"
 )
+
+// DescKeys for scratchpad recipient output.
+const (
+	// DescKeyWritePadIdentityCreated is the text key for write pad
+	// identity created messages.
+	DescKeyWritePadIdentityCreated = "write.pad-identity-created"
+	// DescKeyWritePadRecipientAdded is the text key for write pad
+	// recipient added messages.
+	DescKeyWritePadRecipientAdded = "write.pad-recipient-added"
+	// DescKeyWritePadRecipientNone is the text key for write pad
+	// recipient none messages.
+	DescKeyWritePadRecipientNone = "write.pad-recipient-none"
+	// DescKeyWritePadRecipientRemoved is the text key for write pad
+	// recipient removed messages.
+	DescKeyWritePadRecipientRemoved = "write.pad-recipient-removed"
+	// DescKeyWritePadRecipientRow is the text key for write pad
+	// recipient row messages.
+	DescKeyWritePadRecipientRow = "write.pad-recipient-row"
+	// DescKeyWritePadRecipientSelf is the text key for write pad
+	// recipient self messages.
+	DescKeyWritePadRecipientSelf = "write.pad-recipient-self"
+	// DescKeyWritePadRecipientSingleKey is the text key for write pad
+	// recipient single key messages.
+	DescKeyWritePadRecipientSingleKey = "write.pad-recipient-single-key"
+	// DescKeyWritePadRecipientYou is the text key for the write pad
+	// recipient marker on the current user's row.
+	DescKeyWritePadRecipientYou = "write.pad-recipient-you"
+)
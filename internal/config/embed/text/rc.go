@@ -0,0 +1,83 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for .ctxrc semantic validation warnings.
+const (
+	// DescKeyRCDriftSeverity is the text key for unknown drift check
+	// severity warnings.
+	DescKeyRCDriftSeverity = "rc.drift-severity"
+	// DescKeyRCDriftTimeout is the text key for negative drift
+	// timeout warnings.
+	DescKeyRCDriftTimeout = "rc.drift-timeout"
+	// DescKeyRCSecretEntropy is the text key for negative secret
+	// entropy warnings.
+	DescKeyRCSecretEntropy = "rc.secret-entropy"
+	// DescKeyRCSecretRuleName is the text key for unnamed secret
+	// rule warnings.
+	DescKeyRCSecretRuleName = "rc.secret-rule-name"
+	// DescKeyRCSecretRulePattern is the text key for invalid
+	// secret rule pattern warnings.
+	DescKeyRCSecretRulePattern = "rc.secret-rule-pattern"
+	// DescKeyRCRedactMode is the text key for unknown redact mode
+	// warnings.
+	DescKeyRCRedactMode = "rc.redact-mode"
+	// DescKeyRCRedactKind is the text key for unknown redaction
+	// kind warnings.
+	DescKeyRCRedactKind = "rc.redact-kind"
+	// DescKeyRCRedactRuleName is the text key for unnamed
+	// redaction pattern warnings.
+	DescKeyRCRedactRuleName = "rc.redact-rule-name"
+	// DescKeyRCRedactRulePattern is the text key for invalid
+	// redaction pattern warnings.
+	DescKeyRCRedactRulePattern = "rc.redact-rule-pattern"
+	// DescKeyRCTraceResolverName is the text key for unnamed trace
+	// resolver warnings.
+	DescKeyRCTraceResolverName = "rc.trace-resolver-name"
+	// DescKeyRCTraceResolverDupe is the text key for duplicate trace
+	// resolver name warnings.
+	DescKeyRCTraceResolverDupe = "rc.trace-resolver-dupe"
+	// DescKeyRCTraceResolverReserved is the text key for trace resolvers
+	// named after a built-in ref type.
+	DescKeyRCTraceResolverReserved = "rc.trace-resolver-reserved"
+	// DescKeyRCTraceResolverPattern is the text key for invalid trace
+	// resolver pattern warnings.
+	DescKeyRCTraceResolverPattern = "rc.trace-resolver-pattern"
+	// DescKeyRCNotifyChannelName is the text key for unnamed
+	// notification channel warnings.
+	DescKeyRCNotifyChannelName = "rc.notify-channel-name"
+	// DescKeyRCNotifyChannelDupe is the text key for duplicate
+	// notification channel name warnings.
+	DescKeyRCNotifyChannelDupe = "rc.notify-channel-dupe"
+	// DescKeyRCNotifyChannelType is the text key for unknown
+	// notification channel type warnings.
+	DescKeyRCNotifyChannelType = "rc.notify-channel-type"
+	// DescKeyRCPriceNegative is the text key for negative model
+	// price warnings.
+	DescKeyRCPriceNegative = "rc.price-negative"
+	// DescKeyRCScoringNegative is the text key for negative scoring
+	// value warnings.
+	DescKeyRCScoringNegative = "rc.scoring-negative"
+	// DescKeyRCScoringPctRange is the text key for out-of-range tier
+	// percentage warnings.
+	DescKeyRCScoringPctRange = "rc.scoring-pct-range"
+	// DescKeyRCScoringRuleKey is the text key for scoring rules that
+	// set neither tag nor section.
+	DescKeyRCScoringRuleKey = "rc.scoring-rule-key"
+	// DescKeyRCScoringSplitMin is the text key for split minimums
+	// that cannot both be honored.
+	DescKeyRCScoringSplitMin = "rc.scoring-split-min"
+	// DescKeyRCScoringTierSum is the text key for tier percentages
+	// that exceed the whole budget.
+	DescKeyRCScoringTierSum = "rc.scoring-tier-sum"
+	// DescKeyRCScoringUnknownType is the text key for unknown entry
+	// type warnings.
+	DescKeyRCScoringUnknownType = "rc.scoring-unknown-type"
+	// DescKeyRCScoringWeight is the text key for non-positive type
+	// weight warnings.
+	DescKeyRCScoringWeight = "rc.scoring-weight"
+)
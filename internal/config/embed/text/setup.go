@@ -33,10 +33,27 @@ const (
 	// DescKeyWriteSetupDeploySkipSteer is the text key for write setup deploy
 	// skip steer messages.
 	DescKeyWriteSetupDeploySkipSteer = "write.setup-deploy-skip-steer"
+	// DescKeyWriteSetupGitAttrAdded is the text key for a line added
+	// to .gitattributes.
+	DescKeyWriteSetupGitAttrAdded = "write.setup-git-attr-added"
+	// DescKeyWriteSetupGitAttrExists is the text key for a line
+	// already present in .gitattributes.
+	DescKeyWriteSetupGitAttrExists = "write.setup-git-attr-exists"
+	// DescKeyWriteSetupGitConfigSet is the text key for a git config
+	// value written.
+	DescKeyWriteSetupGitConfigSet = "write.setup-git-config-set"
 )
 
 // DescKeys for setup integration instruction output.
 const (
+	// DescKeyWriteSetupGitHead is the git section header.
+	DescKeyWriteSetupGitHead = "write.setup-git-head"
+	// DescKeyWriteSetupGitRun is the git run command hint.
+	DescKeyWriteSetupGitRun = "write.setup-git-run"
+	// DescKeyWriteSetupGitAttr is the git attributes hint.
+	DescKeyWriteSetupGitAttr = "write.setup-git-attr"
+	// DescKeyWriteSetupGitConfig is the git config hint.
+	DescKeyWriteSetupGitConfig = "write.setup-git-config"
 	// DescKeyWriteSetupCursorHead is the Cursor section header.
 	DescKeyWriteSetupCursorHead = "write.setup-cursor-head"
 	// DescKeyWriteSetupCursorRun is the Cursor run command hint.
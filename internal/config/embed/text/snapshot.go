@@ -0,0 +1,39 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package text
+
+// DescKeys for context snapshot write output.
+const (
+	// DescKeyWriteSnapshotChange is the text key for write snapshot change
+	// lines.
+	DescKeyWriteSnapshotChange = "write.snapshot-change"
+	// DescKeyWriteSnapshotCreated is the text key for write snapshot created
+	// messages.
+	DescKeyWriteSnapshotCreated = "write.snapshot-created"
+	// DescKeyWriteSnapshotFile is the text key for write snapshot file lines.
+	DescKeyWriteSnapshotFile = "write.snapshot-file"
+	// DescKeyWriteSnapshotHeader is the text key for write snapshot header
+	// lines.
+	DescKeyWriteSnapshotHeader = "write.snapshot-header"
+	// DescKeyWriteSnapshotIdentical is the text key for write snapshot
+	// identical messages.
+	DescKeyWriteSnapshotIdentical = "write.snapshot-identical"
+	// DescKeyWriteSnapshotItem is the text key for write snapshot list items.
+	DescKeyWriteSnapshotItem = "write.snapshot-item"
+	// DescKeyWriteSnapshotNameSuffix is the text key for write snapshot name
+	// suffixes.
+	DescKeyWriteSnapshotNameSuffix = "write.snapshot-name-suffix"
+	// DescKeyWriteSnapshotNone is the text key for write snapshot none
+	// messages.
+	DescKeyWriteSnapshotNone = "write.snapshot-none"
+	// DescKeyWriteSnapshotRestored is the text key for write snapshot
+	// restored messages.
+	DescKeyWriteSnapshotRestored = "write.snapshot-restored"
+	// DescKeyWriteSnapshotSafety is the text key for write snapshot safety
+	// messages.
+	DescKeyWriteSnapshotSafety = "write.snapshot-safety"
+)
@@ -27,10 +27,19 @@ const (
 	// DescKeyWriteStatusHeaderBlock is the text key for write status header block
 	// messages.
 	DescKeyWriteStatusHeaderBlock = "write.status-header-block"
+	// DescKeyWriteStatusHealth is the text key for the status health
+	// score block.
+	DescKeyWriteStatusHealth = "write.status-health"
 	// DescKeyWriteStatusNoDrift is the text key for write status no drift
 	// messages.
 	DescKeyWriteStatusNoDrift = "write.status-no-drift"
 	// DescKeyWriteStatusPreviewLine is the text key for write status preview line
 	// messages.
 	DescKeyWriteStatusPreviewLine = "write.status-preview-line"
+	// DescKeyWriteStatusTrendHeader is the text key for the status trend
+	// heading.
+	DescKeyWriteStatusTrendHeader = "write.status-trend-header"
+	// DescKeyWriteStatusTrendRow is the text key for one status trend
+	// sparkline row.
+	DescKeyWriteStatusTrendRow = "write.status-trend-row"
 )
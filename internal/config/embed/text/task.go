@@ -54,6 +54,30 @@ const (
 
 // DescKeys for task management write output.
 const (
+	// DescKeyWriteTaskGraphAfter is the text key for the pending tasks a
+	// task waits for in the task graph text view.
+	DescKeyWriteTaskGraphAfter = "write.task-graph-after"
+	// DescKeyWriteTaskGraphBlocked is the text key for the blocked tasks
+	// heading.
+	DescKeyWriteTaskGraphBlocked = "write.task-graph-blocked"
+	// DescKeyWriteTaskGraphCritical is the text key for the critical path
+	// line.
+	DescKeyWriteTaskGraphCritical = "write.task-graph-critical"
+	// DescKeyWriteTaskGraphEmpty is the text key for a TASKS.md without
+	// dependency metadata.
+	DescKeyWriteTaskGraphEmpty = "write.task-graph-empty"
+	// DescKeyWriteTaskGraphItem is the text key for one task line in the
+	// task graph text view.
+	DescKeyWriteTaskGraphItem = "write.task-graph-item"
+	// DescKeyWriteTaskGraphItemID is the text key for a task shown with
+	// its ID.
+	DescKeyWriteTaskGraphItemID = "write.task-graph-item-id"
+	// DescKeyWriteTaskGraphProblem is the text key for one dependency
+	// metadata problem.
+	DescKeyWriteTaskGraphProblem = "write.task-graph-problem"
+	// DescKeyWriteTaskGraphReady is the text key for the ready tasks
+	// heading.
+	DescKeyWriteTaskGraphReady = "write.task-graph-ready"
 	// DescKeyWriteCompletedTask is the text key for write completed task messages.
 	DescKeyWriteCompletedTask = "write.completed-task"
 	// DescKeyWriteMovingTask is the text key for write moving task messages.
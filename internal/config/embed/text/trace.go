@@ -57,4 +57,38 @@ const (
 	DescKeyWriteTraceResolvedRaw = "write.trace-resolved-raw"
 	// DescKeyWriteTraceTagged is the text key for write trace tagged messages.
 	DescKeyWriteTraceTagged = "write.trace-tagged"
+	// DescKeyWriteTraceNotesFetched is the text key for a completed notes
+	// fetch.
+	DescKeyWriteTraceNotesFetched = "write.trace-notes-fetched"
+	// DescKeyWriteTraceNotesHint is the text key for the hint to enable
+	// trace.notes after a migration.
+	DescKeyWriteTraceNotesHint = "write.trace-notes-hint"
+	// DescKeyWriteTraceNotesMigrated is the text key for the migration
+	// summary.
+	DescKeyWriteTraceNotesMigrated = "write.trace-notes-migrated"
+	// DescKeyWriteTraceNotesPushed is the text key for a completed notes
+	// push.
+	DescKeyWriteTraceNotesPushed = "write.trace-notes-pushed"
+	// DescKeyWriteTraceSyncFailed is the text key for an external title
+	// that could not be fetched.
+	DescKeyWriteTraceSyncFailed = "write.trace-sync-failed"
+	// DescKeyWriteTraceSyncNoResolvers is the text key for a sync with no
+	// resolvers configured.
+	DescKeyWriteTraceSyncNoResolvers = "write.trace-sync-no-resolvers"
+	// DescKeyWriteTraceSynced is the text key for the sync summary.
+	DescKeyWriteTraceSynced = "write.trace-synced"
+	// DescKeyWriteTraceBlameHeader is the text key for the commit header
+	// above a block of blamed lines.
+	DescKeyWriteTraceBlameHeader = "write.trace-blame-header"
+	// DescKeyWriteTraceBlameLine is the text key for one blamed line.
+	DescKeyWriteTraceBlameLine = "write.trace-blame-line"
+	// DescKeyWriteTraceBlameRef is the text key for a resolved ref under a
+	// blame header.
+	DescKeyWriteTraceBlameRef = "write.trace-blame-ref"
+	// DescKeyWriteTraceBlameRefRaw is the text key for an unresolved ref
+	// under a blame header.
+	DescKeyWriteTraceBlameRefRaw = "write.trace-blame-ref-raw"
+	// DescKeyWriteTraceBlameUncommitted is the text key for the header
+	// above lines that are not committed yet.
+	DescKeyWriteTraceBlameUncommitted = "write.trace-blame-uncommitted"
 )
@@ -10,6 +10,9 @@ package text
 const (
 	// DescKeyWriteAddedTo is the text key for write added to messages.
 	DescKeyWriteAddedTo = "write.added-to"
+	// DescKeyWriteAddRedacted is the text key for write add redacted
+	// messages.
+	DescKeyWriteAddRedacted = "write.add-redacted"
 	// DescKeyWriteArchived is the text key for write archived messages.
 	DescKeyWriteArchived = "write.archived"
 	// DescKeyWriteSpecNudgeTip is the text key for write spec nudge tip messages.
@@ -14,6 +14,8 @@ const (
 	ExtTxt = ".txt"
 	// ExtGo is the Go source file extension.
 	ExtGo = ".go"
+	// ExtJSON is the JSON file extension.
+	ExtJSON = ".json"
 	// ExtJSONL is the JSON Lines file extension.
 	ExtJSONL = ".jsonl"
 	// ExtYAML is the YAML file extension.
@@ -22,6 +24,10 @@ const (
 	ExtSh = ".sh"
 	// ExtPs1 is the PowerShell script file extension.
 	ExtPs1 = ".ps1"
+	// ExtEnc is the encrypted blob file extension.
+	ExtEnc = ".enc"
+	// ExtKey is the encryption key file extension.
+	ExtKey = ".key"
 	// ExtTmp is the temporary file suffix for atomic writes.
 	ExtTmp = ".tmp"
 	// ExtExample is the suffix for example/template files that are safe
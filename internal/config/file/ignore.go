@@ -25,6 +25,9 @@ var Gitignore = []string{
 	path.Join(dir.Context, dir.JournalObsidian, "/"),
 	path.Join(dir.Context, dir.Logs, "/"),
 	".context/.ctx.key",
+	// Grace, staged, and journal files of ctx key rotate.
+	".context/.ctx.key.*",
 	".context/state/",
+	path.Join(dir.Context, dir.Snapshots, "/"),
 	".claude/settings.local.json",
 }
@@ -58,11 +58,15 @@ const (
 	All         = "all"
 	AllProjects = "all-projects"
 	Append      = "append"
+	At          = "at"
 	Archive     = "archive"
 	BaseURL     = "base-url"
 	Blob        = "blob"
 	Build       = "build"
+	By          = "by"
 	Caller      = "caller"
+	Category    = "category"
+	Channel     = "channel"
 	Check       = "check"
 	Commands    = "commands"
 	Completion  = "completion"
@@ -72,11 +76,14 @@ const (
 	Dir         = "dir"
 	DryRun      = "dry-run"
 	Event       = "event"
+	Expires     = "expires"
 
 	IncludeHub      = "include-hub"
 	Fix             = "fix"
 	Force           = "force"
 	Reset           = "reset"
+	Resume          = "resume"
+	Rollback        = "rollback"
 	Full            = "full"
 	Hook            = "hook"
 	JSON            = "json"
@@ -84,13 +91,16 @@ const (
 	Key             = "key"
 	Label           = "label"
 	Last            = "last"
+	Mark            = "mark"
 	Latest          = "latest"
 	Limit           = "limit"
+	ListChecks      = "list-checks"
 	MaxIterations   = "max-iterations"
 	Merge           = "merge"
 	Note            = "note"
 	Message         = "message"
 	Minimal         = "minimal"
+	Name            = "name"
 	NoPluginEnable  = "no-plugin-enable"
 	NoSteeringInit  = "no-steering-init"
 	Out             = "out"
@@ -102,6 +112,8 @@ const (
 	Quiet           = "quiet"
 	Raw             = "raw"
 	Record          = "record"
+	Redact          = "redact"
+	Refresh         = "refresh"
 	Regenerate      = "regenerate"
 	Scope           = "scope"
 	Peers           = "peers"
@@ -111,12 +123,16 @@ const (
 	Show            = "show"
 	SessionID       = "session-id"
 	Skills          = "skills"
+	Staged          = "staged"
+	Stats           = "stats"
 	Tag             = "tag"
 	Tool            = "tool"
 	Token           = "token"
+	Trend           = "trend"
 	Type            = "type"
 	Variant         = "variant"
 	Verbose         = "verbose"
+	Weeks           = "weeks"
 	Width           = "width"
 	Write           = "write"
 	Yes             = "yes"
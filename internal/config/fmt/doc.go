@@ -23,6 +23,13 @@
 //   - FormatMarkdown ("md"): selects Markdown
 //     output, the default human-readable format for
 //     most commands
+//   - FormatTable ("table"): selects aligned
+//     plain-text tables for report commands
+//   - FormatCSV ("csv"): selects comma-separated
+//     output for spreadsheets
+//   - FormatText ("text"), FormatDOT ("dot") and
+//     FormatMermaid ("mermaid"): graph renderings
+//     for terminals, Graphviz and Markdown docs
 //
 // # Usage Pattern
 //
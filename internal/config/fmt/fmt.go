@@ -12,4 +12,14 @@ const (
 	FormatJSON = "json"
 	// FormatMarkdown selects Markdown output.
 	FormatMarkdown = "md"
+	// FormatTable selects aligned plain-text table output.
+	FormatTable = "table"
+	// FormatCSV selects comma-separated output.
+	FormatCSV = "csv"
+	// FormatText selects indented plain-text output.
+	FormatText = "text"
+	// FormatDOT selects Graphviz DOT output.
+	FormatDOT = "dot"
+	// FormatMermaid selects Mermaid flowchart output.
+	FormatMermaid = "mermaid"
 )
@@ -31,6 +31,18 @@
 //   - HashPrefixLen (8): number of hex characters
 //     shown for truncated commit or content hashes
 //
+// # Unified Diffs
+//
+//   - DiffContext (3): unchanged lines shown around
+//     each change
+//   - DiffOldHeader, DiffNewHeader, DiffHunkHeader:
+//     the ---/+++/@@ line formats
+//
+// # Sparklines
+//
+//   - SparkRunes: the eight block levels, lowest first
+//   - SparkGap: placeholder for a missing value
+//
 // # Text Truncation Widths
 //
 // These constants control how long strings are clipped
@@ -47,3 +47,25 @@ const (
 
 // IEC binary unit prefix string for byte formatting.
 const IECPrefixes = "KMGTPE"
+
+// Unified diff constants.
+const (
+	// DiffContext is the number of unchanged lines shown around
+	// each change in a unified diff.
+	DiffContext = 3
+	// DiffOldHeader opens a unified diff. Takes the old label.
+	DiffOldHeader = "--- %s\n"
+	// DiffNewHeader follows DiffOldHeader. Takes the new label.
+	DiffNewHeader = "+++ %s\n"
+	// DiffHunkHeader opens a hunk. Takes the old start and count,
+	// then the new start and count.
+	DiffHunkHeader = "@@ -%d,%d +%d,%d @@\n"
+)
+
+// Sparkline constants.
+const (
+	// SparkRunes are the sparkline levels, lowest first.
+	SparkRunes = "▁▂▃▄▅▆▇█"
+	// SparkGap stands in for a missing value.
+	SparkGap = " "
+)
@@ -0,0 +1,20 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package gemini centralizes constants for parsing Gemini CLI
+// chat logs.
+//
+// Gemini CLI records each session as one JSON document under
+// ~/.gemini/tmp/<project-hash>/chats/session-*.json. The
+// project hash is the SHA-256 of the project root, so the
+// parser recovers the working directory by hashing candidate
+// paths rather than reading it from the file.
+//
+// Messages carry a [MsgUser] or [MsgGemini] type; other types
+// (info, error) are CLI status lines and are skipped. Tool calls
+// whose status is [ToolStatusError] are reported as failed
+// results.
+package gemini
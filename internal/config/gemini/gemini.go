@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package gemini
+
+// Gemini CLI storage locations.
+const (
+	// DirHome is the Gemini home directory under the user's home.
+	DirHome = ".gemini"
+	// DirTmp holds one directory per project hash.
+	DirTmp = "tmp"
+	// DirChats holds the recorded sessions of a project.
+	DirChats = "chats"
+	// FilePrefix starts every session file name.
+	FilePrefix = "session-"
+)
+
+// Message types in a session file.
+const (
+	MsgUser   = "user"
+	MsgGemini = "gemini"
+)
+
+// ToolStatusError marks a failed tool call.
+const ToolStatusError = "error"
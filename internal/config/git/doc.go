@@ -26,8 +26,17 @@
 //
 // # Subcommands
 //
-//   - Branch, Diff, DiffTree, Log, Remote, RevParse
-//     are first arguments to the git binary
+//   - Blame, Branch, CatFile, Config, Diff, DiffTree,
+//     Fetch, Log, Notes, Push, Remote, RevParse are first
+//     arguments to the git binary
+//   - FlagPorcelain and FlagLineRange drive git blame;
+//     the Blame* keys name the porcelain header fields
+//   - NotesAppend, NotesList, NotesMerge, NotesShow with
+//     FlagNotesRef address a notes ref; RefspecFormat
+//     builds fetch refspecs
+//   - FlagBatch reads many objects through one
+//     git cat-file process; BatchHeaderFields parses
+//     its output
 //
 // # Hook Names
 //
@@ -51,9 +60,12 @@
 // Git format templates for extracting commit data:
 //
 //   - FormatAuthor, FormatBody, FormatDateISO,
-//     FormatHashDateSubj, FormatHashSubj,
-//     FormatSubject, FormatTrailerValue
+//     FormatHashBody, FormatHashDateSubj,
+//     FormatHashSubj, FormatSubject,
+//     FormatTrailerValue
 //   - FormatEmpty: suppresses default output
+//   - LogFieldSep, LogRecordSep: split FormatHashBody
+//     output into commits
 //
 // # Refs and Remotes
 //
@@ -11,18 +11,74 @@ const Binary = "git"
 
 // Subcommand names passed as the first argument to git.
 const (
+	Blame    = "blame"
 	Branch   = "branch"
+	CatFile  = "cat-file"
+	Config   = "config"
 	Diff     = "diff"
 	DiffTree = "diff-tree"
+	Fetch    = "fetch"
 	Log      = "log"
+	LsFiles  = "ls-files"
+	Notes    = "notes"
+	Push     = "push"
 	Remote   = "remote"
 	RevParse = "rev-parse"
+	Show     = "show"
+)
+
+// Notes subcommands and flags (git notes --ref <ref> <sub>).
+const (
+	NotesAppend = "append"
+	NotesList   = "list"
+	NotesMerge  = "merge"
+	NotesShow   = "show"
+	// NoteListFields is the field count of a
+	// "<note-object> <commit>" line of git notes list.
+	NoteListFields = 2
+	FlagNotesRef   = "--ref"
+	FlagMessage    = "-m"
+	FlagStrategy   = "--strategy"
+	FlagQuiet      = "--quiet"
+	RefspecForce   = "+"
+	RefspecFormat  = "%s%s:%s"
+)
+
+// Batch object reads (git cat-file --batch).
+const (
+	FlagBatch = "--batch"
+	// BatchHeaderFields is the field count of a
+	// "<oid> <type> <size>" header.
+	BatchHeaderFields = 3
+)
+
+// Blame flags and porcelain fields (git blame --porcelain).
+const (
+	FlagPorcelain = "--porcelain"
+	FlagLineRange = "-L"
+	// LineRangeFormat renders a start,end pair for FlagLineRange.
+	LineRangeFormat = "%d,%d"
+	// BlameAuthor, BlameAuthorTime and BlameSummary are the
+	// porcelain header keys ctx reads for each commit.
+	BlameAuthor     = "author"
+	BlameAuthorTime = "author-time"
+	BlameSummary    = "summary"
+	// BlameContent prefixes every source line in porcelain
+	// output.
+	BlameContent = "\t"
+	// BlameHeaderFields is the minimum field count of a
+	// "<sha> <orig-line> <final-line>" line header.
+	BlameHeaderFields = 3
+	// BlameZeroDigit fills the all-zero hash git blame uses
+	// for lines that are not committed yet.
+	BlameZeroDigit = "0"
 )
 
 // Hook names used in .git/hooks/.
 const (
 	HookPrepareCommitMsg = "prepare-commit-msg"
 	HookPostCommit       = "post-commit"
+	HookPreCommit        = "pre-commit"
 	HooksDir             = "hooks"
 )
 
@@ -32,6 +88,8 @@ const (
 	FlagShowCurrent  = "--show-current"
 	FlagShowToplevel = "--show-toplevel"
 	FlagGitDir       = "--git-dir"
+	FlagGitPath      = "--git-path"
+	FlagVerify       = "--verify"
 )
 
 // Common flags and format strings for git commands.
@@ -41,6 +99,13 @@ const (
 	FlagLast           = "-1"
 	FlagNoCommitID     = "--no-commit-id"
 	FlagNameOnly       = "--name-only"
+	FlagNameStatus     = "--name-status"
+	FlagNoColor        = "--no-color"
+	FlagNoContext      = "-U0"
+	FlagNoExtDiff      = "--no-ext-diff"
+	FlagFindRenames    = "-M"
+	FlagOnlyRenames    = "--diff-filter=R"
+	FlagRelative       = "--relative"
 	FlagOneline        = "--oneline"
 	FlagRecursive      = "-r"
 	FlagSince          = "--since"
@@ -48,10 +113,15 @@ const (
 	FormatBody         = "--format=%B"
 	FormatEmpty        = "--format="
 	FormatDateISO      = "--format=%ci"
+	FormatHashBody     = "--format=%H%x1f%B%x1e"
 	FormatHashDateSubj = "--format=%H %ci %s"
 	FormatHashSubj     = "--format=%H %s"
 	FormatSubject      = "--format=%s"
 	FormatTrailerValue = "--format=%%(trailers:key=%s,valueonly)"
+	// LogFieldSep and LogRecordSep split FormatHashBody output
+	// into commits and their hash and message.
+	LogFieldSep  = "\x1f"
+	LogRecordSep = "\x1e"
 	// FlagPathSep is the separator between flags and paths.
 	FlagPathSep = "--"
 	// FlagLastN is the format string for limiting git log
@@ -63,6 +133,9 @@ const (
 const (
 	// RefHead is the symbolic reference for the current commit.
 	RefHead = "HEAD"
+	// PeelCommit suffixes an object name so rev-parse only
+	// accepts it when it names an existing commit.
+	PeelCommit = "^{commit}"
 )
 
 // Remote subcommands and arguments.
@@ -71,9 +144,29 @@ const (
 	RemoteOrigin = "origin"
 )
 
+// ConfigUserName is the git config key holding the author name.
+const ConfigUserName = "user.name"
+
 // PathSeparator is the separator git uses in file paths (always forward slash).
 const PathSeparator = "/"
 
+// StatusRenamed prefixes the --name-status code of a rename
+// (e.g. "R100"), followed by the old and new paths.
+const StatusRenamed = "R"
+
+// StatusAdded is the --name-status code of a new file.
+const StatusAdded = "A"
+
+// StatusDeleted is the --name-status code of a deleted file.
+const StatusDeleted = "D"
+
+// NameStatusSep separates the fields of a --name-status line.
+const NameStatusSep = "\t"
+
+// ObjectSep joins a ref and a path into a git object name
+// (e.g. "origin/main:.context/CONSTITUTION.md").
+const ObjectSep = ":"
+
 // Commit trailer keys for structured metadata in commit messages.
 const (
 	// TrailerSpec is the commit trailer for spec references.
@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package harvest holds the constants behind
+// ctx journal harvest: which classifier targets become
+// candidates, the smallest paragraph worth proposing, the
+// explicit marker tag names, the default task section, and
+// the answers the review prompt accepts.
+//
+// The package is a typed constants registry, not logic.
+package harvest
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package harvest
+
+import "github.com/ActiveMemory/ctx/internal/config/entry"
+
+// Targets lists the classifier targets harvest proposes, in
+// the order candidates of equal position are reviewed.
+var Targets = []string{entry.Decision, entry.Learning, entry.Task}
+
+// Candidate extraction limits.
+const (
+	// MinContentLen is the shortest candidate title, in bytes,
+	// that a keyword match may propose. Shorter paragraphs are
+	// usually acknowledgements, not knowledge.
+	MinContentLen = 20
+	// MaxContentLen caps a keyword candidate title; longer
+	// first lines are cut at a word boundary.
+	MaxContentLen = 160
+)
+
+// DefaultSection is the TASKS.md section harvested tasks are
+// added under when --section is not given.
+const DefaultSection = "Harvested"
+
+// Review prompt answers.
+const (
+	// AnswerAccept writes the candidate as proposed.
+	AnswerAccept = "a"
+	// AnswerEdit asks for a replacement title, then writes.
+	AnswerEdit = "e"
+	// AnswerReject drops the candidate.
+	AnswerReject = "r"
+	// AnswerQuit drops this and every remaining candidate.
+	AnswerQuit = "q"
+)
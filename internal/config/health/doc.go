@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package health defines weights, thresholds, and file names
+// for the context health score reported by `ctx status`.
+//
+// # Score
+//
+// The score is a 0-100 weighted sum of five components,
+// each itself scored 0-100:
+//
+//   - drift: penalized per drift violation and warning
+//   - staleness: share of context files not flagged stale
+//   - velocity: tasks completed in the last week against
+//     [VelocityTarget]
+//   - index: share of decision and learning entries listed
+//     in their file's index table
+//   - tokens: context size against the token budget
+//
+// The Weight constants sum to [MaxScore].
+//
+// # History
+//
+// Every `ctx status` run appends a record to
+// [HistoryFile] under .context/state/. The file is capped
+// at [HistoryMax] records.
+package health
@@ -0,0 +1,76 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import "time"
+
+// Score bounds and component weights.
+const (
+	// MaxScore is the best possible score and the sum of all
+	// weights.
+	MaxScore = 100
+	// WeightDrift is the drift component's share of the score.
+	WeightDrift = 30
+	// WeightStaleness is the staleness component's share.
+	WeightStaleness = 20
+	// WeightVelocity is the task velocity component's share.
+	WeightVelocity = 20
+	// WeightIndex is the index coverage component's share.
+	WeightIndex = 15
+	// WeightTokens is the token pressure component's share.
+	WeightTokens = 15
+)
+
+// Penalties and targets for component scoring.
+const (
+	// ViolationPenalty is subtracted from the drift component per
+	// constitution violation.
+	ViolationPenalty = 25
+	// WarningPenalty is subtracted from the drift component per
+	// drift warning (stale files are scored separately).
+	WarningPenalty = 5
+	// VelocityTarget is the number of tasks completed per week
+	// that earns a full velocity component.
+	VelocityTarget = 3
+	// VelocityWindow is the look-back for counting completions.
+	VelocityWindow = 7 * 24 * time.Hour
+)
+
+// History file settings.
+const (
+	// HistoryFile is the JSONL history file in .context/state/.
+	HistoryFile = "health.jsonl"
+	// HistoryMax is the number of records kept; older records are
+	// dropped on append.
+	HistoryMax = 1000
+)
+
+// Trend rendering.
+const (
+	// DefaultTrendWeeks is the default --weeks value.
+	DefaultTrendWeeks = 8
+	// WeekDays is the number of days in one trend bucket.
+	WeekDays = 7
+	// Week is the length of one trend bucket.
+	Week = WeekDays * 24 * time.Hour
+)
+
+// Component labels for trend output.
+const (
+	// LabelScore labels the overall score row.
+	LabelScore = "score"
+	// LabelDrift labels the drift component row.
+	LabelDrift = "drift"
+	// LabelStaleness labels the staleness component row.
+	LabelStaleness = "staleness"
+	// LabelVelocity labels the velocity component row.
+	LabelVelocity = "velocity"
+	// LabelIndex labels the index coverage component row.
+	LabelIndex = "index"
+	// LabelTokens labels the token pressure component row.
+	LabelTokens = "tokens"
+)
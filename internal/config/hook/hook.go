@@ -28,6 +28,8 @@ const (
 	CheckMapStaleness = "check-map-staleness"
 	// CheckMemoryDrift is the hook name for memory drift checks.
 	CheckMemoryDrift = "check-memory-drift"
+	// CheckPadExpiry is the hook name for scratchpad expiry nudges.
+	CheckPadExpiry = "check-pad-expiry"
 	// CheckPersistence is the hook name for context persistence nudges.
 	CheckPersistence = "check-persistence"
 	// CheckReminder is the hook name for session reminder checks.
@@ -63,6 +65,7 @@ const (
 	ToolCopilot    = "copilot"
 	ToolCopilotCLI = "copilot-cli"
 	ToolCursor     = "cursor"
+	ToolGit        = "git"
 	ToolKiro       = "kiro"
 	ToolCline      = "cline"
 	ToolCodex      = "codex"
@@ -118,6 +121,9 @@ const (
 	// PrefixMemoryDriftThrottle is the state file prefix for per-session
 	// memory drift nudge tombstones.
 	PrefixMemoryDriftThrottle = "memory-drift-nudged-"
+	// PrefixPadExpiryThrottle is the state file prefix for per-session
+	// scratchpad expiry nudge tombstones.
+	PrefixPadExpiryThrottle = "pad-expiry-nudged-"
 	// PrefixSkillDiscoveryGuard is the state file prefix for
 	// the one-shot skill discovery nudge guard.
 	PrefixSkillDiscoveryGuard = "skill-discovery-"
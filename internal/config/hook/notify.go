@@ -14,4 +14,7 @@ const (
 	NotifyChannelNudge = "nudge"
 	// NotifyChannelRelay is the notification channel for relay messages.
 	NotifyChannelRelay = "relay"
+	// NotifyChannelKeyRotation is the notification channel for
+	// encryption key rotation reminders.
+	NotifyChannelKeyRotation = "key-rotation"
 )
@@ -20,6 +20,14 @@
 //   - MimeJSON ("application/json"): the Content-Type
 //     header set on webhook POST requests
 //
+// # Request Headers
+//
+//   - HeaderAccept, HeaderAuthorization: header names
+//     for authenticated JSON requests (notification
+//     providers, trace title sync)
+//   - BearerFormat ("Bearer %s"): Authorization value
+//     for token-based APIs
+//
 // # Timeouts
 //
 //   - WebhookTimeout (5 seconds): the HTTP client
@@ -12,6 +12,16 @@ const (
 	MimeJSON = "application/json"
 )
 
+// Request header constants.
+const (
+	// HeaderAccept is the Accept header.
+	HeaderAccept = "Accept"
+	// HeaderAuthorization is the Authorization header.
+	HeaderAuthorization = "Authorization"
+	// BearerFormat renders a bearer token header value.
+	BearerFormat = "Bearer %s"
+)
+
 // Timeout constants (in seconds).
 const (
 	// WebhookTimeout is the HTTP client timeout for webhook delivery.
@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package inherit defines constants for layered context
+// composition: the `inherit:` list in .ctxrc that pulls
+// parent or sibling `.context/` directories into the
+// context ctx load, ctx agent, and ctx drift see.
+//
+// # Markers
+//
+// Every inherited block appended to a local file starts
+// with a machine-readable marker comment ([MarkerFmt])
+// followed by a human-readable heading ([HeadingFmt]).
+// The marker carries the layer label so drift can resolve
+// path references in that block against the layer's own
+// project root, and so [MarkerPrefix] can split local
+// content from inherited content.
+//
+// # Git Layers
+//
+// Layers with a ref are read with `git show <ref>:<path>`
+// instead of from disk. [RefSep] joins ref and path in
+// both the git object name and the default label.
+//
+// # Why Centralized
+//
+// The loader writes markers and drift reads them back;
+// keeping the format in one place keeps both sides in
+// step.
+package inherit
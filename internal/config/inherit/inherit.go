@@ -0,0 +1,60 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package inherit
+
+import "github.com/ActiveMemory/ctx/internal/config/ctx"
+
+// Inherited block markers.
+const (
+	// MarkerFmt is the marker comment opening an inherited block.
+	// Takes the layer label.
+	MarkerFmt = "<!-- ctx:layer %s -->"
+	// MarkerPrefix is the marker comment prefix used to find
+	// inherited blocks.
+	MarkerPrefix = "<!-- ctx:layer "
+	// MarkerSuffix closes the marker comment.
+	MarkerSuffix = " -->"
+	// HeadingFmt is the provenance heading under the marker.
+	// Takes the layer label.
+	HeadingFmt = "## Inherited from %s"
+)
+
+// Git layer addressing.
+const (
+	// RefSep separates a git ref from the path inside it
+	// (e.g. origin/main:platform/.context).
+	RefSep = ":"
+)
+
+// Merge strategies applied when both the project and a layer
+// have the same context file.
+const (
+	// StrategyRules appends layer checkbox rules the project does
+	// not already state.
+	StrategyRules = "rules"
+	// StrategyConcat appends the layer's body under a provenance
+	// heading.
+	StrategyConcat = "concat"
+	// StrategyShadow appends layer entries whose titles the project
+	// does not already use; project entries win.
+	StrategyShadow = "shadow"
+	// StrategyLocal keeps the project file and ignores the layer's.
+	StrategyLocal = "local"
+)
+
+// Strategy maps each inheritable context file to its merge
+// strategy. Files absent from the map (TASKS.md) are never
+// inherited: work items belong to one project.
+var Strategy = map[string]string{
+	ctx.Constitution:  StrategyRules,
+	ctx.Convention:    StrategyConcat,
+	ctx.Glossary:      StrategyConcat,
+	ctx.Decision:      StrategyShadow,
+	ctx.Learning:      StrategyShadow,
+	ctx.Architecture:  StrategyLocal,
+	ctx.AgentPlaybook: StrategyLocal,
+}
@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package notify defines constants for notification
+// channels: provider types, secret field names and the
+// fixed parts of each provider's native payload.
+//
+// # Provider Types
+//
+// A channel in `.ctxrc` names one provider with its
+// `type:` field:
+//
+//   - [TypeWebhook]: the generic ctx JSON payload, the
+//     same one the single `.notify.enc` webhook gets.
+//   - [TypeSlack]: an incoming-webhook message with
+//     Block Kit blocks.
+//   - [TypeDiscord]: a webhook message with one embed.
+//   - [TypeMatrix]: an m.text room message sent with
+//     the client-server API.
+//   - [TypeNtfy]: a plain-text publish with Title,
+//     Tags and Priority headers.
+//   - [TypeEmail]: a plain-text SMTP message.
+//
+// # Secrets
+//
+// URLs, tokens and passwords never appear in `.ctxrc`.
+// They are prompted by `ctx hook notify setup
+// --channel` and kept in the encrypted
+// `.notify-channels.enc`; [Fields] lists which ones each
+// provider needs.
+//
+// # Outbox
+//
+// With `notify.outbox: true`, failed deliveries are queued
+// as files under `.context/state/` [OutboxDir] and retried
+// with exponential backoff from [BackoffBase] up to
+// [BackoffMax]. After `notify.max_attempts`
+// ([DefaultMaxAttempts]) they move to [OutboxDeadDir].
+// Every attempt is recorded in [FileDeliveryLog] with one
+// of the Status constants.
+package notify
@@ -0,0 +1,163 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+// Channel provider types accepted in .ctxrc.
+const (
+	// TypeWebhook posts the generic ctx JSON payload.
+	TypeWebhook = "webhook"
+	// TypeSlack posts a Slack incoming-webhook message.
+	TypeSlack = "slack"
+	// TypeDiscord posts a Discord webhook message.
+	TypeDiscord = "discord"
+	// TypeMatrix sends a Matrix room message.
+	TypeMatrix = "matrix"
+	// TypeNtfy publishes to an ntfy topic.
+	TypeNtfy = "ntfy"
+	// TypeEmail sends an email over SMTP.
+	TypeEmail = "email"
+)
+
+// Secret field names, used as setup prompts and JSON keys.
+const (
+	// FieldURL is the webhook, topic, homeserver or SMTP
+	// server address.
+	FieldURL = "url"
+	// FieldToken is a bearer access token.
+	FieldToken = "token"
+	// FieldUser is the SMTP user name.
+	FieldUser = "user"
+	// FieldPassword is the SMTP password.
+	FieldPassword = "password"
+	// FieldRoom is the Matrix room ID, set in .ctxrc.
+	FieldRoom = "room"
+)
+
+// Fields lists the secret fields each provider type prompts
+// for, in prompt order.
+var Fields = map[string][]string{
+	TypeWebhook: {FieldURL},
+	TypeSlack:   {FieldURL},
+	TypeDiscord: {FieldURL},
+	TypeMatrix:  {FieldURL, FieldToken},
+	TypeNtfy:    {FieldURL, FieldToken},
+	TypeEmail:   {FieldURL, FieldUser, FieldPassword},
+}
+
+// Required lists the secret fields a provider cannot work
+// without; the others may be left empty at the prompt.
+var Required = map[string][]string{
+	TypeWebhook: {FieldURL},
+	TypeSlack:   {FieldURL},
+	TypeDiscord: {FieldURL},
+	TypeMatrix:  {FieldURL, FieldToken},
+	TypeNtfy:    {FieldURL},
+	TypeEmail:   {FieldURL},
+}
+
+// Shared message formatting.
+const (
+	// TitleFormat renders a message title (and email subject)
+	// from project and event.
+	TitleFormat = "[ctx] %s: %s"
+	// SessionFormat renders the session line of a message.
+	SessionFormat = "session %s"
+	// DetailFormat renders the template reference of a message.
+	DetailFormat = "%s/%s"
+	// Separator joins context fields on one line.
+	Separator = " · "
+)
+
+// Slack Block Kit constants.
+const (
+	// SlackBlockHeader is the header block type.
+	SlackBlockHeader = "header"
+	// SlackBlockSection is the section block type.
+	SlackBlockSection = "section"
+	// SlackBlockContext is the context block type.
+	SlackBlockContext = "context"
+	// SlackTextPlain is the plain text object type.
+	SlackTextPlain = "plain_text"
+)
+
+// Discord embed constants.
+const (
+	// DiscordUsername is the webhook display name.
+	DiscordUsername = "ctx"
+	// DiscordColor is the embed accent color (0xRRGGBB).
+	DiscordColor = 0x5865F2
+)
+
+// Matrix client-server API constants.
+const (
+	// MatrixSendPath is the room message path; the
+	// placeholders are the homeserver URL, the escaped room
+	// ID and the transaction ID.
+	MatrixSendPath = "%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s"
+	// MatrixMsgType is the message type of a notification.
+	MatrixMsgType = "m.text"
+	// MatrixFormat is the formatted_body format.
+	MatrixFormat = "org.matrix.custom.html"
+	// MatrixHTMLFormat renders the formatted body from the
+	// escaped title and message.
+	MatrixHTMLFormat = "<strong>%s</strong><br>%s"
+	// MatrixTxnFormat renders a transaction ID from a Unix
+	// nanosecond timestamp.
+	MatrixTxnFormat = "ctx-%d"
+)
+
+// HTTP headers used by providers.
+const (
+	// HeaderContentType is the Content-Type header.
+	HeaderContentType = "Content-Type"
+	// MimeText is the Content-Type of plain-text bodies.
+	MimeText = "text/plain; charset=utf-8"
+)
+
+// ntfy publish headers.
+const (
+	// NtfyTitle is the notification title header.
+	NtfyTitle = "Title"
+	// NtfyTags is the comma-separated tags header.
+	NtfyTags = "Tags"
+	// NtfyPriority is the priority header.
+	NtfyPriority = "Priority"
+	// NtfyPriorityDefault is the priority of most events.
+	NtfyPriorityDefault = "default"
+	// NtfyPriorityHigh is the priority of key rotation
+	// reminders.
+	NtfyPriorityHigh = "high"
+)
+
+// Email message constants.
+const (
+	// EmailHeaderFormat renders one message header line.
+	EmailHeaderFormat = "%s: %s\r\n"
+	// EmailFrom is the From header.
+	EmailFrom = "From"
+	// EmailTo is the To header.
+	EmailTo = "To"
+	// EmailSubject is the Subject header.
+	EmailSubject = "Subject"
+	// EmailDate is the Date header.
+	EmailDate = "Date"
+	// EmailMIMEVersion is the MIME-Version header.
+	EmailMIMEVersion = "MIME-Version"
+	// EmailMIMEVersionValue is the MIME version in use.
+	EmailMIMEVersionValue = "1.0"
+	// EmailDialTimeout bounds the whole SMTP exchange, in seconds.
+	EmailDialTimeout = 10
+	// EmailRecipientSep joins recipients in the To header.
+	EmailRecipientSep = ", "
+	// EmailLineBreak ends header lines and separates the body.
+	EmailLineBreak = "\r\n"
+	// EmailNetwork is the dial network of SMTP servers.
+	EmailNetwork = "tcp"
+	// EmailStartTLS is the SMTP extension that upgrades the
+	// connection to TLS.
+	EmailStartTLS = "STARTTLS"
+)
@@ -0,0 +1,78 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+// Outbox layout under .context/state/.
+const (
+	// OutboxDir holds one JSON file per queued delivery.
+	OutboxDir = "notify-outbox"
+	// OutboxDeadDir holds dead-lettered deliveries, inside
+	// OutboxDir.
+	OutboxDeadDir = "dead"
+	// OutboxExt is the extension of a queued delivery file.
+	OutboxExt = ".json"
+	// OutboxClaimExt is appended to a delivery file while a
+	// flush is attempting it.
+	OutboxClaimExt = ".claim"
+	// FileFlushStamp records, inside OutboxDir, the last
+	// opportunistic flush run by the heartbeat hook.
+	FileFlushStamp = ".flushed"
+	// OutboxIDFormat renders a delivery ID from a Unix
+	// nanosecond timestamp and the process ID.
+	OutboxIDFormat = "%d-%d"
+	// FileDeliveryLog is the JSONL delivery log.
+	FileDeliveryLog = "notify-log.jsonl"
+	// FileDeliveryLogPrev is the rotated delivery log.
+	FileDeliveryLogPrev = "notify-log.1.jsonl"
+	// DeliveryLogMaxBytes is the size at which the delivery
+	// log is rotated.
+	DeliveryLogMaxBytes = 1 << 20
+)
+
+// Retry policy.
+const (
+	// DefaultMaxAttempts is the number of attempts before a
+	// delivery is dead-lettered, when notify.max_attempts is
+	// unset.
+	DefaultMaxAttempts = 8
+	// BackoffBase is the delay before the first retry, in
+	// seconds; it doubles with every failed attempt.
+	BackoffBase = 30
+	// BackoffMax caps the retry delay, in seconds.
+	BackoffMax = 3600
+	// ClaimStale is the age, in seconds, after which a claim
+	// left by an interrupted flush is released.
+	ClaimStale = 300
+	// FlushBatch caps the retries made opportunistically by
+	// one notification.
+	FlushBatch = 5
+	// FlushInterval is the minimum time, in seconds, between
+	// two opportunistic flushes run by the heartbeat hook.
+	FlushInterval = 300
+)
+
+// Delivery log statuses.
+const (
+	// StatusSent records a successful delivery.
+	StatusSent = "sent"
+	// StatusQueued records a first attempt that failed and
+	// was queued for retry.
+	StatusQueued = "queued"
+	// StatusFailed records a failed retry.
+	StatusFailed = "failed"
+	// StatusDead records a delivery given up on.
+	StatusDead = "dead"
+)
+
+// Delivery log display.
+const (
+	// TargetWebhook labels the .notify.enc webhook in the log.
+	TargetWebhook = "(webhook)"
+	// DefaultLogLast is the number of log entries shown by
+	// default.
+	DefaultLogLast = 20
+)
@@ -15,4 +15,9 @@ const (
 	MaxBlobSize = 64 * 1024
 	// BlobTag is the display tag appended to blob labels.
 	BlobTag = " [BLOB]"
+	// BlobDigestTag is the tag git-textconv appends to blob labels;
+	// the short digest makes a changed payload show up in a diff.
+	BlobDigestTag = " [BLOB sha256:%s]"
+	// BlobDigestLen is the number of hex digits of the digest shown.
+	BlobDigestLen = 12
 )
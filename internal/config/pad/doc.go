@@ -17,6 +17,10 @@
 //   - Md ("scratchpad.md"): the plaintext working
 //     copy (gitignored).
 //
+// A shared pad adds Recipients ("scratchpad.recipients"),
+// the committed list of public keys the encrypted file is
+// sealed for.
+//
 // During merge conflicts the encrypted file may split
 // into EncOurs and EncTheirs variants so the user can
 // resolve manually.
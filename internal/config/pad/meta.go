@@ -0,0 +1,52 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pad
+
+// Entry metadata markers. Metadata rides inside the entry
+// text (and so inside the encrypted payload) as a prefix
+// such as "{ctx expires=2026-11-01 category=creds} ".
+const (
+	// MetaOpen starts the metadata prefix.
+	MetaOpen = "{ctx"
+	// MetaClose ends the metadata prefix, including the
+	// space that separates it from the entry body.
+	MetaClose = "} "
+	// MetaAssign separates a metadata key from its value.
+	MetaAssign = "="
+)
+
+// Entry metadata keys.
+const (
+	// MetaExpires is the key for the expiry date (YYYY-MM-DD).
+	MetaExpires = "expires"
+	// MetaCategory is the key for the entry category.
+	MetaCategory = "category"
+	// MetaCreatedBy is the key for the entry author.
+	MetaCreatedBy = "created_by"
+)
+
+// Expiry handling.
+const (
+	// ExpiryDaySuffix marks a relative expiry such as "7d".
+	ExpiryDaySuffix = "d"
+	// ExpirySoonDays is how many days ahead the session-start
+	// nudge looks for entries about to expire.
+	ExpirySoonDays = 3
+	// ExpiredTag is the tag gc --mark adds to expired entries.
+	ExpiredTag = "expired"
+)
+
+// Access log.
+const (
+	// AccessLog is the JSONL file under .context/state/ that
+	// records which entries ctx pad show decrypted.
+	AccessLog = "pad-access.jsonl"
+	// AccessKindText marks a text entry in the access log.
+	AccessKindText = "text"
+	// AccessKindBlob marks a blob entry in the access log.
+	AccessKindBlob = "blob"
+)
@@ -16,6 +16,9 @@ const (
 	EncTheirs = Enc + ".theirs"
 	// Md is the plaintext scratchpad file.
 	Md = "scratchpad.md"
+	// Recipients lists the public keys a shared scratchpad is
+	// encrypted for, one per line with an optional name.
+	Recipients = "scratchpad.recipients"
 )
 
 // FmtPadEntryID is the format string for rendering a stable
@@ -0,0 +1,11 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pad
+
+// VarExpiringList is the template variable for the formatted
+// list of expired and soon-to-expire entries.
+const VarExpiringList = "ExpiringList"
@@ -35,6 +35,14 @@
 // session ID. The parser skips this directory to
 // avoid importing duplicate content.
 //
+// # Session Index
+//
+// FileIndex ("session-index.json") is the cache under
+// .context/state/ that records, per session file, its size,
+// modification time and the session metadata parsed from it,
+// so discovery only re-parses files that changed. An index
+// whose version differs from IndexVersion is rebuilt.
+//
 // # Session Header Prefixes
 //
 // DefaultSessionPrefixes (["Session:"]) lists the
@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+// Session index cache.
+const (
+	// FileIndex is the session index file under .context/state/.
+	FileIndex = "session-index.json"
+	// IndexVersion is the current index format; an index with
+	// another version is discarded and rebuilt.
+	IndexVersion = 1
+)
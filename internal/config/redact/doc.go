@@ -0,0 +1,31 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redact defines constants for the journal redaction
+// pipeline that rewrites sessions before they are written to
+// the journal.
+//
+// # Modes
+//
+// The redact key in .ctxrc selects [ModeOff], [ModeOn] (the
+// default) or [ModeRequired]. Required mode additionally makes
+// ctx journal site and ctx journal obsidian refuse entries whose
+// frontmatter lacks the redacted marker.
+//
+// # Kinds
+//
+// Built-in kinds ([KindSecret], [KindEmail], [KindHost],
+// [KindHome]) are listed in [Kinds] and can be narrowed with
+// redaction.kinds. Project patterns under redaction.patterns
+// report their rule name as the kind; every placeholder label
+// is the upper-cased kind.
+//
+// # Placeholders
+//
+// Every distinct value gets [PlaceholderFormat] with a
+// per-session counter, so the same address becomes the same
+// placeholder wherever it appears in that session.
+package redact
@@ -0,0 +1,55 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+// Redaction modes for the redact key in .ctxrc.
+const (
+	// ModeOff disables the redaction pipeline. The secret
+	// scanner still runs over generated journal content.
+	ModeOff = "off"
+	// ModeOn redacts sessions on import. This is the default.
+	ModeOn = "on"
+	// ModeRequired redacts on import and makes the site and
+	// Obsidian exporters refuse unredacted entries.
+	ModeRequired = "required"
+)
+
+// Built-in redaction kinds.
+const (
+	// KindSecret redacts credentials found by the secret scanner.
+	KindSecret = "secret"
+	// KindEmail redacts email addresses.
+	KindEmail = "email"
+	// KindHost redacts hostnames under an internal domain.
+	KindHost = "host"
+	// KindHome redacts the home directory prefix of absolute paths.
+	KindHome = "home"
+)
+
+// Kinds lists the built-in kinds in the order they are applied.
+var Kinds = []string{KindSecret, KindEmail, KindHome, KindHost}
+
+// DefaultHosts are the internal domain suffixes redacted when
+// redaction.hosts is unset.
+var DefaultHosts = []string{
+	"internal", "corp", "lan", "intranet", "localdomain",
+}
+
+// Placeholder rendering and matching.
+const (
+	// PlaceholderFormat renders a placeholder from a label and a
+	// per-session counter, e.g. <EMAIL_1>.
+	PlaceholderFormat = "<%s_%d>"
+	// PlaceholderHTML escapes a placeholder matched by
+	// regex.RedactPlaceholder so HTML renderers show it instead
+	// of treating it as a tag.
+	PlaceholderHTML = "&lt;$1&gt;"
+	// HostSep separates hostname labels.
+	HostSep = "."
+	// KeySep joins a kind and a value in the placeholder map.
+	KeySep = "\x00"
+)
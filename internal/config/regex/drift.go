@@ -22,3 +22,9 @@ var InternalPkg = regexp.MustCompile(
 		"[^" + token.Backtick + "]+)" +
 		token.Backtick,
 )
+
+// DiffHunk matches a unified diff hunk header.
+//
+// Groups:
+//   - 1: first line number of the post-image range
+var DiffHunk = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// HarvestTag matches a bare entry marker such as
+// <decision>Use SQLite</decision> in session text.
+//
+// Groups:
+//   - 1: entry type (decision, learning, task, convention)
+//   - 2: content between tags
+var HarvestTag = regexp.MustCompile(
+	`<(decision|learning|task|convention)>` +
+		`([^<]+)</(?:decision|learning|task|convention)>`)
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// AiderTokens matches the token report Aider prints after each
+// reply ("Tokens: 2.3k sent, 1.1k cache write, 150 received.").
+//
+// Groups:
+//   - 1: tokens sent
+//   - 2: sent suffix ("", "k", or "M")
+//   - 3: tokens received
+//   - 4: received suffix
+var AiderTokens = regexp.MustCompile(
+	`Tokens: ([\d.]+)([kM]?) sent,.*?([\d.]+)([kM]?) received`,
+)
+
+// AiderTokens submatch indices.
+const (
+	AiderSentNum    = 1
+	AiderSentSuffix = 2
+	AiderRecvNum    = 3
+	AiderRecvSuffix = 4
+)
+
+// ClineCWD matches the working directory Cline reports in the
+// environment details of a task's first message.
+//
+// Groups:
+//   - 1: absolute working directory
+var ClineCWD = regexp.MustCompile(`# Current Working Directory \(([^)]+)\)`)
+
+// ClineEnvDetails matches the environment details block Cline
+// appends to user messages.
+var ClineEnvDetails = regexp.MustCompile(
+	`(?s)\s*<environment_details>.*?</environment_details>\s*`,
+)
+
+// ClineTaskTag matches the <task> tags Cline wraps around the
+// prompt that starts a task.
+var ClineTaskTag = regexp.MustCompile(`</?task>`)
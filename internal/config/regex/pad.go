@@ -16,3 +16,16 @@ const padEntryIDPattern = `^\[(\d+)\]\s`
 // Groups:
 //   - 1: numeric ID
 var PadEntryID = regexp.MustCompile(padEntryIDPattern)
+
+// padMetaPattern matches a metadata prefix like
+// "{ctx expires=2026-11-01 category=creds} ".
+const padMetaPattern = `^\{ctx((?: [a-z_]+=[^\s{}]+)+)\} `
+
+// PadMeta matches the metadata prefix on a scratchpad entry.
+//
+// Groups:
+//   - 1: space-separated key=value pairs
+var PadMeta = regexp.MustCompile(padMetaPattern)
+
+// PadCategory matches a valid scratchpad entry category.
+var PadCategory = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// RedactEmail matches an email address.
+var RedactEmail = regexp.MustCompile(
+	`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+` +
+		`(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}\b`,
+)
+
+// RedactHome matches the home directory prefix of an absolute
+// path on Linux, macOS and Windows.
+var RedactHome = regexp.MustCompile(
+	`(?:/home/|/Users/|\b[A-Za-z]:\\+Users\\+)[^/\\\s'"` + "`" +
+		`<>:]+`,
+)
+
+// RedactPlaceholder matches a placeholder written by the
+// redaction pipeline, e.g. <EMAIL_1>.
+//
+// Groups:
+//   - 1: the label and counter
+var RedactPlaceholder = regexp.MustCompile(`<([A-Z][A-Z0-9_-]*_[0-9]+)>`)
+
+// RedactHost matches a dotted hostname. Callers keep only
+// hosts under a configured internal domain.
+var RedactHost = regexp.MustCompile(
+	`(?i)\b(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z][a-z0-9-]*\b`,
+)
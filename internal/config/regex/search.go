@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// SearchTerm matches one indexable word.
+var SearchTerm = regexp.MustCompile(`[\p{L}\p{N}_]+`)
+
+// SearchQueryPart matches one part of a search query: a quoted
+// phrase or a run of non-space characters.
+var SearchQueryPart = regexp.MustCompile(`"[^"]*"?|\S+`)
+
+// SearchSinceDays matches the relative form of since:, e.g. 7d.
+//
+// Groups:
+//   - 1: number of days
+var SearchSinceDays = regexp.MustCompile(`^(\d+)d$`)
+
+// JournalMetaRow matches a row of the metadata table in a
+// journal entry.
+//
+// Groups:
+//   - 1: label (e.g. "Tool")
+//   - 2: value
+var JournalMetaRow = regexp.MustCompile(
+	`^<tr><td><strong>([^<]+)</strong></td><td>([^<]*)</td></tr>$`,
+)
+
+// JournalToolFile matches a tool-use line that reads or
+// writes a file, with or without the bold markers the site
+// normalizer strips.
+//
+// Groups:
+//   - 1: tool name
+//   - 2: file path
+var JournalToolFile = regexp.MustCompile(
+	`^🔧\s*(?:\*\*)?(Read|Write|Edit): (.+?)(?:\*\*)?$`,
+)
@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// SecretAWSAccessKey matches an AWS access key ID.
+var SecretAWSAccessKey = regexp.MustCompile(
+	`\b(?:AKIA|ASIA|AGPA|AIDA|AROA)[0-9A-Z]{16}\b`,
+)
+
+// SecretAWSSecretKey matches an AWS secret access key assigned
+// to an aws_secret_access_key setting.
+//
+// Groups:
+//   - 1: the key
+var SecretAWSSecretKey = regexp.MustCompile(
+	`(?i)aws_?secret_?(?:access_?)?key["']?\s*[:=]\s*["']?` +
+		`([A-Za-z0-9/+=]{40})`,
+)
+
+// SecretGitHubToken matches a GitHub token.
+var SecretGitHubToken = regexp.MustCompile(
+	`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`,
+)
+
+// SecretJWT matches a JSON Web Token.
+var SecretJWT = regexp.MustCompile(
+	`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`,
+)
+
+// SecretPrivateKey matches a PEM private key block, or its
+// header when the block is cut off.
+var SecretPrivateKey = regexp.MustCompile(
+	`-----BEGIN[A-Z ]*PRIVATE KEY(?: BLOCK)?-----` +
+		`(?:[\s\S]*?-----END[A-Z ]*PRIVATE KEY(?: BLOCK)?-----)?`,
+)
+
+// SecretSlackToken matches a Slack token.
+var SecretSlackToken = regexp.MustCompile(
+	`\bxox[abposr]-[A-Za-z0-9-]{10,}`,
+)
+
+// SecretAPIKey matches an sk- prefixed provider API key.
+var SecretAPIKey = regexp.MustCompile(
+	`\bsk-(?:[a-z]+-)?[A-Za-z0-9_-]{32,}`,
+)
+
+// SecretAssignment matches a value assigned to a secret-looking
+// key.
+//
+// Groups:
+//   - 1: the value
+var SecretAssignment = regexp.MustCompile(
+	`(?i)\b(?:api[_-]?key|secret(?:[_-]?key)?|access[_-]?token|` +
+		`auth[_-]?token|password|passwd)["']?\s*[:=]\s*["']?` +
+		`([A-Za-z0-9_\-+/=.!@#%^&*]{12,})`,
+)
+
+// SecretHighEntropy matches a long token drawn from the base64
+// alphabet, a candidate for the entropy test.
+var SecretHighEntropy = regexp.MustCompile(
+	`\b[A-Za-z0-9+_-]{32,}`,
+)
+
+// Compile compiles a pattern supplied at run time: secrets.rules
+// entries in .ctxrc and secret allowlist lines. Patterns known at
+// build time are package-level variables in this package instead.
+var Compile = regexp.Compile
@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package regex
+
+import "regexp"
+
+// SymbolRef matches a backtick-quoted code identifier in context
+// files: dotted names such as `store.Append` or calls such as
+// `loadConfig()`.
+//
+// Groups:
+//   - 1: identifier, dotted parts included
+//   - 2: "()" when written as a call
+var SymbolRef = regexp.MustCompile(
+	"`([A-Za-z_][A-Za-z0-9_]*(?:\\.[A-Za-z_][A-Za-z0-9_]*)*)(\\(\\))?`",
+)
+
+// SymbolTSDecl matches a top-level TypeScript or JavaScript
+// declaration.
+//
+// Groups:
+//   - 1: "class" when the declaration opens a class body
+//   - 2: declared name
+var SymbolTSDecl = regexp.MustCompile(
+	`^(?:export\s+)?(?:default\s+)?(?:declare\s+)?(?:abstract\s+)?` +
+		`(?:async\s+)?(?:(class)|function\*?|interface|type|enum|` +
+		`const|let|var)\s+([A-Za-z_$][\w$]*)`,
+)
+
+// SymbolTSMethod matches a method or field declaration inside a
+// TypeScript or JavaScript class body.
+//
+// Groups:
+//   - 1: member name
+var SymbolTSMethod = regexp.MustCompile(
+	`^\s+(?:(?:public|private|protected|static|readonly|async|` +
+		`get|set|override)\s+)*#?([A-Za-z_$][\w$]*)\s*[(:=<]`,
+)
+
+// SymbolPyDef matches a Python def or class line.
+//
+// Groups:
+//   - 1: leading indentation
+//   - 2: "class" for a class, empty for a function
+//   - 3: declared name
+var SymbolPyDef = regexp.MustCompile(
+	`^(\s*)(?:async\s+)?(?:def|(class))\s+([A-Za-z_]\w*)`,
+)
+
+// SymbolPyConst matches a module-level Python assignment to an
+// upper-case name.
+//
+// Groups:
+//   - 1: assigned name
+var SymbolPyConst = regexp.MustCompile(`^([A-Z_][A-Z0-9_]*)\s*[:=]`)
+
+// ImportTS matches a TypeScript or JavaScript module import on
+// one line: import/export ... from, bare import, or require().
+//
+// Groups:
+//   - 1: module specifier
+var ImportTS = regexp.MustCompile(
+	`(?:\bfrom\s+|^\s*import\s+|\brequire\(\s*)['"]([^'"]+)['"]`,
+)
+
+// ImportPy matches a Python import statement.
+//
+// Groups:
+//   - 1: module of a "from x import" statement
+//   - 2: first module of an "import x" statement
+var ImportPy = regexp.MustCompile(
+	`^\s*(?:from\s+([\w.]+)\s+import\b|import\s+([\w.]+))`,
+)
@@ -29,6 +29,20 @@ var Task = regexp.MustCompile(taskPattern)
 // Use with FindAllStringSubmatch on multiline content.
 var TaskMultiline = regexp.MustCompile(`(?m)` + taskPattern)
 
+// TaskDep matches task dependency metadata: #id:<id>,
+// #blocks:<id>[,<id>...] and #after:<id>[,<id>...].
+//
+// Groups:
+//   - 1: tag name (id, blocks, after)
+//   - 2: value (one ID, or comma-separated IDs)
+var TaskDep = regexp.MustCompile(
+	`(?:^|\s)#(id|blocks|after):([\w.-]+(?:,[\w.-]+)*)`,
+)
+
+// TaskTag matches an inline #tag or #key:value token, used to
+// strip metadata from task text for display.
+var TaskTag = regexp.MustCompile(`\s+#[a-z][\w-]*(?::\S*)?`)
+
 // Runtime configuration.
 const (
 	// TaskCompleteReplace is the regex replacement string for marking a task done.
@@ -34,6 +34,8 @@
 //     calls between task-completion nudges.
 //   - [DefaultKeyRotationDays] (90): days before
 //     an encryption key rotation nudge.
+//   - [DefaultKeyGraceDays] (14): days a rotated-out
+//     key still decrypts.
 //   - [DefaultStaleAgeDays] (30): days before a
 //     context file is flagged as stale.
 //   - [DefaultPruneDays] (7): age threshold for
@@ -29,6 +29,9 @@ const (
 	DefaultTaskNudgeInterval = 5
 	// DefaultKeyRotationDays is the days before encryption key rotation nudge.
 	DefaultKeyRotationDays = 90
+	// DefaultKeyGraceDays is the days a rotated-out key stays
+	// usable for decryption.
+	DefaultKeyGraceDays = 14
 	// DefaultStaleAgeDays is the days before a context file is
 	// flagged as stale by drift detection.
 	DefaultStaleAgeDays = 30
@@ -0,0 +1,26 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search defines constants for ctx journal search and
+// its inverted index.
+//
+// # Index
+//
+// [FileIndex] under .context/state/ maps every term to the
+// journal turns that contain it. [IndexVersion] guards the
+// format; an index with another version is rebuilt.
+//
+// # Query Syntax
+//
+// Bare words must all appear in a turn; quoted phrases must
+// appear verbatim. Field filters ([FieldTool], [FieldBranch],
+// [FieldModel], [FieldSince], [FieldFile]) and [TagPrefix]
+// tags narrow the sessions considered.
+//
+// # Ranking
+//
+// Turns are scored with BM25 ([BM25K1], [BM25B]).
+package search
@@ -0,0 +1,52 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+// Search index file.
+const (
+	// FileIndex is the search index file under .context/state/.
+	FileIndex = "journal-search.json"
+	// IndexVersion is the current index format; an index with
+	// another version is discarded and rebuilt.
+	IndexVersion = 1
+)
+
+// Query field names, written as name:value.
+const (
+	// FieldTool filters by the AI tool that recorded the session.
+	FieldTool = "tool"
+	// FieldBranch filters by git branch.
+	FieldBranch = "branch"
+	// FieldModel filters by model (substring match).
+	FieldModel = "model"
+	// FieldSince keeps sessions on or after a date (YYYY-MM-DD)
+	// or within the last N days (Nd).
+	FieldSince = "since"
+	// FieldFile filters by a file touched in tool uses
+	// (substring match).
+	FieldFile = "file"
+	// FieldSep separates a field name from its value.
+	FieldSep = ":"
+	// TagPrefix marks a topic tag, e.g. #caching.
+	TagPrefix = "#"
+	// Quote delimits a phrase.
+	Quote = `"`
+)
+
+// Ranking and display defaults.
+const (
+	// BM25K1 is the BM25 term-frequency saturation.
+	BM25K1 = 1.2
+	// BM25B is the BM25 length normalization.
+	BM25B = 0.75
+	// MinTokenLen is the shortest indexed term.
+	MinTokenLen = 2
+	// DefaultLimit is the number of results shown.
+	DefaultLimit = 10
+	// DefaultContext is the number of lines shown around a match.
+	DefaultContext = 2
+)
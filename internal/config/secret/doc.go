@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package secret defines constants for the content secret
+// scanner used by ctx drift, the journal importer, ctx add, and
+// hub publish.
+//
+// # Rules
+//
+// Built-in rule names ([RuleAWSAccessKey], [RuleAWSSecretKey],
+// [RuleGitHubToken], [RuleJWT], [RulePrivateKey],
+// [RuleSlackToken], [RuleAPIKey], [RuleAssignment],
+// [RuleHighEntropy]) label findings and redaction
+// placeholders. Their patterns live in config/regex. Projects
+// add their own patterns as [Rule] values under
+// secrets.rules in .ctxrc.
+//
+// # Entropy
+//
+// Generic rules only fire when the candidate value is random
+// enough: [AssignEntropy] bits per character for values
+// assigned to secret-looking keys, [DefaultEntropy] (or
+// secrets.entropy) for bare high-entropy tokens.
+//
+// # Allowlist
+//
+// [AllowlistFile] in the context directory holds one regular
+// expression per line; a finding whose value matches any of
+// them is ignored. Lines starting with [AllowComment] are
+// comments.
+//
+// # Redaction
+//
+// [RedactFormat] replaces a secret with a placeholder naming
+// its rule. Previews in reports keep [MaskKeep] characters at
+// each end.
+package secret
@@ -0,0 +1,64 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+// Built-in rule names.
+const (
+	// RuleAWSAccessKey matches AWS access key IDs.
+	RuleAWSAccessKey = "aws_access_key"
+	// RuleAWSSecretKey matches AWS secret access keys assigned
+	// to an aws_secret_access_key setting.
+	RuleAWSSecretKey = "aws_secret_key"
+	// RuleGitHubToken matches GitHub personal, OAuth, app, and
+	// fine-grained tokens.
+	RuleGitHubToken = "github_token"
+	// RuleJWT matches JSON Web Tokens.
+	RuleJWT = "jwt"
+	// RulePrivateKey matches PEM private key blocks.
+	RulePrivateKey = "private_key"
+	// RuleSlackToken matches Slack bot, user, and app tokens.
+	RuleSlackToken = "slack_token"
+	// RuleAPIKey matches sk- prefixed provider API keys.
+	RuleAPIKey = "api_key"
+	// RuleAssignment matches values assigned to secret-looking
+	// keys (password=, api_key:, token=).
+	RuleAssignment = "secret_assignment"
+	// RuleHighEntropy matches long random-looking tokens.
+	RuleHighEntropy = "high_entropy"
+)
+
+// Entropy thresholds in bits per character.
+const (
+	// AssignEntropy is the minimum entropy of a value assigned
+	// to a secret-looking key.
+	AssignEntropy = 3.0
+	// DefaultEntropy is the minimum entropy of a bare
+	// high-entropy token when secrets.entropy is unset.
+	DefaultEntropy = 4.5
+)
+
+// Allowlist and redaction settings.
+const (
+	// AllowlistFile is the allowlist file name within .context/.
+	AllowlistFile = "secrets.allow"
+	// AllowComment starts a comment line in the allowlist.
+	AllowComment = "#"
+	// RedactFormat is the placeholder written over a secret.
+	// The %s is the rule name.
+	RedactFormat = "[REDACTED:%s]"
+	// MaskKeep is the number of characters a preview keeps at
+	// each end of a secret.
+	MaskKeep = 4
+	// MaskFill replaces the hidden middle of a preview.
+	MaskFill = "…"
+)
+
+// Placeholders lists lower-case fragments that mark an assigned
+// value as an example rather than a secret.
+var Placeholders = []string{
+	"example", "placeholder", "changeme", "your", "xxxx", "dummy",
+}
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+// Rule is a project-defined secret pattern from .ctxrc.
+//
+// Fields:
+//   - Name: Rule name shown in findings and placeholders
+//   - Pattern: Regular expression; when it has a capture
+//     group, the first group is the secret, otherwise the
+//     whole match
+type Rule struct {
+	Name    string `yaml:"name"`
+	Pattern string `yaml:"pattern"`
+}
@@ -21,6 +21,11 @@
 //   - [ToolCopilot]: VS Code Copilot Chat.
 //   - [ToolCopilotCLI]: GitHub Copilot CLI.
 //   - [ToolMarkdown]: plain Markdown transcripts.
+//   - [ToolCodex]: OpenAI Codex CLI rollouts.
+//   - [ToolGemini]: Gemini CLI chat logs.
+//   - [ToolCursor]: Cursor's SQLite chat store.
+//   - [ToolAider]: Aider chat histories.
+//   - [ToolCline]: Cline task directories.
 //
 // # Claude Code Tool Names
 //
@@ -43,6 +43,8 @@ const (
 	FmKeyTokensOut  = "tokens_out"
 	FmKeyID         = "session_id"
 	FmKeyEntrypoint = "entrypoint"
+	FmKeyRedacted   = "redacted"
+	FmKeyRedactions = "redactions"
 )
 
 // Entrypoint values.
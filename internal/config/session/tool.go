@@ -16,6 +16,16 @@ const (
 	ToolCopilotCLI = "copilot-cli"
 	// ToolMarkdown is the tool identifier for Markdown session files.
 	ToolMarkdown = "markdown"
+	// ToolCodex is the tool identifier for OpenAI Codex CLI sessions.
+	ToolCodex = "codex"
+	// ToolGemini is the tool identifier for Gemini CLI sessions.
+	ToolGemini = "gemini"
+	// ToolCursor is the tool identifier for Cursor agent sessions.
+	ToolCursor = "cursor"
+	// ToolAider is the tool identifier for Aider chat histories.
+	ToolAider = "aider"
+	// ToolCline is the tool identifier for Cline tasks.
+	ToolCline = "cline"
 )
 
 // Claude Code tool names used in session transcripts.
@@ -6,6 +6,8 @@
 
 package setup
 
+import "github.com/ActiveMemory/ctx/internal/config/token"
+
 // Display names for supported integration tools.
 const (
 	// DisplayKiro is the display name for Kiro.
@@ -52,3 +54,58 @@ const (
 	// for Cline.
 	SteeringPathCline = ".clinerules/"
 )
+
+// Git scratchpad driver registration.
+const (
+	// DisplayGit is the display name for the git integration.
+	DisplayGit = "Git"
+	// FileGitattributes is the git attributes file at the
+	// repository root.
+	FileGitattributes = ".gitattributes"
+	// GitAttrLine is the attributes line that routes the
+	// encrypted scratchpad to the ctx-pad drivers; %s is the
+	// pad path pattern relative to the repository root.
+	GitAttrLine = "%s merge=ctx-pad diff=ctx-pad"
+	// GitMergeNameKey is the git config key naming the merge
+	// driver.
+	GitMergeNameKey = "merge.ctx-pad.name"
+	// GitMergeName is the human-readable merge driver name.
+	GitMergeName = "ctx encrypted scratchpad"
+	// GitMergeDriverKey is the git config key holding the
+	// merge driver command.
+	GitMergeDriverKey = "merge.ctx-pad.driver"
+	// GitMergeDriver is the merge driver command; %s is the
+	// shell-quoted absolute context directory.
+	GitMergeDriver = "CTX_DIR=%s ctx pad git-merge-driver %%O %%A %%B"
+	// GitTextconvKey is the git config key holding the diff
+	// text converter command.
+	GitTextconvKey = "diff.ctx-pad.textconv"
+	// GitTextconv is the diff text converter command; %s is
+	// the shell-quoted absolute context directory.
+	GitTextconv = "CTX_DIR=%s ctx pad git-textconv"
+)
+
+// GitAttrQuoteTrigger lists the characters that force a
+// .gitattributes pattern into C-style double quotes; an
+// unquoted pattern ends at whitespace.
+const GitAttrQuoteTrigger = token.Space + token.Tab +
+	token.NewlineLF + token.DoubleQuote
+
+// GitAttrGlobEscapes pairs each wildmatch metacharacter with
+// its backslash escape, so a pad path matches only itself.
+var GitAttrGlobEscapes = []string{
+	`\`, `\\`,
+	"*", `\*`,
+	"?", `\?`,
+	"[", `\[`,
+}
+
+// GitAttrQuoteEscapes pairs each character that cannot appear
+// raw inside a quoted .gitattributes pattern with its C-style
+// escape.
+var GitAttrQuoteEscapes = []string{
+	`\`, `\\`,
+	token.DoubleQuote, token.EscapedDoubleQuote,
+	token.Tab, `\t`,
+	token.NewlineLF, `\n`,
+}
@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot defines layout and naming constants for
+// the context snapshot store.
+//
+// # Store Layout
+//
+// Snapshots live under .context/snapshots/:
+//
+//	snapshots/
+//	  objects/ab/cdef...   file contents, named by SHA-256
+//	  manifests/<id>.json  one manifest per snapshot
+//
+// Objects are shared between snapshots, so capturing an
+// unchanged tree costs one manifest. IDs use [IDFormat]
+// so they sort by creation time.
+//
+// # Exclusions
+//
+// [ExcludeDirs] and [ExcludeExts] keep runtime state,
+// the store itself, and encrypted blobs and keys out of
+// snapshots.
+package snapshot
@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/crypto"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+)
+
+// Store layout constants.
+const (
+	// Objects is the content-addressed object directory
+	// inside the store.
+	Objects = "objects"
+	// Manifests is the manifest directory inside the store.
+	Manifests = "manifests"
+	// FanOut is the number of leading hash characters used
+	// as the object subdirectory name.
+	FanOut = 2
+)
+
+// Snapshot naming constants.
+const (
+	// IDFormat is the time layout used for snapshot IDs.
+	IDFormat = "20060102-150405"
+	// IDCollisionFmt disambiguates snapshots created within
+	// the same second. Takes the base ID and a counter.
+	IDCollisionFmt = "%s-%d"
+	// PreRestoreName names the safety snapshot taken before
+	// a restore.
+	PreRestoreName = "pre-restore"
+	// WorkingTree labels the live .context/ tree in diffs.
+	WorkingTree = "working tree"
+)
+
+// Diff status markers.
+const (
+	// StatusAdded marks a file present only on the new side.
+	StatusAdded = "A"
+	// StatusRemoved marks a file present only on the old side.
+	StatusRemoved = "D"
+	// StatusModified marks a file whose content changed.
+	StatusModified = "M"
+)
+
+// ExcludeDirs lists .context/ subdirectories never captured.
+var ExcludeDirs = []string{dir.State, dir.Snapshots}
+
+// ExcludeExts lists file extensions never captured:
+// encrypted blobs and key files.
+var ExcludeExts = []string{file.ExtEnc, file.ExtKey}
+
+// ExcludeKeyPrefix matches the context key and the grace, staged
+// and journal files ctx key rotate leaves next to it, whose
+// extensions ExcludeExts does not catch.
+const ExcludeKeyPrefix = crypto.ContextKey
@@ -0,0 +1,14 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package sqlite centralizes constants for running the sqlite3
+// command-line tool.
+//
+// ctx reads SQLite stores such as Cursor's state database
+// through the sqlite3 binary rather than linking a driver, so
+// the module stays free of cgo. Queries run read-only and print
+// their rows as JSON ([FlagReadonly], [FlagJSON]).
+package sqlite
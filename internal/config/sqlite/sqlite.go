@@ -0,0 +1,18 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+// Binary is the sqlite3 command-line tool.
+const Binary = "sqlite3"
+
+// sqlite3 flags.
+const (
+	// FlagReadonly opens the database read-only.
+	FlagReadonly = "-readonly"
+	// FlagJSON prints query results as a JSON array.
+	FlagJSON = "-json"
+)
@@ -24,6 +24,10 @@
 //   - **Format-string constants**: the per-line
 //     templates used to render "FILE:  N tokens
 //     (PCT%)" rows.
+//   - **Journal stats constants**: the `--by`
+//     grouping dimensions ([By]), the price-table
+//     unit, and the site page name used by
+//     `ctx journal stats`.
 //
 // # Concurrency
 //
@@ -0,0 +1,50 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import "github.com/ActiveMemory/ctx/internal/config/fmt"
+
+// Journal stats grouping dimensions for ctx journal stats --by.
+const (
+	// ByDay groups sessions by local start date.
+	ByDay = "day"
+	// ByWeek groups sessions by ISO week.
+	ByWeek = "week"
+	// ByBranch groups sessions by git branch.
+	ByBranch = "branch"
+	// ByModel groups sessions by model.
+	ByModel = "model"
+	// ByTool groups sessions by the AI tool that recorded them.
+	ByTool = "tool"
+)
+
+// By lists the valid grouping dimensions in display order.
+var By = []string{ByDay, ByWeek, ByBranch, ByModel, ByTool}
+
+// Formats lists the ctx journal stats --format values.
+var Formats = []string{fmt.FormatTable, fmt.FormatJSON, fmt.FormatCSV}
+
+// Journal stats report settings.
+const (
+	// WeekFormat renders an ISO year and week, e.g. 2026-W09.
+	WeekFormat = "%d-W%02d"
+	// TokensPerPrice is the token count a price-table entry is
+	// quoted for (USD per million tokens).
+	TokensPerPrice = 1_000_000
+	// TopTools is the number of most-used tools reported.
+	TopTools = 10
+	// FileJournalPage is the journal site page for the report.
+	FileJournalPage = "stats.md"
+)
+
+// CSVHeader names the ctx journal stats --format csv columns
+// after the first, which is named for the grouping dimension.
+var CSVHeader = []string{
+	"sessions", "turns", "tokens_in", "tokens_out", "cost_usd",
+	"unpriced_sessions", "sessions_with_errors", "error_rate",
+	"avg_turns", "avg_minutes",
+}
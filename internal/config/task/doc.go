@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package task defines the constants behind task
+// dependency metadata in TASKS.md.
+//
+// A task opts into the dependency graph with inline
+// tags next to its provenance tags:
+//
+//   - [ ] Build auth API #id:auth-api #after:db-schema
+//   - [ ] Login screen #blocks:release #after:auth-api
+//
+// [TagID] names the task, [TagAfter] lists the tasks
+// it waits for and [TagBlocks] lists the tasks that
+// wait for it. The matching regular expression lives
+// in [internal/config/regex.TaskDep].
+//
+// [GraphFormats] enumerates the renderings offered by
+// ctx task graph; [NodeKeyFormat] and [MermaidQuote]
+// keep DOT and Mermaid node names and labels valid.
+package task
@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import "github.com/ActiveMemory/ctx/internal/config/fmt"
+
+// Task dependency metadata tags (the part between "#" and ":").
+const (
+	// TagID names a task so other tasks can refer to it.
+	TagID = "id"
+	// TagBlocks lists tasks that must wait for this one.
+	TagBlocks = "blocks"
+	// TagAfter lists tasks this one must wait for.
+	TagAfter = "after"
+)
+
+// Dependency graph rendering.
+const (
+	// NodeKeyFormat names a graph node in DOT and Mermaid output
+	// by its one-based TASKS.md line number.
+	NodeKeyFormat = "t%d"
+	// MermaidQuote replaces double quotes inside Mermaid labels.
+	MermaidQuote = "#quot;"
+)
+
+// GraphFormats lists the output formats of ctx task graph.
+var GraphFormats = []string{
+	fmt.FormatText, fmt.FormatDOT, fmt.FormatMermaid,
+}
@@ -59,6 +59,8 @@ const (
 	Plus = "+"
 	// Hash is the hash/pound character.
 	Hash = "#"
+	// Arrow joins the steps of a chain for display (a → b → c).
+	Arrow = " → "
 	// ParentDir is the relative parent directory component.
 	ParentDir = ".."
 	// FrontmatterDelimiter is the YAML frontmatter
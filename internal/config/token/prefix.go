@@ -38,6 +38,8 @@ const (
 	PrefixHTTP = "http"
 	// PrefixProtocolRelative is the protocol-relative URL prefix.
 	PrefixProtocolRelative = "//"
+	// SchemeSep separates a URL scheme from the rest of the URL.
+	SchemeSep = "://"
 )
 
 // Template and glob indicator characters.
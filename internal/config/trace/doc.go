@@ -31,6 +31,11 @@
 //     used in ctx-context trailer values.
 //   - [RefFormat], [SessionRefFormat]: format strings
 //     for numbered and session refs.
+//   - [ExternalRefFormat]: "<resolver>:<id>" refs to
+//     issue trackers configured in trace.resolvers.
+//     [ResolverID] is the "{id}" placeholder in their
+//     url and api templates; [DefaultTitleField] is
+//     the JSON field read by ctx trace sync.
 //
 // # Display Defaults
 //
@@ -39,6 +44,8 @@
 //   - [DefaultLastShow] (10): commits shown by
 //     ctx trace with no arguments.
 //   - [ShortHashLen] (7): abbreviated hash length.
+//   - [DefaultSyncLast] (200): commits scanned by
+//     ctx trace sync.
 //
 // # Hook Management
 //
@@ -55,6 +62,18 @@
 //   - [FileHistory], [FileOverrides],
 //     [FilePending]: JSONL filenames within the
 //     trace state directory.
+//   - [FileTitles]: offline cache of external issue
+//     titles written by ctx trace sync.
+//
+// # Git Notes
+//
+//   - [NotesRef] ("refs/notes/ctx"): notes ref that
+//     carries commit context links with the repository.
+//   - [NotesIncomingRef]: staging ref for fetched notes.
+//   - [NotesMergeStrategy] ("cat_sort_uniq"): line-wise
+//     merge of fetched notes.
+//   - [NoteKindHistory], [NoteKindOverride]: record
+//     kinds within a note.
 //
 // # Concurrency
 //
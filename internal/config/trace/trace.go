@@ -70,6 +70,26 @@ const (
 	FileHistory   = "history.jsonl"
 	FileOverrides = "overrides.jsonl"
 	FilePending   = "pending-context.jsonl"
+	FileTitles    = "titles.jsonl"
+)
+
+// External ref resolvers (trace.resolvers in .ctxrc).
+const (
+	// ExternalRefFormat renders an external ref from a resolver
+	// name and an issue ID (e.g. "jira:PAY-567").
+	ExternalRefFormat = "%s:%s"
+	// ResolverID is the placeholder in url and api templates
+	// replaced with the matched issue ID.
+	ResolverID = "{id}"
+	// DefaultTitleField is the JSON field read from the api
+	// response when title_field is not set.
+	DefaultTitleField = "title"
+	// DefaultSyncLast is the number of recent commits ctx trace
+	// sync scans for external refs.
+	DefaultSyncLast = 200
+	// TitleTimeout is the per-request timeout, in seconds, for
+	// fetching an external title.
+	TitleTimeout = 10
 )
 
 // Embedded hook script filenames.
@@ -77,3 +97,22 @@ const (
 	ScriptPrepareCommitMsg = "prepare-commit-msg.sh"
 	ScriptPostCommit       = "post-commit.sh"
 )
+
+// Git notes storage for commit context links.
+const (
+	// NotesRef is the notes ref holding one JSONL note per
+	// traced commit.
+	NotesRef = "refs/notes/ctx"
+	// NotesIncomingRef receives the remote notes ref during
+	// ctx trace notes fetch, before it is merged into NotesRef.
+	NotesIncomingRef = "refs/notes/ctx-incoming"
+	// NotesMergeStrategy merges notes line by line, dropping
+	// duplicates, which suits JSONL records.
+	NotesMergeStrategy = "cat_sort_uniq"
+	// NoteKindHistory marks a note record written at commit
+	// time (a HistoryEntry).
+	NoteKindHistory = "history"
+	// NoteKindOverride marks a note record added by ctx trace
+	// tag (an OverrideEntry).
+	NoteKindOverride = "override"
+)
@@ -104,6 +104,21 @@ const (
 	// explains why the state directory resolution went sideways
 	// before the caller surfaces an empty-path error.
 	StateDirProbe = "probe state dir: %v"
+
+	// NotifyFlushStart is the stderr format for a failure to start
+	// the detached outbox flush from the heartbeat hook. The queue
+	// stays intact and the next due heartbeat tries again.
+	NotifyFlushStart = "start notify flush: %v"
+
+	// SessionSkipped is the stderr format for a session whose
+	// messages could not be loaded; journal stats, site, and
+	// import carry on without it.
+	SessionSkipped = "skip session %s: %v"
+
+	// HealthHistory is the stderr format for health history read or
+	// append failures in ctx status. The score still prints; the
+	// warning explains gaps in a later --trend.
+	HealthHistory = "health history: %v"
 )
 
 // Warn context identifiers for index generation.
@@ -0,0 +1,42 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package layer composes a project's context with the
+// parent or sibling `.context/` directories listed under
+// `inherit:` in .ctxrc.
+//
+// In a monorepo the org keeps CONSTITUTION.md and
+// CONVENTIONS.md at the repository root while each service
+// keeps its own `.context/`. [Load] reads the service
+// context with [load.Do], then merges each layer in .ctxrc
+// order (nearest first) according to
+// [internal/config/inherit.Strategy]:
+//
+//   - CONSTITUTION.md: layer checkbox rules the project does
+//     not already state are appended.
+//   - CONVENTIONS.md, GLOSSARY.md: the layer body is appended
+//     under a provenance heading.
+//   - DECISIONS.md, LEARNINGS.md: layer entries are appended
+//     unless the project (or a nearer layer) already has an
+//     entry with the same title; child entries shadow
+//     parent ones.
+//   - ARCHITECTURE.md, AGENT_PLAYBOOK.md: the project's copy
+//     wins.
+//   - TASKS.md is never inherited.
+//
+// A file the project lacks is taken from the layer whole,
+// tagged with [entity.FileInfo.Layer].
+//
+// Every appended block opens with a marker comment naming
+// the layer. [Local] strips inherited blocks back off, and
+// drift uses the markers to resolve path references
+// against the right project root.
+//
+// Layers are read from disk, or with `git show` when a ref
+// is configured. A layer that cannot be read is recorded
+// in [entity.Context.Layers] with its error and otherwise
+// skipped; loading never fails because of a layer.
+package layer
@@ -0,0 +1,72 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package layer
+
+import (
+	"bytes"
+	"path/filepath"
+	"unicode"
+
+	cfgInherit "github.com/ActiveMemory/ctx/internal/config/inherit"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/context/load"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Load reads the project context and merges every inherited
+// layer from .ctxrc into it.
+//
+// Without an inherit list the result equals [load.Do].
+//
+// Parameters:
+//   - dir: Directory path to load from, or empty string for the
+//     declared context directory
+//
+// Returns:
+//   - *entity.Context: Merged context; Layers lists each layer
+//     with the files it contributed or the error that skipped it
+//   - error: Any error from loading the project's own context
+func Load(dir string) (*entity.Context, error) {
+	ctx, loadErr := load.Do(dir)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	inherits := rc.Inherit()
+	if len(inherits) == 0 {
+		return ctx, nil
+	}
+
+	root := filepath.Dir(ctx.Dir)
+	for _, in := range inherits {
+		l, files := read(ctx.Dir, root, in.Path, in.Ref, in.Label)
+		if l.Error == "" {
+			l.Files = merge(ctx, l.Label, files)
+		}
+		ctx.Layers = append(ctx.Layers, l)
+	}
+	total(ctx)
+	return ctx, nil
+}
+
+// Local returns the part of a merged file that came from the
+// project itself, dropping every inherited block.
+//
+// Parameters:
+//   - content: File content, possibly with inherited blocks
+//
+// Returns:
+//   - []byte: Content before the first layer marker, ending in a
+//     single newline
+func Local(content []byte) []byte {
+	i := bytes.Index(content, []byte(cfgInherit.MarkerPrefix))
+	if i < 0 {
+		return content
+	}
+	local := bytes.TrimRightFunc(content[:i], unicode.IsSpace)
+	return append(local, token.NewlineLF...)
+}
@@ -0,0 +1,176 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package layer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/env"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// writeFiles creates a .context directory under root with the
+// given files.
+func writeFiles(t *testing.T, root string, files map[string]string) string {
+	t.Helper()
+	ctxDir := filepath.Join(root, dir.Context)
+	if mkErr := os.MkdirAll(ctxDir, 0700); mkErr != nil {
+		t.Fatalf("mkdir: %v", mkErr)
+	}
+	for name, content := range files {
+		p := filepath.Join(ctxDir, name)
+		if wErr := os.WriteFile(p, []byte(content), 0600); wErr != nil {
+			t.Fatalf("write %s: %v", name, wErr)
+		}
+	}
+	return ctxDir
+}
+
+// monorepo lays out an org-level .context at the repo root and a
+// service .context under svc/ that inherits from it.
+func monorepo(t *testing.T, ctxrc string) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		cfgCtx.Constitution: "# Constitution\n\n" +
+			"- [ ] Never commit secrets\n" +
+			"- [ ] All code must pass tests\n",
+		cfgCtx.Convention: "# Conventions\n\n## Naming\n\nUse camelCase.\n",
+		cfgCtx.Decision: "# Decisions\n\n" +
+			"## [2026-01-01-120000] Use PostgreSQL\n\nOrg default.\n\n" +
+			"## [2026-01-02-120000] Use gRPC\n\nFor services.\n",
+		cfgCtx.Glossary: "# Glossary\n\n- **SLO**: objective\n",
+		cfgCtx.Task:     "# Tasks\n\n- [ ] Org task\n",
+	})
+	svc := filepath.Join(root, "svc")
+	ctxDir := writeFiles(t, svc, map[string]string{
+		cfgCtx.Constitution: "# Constitution\n\n- [ ] Never commit secrets\n",
+		cfgCtx.Convention:   "# Conventions\n\n## Errors\n\nWrap errors.\n",
+		cfgCtx.Decision: "# Decisions\n\n" +
+			"## [2026-02-01-120000] Use PostgreSQL\n\nService uses SQLite.\n",
+		cfgCtx.Task: "# Tasks\n\n- [ ] Service task\n",
+	})
+	rcPath := filepath.Join(svc, ".ctxrc")
+	if wErr := os.WriteFile(rcPath, []byte(ctxrc), 0600); wErr != nil {
+		t.Fatalf("write .ctxrc: %v", wErr)
+	}
+	t.Setenv(env.CtxDir, ctxDir)
+	rc.Reset()
+	t.Cleanup(rc.Reset)
+	return ctxDir
+}
+
+func TestLoad_NoInherit(t *testing.T) {
+	monorepo(t, "")
+	ctx, loadErr := Load("")
+	if loadErr != nil {
+		t.Fatalf("Load: %v", loadErr)
+	}
+	if len(ctx.Layers) != 0 {
+		t.Errorf("Layers = %d, want 0", len(ctx.Layers))
+	}
+	if ctx.File(cfgCtx.Glossary) != nil {
+		t.Error("glossary loaded without inherit")
+	}
+}
+
+func TestLoad_Merge(t *testing.T) {
+	monorepo(t, "inherit:\n  - path: ..\n    label: org\n")
+	ctx, loadErr := Load("")
+	if loadErr != nil {
+		t.Fatalf("Load: %v", loadErr)
+	}
+	if len(ctx.Layers) != 1 || ctx.Layers[0].Error != "" {
+		t.Fatalf("Layers = %+v, want one loaded layer", ctx.Layers)
+	}
+
+	constitution := string(ctx.File(cfgCtx.Constitution).Content)
+	if strings.Count(constitution, "Never commit secrets") != 1 {
+		t.Errorf("duplicate rule not collapsed:\n%s", constitution)
+	}
+	if !strings.Contains(constitution, "All code must pass tests") {
+		t.Errorf("parent rule missing:\n%s", constitution)
+	}
+
+	conventions := string(ctx.File(cfgCtx.Convention).Content)
+	for _, want := range []string{
+		"Wrap errors.", "<!-- ctx:layer org -->",
+		"## Inherited from org", "Use camelCase.",
+	} {
+		if !strings.Contains(conventions, want) {
+			t.Errorf("conventions missing %q:\n%s", want, conventions)
+		}
+	}
+	if strings.Count(conventions, "# Conventions\n") != 1 {
+		t.Errorf("parent title not dropped:\n%s", conventions)
+	}
+
+	decisions := string(ctx.File(cfgCtx.Decision).Content)
+	if strings.Contains(decisions, "Org default.") {
+		t.Errorf("child decision did not shadow parent:\n%s", decisions)
+	}
+	if !strings.Contains(decisions, "Use gRPC") {
+		t.Errorf("parent decision missing:\n%s", decisions)
+	}
+
+	glossary := ctx.File(cfgCtx.Glossary)
+	if glossary == nil || glossary.Layer != "org" {
+		t.Errorf("glossary = %+v, want inherited whole from org", glossary)
+	}
+	if strings.Contains(string(ctx.File(cfgCtx.Task).Content), "Org task") {
+		t.Error("tasks must not be inherited")
+	}
+
+	var tokens int
+	for _, f := range ctx.Files {
+		tokens += f.Tokens
+	}
+	if ctx.TotalTokens != tokens {
+		t.Errorf("TotalTokens = %d, want %d", ctx.TotalTokens, tokens)
+	}
+}
+
+func TestLoad_LayerErrors(t *testing.T) {
+	monorepo(t, "inherit:\n"+
+		"  - path: .\n"+
+		"  - path: missing\n")
+	ctx, loadErr := Load("")
+	if loadErr != nil {
+		t.Fatalf("Load: %v", loadErr)
+	}
+	if len(ctx.Layers) != 2 {
+		t.Fatalf("Layers = %d, want 2", len(ctx.Layers))
+	}
+	for _, l := range ctx.Layers {
+		if l.Error == "" {
+			t.Errorf("layer %q: want error", l.Label)
+		}
+		if len(l.Files) != 0 {
+			t.Errorf("layer %q contributed %v", l.Label, l.Files)
+		}
+	}
+}
+
+func TestLocal(t *testing.T) {
+	merged := "# Decisions\n\n## [2026-01-01-120000] A\n\n" +
+		"<!-- ctx:layer org -->\n## Inherited from org\n\nmore\n"
+	got := string(Local([]byte(merged)))
+	want := "# Decisions\n\n## [2026-01-01-120000] A\n"
+	if got != want {
+		t.Errorf("Local = %q, want %q", got, want)
+	}
+
+	plain := "# Decisions\n"
+	if got := string(Local([]byte(plain))); got != plain {
+		t.Errorf("Local(plain) = %q, want unchanged", got)
+	}
+}
@@ -0,0 +1,186 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package layer
+
+import (
+	"fmt"
+	"strings"
+
+	cfgInherit "github.com/ActiveMemory/ctx/internal/config/inherit"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	ctxToken "github.com/ActiveMemory/ctx/internal/context/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/index"
+	"github.com/ActiveMemory/ctx/internal/task"
+)
+
+// merge folds a layer's files into the context.
+//
+// Files the project lacks are added whole, tagged with the layer
+// label. Files both have are merged per [cfgInherit.Strategy].
+//
+// Parameters:
+//   - ctx: Context to merge into; modified in place
+//   - label: Layer label for provenance markers
+//   - files: The layer's context files
+//
+// Returns:
+//   - []string: Names of files the layer contributed to
+func merge(
+	ctx *entity.Context, label string, files []entity.FileInfo,
+) []string {
+	var contributed []string
+	for _, lf := range files {
+		strategy, ok := cfgInherit.Strategy[lf.Name]
+		if !ok || lf.IsEmpty {
+			continue
+		}
+		local := ctx.File(lf.Name)
+		if local == nil {
+			lf.Layer = label
+			ctx.Files = append(ctx.Files, lf)
+			contributed = append(contributed, lf.Name)
+			continue
+		}
+		// A file inherited whole from an earlier layer is not the
+		// project's own; the first layer wins.
+		if local.Layer != "" {
+			continue
+		}
+
+		var block string
+		switch strategy {
+		case cfgInherit.StrategyRules:
+			block = mergeRules(string(local.Content), string(lf.Content))
+		case cfgInherit.StrategyConcat:
+			block = mergeConcat(string(lf.Content))
+		case cfgInherit.StrategyShadow:
+			block = mergeShadow(string(local.Content), string(lf.Content))
+		}
+		if block == "" {
+			continue
+		}
+		appendBlock(local, label, block)
+		contributed = append(contributed, lf.Name)
+	}
+	return contributed
+}
+
+// mergeRules returns the layer's checkbox rules the project does
+// not already state.
+//
+// Parameters:
+//   - local: Project file content
+//   - layer: Layer file content
+//
+// Returns:
+//   - string: Missing rule lines, or empty if none
+func mergeRules(local, layer string) string {
+	have := make(map[string]bool)
+	for _, line := range strings.Split(local, token.NewlineLF) {
+		if m := regex.Task.FindStringSubmatch(line); m != nil {
+			have[strings.TrimSpace(task.Content(m))] = true
+		}
+	}
+	var missing []string
+	for _, line := range strings.Split(layer, token.NewlineLF) {
+		m := regex.Task.FindStringSubmatch(line)
+		if m == nil || task.Sub(m) {
+			continue
+		}
+		c := strings.TrimSpace(task.Content(m))
+		if have[c] {
+			continue
+		}
+		have[c] = true
+		missing = append(missing, strings.TrimSpace(line))
+	}
+	return strings.Join(missing, token.NewlineLF)
+}
+
+// mergeConcat returns the layer's body without its title line.
+//
+// Parameters:
+//   - layer: Layer file content
+//
+// Returns:
+//   - string: Body to append, or empty if the layer has none
+func mergeConcat(layer string) string {
+	lines := strings.Split(layer, token.NewlineLF)
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if strings.HasPrefix(line, token.HeadingLevelOneStart) {
+			lines = lines[i+1:]
+		}
+		break
+	}
+	return strings.TrimSpace(strings.Join(lines, token.NewlineLF))
+}
+
+// mergeShadow returns the layer's entries whose titles the
+// project does not already use.
+//
+// Parameters:
+//   - local: Project file content
+//   - layer: Layer file content
+//
+// Returns:
+//   - string: Entries to append, or empty if all are shadowed
+func mergeShadow(local, layer string) string {
+	have := make(map[string]bool)
+	for _, eb := range index.ParseEntryBlocks(local) {
+		have[strings.ToLower(eb.Entry.Title)] = true
+	}
+	var kept []string
+	for _, eb := range index.ParseEntryBlocks(layer) {
+		if have[strings.ToLower(eb.Entry.Title)] {
+			continue
+		}
+		kept = append(kept, eb.BlockContent())
+	}
+	return strings.Join(kept, token.DoubleNewline)
+}
+
+// appendBlock adds an inherited block under a layer marker and
+// provenance heading, then refreshes the file's size and tokens.
+//
+// Parameters:
+//   - f: Project file to extend; modified in place
+//   - label: Layer label
+//   - block: Content to append
+func appendBlock(f *entity.FileInfo, label, block string) {
+	var sb strings.Builder
+	sb.WriteString(strings.TrimRight(string(f.Content), token.NewlineLF))
+	sb.WriteString(token.DoubleNewline)
+	sb.WriteString(fmt.Sprintf(cfgInherit.MarkerFmt, label))
+	sb.WriteString(token.NewlineLF)
+	sb.WriteString(fmt.Sprintf(cfgInherit.HeadingFmt, label))
+	sb.WriteString(token.DoubleNewline)
+	sb.WriteString(block)
+	sb.WriteString(token.NewlineLF)
+
+	f.Content = []byte(sb.String())
+	f.Size = int64(len(f.Content))
+	f.Tokens = ctxToken.Estimate(f.Content)
+	f.IsEmpty = false
+}
+
+// total recomputes the context's aggregate token and size counts.
+//
+// Parameters:
+//   - ctx: Context to update in place
+func total(ctx *entity.Context) {
+	ctx.TotalTokens = 0
+	ctx.TotalSize = 0
+	for _, f := range ctx.Files {
+		ctx.TotalTokens += f.Tokens
+		ctx.TotalSize += f.Size
+	}
+}
@@ -0,0 +1,126 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package layer
+
+import (
+	"path"
+	"path/filepath"
+
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgInherit "github.com/ActiveMemory/ctx/internal/config/inherit"
+	"github.com/ActiveMemory/ctx/internal/context/load"
+	"github.com/ActiveMemory/ctx/internal/context/sanitize"
+	"github.com/ActiveMemory/ctx/internal/context/summary"
+	"github.com/ActiveMemory/ctx/internal/context/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// read resolves one inherit entry and loads its context files.
+//
+// Parameters:
+//   - ctxDir: The project's own context directory
+//   - root: The project root that relative paths resolve against
+//   - p: Layer path from .ctxrc
+//   - ref: Optional git ref from .ctxrc
+//   - label: Optional label from .ctxrc
+//
+// Returns:
+//   - entity.ContextLayer: Layer description; Error is set when
+//     the layer could not be read
+//   - []entity.FileInfo: The layer's context files
+func read(
+	ctxDir, root, p, ref, label string,
+) (entity.ContextLayer, []entity.FileInfo) {
+	if ref != "" {
+		return readGit(root, p, ref, label)
+	}
+
+	l := entity.ContextLayer{Label: label}
+	given := p
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(root, p)
+	}
+	p = filepath.Clean(p)
+	if filepath.Base(p) != dir.Context {
+		p = filepath.Join(p, dir.Context)
+	}
+	if l.Label == "" {
+		l.Label = given
+	}
+	l.Dir = p
+	l.Root = filepath.Dir(p)
+
+	if p == filepath.Clean(ctxDir) {
+		l.Error = errCtx.LayerSelf(l.Label).Error()
+		return l, nil
+	}
+	c, loadErr := load.Do(p)
+	if loadErr != nil {
+		l.Error = loadErr.Error()
+		return l, nil
+	}
+	if len(c.Files) == 0 {
+		l.Error = errCtx.LayerEmpty(l.Label).Error()
+	}
+	return l, c.Files
+}
+
+// readGit loads a layer's context files from a git ref.
+//
+// Only inheritable files (see [cfgInherit.Strategy]) are read.
+// Git layers have no Root, so drift skips path checks for them.
+//
+// Parameters:
+//   - root: Repository directory to run git in
+//   - base: Path of the .context/ directory inside the tree
+//   - ref: Git ref to read from
+//   - label: Optional label from .ctxrc
+//
+// Returns:
+//   - entity.ContextLayer: Layer description
+//   - []entity.FileInfo: Files found at the ref
+func readGit(
+	root, base, ref, label string,
+) (entity.ContextLayer, []entity.FileInfo) {
+	if base == "" {
+		base = dir.Context
+	}
+	l := entity.ContextLayer{Label: label, Ref: ref}
+	if l.Label == "" {
+		l.Label = ref + cfgInherit.RefSep + base
+	}
+
+	var files []entity.FileInfo
+	for _, name := range cfgCtx.ReadOrder {
+		if _, ok := cfgInherit.Strategy[name]; !ok {
+			continue
+		}
+		object := path.Join(filepath.ToSlash(base), name)
+		content, showErr := execGit.ShowFile(root, ref, object)
+		if showErr != nil {
+			continue
+		}
+		tokens := token.Estimate(content)
+		files = append(files, entity.FileInfo{
+			Name:    name,
+			Path:    ref + cfgInherit.RefSep + object,
+			Size:    int64(len(content)),
+			Content: content,
+			IsEmpty: len(content) == 0 ||
+				sanitize.EffectivelyEmpty(content),
+			Tokens:  tokens,
+			Summary: summary.Generate(name, content),
+		})
+	}
+	if len(files) == 0 {
+		l.Error = errCtx.LayerEmpty(l.Label).Error()
+	}
+	return l, files
+}
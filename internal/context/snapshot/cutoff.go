@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"time"
+
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+)
+
+// parseCutoff turns a date or timestamp reference into the
+// latest instant a matching snapshot may have.
+//
+// Parameters:
+//   - ref: YYYY-MM-DD date or RFC 3339 timestamp
+//
+// Returns:
+//   - time.Time: End of the day for dates, the instant itself
+//     for timestamps
+//   - bool: False if ref is neither
+func parseCutoff(ref string) (time.Time, bool) {
+	if day, dErr := time.ParseInLocation(
+		cfgTime.DateFormat, ref, time.Local,
+	); dErr == nil {
+		return day.AddDate(0, 0, 1).Add(-time.Nanosecond), true
+	}
+	if ts, tErr := time.Parse(time.RFC3339, ref); tErr == nil {
+		return ts, true
+	}
+	return time.Time{}, false
+}
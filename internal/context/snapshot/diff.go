@@ -0,0 +1,60 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"sort"
+
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Diff compares two file lists by path and hash.
+//
+// Parameters:
+//   - older: Files on the old side
+//   - newer: Files on the new side
+//
+// Returns:
+//   - []entity.SnapshotChange: Added, removed, and modified
+//     files in path order; empty when the trees match
+func Diff(older, newer []entity.SnapshotFile) []entity.SnapshotChange {
+	oldByPath := make(map[string]*entity.SnapshotFile, len(older))
+	for i := range older {
+		oldByPath[older[i].Path] = &older[i]
+	}
+	newByPath := make(map[string]*entity.SnapshotFile, len(newer))
+	for i := range newer {
+		newByPath[newer[i].Path] = &newer[i]
+	}
+
+	var changes []entity.SnapshotChange
+	for p, o := range oldByPath {
+		n, ok := newByPath[p]
+		switch {
+		case !ok:
+			changes = append(changes, entity.SnapshotChange{
+				Path: p, Status: cfgSnap.StatusRemoved, Old: o,
+			})
+		case n.Hash != o.Hash:
+			changes = append(changes, entity.SnapshotChange{
+				Path: p, Status: cfgSnap.StatusModified, Old: o, New: n,
+			})
+		}
+	}
+	for p, n := range newByPath {
+		if _, ok := oldByPath[p]; !ok {
+			changes = append(changes, entity.SnapshotChange{
+				Path: p, Status: cfgSnap.StatusAdded, New: n,
+			})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
@@ -0,0 +1,36 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot captures the .context/ tree into a
+// content-addressed store and reads it back.
+//
+// # Store
+//
+// [Create] hashes every file under .context/ (minus
+// runtime state, the store itself, and encrypted blobs),
+// writes objects the store does not have yet, and records
+// a manifest. Unchanged files cost nothing on later
+// snapshots.
+//
+// # Lookup
+//
+// [Resolve] accepts a snapshot ID, a snapshot name (the
+// latest with that name wins), or a date: YYYY-MM-DD
+// picks the last snapshot taken on or before that day,
+// and an RFC 3339 timestamp the last one at or before
+// that instant.
+//
+// # Time Travel
+//
+// [Load] builds an [entity.Context] from a snapshot the
+// same way [load.Do] builds one from disk, so ctx load
+// and ctx agent can reproduce what an agent saw.
+//
+// # Restore
+//
+// [Restore] writes a snapshot's files back into
+// .context/. Files added since the snapshot are kept.
+package snapshot
@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/context/sanitize"
+	"github.com/ActiveMemory/ctx/internal/context/summary"
+	ctxToken "github.com/ActiveMemory/ctx/internal/context/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Load builds a context from a snapshot instead of the live
+// directory.
+//
+// Mirrors [load.Do]: only top-level Markdown files are
+// included. Every file's ModTime is the snapshot time.
+//
+// Parameters:
+//   - ctxDir: Context directory holding the store
+//   - ref: Snapshot ID, name, date, or timestamp (see [Resolve])
+//
+// Returns:
+//   - *entity.Context: Context as captured
+//   - *entity.Snapshot: The snapshot that was loaded
+//   - error: Non-nil if the reference does not resolve or an
+//     object cannot be read
+func Load(
+	ctxDir, ref string,
+) (*entity.Context, *entity.Snapshot, error) {
+	s, resolveErr := Resolve(ctxDir, ref)
+	if resolveErr != nil {
+		return nil, nil, resolveErr
+	}
+
+	ctx := &entity.Context{
+		Dir:   ctxDir,
+		Files: []entity.FileInfo{},
+	}
+	for _, f := range s.Files {
+		if strings.Contains(f.Path, token.Slash) ||
+			filepath.Ext(f.Path) != file.ExtMarkdown {
+			continue
+		}
+		content, readErr := ReadFile(ctxDir, f)
+		if readErr != nil {
+			return nil, nil, readErr
+		}
+		tokens := ctxToken.Estimate(content)
+		ctx.Files = append(ctx.Files, entity.FileInfo{
+			Name:    f.Path,
+			Path:    filepath.Join(ctxDir, f.Path),
+			Size:    f.Size,
+			ModTime: s.Created,
+			Content: content,
+			IsEmpty: len(content) == 0 ||
+				sanitize.EffectivelyEmpty(content),
+			Tokens:  tokens,
+			Summary: summary.Generate(f.Path, content),
+		})
+		ctx.TotalTokens += tokens
+		ctx.TotalSize += f.Size
+	}
+	return ctx, s, nil
+}
@@ -0,0 +1,55 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+)
+
+// root returns the snapshot store directory.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//
+// Returns:
+//   - string: Path to .context/snapshots
+func root(ctxDir string) string {
+	return filepath.Join(ctxDir, dir.Snapshots)
+}
+
+// objectPath returns the store path for an object hash.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//   - h: Hex object hash
+//
+// Returns:
+//   - string: Path under objects/, fanned out by hash prefix
+func objectPath(ctxDir, h string) string {
+	return filepath.Join(
+		root(ctxDir), cfgSnap.Objects,
+		h[:cfgSnap.FanOut], h[cfgSnap.FanOut:],
+	)
+}
+
+// manifestPath returns the store path for a manifest.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//   - id: Snapshot ID
+//
+// Returns:
+//   - string: Path under manifests/
+func manifestPath(ctxDir, id string) string {
+	return filepath.Join(
+		root(ctxDir), cfgSnap.Manifests, id+file.ExtJSON,
+	)
+}
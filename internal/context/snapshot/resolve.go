@@ -0,0 +1,59 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errSnap "github.com/ActiveMemory/ctx/internal/err/snapshot"
+)
+
+// Resolve finds the snapshot a reference names.
+//
+// The reference is tried, in order, as a snapshot ID, a
+// snapshot name (latest wins), a YYYY-MM-DD date (last
+// snapshot on or before that day), and an RFC 3339
+// timestamp (last snapshot at or before that instant).
+//
+// Parameters:
+//   - ctxDir: Context directory holding the store
+//   - ref: Snapshot ID, name, date, or timestamp
+//
+// Returns:
+//   - *entity.Snapshot: The matching snapshot
+//   - error: None when the store is empty, NotFound when
+//     nothing matches, or a store read error
+func Resolve(ctxDir, ref string) (*entity.Snapshot, error) {
+	all, listErr := List(ctxDir)
+	if listErr != nil {
+		return nil, listErr
+	}
+	if len(all) == 0 {
+		return nil, errSnap.None()
+	}
+
+	for i := range all {
+		if all[i].ID == ref {
+			return &all[i], nil
+		}
+	}
+	for i := len(all) - 1; i >= 0; i-- {
+		if all[i].Name == ref {
+			return &all[i], nil
+		}
+	}
+
+	cutoff, ok := parseCutoff(ref)
+	if !ok {
+		return nil, errSnap.NotFound(ref)
+	}
+	for i := len(all) - 1; i >= 0; i-- {
+		if !all[i].Created.After(cutoff) {
+			return &all[i], nil
+		}
+	}
+	return nil, errSnap.NotFound(ref)
+}
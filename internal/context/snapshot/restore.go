@@ -0,0 +1,78 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"path/filepath"
+
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errSnap "github.com/ActiveMemory/ctx/internal/err/snapshot"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Restore writes a snapshot's files back into the context
+// directory.
+//
+// Files whose content already matches are left untouched.
+// Files created after the snapshot are kept.
+//
+// Parameters:
+//   - ctxDir: Context directory to restore into
+//   - s: Snapshot to restore
+//
+// Returns:
+//   - []entity.SnapshotChange: Files that were written, marked
+//     added when missing before the restore and modified otherwise
+//   - error: Non-nil if an object cannot be read or a file
+//     cannot be written
+func Restore(
+	ctxDir string, s *entity.Snapshot,
+) ([]entity.SnapshotChange, error) {
+	current, scanErr := Scan(ctxDir)
+	if scanErr != nil {
+		return nil, scanErr
+	}
+	have := make(map[string]*entity.SnapshotFile, len(current))
+	for i := range current {
+		have[current[i].Path] = &current[i]
+	}
+
+	var restored []entity.SnapshotChange
+	for i := range s.Files {
+		f := s.Files[i]
+		old, existed := have[f.Path]
+		if existed && old.Hash == f.Hash {
+			continue
+		}
+		content, readErr := ReadFile(ctxDir, f)
+		if readErr != nil {
+			return restored, readErr
+		}
+		p := filepath.Join(ctxDir, filepath.FromSlash(f.Path))
+		if mkErr := ctxIo.SafeMkdirAll(
+			filepath.Dir(p), cfgFs.PermExec,
+		); mkErr != nil {
+			return restored, errSnap.Write(mkErr)
+		}
+		if wErr := ctxIo.SafeWriteFile(
+			p, content, cfgFs.PermFile,
+		); wErr != nil {
+			return restored, errSnap.Write(wErr)
+		}
+		change := entity.SnapshotChange{
+			Path: f.Path, Status: cfgSnap.StatusAdded, New: &s.Files[i],
+		}
+		if existed {
+			change.Status = cfgSnap.StatusModified
+			change.Old = old
+		}
+		restored = append(restored, change)
+	}
+	return restored, nil
+}
@@ -0,0 +1,240 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+)
+
+// setup creates a context directory with a task file, a state
+// file, and a key file.
+func setup(t *testing.T) string {
+	t.Helper()
+	ctxDir := filepath.Join(t.TempDir(), dir.Context)
+	write(t, ctxDir, cfgCtx.Task, "# Tasks\n\n- [ ] One\n")
+	write(t, ctxDir, filepath.Join(dir.State, "x.json"), "{}")
+	write(t, ctxDir, ".ctx.key", "secret")
+	return ctxDir
+}
+
+func write(t *testing.T, ctxDir, name, content string) {
+	t.Helper()
+	p := filepath.Join(ctxDir, name)
+	if mkErr := os.MkdirAll(filepath.Dir(p), 0700); mkErr != nil {
+		t.Fatalf("mkdir: %v", mkErr)
+	}
+	if wErr := os.WriteFile(p, []byte(content), 0600); wErr != nil {
+		t.Fatalf("write %s: %v", name, wErr)
+	}
+}
+
+func TestCreate_ExcludesStateAndKeys(t *testing.T) {
+	ctxDir := setup(t)
+	s, written, createErr := Create(ctxDir, "", time.Now())
+	if createErr != nil {
+		t.Fatalf("Create: %v", createErr)
+	}
+	if len(s.Files) != 1 || s.Files[0].Path != cfgCtx.Task {
+		t.Fatalf("Files = %+v, want only %s", s.Files, cfgCtx.Task)
+	}
+	if written != 1 {
+		t.Errorf("written = %d, want 1", written)
+	}
+
+	// Unchanged content is deduplicated.
+	_, written, createErr = Create(ctxDir, "", time.Now())
+	if createErr != nil {
+		t.Fatalf("Create: %v", createErr)
+	}
+	if written != 0 {
+		t.Errorf("second written = %d, want 0", written)
+	}
+}
+
+func TestCreate_ExcludesGitignoredAndRotatedKeys(t *testing.T) {
+	ctxDir := setup(t)
+	write(t, ctxDir, filepath.Join(dir.Journal, "2026-01-01.md"), "# s")
+	write(t, ctxDir, filepath.Join(dir.JournalSite, "index.md"), "# s")
+	write(t, ctxDir, filepath.Join(dir.Logs, "hook.log"), "log")
+	write(t, ctxDir, ".ctx.key.prev", "old secret")
+	write(t, ctxDir, ".ctx.key.next", "new secret")
+
+	s, _, createErr := Create(ctxDir, "", time.Now())
+	if createErr != nil {
+		t.Fatalf("Create: %v", createErr)
+	}
+	if len(s.Files) != 1 || s.Files[0].Path != cfgCtx.Task {
+		t.Fatalf("Files = %+v, want only %s", s.Files, cfgCtx.Task)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctxDir := setup(t)
+	day1 := time.Date(2026, 3, 1, 10, 0, 0, 0, time.Local)
+	day2 := time.Date(2026, 3, 5, 10, 0, 0, 0, time.Local)
+	first, _, _ := Create(ctxDir, "release", day1)
+	write(t, ctxDir, cfgCtx.Task, "# Tasks\n\n- [x] One\n")
+	second, _, _ := Create(ctxDir, "", day2)
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{first.ID, first.ID},
+		{"release", first.ID},
+		{"2026-03-03", first.ID},
+		{"2026-03-05", second.ID},
+	}
+	for _, tt := range tests {
+		s, resolveErr := Resolve(ctxDir, tt.ref)
+		if resolveErr != nil {
+			t.Errorf("Resolve(%q): %v", tt.ref, resolveErr)
+			continue
+		}
+		if s.ID != tt.want {
+			t.Errorf("Resolve(%q) = %s, want %s", tt.ref, s.ID, tt.want)
+		}
+	}
+
+	if _, resolveErr := Resolve(ctxDir, "2026-02-01"); resolveErr == nil {
+		t.Error("Resolve before first snapshot: want error")
+	}
+	if _, resolveErr := Resolve(ctxDir, "nope"); resolveErr == nil {
+		t.Error("Resolve unknown name: want error")
+	}
+}
+
+func TestResolve_EmptyStore(t *testing.T) {
+	ctxDir := setup(t)
+	if _, resolveErr := Resolve(ctxDir, "anything"); resolveErr == nil {
+		t.Error("Resolve on empty store: want error")
+	}
+}
+
+func TestLoad_AtSnapshot(t *testing.T) {
+	ctxDir := setup(t)
+	s, _, _ := Create(ctxDir, "", time.Now())
+	write(t, ctxDir, cfgCtx.Task, "# Tasks\n\n- [ ] Changed\n")
+
+	ctx, loaded, loadErr := Load(ctxDir, s.ID)
+	if loadErr != nil {
+		t.Fatalf("Load: %v", loadErr)
+	}
+	if loaded.ID != s.ID {
+		t.Errorf("loaded = %s, want %s", loaded.ID, s.ID)
+	}
+	f := ctx.File(cfgCtx.Task)
+	if f == nil {
+		t.Fatalf("%s missing from snapshot context", cfgCtx.Task)
+	}
+	if string(f.Content) != "# Tasks\n\n- [ ] One\n" {
+		t.Errorf("content = %q, want captured version", f.Content)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	ctxDir := setup(t)
+	write(t, ctxDir, cfgCtx.Decision, "# Decisions\n")
+	older, _, _ := Create(ctxDir, "", time.Now())
+
+	write(t, ctxDir, cfgCtx.Task, "# Tasks\n\n- [x] One\n")
+	write(t, ctxDir, cfgCtx.Learning, "# Learnings\n")
+	if rmErr := os.Remove(
+		filepath.Join(ctxDir, cfgCtx.Decision),
+	); rmErr != nil {
+		t.Fatalf("remove: %v", rmErr)
+	}
+	current, scanErr := Scan(ctxDir)
+	if scanErr != nil {
+		t.Fatalf("Scan: %v", scanErr)
+	}
+
+	got := map[string]string{}
+	for _, c := range Diff(older.Files, current) {
+		got[c.Path] = c.Status
+	}
+	want := map[string]string{
+		cfgCtx.Decision: cfgSnap.StatusRemoved,
+		cfgCtx.Learning: cfgSnap.StatusAdded,
+		cfgCtx.Task:     cfgSnap.StatusModified,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("changes = %v, want %v", got, want)
+	}
+	for p, status := range want {
+		if got[p] != status {
+			t.Errorf("%s = %q, want %q", p, got[p], status)
+		}
+	}
+}
+
+func TestRestore(t *testing.T) {
+	ctxDir := setup(t)
+	write(t, ctxDir, cfgCtx.Decision, "# Decisions\n")
+	s, _, _ := Create(ctxDir, "", time.Now())
+	write(t, ctxDir, cfgCtx.Task, "# Tasks\n\n- [x] One\n")
+	write(t, ctxDir, cfgCtx.Learning, "# Learnings\n")
+	if rmErr := os.Remove(
+		filepath.Join(ctxDir, cfgCtx.Decision),
+	); rmErr != nil {
+		t.Fatalf("remove: %v", rmErr)
+	}
+
+	restored, restoreErr := Restore(ctxDir, s)
+	if restoreErr != nil {
+		t.Fatalf("Restore: %v", restoreErr)
+	}
+	got := make(map[string]string, len(restored))
+	for _, c := range restored {
+		got[c.Path] = c.Status
+	}
+	want := map[string]string{
+		cfgCtx.Task:     cfgSnap.StatusModified,
+		cfgCtx.Decision: cfgSnap.StatusAdded,
+	}
+	if len(got) != len(want) {
+		t.Errorf("restored = %v, want %v", got, want)
+	}
+	for p, status := range want {
+		if got[p] != status {
+			t.Errorf("%s = %q, want %q", p, got[p], status)
+		}
+	}
+	data, _ := os.ReadFile(filepath.Join(ctxDir, cfgCtx.Task))
+	if string(data) != "# Tasks\n\n- [ ] One\n" {
+		t.Errorf("task content = %q", data)
+	}
+	if _, statErr := os.Stat(
+		filepath.Join(ctxDir, cfgCtx.Learning),
+	); statErr != nil {
+		t.Errorf("file added after snapshot was removed: %v", statErr)
+	}
+}
+
+func TestReadFile_Corrupt(t *testing.T) {
+	ctxDir := setup(t)
+	s, _, _ := Create(ctxDir, "", time.Now())
+	f := s.Files[0]
+	if wErr := os.WriteFile(
+		objectPath(ctxDir, f.Hash), []byte("tampered"), 0600,
+	); wErr != nil {
+		t.Fatalf("write: %v", wErr)
+	}
+	if _, readErr := ReadFile(ctxDir, f); readErr == nil {
+		t.Error("ReadFile on tampered object: want error")
+	} else if errors.Is(readErr, os.ErrNotExist) {
+		t.Errorf("ReadFile error = %v, want corruption", readErr)
+	}
+}
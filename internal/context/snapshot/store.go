@@ -0,0 +1,181 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errSnap "github.com/ActiveMemory/ctx/internal/err/snapshot"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Create captures the context directory into the store.
+//
+// Parameters:
+//   - ctxDir: Context directory to capture
+//   - name: Optional snapshot label
+//   - now: Capture time; also determines the ID
+//
+// Returns:
+//   - *entity.Snapshot: The recorded manifest
+//   - int: Number of objects newly written to the store
+//   - error: Non-nil if the tree cannot be read or the store
+//     cannot be written
+func Create(
+	ctxDir, name string, now time.Time,
+) (*entity.Snapshot, int, error) {
+	written := 0
+	files, walkErr := walk(ctxDir, func(
+		f entity.SnapshotFile, content []byte,
+	) error {
+		p := objectPath(ctxDir, f.Hash)
+		if _, statErr := os.Stat(p); statErr == nil {
+			return nil
+		}
+		if mkErr := ctxIo.SafeMkdirAll(
+			filepath.Dir(p), cfgFs.PermExec,
+		); mkErr != nil {
+			return errSnap.Write(mkErr)
+		}
+		if wErr := ctxIo.SafeWriteFile(
+			p, content, cfgFs.PermFile,
+		); wErr != nil {
+			return errSnap.Write(wErr)
+		}
+		written++
+		return nil
+	})
+	if walkErr != nil {
+		return nil, written, walkErr
+	}
+
+	s := &entity.Snapshot{
+		ID:      nextID(ctxDir, now),
+		Name:    name,
+		Created: now,
+		Files:   files,
+	}
+	data, marshalErr := json.MarshalIndent(s, "", token.Indent2)
+	if marshalErr != nil {
+		return nil, written, errSnap.Write(marshalErr)
+	}
+	mDir := filepath.Join(root(ctxDir), cfgSnap.Manifests)
+	if mkErr := ctxIo.SafeMkdirAll(mDir, cfgFs.PermExec); mkErr != nil {
+		return nil, written, errSnap.Write(mkErr)
+	}
+	if wErr := ctxIo.SafeWriteFile(
+		manifestPath(ctxDir, s.ID), data, cfgFs.PermFile,
+	); wErr != nil {
+		return nil, written, errSnap.Write(wErr)
+	}
+	return s, written, nil
+}
+
+// Scan hashes the live context directory without writing
+// anything to the store.
+//
+// Parameters:
+//   - ctxDir: Context directory to scan
+//
+// Returns:
+//   - []entity.SnapshotFile: Files in path order
+//   - error: Non-nil if the tree cannot be read
+func Scan(ctxDir string) ([]entity.SnapshotFile, error) {
+	return walk(ctxDir, nil)
+}
+
+// List returns every snapshot in the store, oldest first.
+//
+// Parameters:
+//   - ctxDir: Context directory holding the store
+//
+// Returns:
+//   - []entity.Snapshot: Manifests sorted by creation time;
+//     nil when the store is empty
+//   - error: Non-nil if a manifest cannot be read or parsed
+func List(ctxDir string) ([]entity.Snapshot, error) {
+	mDir := filepath.Join(root(ctxDir), cfgSnap.Manifests)
+	entries, readErr := os.ReadDir(mDir)
+	if readErr != nil {
+		if errors.Is(readErr, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errSnap.Read(readErr)
+	}
+
+	var out []entity.Snapshot
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != file.ExtJSON {
+			continue
+		}
+		id := e.Name()[:len(e.Name())-len(file.ExtJSON)]
+		data, rErr := ctxIo.SafeReadUserFile(manifestPath(ctxDir, id))
+		if rErr != nil {
+			return nil, errSnap.Read(rErr)
+		}
+		var s entity.Snapshot
+		if pErr := json.Unmarshal(data, &s); pErr != nil {
+			return nil, errSnap.Parse(id, pErr)
+		}
+		out = append(out, s)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Created.Equal(out[j].Created) {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Created.Before(out[j].Created)
+	})
+	return out, nil
+}
+
+// ReadFile returns the content of a snapshot file, verifying
+// it against its hash.
+//
+// Parameters:
+//   - ctxDir: Context directory holding the store
+//   - f: File entry from a manifest
+//
+// Returns:
+//   - []byte: File content
+//   - error: Non-nil if the object is missing or corrupt
+func ReadFile(ctxDir string, f entity.SnapshotFile) ([]byte, error) {
+	data, readErr := ctxIo.SafeReadUserFile(objectPath(ctxDir, f.Hash))
+	if readErr != nil {
+		return nil, errSnap.Read(readErr)
+	}
+	if hash(data) != f.Hash {
+		return nil, errSnap.Corrupt(f.Hash)
+	}
+	return data, nil
+}
+
+// Find returns the manifest entry for a path.
+//
+// Parameters:
+//   - s: Snapshot manifest
+//   - path: Slash-separated path relative to .context/
+//
+// Returns:
+//   - *entity.SnapshotFile: The entry, or nil if absent
+func Find(s *entity.Snapshot, path string) *entity.SnapshotFile {
+	for i := range s.Files {
+		if s.Files[i].Path == path {
+			return &s.Files[i]
+		}
+	}
+	return nil
+}
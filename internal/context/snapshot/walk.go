@@ -0,0 +1,155 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgSnap "github.com/ActiveMemory/ctx/internal/config/snapshot"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errSnap "github.com/ActiveMemory/ctx/internal/err/snapshot"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// walk visits every capturable file under the context
+// directory in path order.
+//
+// Parameters:
+//   - ctxDir: Context directory to walk
+//   - visit: Optional callback receiving each file and its
+//     content; a non-nil error stops the walk
+//
+// Returns:
+//   - []entity.SnapshotFile: Files in path order
+//   - error: First error from reading or from visit
+func walk(
+	ctxDir string,
+	visit func(entity.SnapshotFile, []byte) error,
+) ([]entity.SnapshotFile, error) {
+	var files []entity.SnapshotFile
+	walkErr := filepath.WalkDir(ctxDir, func(
+		p string, d fs.DirEntry, err error,
+	) error {
+		if err != nil {
+			return err
+		}
+		rel, relErr := filepath.Rel(ctxDir, p)
+		if relErr != nil {
+			return relErr
+		}
+		if d.IsDir() {
+			if slices.Contains(cfgSnap.ExcludeDirs, rel) ||
+				(rel != token.Dot && ignored(rel, true)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() ||
+			slices.Contains(cfgSnap.ExcludeExts, filepath.Ext(p)) ||
+			strings.HasPrefix(d.Name(), cfgSnap.ExcludeKeyPrefix) ||
+			ignored(rel, false) {
+			return nil
+		}
+		content, readErr := ctxIo.SafeReadUserFile(p)
+		if readErr != nil {
+			return readErr
+		}
+		f := entity.SnapshotFile{
+			Path: filepath.ToSlash(rel),
+			Hash: hash(content),
+			Size: int64(len(content)),
+		}
+		if visit != nil {
+			if vErr := visit(f, content); vErr != nil {
+				return vErr
+			}
+		}
+		files = append(files, f)
+		return nil
+	})
+	if walkErr != nil {
+		return nil, errSnap.Read(walkErr)
+	}
+	return files, nil
+}
+
+// ignored reports whether a path inside the context directory is
+// covered by a .context/ entry of the ctx-managed .gitignore, so
+// a snapshot never holds what git is told to keep out.
+//
+// Parameters:
+//   - rel: Path relative to the context directory
+//   - isDir: Whether rel is a directory
+//
+// Returns:
+//   - bool: True when a gitignore entry matches
+func ignored(rel string, isDir bool) bool {
+	rel = filepath.ToSlash(rel)
+	prefix := dir.Context + token.Slash
+	for _, entry := range file.Gitignore {
+		pattern, ok := strings.CutPrefix(entry, prefix)
+		if !ok {
+			continue
+		}
+		if dirPattern, isDirEntry := strings.CutSuffix(
+			pattern, token.Slash,
+		); isDirEntry {
+			if isDir && rel == dirPattern {
+				return true
+			}
+			continue
+		}
+		if matched, _ := path.Match(pattern, rel); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// nextID derives a snapshot ID from the capture time,
+// adding a counter when the second is already taken.
+//
+// Parameters:
+//   - ctxDir: Context directory holding the store
+//   - now: Capture time
+//
+// Returns:
+//   - string: Unused snapshot ID
+func nextID(ctxDir string, now time.Time) string {
+	base := now.Format(cfgSnap.IDFormat)
+	id := base
+	for n := 2; ; n++ {
+		if _, statErr := os.Stat(manifestPath(ctxDir, id)); statErr != nil {
+			return id
+		}
+		id = fmt.Sprintf(cfgSnap.IDCollisionFmt, base, n)
+	}
+}
+
+// hash returns the hex SHA-256 of content.
+//
+// Parameters:
+//   - content: Bytes to hash
+//
+// Returns:
+//   - string: Lowercase hex digest
+func hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
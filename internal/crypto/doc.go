@@ -21,10 +21,9 @@
 //     (256-bit) key from `crypto/rand`. The caller
 //     persists it via [SaveKey].
 //   - **[SaveKey](path, key)**: writes the key to
-//     `path` with `0o600` permissions. Refuses to
-//     overwrite an existing file (the caller must
-//     remove first; `ctx pad rotate` does this
-//     intentionally).
+//     `path` with `0o600` permissions. `ctx key
+//     rotate` stages the new key and keeps the old
+//     one through it.
 //   - **[LoadKey](path)**: reads the key back. Returns
 //     a typed error from [internal/err/crypto] when
 //     the file is missing, world-readable, or the
@@ -37,6 +36,19 @@
 //     ciphertext and decrypts. Returns a typed error
 //     on auth-tag mismatch, short payload, or
 //     missing key.
+//   - **[LoadKeyring](path, graceDays)** /
+//     **[DecryptAny](keys, payload)**: the active key
+//     plus the rotated-out key at [GracePath] while it
+//     is younger than the grace period. Readers use
+//     these so ciphertext from before a rotation stays
+//     readable; writers keep using [LoadKey].
+//   - **[GenerateIdentity]**, **[PublicKey]**,
+//     **[ParsePublicKey]**: per-user X25519 identities
+//     and their printable `ctxpub1...` public keys.
+//   - **[Seal](recipients, plaintext)** /
+//     **[Open](identity, envelope)**: multi-recipient
+//     envelopes; [IsEnvelope] tells them apart from
+//     single-key ciphertext.
 //
 // # File Format
 //
@@ -46,13 +58,23 @@
 // The format is purely
 // `nonce(12) || ciphertext(...) || tag(16)`.
 //
+// A scratchpad shared with recipients is an envelope
+// instead: a `ctx-envelope/v1` text header with one
+// `-> X25519` stanza per recipient, then `---`, then the
+// [Encrypt] output under a random data key. Each stanza
+// holds the data key encrypted under a wrap key derived
+// (HKDF-SHA256) from an ephemeral X25519 exchange with
+// that recipient, age-style.
+//
 // # Per-Machine Key
 //
 // The key lives at `~/.ctx/.ctx.key` (one key per user,
 // shared by every project on that machine). Cross-machine
 // scratchpad sync requires copying that key; see
 // `docs/recipes/scratchpad-sync.md` for the user-facing
-// procedure.
+// procedure. Shared scratchpads avoid that: each user keeps
+// a private identity at `~/.ctx/.ctx.identity`
+// ([IdentityPath]) and only public keys travel.
 //
 // # Concurrency
 //
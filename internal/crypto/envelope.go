@@ -0,0 +1,164 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+)
+
+// GenerateIdentity returns a new X25519 private key for opening
+// multi-recipient envelopes. It is 32 bytes, so [SaveKey] and
+// [LoadKey] store it like a symmetric key.
+//
+// Returns:
+//   - []byte: Raw 32-byte private key
+//   - error: Non-nil if the system random source fails
+func GenerateIdentity() ([]byte, error) {
+	priv, genErr := ecdh.X25519().GenerateKey(rand.Reader)
+	if genErr != nil {
+		return nil, errCrypto.GenerateKey(genErr)
+	}
+	return priv.Bytes(), nil
+}
+
+// PublicKey returns the printable public key for an identity,
+// e.g. "ctxpub1" followed by unpadded URL-safe base64.
+//
+// Parameters:
+//   - identity: Raw private key from [GenerateIdentity]
+//
+// Returns:
+//   - string: Public key to share with teammates
+//   - error: Non-nil if identity is not a valid X25519 key
+func PublicKey(identity []byte) (string, error) {
+	priv, keyErr := ecdh.X25519().NewPrivateKey(identity)
+	if keyErr != nil {
+		return "", errCrypto.InvalidKeySize(len(identity), cfgCrypto.KeySize)
+	}
+	return cfgCrypto.PublicKeyPrefix +
+		base64.RawURLEncoding.EncodeToString(priv.PublicKey().Bytes()), nil
+}
+
+// ParsePublicKey validates a printable public key.
+//
+// Parameters:
+//   - s: Public key as printed by [PublicKey]
+//
+// Returns:
+//   - *ecdh.PublicKey: Parsed key
+//   - error: Non-nil if s is not a ctxpub1 X25519 key
+func ParsePublicKey(s string) (*ecdh.PublicKey, error) {
+	raw, ok := strings.CutPrefix(s, cfgCrypto.PublicKeyPrefix)
+	if !ok {
+		return nil, errCrypto.InvalidPublicKey(s)
+	}
+	b, decErr := base64.RawURLEncoding.DecodeString(raw)
+	if decErr != nil {
+		return nil, errCrypto.InvalidPublicKey(s)
+	}
+	pub, keyErr := ecdh.X25519().NewPublicKey(b)
+	if keyErr != nil {
+		return nil, errCrypto.InvalidPublicKey(s)
+	}
+	return pub, nil
+}
+
+// IsEnvelope reports whether data is a multi-recipient envelope
+// rather than a single-key ciphertext from [Encrypt].
+//
+// Parameters:
+//   - data: File content
+//
+// Returns:
+//   - bool: True if data starts with the envelope header line
+func IsEnvelope(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(cfgCrypto.EnvelopeMagic+token.NewlineLF))
+}
+
+// Seal encrypts plaintext for every recipient.
+//
+// A fresh random data key encrypts the payload with [Encrypt].
+// For each recipient, an ephemeral X25519 key agreement and
+// HKDF-SHA256 derive a wrap key that encrypts the data key into
+// one header stanza. Any one recipient's identity opens the
+// envelope; sealing again after a membership change uses a new
+// data key, so removed recipients cannot open later versions.
+//
+// Parameters:
+//   - recipients: Public keys as printed by [PublicKey]
+//   - plaintext: Data to encrypt
+//
+// Returns:
+//   - []byte: Envelope bytes
+//   - error: Non-nil if there are no recipients, a key is
+//     invalid, or encryption fails
+func Seal(recipients []string, plaintext []byte) ([]byte, error) {
+	if len(recipients) == 0 {
+		return nil, errCrypto.NoRecipients()
+	}
+	dataKey, genErr := GenerateKey()
+	if genErr != nil {
+		return nil, genErr
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(cfgCrypto.EnvelopeMagic + token.NewlineLF)
+	for _, r := range recipients {
+		pub, parseErr := ParsePublicKey(r)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		line, wrapErr := stanza(pub, dataKey)
+		if wrapErr != nil {
+			return nil, wrapErr
+		}
+		buf.WriteString(line + token.NewlineLF)
+	}
+	buf.WriteString(cfgCrypto.HeaderEnd + token.NewlineLF)
+
+	payload, encErr := Encrypt(dataKey, plaintext)
+	if encErr != nil {
+		return nil, encErr
+	}
+	buf.Write(payload)
+	return buf.Bytes(), nil
+}
+
+// Open decrypts an envelope produced by [Seal].
+//
+// Parameters:
+//   - identity: Raw private key of one of the recipients
+//   - data: Envelope bytes
+//
+// Returns:
+//   - []byte: Decrypted plaintext
+//   - error: Non-nil if the header is malformed, no stanza is
+//     addressed to identity, or the payload fails to decrypt
+func Open(identity, data []byte) ([]byte, error) {
+	priv, keyErr := ecdh.X25519().NewPrivateKey(identity)
+	if keyErr != nil {
+		return nil, errCrypto.InvalidKeySize(len(identity), cfgCrypto.KeySize)
+	}
+	stanzas, payload, parseErr := header(data)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	for _, s := range stanzas {
+		if dataKey, ok := unwrap(priv, s); ok {
+			return Decrypt(dataKey, payload)
+		}
+	}
+	return nil, errCrypto.NotRecipient()
+}
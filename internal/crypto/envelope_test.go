@@ -0,0 +1,121 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/config/crypto"
+)
+
+// identity returns a fresh identity and its public key.
+func identity(t *testing.T) ([]byte, string) {
+	t.Helper()
+	id, err := GenerateIdentity()
+	if err != nil {
+		t.Fatalf("GenerateIdentity() error: %v", err)
+	}
+	pub, err := PublicKey(id)
+	if err != nil {
+		t.Fatalf("PublicKey() error: %v", err)
+	}
+	return id, pub
+}
+
+func TestSealOpen_EveryRecipient(t *testing.T) {
+	alice, alicePub := identity(t)
+	bob, bobPub := identity(t)
+	plaintext := []byte("shared scratchpad\n")
+
+	env, err := Seal([]string{alicePub, bobPub}, plaintext)
+	if err != nil {
+		t.Fatalf("Seal() error: %v", err)
+	}
+	if !IsEnvelope(env) {
+		t.Fatal("IsEnvelope() = false for Seal output")
+	}
+	if bytes.Contains(env, plaintext) {
+		t.Error("envelope contains the plaintext")
+	}
+
+	for name, id := range map[string][]byte{"alice": alice, "bob": bob} {
+		got, openErr := Open(id, env)
+		if openErr != nil {
+			t.Fatalf("Open(%s) error: %v", name, openErr)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Errorf("Open(%s) = %q, want %q", name, got, plaintext)
+		}
+	}
+}
+
+func TestOpen_NotRecipient(t *testing.T) {
+	_, alicePub := identity(t)
+	eve, _ := identity(t)
+
+	env, err := Seal([]string{alicePub}, []byte("secret"))
+	if err != nil {
+		t.Fatalf("Seal() error: %v", err)
+	}
+	if _, openErr := Open(eve, env); openErr == nil {
+		t.Error("Open() by a non-recipient should fail")
+	}
+}
+
+func TestSeal_FreshDataKey(t *testing.T) {
+	_, pub := identity(t)
+	a, _ := Seal([]string{pub}, []byte("x"))
+	b, _ := Seal([]string{pub}, []byte("x"))
+	if bytes.Equal(a, b) {
+		t.Error("two seals of the same plaintext should differ")
+	}
+}
+
+func TestSeal_Errors(t *testing.T) {
+	if _, err := Seal(nil, []byte("x")); err == nil {
+		t.Error("Seal() with no recipients should fail")
+	}
+	if _, err := Seal([]string{"age1abc"}, []byte("x")); err == nil {
+		t.Error("Seal() with an invalid key should fail")
+	}
+}
+
+func TestIsEnvelope_SingleKeyCiphertext(t *testing.T) {
+	key, _ := GenerateKey()
+	ct, err := Encrypt(key, []byte("legacy"))
+	if err != nil {
+		t.Fatalf("Encrypt() error: %v", err)
+	}
+	if IsEnvelope(ct) {
+		t.Error("IsEnvelope() = true for single-key ciphertext")
+	}
+}
+
+func TestOpen_MalformedHeader(t *testing.T) {
+	id, _ := identity(t)
+	bad := []byte(crypto.EnvelopeMagic + "\n-> X25519 only-two\n---\n")
+	if _, err := Open(id, bad); err == nil {
+		t.Error("Open() of a malformed header should fail")
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	_, pub := identity(t)
+	if !strings.HasPrefix(pub, crypto.PublicKeyPrefix) {
+		t.Errorf("PublicKey() = %q, want prefix %q", pub, crypto.PublicKeyPrefix)
+	}
+	if _, err := ParsePublicKey(pub); err != nil {
+		t.Errorf("ParsePublicKey(own key) error: %v", err)
+	}
+	for _, bad := range []string{"", "ctxpub1", "ctxpub1!!!", pub[1:]} {
+		if _, err := ParsePublicKey(bad); err == nil {
+			t.Errorf("ParsePublicKey(%q) should fail", bad)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"os"
+	"time"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+)
+
+// GracePath returns where a rotation keeps the previous key.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//
+// Returns:
+//   - string: keyPath with the grace suffix
+func GracePath(keyPath string) string {
+	return keyPath + cfgCrypto.GraceSuffix
+}
+
+// LoadKeyring loads the active key followed by the grace key
+// left by the last rotation, while it is younger than graceDays.
+//
+// The grace key is decrypt-only: writers keep using [LoadKey]
+// so new ciphertext is always under the active key. A missing,
+// expired, or unreadable grace key is silently skipped.
+//
+// Parameters:
+//   - keyPath: Path of the active key
+//   - graceDays: How long a rotated-out key stays usable
+//
+// Returns:
+//   - [][]byte: Keys to try, active key first
+//   - error: Non-nil if the active key cannot be loaded
+func LoadKeyring(keyPath string, graceDays int) ([][]byte, error) {
+	key, loadErr := LoadKey(keyPath)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	keys := [][]byte{key}
+
+	gracePath := GracePath(keyPath)
+	info, statErr := os.Stat(gracePath)
+	if statErr != nil {
+		return keys, nil
+	}
+	maxAge := time.Duration(graceDays*cfgTime.HoursPerDay) * time.Hour
+	if time.Since(info.ModTime()) > maxAge {
+		return keys, nil
+	}
+	if grace, graceErr := LoadKey(gracePath); graceErr == nil {
+		keys = append(keys, grace)
+	}
+	return keys, nil
+}
+
+// DecryptAny decrypts ciphertext with the first key that
+// authenticates it.
+//
+// Parameters:
+//   - keys: Candidate keys, in preference order
+//   - ciphertext: Nonce-prefixed AES-256-GCM ciphertext
+//
+// Returns:
+//   - []byte: Decrypted plaintext
+//   - error: The last key's failure when none succeeds
+func DecryptAny(keys [][]byte, ciphertext []byte) ([]byte, error) {
+	lastErr := errCrypto.DecryptFailed()
+	for _, key := range keys {
+		plaintext, decErr := Decrypt(key, ciphertext)
+		if decErr == nil {
+			return plaintext, nil
+		}
+		lastErr = decErr
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,55 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadKeyring_GraceWindow(t *testing.T) {
+	dir := t.TempDir()
+	kp := filepath.Join(dir, "ctx.key")
+	active, _ := GenerateKey()
+	old, _ := GenerateKey()
+	if err := SaveKey(kp, active); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := LoadKeyring(kp, 14)
+	if err != nil || len(keys) != 1 {
+		t.Fatalf("no grace key: got %d keys, %v", len(keys), err)
+	}
+
+	if err := SaveKey(GracePath(kp), old); err != nil {
+		t.Fatal(err)
+	}
+	sealed, _ := Encrypt(old, []byte("before rotation"))
+
+	keys, err = LoadKeyring(kp, 14)
+	if err != nil || len(keys) != 2 {
+		t.Fatalf("fresh grace key: got %d keys, %v", len(keys), err)
+	}
+	plain, err := DecryptAny(keys, sealed)
+	if err != nil || string(plain) != "before rotation" {
+		t.Errorf("DecryptAny = %q, %v", plain, err)
+	}
+
+	expired := time.Now().Add(-15 * 24 * time.Hour)
+	if err := os.Chtimes(GracePath(kp), expired, expired); err != nil {
+		t.Fatal(err)
+	}
+	keys, _ = LoadKeyring(kp, 14)
+	if len(keys) != 1 {
+		t.Fatalf("expired grace key: got %d keys, want 1", len(keys))
+	}
+	if _, err := DecryptAny(keys, sealed); err == nil {
+		t.Error("expired grace key should no longer decrypt")
+	}
+}
@@ -32,6 +32,23 @@ func GlobalKeyPath() string {
 	return filepath.Join(home, dir.CtxData, cfgCrypto.ContextKey)
 }
 
+// IdentityPath returns the path of the user's X25519 identity.
+//
+// Returns ~/.ctx/.ctx.identity using os.UserHomeDir. Unlike the
+// symmetric key, the identity is personal and never lives in a
+// project.
+//
+// Returns:
+//   - string: Absolute path to the identity file, or empty
+//     string if the home directory cannot be determined
+func IdentityPath() string {
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		return ""
+	}
+	return filepath.Join(home, dir.CtxData, cfgCrypto.Identity)
+}
+
 // ExpandHome expands a leading ~/ prefix to the user's home directory.
 //
 // If the path does not start with "~/", it is returned unchanged.
@@ -0,0 +1,17 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+// wrapped is one parsed recipient stanza.
+//
+// Fields:
+//   - ephemeral: Sender's ephemeral X25519 public key
+//   - key: Data key sealed under the derived wrap key
+type wrapped struct {
+	ephemeral []byte
+	key       []byte
+}
@@ -0,0 +1,146 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errCrypto "github.com/ActiveMemory/ctx/internal/err/crypto"
+)
+
+// stanza wraps the data key for one recipient.
+//
+// Parameters:
+//   - pub: Recipient public key
+//   - dataKey: Payload key to wrap
+//
+// Returns:
+//   - string: Stanza line without the trailing newline
+//   - error: Non-nil if key agreement or encryption fails
+func stanza(pub *ecdh.PublicKey, dataKey []byte) (string, error) {
+	eph, genErr := ecdh.X25519().GenerateKey(rand.Reader)
+	if genErr != nil {
+		return "", errCrypto.GenerateKey(genErr)
+	}
+	shared, ecdhErr := eph.ECDH(pub)
+	if ecdhErr != nil {
+		return "", errCrypto.EncryptFailed(ecdhErr)
+	}
+	wk, kdfErr := wrapKey(shared, eph.PublicKey().Bytes(), pub.Bytes())
+	if kdfErr != nil {
+		return "", errCrypto.EncryptFailed(kdfErr)
+	}
+	sealed, encErr := Encrypt(wk, dataKey)
+	if encErr != nil {
+		return "", encErr
+	}
+	enc := base64.RawStdEncoding
+	return strings.Join([]string{
+		cfgCrypto.StanzaX25519,
+		enc.EncodeToString(eph.PublicKey().Bytes()),
+		enc.EncodeToString(sealed),
+	}, token.Space), nil
+}
+
+// unwrap tries to recover the data key from a stanza.
+//
+// Parameters:
+//   - priv: Identity to try
+//   - s: Parsed stanza
+//
+// Returns:
+//   - []byte: Data key
+//   - bool: False if the stanza is addressed to someone else
+func unwrap(priv *ecdh.PrivateKey, s wrapped) ([]byte, bool) {
+	eph, keyErr := ecdh.X25519().NewPublicKey(s.ephemeral)
+	if keyErr != nil {
+		return nil, false
+	}
+	shared, ecdhErr := priv.ECDH(eph)
+	if ecdhErr != nil {
+		return nil, false
+	}
+	wk, kdfErr := wrapKey(shared, s.ephemeral, priv.PublicKey().Bytes())
+	if kdfErr != nil {
+		return nil, false
+	}
+	dataKey, decErr := Decrypt(wk, s.key)
+	if decErr != nil || len(dataKey) != cfgCrypto.KeySize {
+		return nil, false
+	}
+	return dataKey, true
+}
+
+// wrapKey derives a stanza's wrap key. Both public keys are
+// bound into the salt so a stanza cannot be replayed for a
+// different recipient.
+//
+// Parameters:
+//   - shared: X25519 shared secret
+//   - ephemeral: Ephemeral public key bytes
+//   - recipient: Recipient public key bytes
+//
+// Returns:
+//   - []byte: 32-byte AES key
+//   - error: Non-nil if derivation fails
+func wrapKey(shared, ephemeral, recipient []byte) ([]byte, error) {
+	salt := append(append([]byte{}, ephemeral...), recipient...)
+	return hkdf.Key(
+		sha256.New, shared, salt, cfgCrypto.WrapInfo, cfgCrypto.KeySize,
+	)
+}
+
+// header splits an envelope into stanzas and payload.
+//
+// Parameters:
+//   - data: Envelope bytes
+//
+// Returns:
+//   - []wrapped: Recipient stanzas
+//   - []byte: Sealed payload
+//   - error: Non-nil if the header is malformed
+func header(data []byte) ([]wrapped, []byte, error) {
+	nl := []byte(token.NewlineLF)
+	magic := []byte(cfgCrypto.EnvelopeMagic + token.NewlineLF)
+	rest, ok := bytes.CutPrefix(data, magic)
+	if !ok {
+		return nil, nil, errCrypto.MalformedEnvelope()
+	}
+	enc := base64.RawStdEncoding
+	var stanzas []wrapped
+	for {
+		line, after, found := bytes.Cut(rest, nl)
+		if !found {
+			return nil, nil, errCrypto.MalformedEnvelope()
+		}
+		rest = after
+		if string(line) == cfgCrypto.HeaderEnd {
+			return stanzas, rest, nil
+		}
+		body, isStanza := strings.CutPrefix(
+			string(line), cfgCrypto.StanzaX25519+token.Space,
+		)
+		ephField, keyField, twoFields := strings.Cut(body, token.Space)
+		if !isStanza || !twoFields {
+			return nil, nil, errCrypto.MalformedEnvelope()
+		}
+		eph, ephErr := enc.DecodeString(ephField)
+		key, keyErr := enc.DecodeString(keyField)
+		if ephErr != nil || keyErr != nil {
+			return nil, nil, errCrypto.MalformedEnvelope()
+		}
+		stanzas = append(stanzas, wrapped{ephemeral: eph, key: key})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
 	"github.com/ActiveMemory/ctx/internal/config/token"
 	"github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/context/layer"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/index"
 	ctxIo "github.com/ActiveMemory/ctx/internal/io"
@@ -38,21 +39,34 @@ var staleAgeExclude = []string{cfgCtx.Constitution}
 // checkPathReferences scans ARCHITECTURE.md and CONVENTIONS.md for dead paths.
 //
 // Looks for backtick-enclosed file paths and verifies they exist on disk.
-// Skips URLs, template patterns, and glob patterns.
+// Skips URLs, template patterns, and glob patterns. Paths inside
+// inherited content are checked against the layer that supplied them.
 //
 // Parameters:
 //   - ctx: Loaded context containing files to scan
 //   - report: Report to append warnings to (modified in place)
 func checkPathReferences(ctx *entity.Context, report *Report) {
 	foundDeadPaths := false
+	roots := layerRoots(ctx)
 
 	for _, f := range ctx.Files {
 		if f.Name != cfgCtx.Architecture && f.Name != cfgCtx.Convention {
 			continue
 		}
 
+		// Paths in inherited content resolve against the layer's
+		// project root; git layers have none and are skipped.
+		label := f.Layer
 		lines := strings.Split(string(f.Content), token.NewlineLF)
 		for lineNum, line := range lines {
+			if l, ok := layerMarker(line); ok {
+				label = l
+				continue
+			}
+			root, ok := roots[label]
+			if !ok {
+				continue
+			}
 			matches := regex.CodeFencePath.FindAllStringSubmatch(line, -1)
 			for _, m := range matches {
 				path := m[1]
@@ -75,17 +89,20 @@ func checkPathReferences(ctx *entity.Context, report *Report) {
 				// Forward slash is intentional: paths are extracted from
 				// Markdown content, which always uses "/" regardless of OS.
 				topDir := strings.SplitN(path, token.Slash, 2)[0]
-				if _, dirErr := os.Stat(topDir); os.IsNotExist(dirErr) {
+				_, dirErr := os.Stat(filepath.Join(root, topDir))
+				if os.IsNotExist(dirErr) {
 					continue
 				}
 				// Check if the file exists
-				if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
+				_, statErr := os.Stat(filepath.Join(root, path))
+				if os.IsNotExist(statErr) {
 					report.Warnings = append(report.Warnings, Issue{
 						File:    f.Name,
 						Line:    lineNum + 1,
 						Type:    cfgDrift.IssueDeadPath,
 						Message: desc.Text(text.DescKeyDriftDeadPath),
 						Path:    path,
+						Layer:   label,
 					})
 					foundDeadPaths = true
 				}
@@ -248,7 +265,8 @@ func checkFileAge(ctx *entity.Context, report *Report) {
 				break
 			}
 		}
-		if excluded {
+		// Inherited files are maintained by their own project.
+		if excluded || f.Layer != "" {
 			continue
 		}
 
@@ -292,10 +310,11 @@ func checkEntryCount(ctx *entity.Context, report *Report) {
 			continue // disabled
 		}
 		f := ctx.File(c.file)
-		if f == nil {
+		if f == nil || f.Layer != "" {
 			continue
 		}
-		blocks := index.ParseEntryBlocks(string(f.Content))
+		// Count only the project's own entries, not inherited ones.
+		blocks := index.ParseEntryBlocks(string(layer.Local(f.Content)))
 		if len(blocks) > c.threshold {
 			report.Warnings = append(report.Warnings, Issue{
 				File: f.Name,
@@ -401,6 +420,9 @@ func checkTemplateHeaders(ctx *entity.Context, report *Report) {
 	found := false
 
 	for _, f := range ctx.Files {
+		if f.Layer != "" {
+			continue
+		}
 		tplContent, tplErr := readTpl.Template(f.Name)
 		if tplErr != nil {
 			continue // no template for this file
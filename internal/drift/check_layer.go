@@ -0,0 +1,88 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgInherit "github.com/ActiveMemory/ctx/internal/config/inherit"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// checkLayers warns about inherit entries in .ctxrc that could
+// not be loaded.
+//
+// Passes trivially when no layers are configured.
+//
+// Parameters:
+//   - ctx: Loaded context with its layers
+//   - report: Report to append warnings to (modified in place)
+func checkLayers(ctx *entity.Context, report *Report) {
+	found := false
+	for _, l := range ctx.Layers {
+		if l.Error == "" {
+			continue
+		}
+		report.Warnings = append(report.Warnings, Issue{
+			File: file.CtxRC,
+			Type: cfgDrift.IssueUnresolvedLayer,
+			Message: fmt.Sprintf(
+				desc.Text(text.DescKeyDriftLayerUnresolved),
+				l.Label, l.Error,
+			),
+			Layer: l.Label,
+		})
+		found = true
+	}
+
+	if !found {
+		report.Passed = append(report.Passed, cfgDrift.CheckLayers)
+	}
+}
+
+// layerRoots maps each layer label to the root its paths
+// resolve against.
+//
+// The project's own content maps from the empty label to the
+// empty root (paths stay relative to the working directory).
+// Git layers have no checkout and are left out.
+//
+// Parameters:
+//   - ctx: Loaded context with its layers
+//
+// Returns:
+//   - map[string]string: Layer label to project root
+func layerRoots(ctx *entity.Context) map[string]string {
+	roots := map[string]string{"": ""}
+	for _, l := range ctx.Layers {
+		if l.Root != "" {
+			roots[l.Label] = l.Root
+		}
+	}
+	return roots
+}
+
+// layerMarker reports whether a line opens an inherited block.
+//
+// Parameters:
+//   - line: Line of context file content
+//
+// Returns:
+//   - string: Label of the layer the block came from
+//   - bool: True if the line is a layer marker
+func layerMarker(line string) (string, bool) {
+	rest, ok := strings.CutPrefix(line, cfgInherit.MarkerPrefix)
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSuffix(rest, cfgInherit.MarkerSuffix), true
+}
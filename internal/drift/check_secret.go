@@ -0,0 +1,124 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/pad"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/secret"
+)
+
+// checkSecretContent scans the content of the loaded context
+// files for credentials.
+//
+// Unlike checkConstitution, which looks at file names in the
+// project root, this reads the text agents actually paste into.
+// Each finding is a no_secrets violation naming the rule and a
+// masked preview; known false positives belong in the secret
+// allowlist.
+//
+// Parameters:
+//   - ctx: Loaded context containing files to scan
+//   - report: Report to append violations to (modified in place)
+func checkSecretContent(ctx *entity.Context, report *Report) {
+	found := false
+	for _, f := range ctx.Files {
+		if scanSecrets(f.Name, string(f.Content), report) {
+			found = true
+		}
+	}
+	if !found {
+		report.Passed = append(report.Passed, cfgDrift.CheckSecretContent)
+	}
+}
+
+// checkSecretArchive scans exported journal entries and the
+// plaintext scratchpad for credentials.
+//
+// The journal grows with every session, so this check reads
+// more than implicit callers can afford; it runs only from an
+// explicit ctx drift.
+//
+// Parameters:
+//   - ctx: Loaded context whose directory holds the files
+//   - report: Report to append violations to (modified in place)
+func checkSecretArchive(ctx *entity.Context, report *Report) {
+	found := false
+	if ctx.Dir != "" {
+		for _, name := range secretScanFiles(ctx.Dir) {
+			data, readErr := ctxIo.SafeReadUserFile(
+				filepath.Join(ctx.Dir, name),
+			)
+			if readErr == nil && scanSecrets(name, string(data), report) {
+				found = true
+			}
+		}
+	}
+	if !found {
+		report.Passed = append(report.Passed, cfgDrift.CheckSecretArchive)
+	}
+}
+
+// scanSecrets appends a no_secrets violation for every
+// credential found in content.
+//
+// Parameters:
+//   - name: File name reported with each finding
+//   - content: Text to scan
+//   - report: Report to append violations to (modified in place)
+//
+// Returns:
+//   - bool: True when at least one credential was found
+func scanSecrets(name, content string, report *Report) bool {
+	findings := secret.Scan(content)
+	for _, f := range findings {
+		report.Violations = append(report.Violations, Issue{
+			File: name,
+			Line: f.Line,
+			Type: cfgDrift.IssueSecretContent,
+			Message: fmt.Sprintf(
+				desc.Text(text.DescKeyDriftSecretContent),
+				f.Rule, f.Preview,
+			),
+			Rule: cfgDrift.RuleNoSecrets,
+		})
+	}
+	return len(findings) > 0
+}
+
+// secretScanFiles lists the non-context files scanned for
+// secrets: exported journal markdown and the plaintext
+// scratchpad.
+//
+// Parameters:
+//   - ctxDir: Context directory
+//
+// Returns:
+//   - []string: Paths relative to ctxDir
+func secretScanFiles(ctxDir string) []string {
+	var names []string
+	entries, _ := os.ReadDir(filepath.Join(ctxDir, dir.Journal))
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == file.ExtMarkdown {
+			names = append(names, filepath.Join(dir.Journal, e.Name()))
+		}
+	}
+	if _, statErr := os.Stat(filepath.Join(ctxDir, pad.Md)); statErr == nil {
+		names = append(names, pad.Md)
+	}
+	return names
+}
@@ -0,0 +1,115 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// checkSymbols resolves code identifiers quoted in context files
+// against the repository and warns about the ones that no longer
+// exist, suggesting the closest existing name as the likely
+// rename.
+//
+// Go symbols come from go/packages; TypeScript, JavaScript, and
+// Python symbols from line-based indexers. TASKS.md is skipped
+// (pending tasks name code that does not exist yet), as is
+// inherited content, which describes another tree. The index is
+// only built when some file quotes an identifier.
+//
+// Parameters:
+//   - ctx: Loaded context containing files to scan
+//   - report: Report to append warnings to (modified in place)
+func checkSymbols(ctx *entity.Context, report *Report) {
+	refs := symbolRefs(ctx)
+	if len(refs) == 0 || ctx.Dir == "" {
+		report.Passed = append(report.Passed, cfgDrift.CheckSymbols)
+		return
+	}
+
+	root := filepath.Dir(ctx.Dir)
+	idx := newSymbolIndex()
+	indexGo(root, idx)
+	indexText(root, idx)
+
+	found := false
+	for _, ref := range refs {
+		suggestion, ok := idx.resolve(ref.name, ref.call)
+		if ok {
+			continue
+		}
+		msg := fmt.Sprintf(desc.Text(text.DescKeyDriftUnknownSymbol), ref.name)
+		if suggestion != "" {
+			msg = fmt.Sprintf(
+				desc.Text(text.DescKeyDriftUnknownSymbolSuggest),
+				ref.name, suggestion,
+			)
+		}
+		report.Warnings = append(report.Warnings, Issue{
+			File:       ref.file,
+			Line:       ref.line,
+			Type:       cfgDrift.IssueUnknownSymbol,
+			Message:    msg,
+			Symbol:     ref.name,
+			Suggestion: suggestion,
+		})
+		found = true
+	}
+
+	if !found {
+		report.Passed = append(report.Passed, cfgDrift.CheckSymbols)
+	}
+}
+
+// symbolRefs collects the identifiers quoted in the project's own
+// context content.
+//
+// Parameters:
+//   - ctx: Loaded context
+//
+// Returns:
+//   - []symbolRef: References in file and line order
+func symbolRefs(ctx *entity.Context) []symbolRef {
+	var refs []symbolRef
+	for _, f := range ctx.Files {
+		if f.Layer != "" || f.Name == cfgCtx.Task {
+			continue
+		}
+		inherited := false
+		lines := strings.Split(string(f.Content), token.NewlineLF)
+		for lineNum, line := range lines {
+			if _, ok := layerMarker(line); ok {
+				inherited = true
+				continue
+			}
+			if inherited {
+				continue
+			}
+			for _, m := range regex.SymbolRef.FindAllStringSubmatch(
+				line, -1,
+			) {
+				refs = append(refs, symbolRef{
+					file: f.Name,
+					line: lineNum + 1,
+					name: m[1],
+					call: m[2] != "",
+				})
+			}
+		}
+	}
+	return refs
+}
@@ -7,8 +7,13 @@
 package drift
 
 import (
+	"path/filepath"
+
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
 	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
 	"github.com/ActiveMemory/ctx/internal/entity"
+	errGit "github.com/ActiveMemory/ctx/internal/err/git"
+	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
 // Status returns the overall status of the report.
@@ -28,8 +33,15 @@ func (r *Report) Status() cfgDrift.StatusType {
 
 // Detect runs all drift detection checks on the given context.
 //
-// Performs multiple validation checks including path references, staleness
-// indicators, constitution compliance, and required file presence.
+// Runs the built-in checks (path references, staleness,
+// constitution compliance, required files, and more) in registry
+// order. Each check honors its drift.checks override in .ctxrc:
+// disabled checks are skipped and a severity override reports all
+// of the check's issues at that level.
+//
+// Detect never runs explicit-only, opt-in, or external checks,
+// so it stays fast and safe for implicit callers such as ctx
+// status, ctx doctor, and the MCP drift tool.
 //
 // Parameters:
 //   - ctx: Loaded context containing files to check
@@ -43,41 +55,98 @@ func Detect(ctx *entity.Context) *Report {
 		Passed:     []cfgDrift.CheckName{},
 	}
 
-	// Check path references in context files
-	checkPathReferences(ctx, report)
-
-	// Check for staleness indicators
-	checkStaleness(ctx, report)
-
-	// Check constitution rules (basic heuristics)
-	checkConstitution(ctx, report)
-
-	// Check for empty required files
-	checkRequiredFiles(ctx, report)
-
-	// Check for files not modified recently
-	checkFileAge(ctx, report)
-
-	// Check for excessive entry counts in knowledge files
-	checkEntryCount(ctx, report)
-
-	// Check for undocumented internal packages
-	checkMissingPackages(ctx, report)
-
-	// Check context file comment headers against templates
-	checkTemplateHeaders(ctx, report)
+	for _, c := range builtin {
+		runCheck(c.name, report, func(r *Report) {
+			c.run(ctx, r)
+		})
+	}
 
-	// Check steering files for unsupported tool identifiers
-	checkSteeringTools(report)
+	return report
+}
 
-	// Check hook scripts for missing executable permission bit
-	checkHookPerms(report)
+// DetectFull runs [Detect] plus the checks reserved for an
+// explicit ctx drift run.
+//
+// Explicit-only checks (secret_archive) run unless disabled in
+// .ctxrc. Opt-in checks (symbol_references) run when switched
+// on with drift.checks.<name>.enabled: true. External checks in
+// the context directory's checks/ folder run only when .ctxrc
+// sets drift.external: they are executables from the repository
+// and must not run just because a clone was opened.
+//
+// Parameters:
+//   - ctx: Loaded context containing files to check
+//
+// Returns:
+//   - *Report: Drift report with warnings, violations, and passed checks
+func DetectFull(ctx *entity.Context) *Report {
+	report := Detect(ctx)
+	for _, c := range explicit {
+		runCheck(c.name, report, func(r *Report) {
+			c.run(ctx, r)
+		})
+	}
+	for _, c := range optIn {
+		if !rc.DriftCheckOptedIn(c.name) {
+			continue
+		}
+		runCheck(c.name, report, func(r *Report) {
+			c.run(ctx, r)
+		})
+	}
+	if rc.DriftExternal() {
+		checkScripts(ctx, report)
+	}
+	return report
+}
 
-	// Check synced tool-native files are up to date vs source steering files
-	checkSyncStaleness(report)
+// DetectStaged evaluates the structured rules in CONSTITUTION.md
+// against the changes staged for commit.
+//
+// The rules live in a fenced ctx-rules block (forbidden imports,
+// forbidden paths, required tests for new Go packages, a maximum
+// file size, and banned patterns). Only staged content is read,
+// and import and pattern rules only look at added lines, so the
+// check is fast enough for a pre-commit hook. Every finding is a
+// violation naming the rule it broke.
+//
+// Parameters:
+//   - ctx: Loaded context containing CONSTITUTION.md
+//
+// Returns:
+//   - *Report: Violations, or CheckConstitutionRules as passed
+//   - error: Non-nil when the rule block is invalid or the
+//     project is not in a git repository
+func DetectStaged(ctx *entity.Context) (*Report, error) {
+	report := &Report{
+		Warnings:   []Issue{},
+		Violations: []Issue{},
+		Passed:     []cfgDrift.CheckName{},
+	}
 
-	// Check .ctxrc tool field for unsupported tool identifier
-	checkRCTool(report)
+	var rules *constitutionRules
+	if f := ctx.File(cfgCtx.Constitution); f != nil {
+		parsed, parseErr := parseConstitutionRules(string(f.Content))
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		rules = parsed
+	}
+	if rules == nil {
+		report.Passed = append(report.Passed, cfgDrift.CheckConstitutionRules)
+		return report, nil
+	}
 
-	return report
+	root := filepath.Dir(ctx.Dir)
+	files, stagedErr := stagedFiles(root)
+	if stagedErr != nil {
+		return nil, errGit.NotInRepo(stagedErr)
+	}
+	report.Violations = append(
+		report.Violations, evaluateRules(root, rules, files)...,
+	)
+	if len(report.Violations) == 0 {
+		report.Passed = append(report.Passed, cfgDrift.CheckConstitutionRules)
+	}
+	return report, nil
 }
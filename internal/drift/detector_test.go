@@ -217,6 +217,57 @@ func TestCheckPathReferences(t *testing.T) {
 	}
 }
 
+func TestCheckPathReferencesLayered(t *testing.T) {
+	// Inherited content resolves paths against its layer's root.
+	orgRoot := t.TempDir()
+	mkErr := os.MkdirAll(filepath.Join(orgRoot, "pkg", "auth"), 0o750)
+	if mkErr != nil {
+		t.Fatal(mkErr)
+	}
+
+	t.Chdir(t.TempDir())
+
+	ctx := &entity.Context{
+		Dir: ".context",
+		Files: []entity.FileInfo{
+			{
+				Name: "CONVENTIONS.md",
+				Content: []byte(
+					"# Conventions\n\n" +
+						"<!-- ctx:layer org -->\n" +
+						"## Inherited from org\n\n" +
+						"See `pkg/auth` and `pkg/gone.go`.\n" +
+						"<!-- ctx:layer remote -->\n" +
+						"## Inherited from remote\n\n" +
+						"See `pkg/whatever.go`.\n",
+				),
+			},
+		},
+		Layers: []entity.ContextLayer{
+			{Label: "org", Root: orgRoot},
+			{Label: "remote", Ref: "origin/main"},
+			{Label: "broken", Error: "not found"},
+		},
+	}
+
+	report := &Report{}
+	checkPathReferences(ctx, report)
+	if len(report.Warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %+v", report.Warnings)
+	}
+	w := report.Warnings[0]
+	if w.Path != "pkg/gone.go" || w.Layer != "org" {
+		t.Errorf("warning = %+v, want pkg/gone.go from org", w)
+	}
+
+	report = &Report{}
+	checkLayers(ctx, report)
+	if len(report.Warnings) != 1 ||
+		report.Warnings[0].Type != cfgDrift.IssueUnresolvedLayer {
+		t.Errorf("checkLayers warnings = %+v", report.Warnings)
+	}
+}
+
 func TestCheckStaleness(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,105 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"path/filepath"
+	"slices"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// builtin lists the compiled-in drift checks in run order.
+//
+// Checks that only read project state outside the loaded context
+// are wrapped so every entry shares one signature.
+var builtin = []check{
+	{cfgDrift.CheckPathReferences, checkPathReferences},
+	{cfgDrift.CheckStaleness, checkStaleness},
+	{cfgDrift.CheckConstitution, checkConstitution},
+	{cfgDrift.CheckConstitutionRules, checkConstitutionRules},
+	{cfgDrift.CheckSecretContent, checkSecretContent},
+	{cfgDrift.CheckRequiredFiles, checkRequiredFiles},
+	{cfgDrift.CheckFileAge, checkFileAge},
+	{cfgDrift.CheckEntryCount, checkEntryCount},
+	{cfgDrift.CheckMissingPackages, checkMissingPackages},
+	{cfgDrift.CheckTemplateHeaders, checkTemplateHeaders},
+	{cfgDrift.CheckSteeringTools, func(_ *entity.Context, r *Report) {
+		checkSteeringTools(r)
+	}},
+	{cfgDrift.CheckHookPerms, func(_ *entity.Context, r *Report) {
+		checkHookPerms(r)
+	}},
+	{cfgDrift.CheckSyncStaleness, func(_ *entity.Context, r *Report) {
+		checkSyncStaleness(r)
+	}},
+	{cfgDrift.CheckRCTool, func(_ *entity.Context, r *Report) {
+		checkRCTool(r)
+	}},
+	{cfgDrift.CheckLayers, checkLayers},
+}
+
+// explicit lists the built-in checks that read beyond the loaded
+// context and so run only from an explicit ctx drift. They are on
+// unless drift.checks.<name>.enabled is false.
+var explicit = []check{
+	{cfgDrift.CheckSecretArchive, checkSecretArchive},
+}
+
+// optIn lists the built-in checks that are too slow for implicit
+// callers. They run only from an explicit ctx drift, and only when
+// drift.checks.<name>.enabled is true.
+var optIn = []check{
+	{cfgDrift.CheckSymbols, checkSymbols},
+}
+
+// Checks lists every drift check with its effective .ctxrc
+// settings: the built-in checks in run order, the explicit-only
+// and opt-in checks, then the external checks found in the
+// context directory's checks/ folder. External checks report as
+// disabled unless drift.external is set.
+//
+// Parameters:
+//   - ctxDir: Context directory to scan for external checks
+//
+// Returns:
+//   - []entity.DriftCheck: All known checks
+func Checks(ctxDir string) []entity.DriftCheck {
+	checks := make(
+		[]entity.DriftCheck, 0, len(builtin)+len(explicit)+len(optIn),
+	)
+	for _, c := range append(slices.Clone(builtin), explicit...) {
+		checks = append(checks, entity.DriftCheck{
+			Name:     c.name,
+			Source:   cfgDrift.SourceBuiltin,
+			Enabled:  rc.DriftCheckEnabled(c.name),
+			Severity: rc.DriftCheckSeverity(c.name),
+		})
+	}
+	for _, c := range optIn {
+		checks = append(checks, entity.DriftCheck{
+			Name:     c.name,
+			Source:   cfgDrift.SourceBuiltin,
+			Enabled:  rc.DriftCheckOptedIn(c.name),
+			Severity: rc.DriftCheckSeverity(c.name),
+		})
+	}
+	for _, path := range scripts(filepath.Join(ctxDir, dir.Checks)) {
+		name := scriptName(path)
+		checks = append(checks, entity.DriftCheck{
+			Name:     name,
+			Source:   cfgDrift.SourceExternal,
+			Enabled:  rc.DriftExternal() && rc.DriftCheckEnabled(name),
+			Severity: rc.DriftCheckSeverity(name),
+			Path:     path,
+		})
+	}
+	return checks
+}
@@ -0,0 +1,203 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	cfgCtx "github.com/ActiveMemory/ctx/internal/config/ctx"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errDrift "github.com/ActiveMemory/ctx/internal/err/drift"
+)
+
+// checkConstitutionRules validates the ctx-rules block in
+// CONSTITUTION.md.
+//
+// The rules themselves apply to staged changes and are only
+// evaluated by DetectStaged; this check makes sure a broken
+// block is noticed before the pre-commit hook trips over it.
+//
+// Parameters:
+//   - ctx: Loaded context containing CONSTITUTION.md
+//   - report: Report to append warnings to (modified in place)
+func checkConstitutionRules(ctx *entity.Context, report *Report) {
+	f := ctx.File(cfgCtx.Constitution)
+	if f == nil {
+		report.Passed = append(report.Passed, cfgDrift.CheckConstitutionRules)
+		return
+	}
+	if _, parseErr := parseConstitutionRules(
+		string(f.Content),
+	); parseErr != nil {
+		report.Warnings = append(report.Warnings, Issue{
+			File:    f.Name,
+			Type:    cfgDrift.IssueInvalidRules,
+			Message: parseErr.Error(),
+		})
+		return
+	}
+	report.Passed = append(report.Passed, cfgDrift.CheckConstitutionRules)
+}
+
+// parseConstitutionRules extracts the ctx-rules block from
+// CONSTITUTION.md content.
+//
+// The block is a fenced code block whose info string is
+// cfgDrift.RulesFence, holding YAML. Banned patterns are
+// compiled here so a bad regex is reported once, up front.
+//
+// Parameters:
+//   - content: CONSTITUTION.md content
+//
+// Returns:
+//   - *constitutionRules: Parsed rules, nil when there is no
+//     block
+//   - error: Non-nil when the block is not valid YAML or a
+//     pattern does not compile
+func parseConstitutionRules(content string) (*constitutionRules, error) {
+	body, found := rulesBlock(content)
+	if !found {
+		return nil, nil
+	}
+	rules := &constitutionRules{}
+	if yamlErr := yaml.Unmarshal([]byte(body), rules); yamlErr != nil {
+		return nil, errDrift.InvalidRules(yamlErr)
+	}
+	for i := range rules.BannedPatterns {
+		bp := &rules.BannedPatterns[i]
+		re, compileErr := regex.Compile(bp.Pattern)
+		if compileErr != nil {
+			return nil, errDrift.InvalidRules(compileErr)
+		}
+		bp.re = re
+		if bp.Name == "" {
+			bp.Name = cfgDrift.RuleBannedPattern
+		}
+	}
+	return rules, nil
+}
+
+// rulesBlock returns the body of the first ctx-rules fence.
+//
+// Parameters:
+//   - content: Markdown content
+//
+// Returns:
+//   - string: Lines between the fences
+//   - bool: True when a ctx-rules fence was found
+func rulesBlock(content string) (string, bool) {
+	var (
+		body  []string
+		fence string
+		in    bool
+	)
+	for _, line := range strings.Split(content, token.NewlineLF) {
+		m := regex.CodeFenceLine.FindStringSubmatch(line)
+		switch {
+		case !in && m != nil &&
+			strings.TrimSpace(m[2]) == cfgDrift.RulesFence:
+			in, fence = true, m[1]
+		case in && m != nil && strings.HasPrefix(m[1], fence) &&
+			strings.TrimSpace(m[2]) == "":
+			return strings.Join(body, token.NewlineLF), true
+		case in:
+			body = append(body, line)
+		}
+	}
+	return strings.Join(body, token.NewlineLF), in
+}
+
+// matchGlob reports whether a slash-separated path matches a
+// glob. "**" matches any number of segments, including none;
+// other segments use path.Match. A pattern with no slash
+// matches the base name at any depth.
+//
+// Parameters:
+//   - pattern: Glob (e.g. "internal/cli/**", "*.pem")
+//   - name: Path relative to the project root
+//
+// Returns:
+//   - bool: True on a match
+func matchGlob(pattern, name string) bool {
+	if !strings.Contains(pattern, token.Slash) {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	return matchSegments(
+		strings.Split(pattern, token.Slash),
+		strings.Split(name, token.Slash),
+	)
+}
+
+// matchSegments matches glob segments against path segments.
+//
+// Parameters:
+//   - pat: Glob segments
+//   - segs: Path segments
+//
+// Returns:
+//   - bool: True on a match
+func matchSegments(pat, segs []string) bool {
+	for len(pat) > 0 {
+		if pat[0] == cfgDrift.GlobAny {
+			for i := 0; i <= len(segs); i++ {
+				if matchSegments(pat[1:], segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pat[0], segs[0]); !ok {
+			return false
+		}
+		pat, segs = pat[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
+
+// matchAny reports whether a path matches any of the globs.
+//
+// Parameters:
+//   - globs: Patterns to try
+//   - name: Path relative to the project root
+//
+// Returns:
+//   - bool: True when a glob matches
+func matchAny(globs []string, name string) bool {
+	for _, g := range globs {
+		if matchGlob(g, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// inScope reports whether a rule limited to paths, minus except,
+// applies to a file.
+//
+// Parameters:
+//   - paths: Globs the rule applies to; empty means all files
+//   - except: Globs exempt from the rule
+//   - name: Path relative to the project root
+//
+// Returns:
+//   - bool: True when the rule applies
+func inScope(paths, except []string, name string) bool {
+	if len(paths) > 0 && !matchAny(paths, name) {
+		return false
+	}
+	return !matchAny(except, name)
+}
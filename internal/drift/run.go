@@ -0,0 +1,53 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// runCheck runs one drift check with its .ctxrc overrides applied.
+//
+// A disabled check is skipped entirely (it neither reports nor
+// passes). The check writes into a scratch report so a severity
+// override can move its findings before they reach the caller.
+//
+// Parameters:
+//   - name: Check name used to look up overrides
+//   - report: Report to merge findings into (modified in place)
+//   - run: Check body writing to the report it is given
+func runCheck(name string, report *Report, run func(r *Report)) {
+	if !rc.DriftCheckEnabled(name) {
+		return
+	}
+	var scratch Report
+	run(&scratch)
+	merge(report, &scratch, rc.DriftCheckSeverity(name))
+}
+
+// merge appends one check's findings to a report.
+//
+// Parameters:
+//   - dst: Report to append to (modified in place)
+//   - src: Findings from a single check
+//   - severity: StatusWarning or StatusViolation to report every
+//     issue at that level; empty keeps each issue's own level
+func merge(dst, src *Report, severity cfgDrift.StatusType) {
+	switch severity {
+	case cfgDrift.StatusWarning:
+		dst.Warnings = append(dst.Warnings, src.Warnings...)
+		dst.Warnings = append(dst.Warnings, src.Violations...)
+	case cfgDrift.StatusViolation:
+		dst.Violations = append(dst.Violations, src.Violations...)
+		dst.Violations = append(dst.Violations, src.Warnings...)
+	default:
+		dst.Warnings = append(dst.Warnings, src.Warnings...)
+		dst.Violations = append(dst.Violations, src.Violations...)
+	}
+	dst.Passed = append(dst.Passed, src.Passed...)
+}
@@ -0,0 +1,186 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errTrigger "github.com/ActiveMemory/ctx/internal/err/trigger"
+	execTrigger "github.com/ActiveMemory/ctx/internal/exec/trigger"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/trigger"
+)
+
+// checkScripts runs every external check in the context
+// directory's checks/ folder.
+//
+// Each script receives the loaded context as JSON on stdin. A
+// script that cannot run, times out, or prints invalid output
+// is reported as a check_failed warning rather than aborting
+// detection.
+//
+// Parameters:
+//   - ctx: Loaded context passed to each script
+//   - report: Report to merge findings into (modified in place)
+func checkScripts(ctx *entity.Context, report *Report) {
+	if ctx.Dir == "" {
+		return
+	}
+	checksDir := filepath.Join(ctx.Dir, dir.Checks)
+	paths := scripts(checksDir)
+	if len(paths) == 0 {
+		return
+	}
+	input, marshalErr := json.Marshal(ctx)
+	if marshalErr != nil {
+		return
+	}
+	for _, path := range paths {
+		name := scriptName(path)
+		runCheck(name, report, func(r *Report) {
+			checkScript(checksDir, path, name, input, r)
+		})
+	}
+}
+
+// checkScript runs one external check and records its findings.
+//
+// Parameters:
+//   - checksDir: Directory the script must live in
+//   - path: Script path
+//   - name: Check name reported in Passed and as the default type
+//   - input: JSON-encoded context for stdin
+//   - report: Report to append to (modified in place)
+func checkScript(
+	checksDir, path, name string, input []byte, report *Report,
+) {
+	issues, runErr := runScript(checksDir, path, input)
+	if runErr != nil {
+		report.Warnings = append(report.Warnings, Issue{
+			File: filepath.Base(path),
+			Type: cfgDrift.IssueCheckFailed,
+			Message: fmt.Sprintf(
+				desc.Text(text.DescKeyDriftCheckFailed), name, runErr,
+			),
+			Path: path,
+		})
+		return
+	}
+	if len(issues) == 0 {
+		report.Passed = append(report.Passed, name)
+		return
+	}
+	for _, si := range issues {
+		issue := si.Issue
+		if issue.Type == "" {
+			issue.Type = name
+		}
+		if si.Severity == cfgDrift.StatusViolation {
+			report.Violations = append(report.Violations, issue)
+			continue
+		}
+		report.Warnings = append(report.Warnings, issue)
+	}
+}
+
+// runScript executes an external check under the configured
+// timeout and decodes the JSON array it prints.
+//
+// Parameters:
+//   - checksDir: Directory the script must live in
+//   - path: Script path
+//   - input: JSON payload piped to stdin
+//
+// Returns:
+//   - []scriptIssue: Reported issues; empty output means none
+//   - error: Unsafe path, timeout, non-zero exit, or invalid JSON
+func runScript(
+	checksDir, path string, input []byte,
+) ([]scriptIssue, error) {
+	if pathErr := trigger.ValidatePath(checksDir, path); pathErr != nil {
+		return nil, pathErr
+	}
+
+	timeout := rc.DriftCheckTimeout()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := execTrigger.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(input)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if runErr := cmd.Run(); runErr != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, errTrigger.Timeout(timeout)
+		}
+		return nil, errTrigger.Exit(runErr)
+	}
+
+	raw := strings.TrimSpace(stdout.String())
+	if raw == "" {
+		return nil, nil
+	}
+
+	var issues []scriptIssue
+	if jsonErr := json.Unmarshal([]byte(raw), &issues); jsonErr != nil {
+		return nil, errTrigger.InvalidJSONOutput(jsonErr)
+	}
+	return issues, nil
+}
+
+// scripts lists the candidate check files in a directory.
+//
+// Subdirectories and dotfiles are skipped. Files that are not
+// executable are still listed so running them reports why.
+//
+// Parameters:
+//   - checksDir: Directory to scan
+//
+// Returns:
+//   - []string: Script paths in name order; nil when the
+//     directory is missing
+func scripts(checksDir string) []string {
+	entries, readErr := os.ReadDir(checksDir)
+	if readErr != nil {
+		return nil
+	}
+	var paths []string
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), token.PrefixDot) {
+			continue
+		}
+		paths = append(paths, filepath.Join(checksDir, e.Name()))
+	}
+	return paths
+}
+
+// scriptName derives a check name from a script path.
+//
+// Parameters:
+//   - path: Script path
+//
+// Returns:
+//   - string: File name without its extension
+func scriptName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
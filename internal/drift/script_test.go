@@ -0,0 +1,168 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// declareChecks sets up a project with a .ctxrc and the given
+// external check scripts, returning an empty loaded context.
+func declareChecks(
+	t *testing.T, rcContent string, scripts map[string]string,
+) *entity.Context {
+	t.Helper()
+	tmpDir := t.TempDir()
+	origDir := chdir(t, tmpDir)
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+	writeCtxRC(t, tmpDir, rcContent)
+	ctxDir := testctx.Declare(t, tmpDir)
+	checksDir := filepath.Join(ctxDir, "checks")
+	mustMkdir(t, checksDir)
+	for name, body := range scripts {
+		mustWriteFile(t, filepath.Join(checksDir, name), body, 0o755)
+	}
+	return &entity.Context{Dir: ctxDir}
+}
+
+func hasIssue(issues []Issue, typ cfgDrift.IssueType) bool {
+	for _, is := range issues {
+		if is.Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDetectCheckOverrides(t *testing.T) {
+	ctx := declareChecks(t, `drift:
+  checks:
+    required_files:
+      severity: violation
+    rc_tool_field:
+      enabled: false
+`, nil)
+
+	report := Detect(ctx)
+
+	if !hasIssue(report.Violations, cfgDrift.IssueMissing) {
+		t.Errorf("expected missing files as violations, got %+v",
+			report.Violations)
+	}
+	if hasIssue(report.Warnings, cfgDrift.IssueMissing) {
+		t.Error("missing files still reported as warnings")
+	}
+	if checkPassed(report, cfgDrift.CheckRCTool) {
+		t.Error("disabled check should not report as passed")
+	}
+	if !checkPassed(report, cfgDrift.CheckHookPerms) {
+		t.Error("enabled check should still run")
+	}
+}
+
+func TestDetectExternalChecks(t *testing.T) {
+	ctx := declareChecks(t, `drift:
+  external: true
+  checks:
+    skipped:
+      enabled: false
+`, map[string]string{
+		"clean.sh": "#!/bin/sh\ncat >/dev/null\n",
+		"license.sh": "#!/bin/sh\ngrep -q '\"dir\"' || exit 3\n" +
+			`echo '[{"file":"LICENSE","message":"missing header"},` +
+			`{"file":"NOTICE","type":"notice","message":"x",` +
+			`"severity":"violation"}]'` + "\n",
+		"broken.sh":  "#!/bin/sh\necho not-json\n",
+		"skipped.sh": "#!/bin/sh\nexit 1\n",
+		".hidden":    "#!/bin/sh\nexit 1\n",
+	})
+
+	report := DetectFull(ctx)
+
+	if !checkPassed(report, "clean") {
+		t.Error("expected clean check to pass")
+	}
+	if !hasIssue(report.Warnings, "license") {
+		t.Errorf("expected license warning, got %+v", report.Warnings)
+	}
+	if !hasIssue(report.Violations, "notice") {
+		t.Errorf("expected notice violation, got %+v", report.Violations)
+	}
+	failed := 0
+	for _, w := range report.Warnings {
+		if w.Type == cfgDrift.IssueCheckFailed {
+			failed++
+			if w.File != "broken.sh" {
+				t.Errorf("unexpected failed check %q", w.File)
+			}
+		}
+	}
+	if failed != 1 {
+		t.Errorf("expected 1 failed check, got %d", failed)
+	}
+}
+
+func TestDetectExternalChecksOptIn(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "ran")
+	ctx := declareChecks(t, "", map[string]string{
+		"touch.sh": "#!/bin/sh\ntouch " + marker + "\n",
+	})
+
+	for _, report := range []*Report{Detect(ctx), DetectFull(ctx)} {
+		if checkPassed(report, "touch") {
+			t.Error("external check reported without drift.external")
+		}
+	}
+	if _, statErr := os.Stat(marker); statErr == nil {
+		t.Error("external check ran without drift.external")
+	}
+	for _, c := range Checks(ctx.Dir) {
+		if c.Name == "touch" && c.Enabled {
+			t.Errorf("touch = %+v, want disabled", c)
+		}
+	}
+}
+
+func TestChecks(t *testing.T) {
+	ctx := declareChecks(t, `drift:
+  external: true
+  checks:
+    staleness_check:
+      severity: violation
+    lint:
+      enabled: false
+`, map[string]string{"lint.py": "#!/bin/sh\n"})
+
+	checks := Checks(ctx.Dir)
+
+	want := len(builtin) + len(explicit) + len(optIn) + 1
+	if len(checks) != want {
+		t.Fatalf("expected %d checks, got %d", want, len(checks))
+	}
+	for _, c := range checks {
+		switch c.Name {
+		case cfgDrift.CheckStaleness:
+			if c.Severity != cfgDrift.StatusViolation || !c.Enabled {
+				t.Errorf("staleness_check = %+v", c)
+			}
+		case cfgDrift.CheckSymbols:
+			if c.Enabled {
+				t.Errorf("symbol_references = %+v, want opt-in", c)
+			}
+		case "lint":
+			if c.Source != cfgDrift.SourceExternal || c.Enabled {
+				t.Errorf("lint = %+v", c)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"path/filepath"
+	"testing"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+func TestCheckSecretContent(t *testing.T) {
+	ctx := declareChecks(t, "", nil)
+	token := "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+	allowed := "AKIA" + "Q3EGRTYUIOPASDFG"
+	mustWriteFile(t, filepath.Join(ctx.Dir, "secrets.allow"),
+		allowed+"\n", 0o600)
+	mustMkdir(t, filepath.Join(ctx.Dir, "journal"))
+	mustWriteFile(t, filepath.Join(ctx.Dir, "journal", "s.md"),
+		"# Session\n\nexport GH="+token+"\n", 0o600)
+
+	ctx.Files = []entity.FileInfo{
+		{Name: "LEARNINGS.md", Content: []byte(
+			"- fixture key " + allowed + " is documented\n")},
+		{Name: "DECISIONS.md", Content: []byte(
+			"# Decisions\n\nexport GH=" + token + "\n")},
+	}
+
+	report := &Report{}
+	checkSecretContent(ctx, report)
+
+	if len(report.Violations) != 1 {
+		t.Fatalf("expected 1 violation, got %+v", report.Violations)
+	}
+	if v := report.Violations[0]; v.File != "DECISIONS.md" || v.Line != 3 {
+		t.Errorf("unexpected violation %+v", v)
+	}
+
+	clean := &Report{}
+	checkSecretContent(&entity.Context{Files: ctx.Files[:1]}, clean)
+	if !checkPassed(clean, cfgDrift.CheckSecretContent) {
+		t.Errorf("expected pass, got %+v", clean.Violations)
+	}
+}
+
+func TestCheckSecretArchive(t *testing.T) {
+	ctx := declareChecks(t, "", nil)
+	token := "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+	mustMkdir(t, filepath.Join(ctx.Dir, "journal"))
+	mustWriteFile(t, filepath.Join(ctx.Dir, "journal", "s.md"),
+		"# Session\n\nexport GH="+token+"\n", 0o600)
+
+	report := &Report{}
+	checkSecretArchive(ctx, report)
+
+	if len(report.Violations) != 1 {
+		t.Fatalf("expected 1 violation, got %+v", report.Violations)
+	}
+	v := report.Violations[0]
+	if v.Type != cfgDrift.IssueSecretContent || v.Line != 3 ||
+		v.File != filepath.Join("journal", "s.md") {
+		t.Errorf("unexpected violation %+v", v)
+	}
+	if checkPassed(report, cfgDrift.CheckSecretArchive) {
+		t.Error("check should not pass with a secret")
+	}
+
+	// Implicit detection never reads the journal.
+	implicit := Detect(ctx)
+	for _, v := range implicit.Violations {
+		if v.Type == cfgDrift.IssueSecretContent {
+			t.Errorf("Detect reported journal secret %+v", v)
+		}
+	}
+	full := DetectFull(ctx)
+	if checkPassed(full, cfgDrift.CheckSecretArchive) {
+		t.Error("DetectFull should run the journal scan")
+	}
+}
@@ -0,0 +1,359 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgToken "github.com/ActiveMemory/ctx/internal/config/token"
+	execGit "github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// stagedFiles lists the files staged for commit, deletions
+// excluded, with the lines each one adds.
+//
+// Parameters:
+//   - root: Project root; paths are relative to it
+//
+// Returns:
+//   - []stagedFile: Staged files in git order
+//   - error: Non-nil when git fails or root is not in a repository
+func stagedFiles(root string) ([]stagedFile, error) {
+	status, statusErr := execGit.StagedNameStatus(root)
+	if statusErr != nil {
+		return nil, statusErr
+	}
+	diff, diffErr := execGit.StagedDiff(root)
+	if diffErr != nil {
+		return nil, diffErr
+	}
+	added := addedLines(string(diff))
+
+	var files []stagedFile
+	for _, line := range strings.Split(string(status), cfgToken.NewlineLF) {
+		fields := strings.Split(line, cfgGit.NameStatusSep)
+		if len(fields) < 2 ||
+			strings.HasPrefix(fields[0], cfgGit.StatusDeleted) {
+			continue
+		}
+		name := fields[len(fields)-1]
+		files = append(files, stagedFile{
+			path:   name,
+			status: fields[0][:1],
+			added:  added[name],
+		})
+	}
+	return files, nil
+}
+
+// addedLines reads the added lines out of a zero-context unified
+// diff.
+//
+// Parameters:
+//   - diff: Output of git diff --cached -U0
+//
+// Returns:
+//   - map[string]map[int]string: Post-image path to added line
+//     numbers and their text
+func addedLines(diff string) map[string]map[int]string {
+	out := make(map[string]map[int]string)
+	var (
+		current string
+		inHunk  bool
+		lineNo  int
+	)
+	for _, line := range strings.Split(diff, cfgToken.NewlineLF) {
+		switch {
+		case strings.HasPrefix(line, cfgDrift.DiffFile):
+			current, inHunk = "", false
+		case !inHunk && strings.HasPrefix(line, cfgDrift.DiffNewFile):
+			current = strings.TrimPrefix(line, cfgDrift.DiffNewFile)
+		case regex.DiffHunk.MatchString(line):
+			m := regex.DiffHunk.FindStringSubmatch(line)
+			lineNo, _ = strconv.Atoi(m[1])
+			inHunk = true
+		case inHunk && current != "" &&
+			strings.HasPrefix(line, cfgDrift.DiffAdded):
+			if out[current] == nil {
+				out[current] = make(map[int]string)
+			}
+			out[current][lineNo] = strings.TrimPrefix(
+				line, cfgDrift.DiffAdded,
+			)
+			lineNo++
+		}
+	}
+	return out
+}
+
+// evaluateRules checks staged files against the constitution
+// rule block.
+//
+// Parameters:
+//   - root: Project root the paths are relative to
+//   - rules: Parsed rule block
+//   - files: Staged files from stagedFiles
+//
+// Returns:
+//   - []Issue: One violation per broken rule occurrence
+func evaluateRules(
+	root string, rules *constitutionRules, files []stagedFile,
+) []Issue {
+	var issues []Issue
+	violation := func(f string, line int, rule, msg string) {
+		issues = append(issues, Issue{
+			File:    f,
+			Line:    line,
+			Type:    cfgDrift.IssueConstitutionRule,
+			Message: msg,
+			Rule:    rule,
+		})
+	}
+
+	for _, f := range files {
+		if matchAny(rules.ForbiddenPaths, f.path) {
+			violation(f.path, 0, cfgDrift.RuleForbiddenPath,
+				desc.Text(text.DescKeyDriftRuleForbiddenPath))
+		}
+		if rules.MaxFileSize > 0 {
+			data, showErr := execGit.ShowFile(
+				root, "", cfgDrift.StagedPathPrefix+f.path,
+			)
+			if size := int64(len(data)); showErr == nil &&
+				size > rules.MaxFileSize {
+				violation(f.path, 0, cfgDrift.RuleMaxFileSize, fmt.Sprintf(
+					desc.Text(text.DescKeyDriftRuleMaxFileSize),
+					size, rules.MaxFileSize,
+				))
+			}
+		}
+		for _, imp := range addedImports(root, f) {
+			for _, fi := range rules.ForbiddenImports {
+				if inScope(fi.Paths, fi.Except, f.path) &&
+					matchImport(fi.Import, imp.path, f.path) {
+					violation(f.path, imp.line, cfgDrift.RuleForbiddenImport,
+						fmt.Sprintf(
+							desc.Text(text.DescKeyDriftRuleForbiddenImport),
+							imp.path,
+						))
+				}
+			}
+		}
+		for _, n := range sortedLines(f.added) {
+			for _, bp := range rules.BannedPatterns {
+				if inScope(bp.Paths, nil, f.path) &&
+					bp.re.MatchString(f.added[n]) {
+					violation(f.path, n, bp.Name, fmt.Sprintf(
+						desc.Text(text.DescKeyDriftRuleBannedPattern),
+						bp.Pattern,
+					))
+				}
+			}
+		}
+	}
+
+	if rules.RequireTests {
+		for _, pkg := range untestedPackages(root, files) {
+			violation(pkg, 0, cfgDrift.RuleRequireTests,
+				desc.Text(text.DescKeyDriftRuleRequireTests))
+		}
+	}
+	return issues
+}
+
+// addedImports lists the imports a staged change introduces.
+//
+// An import counts when it sits on an added line and the HEAD
+// version of the file did not already have it, so moving an
+// existing import (e.g. into a grouped block) is not reported.
+//
+// Parameters:
+//   - root: Project root
+//   - f: Staged file
+//
+// Returns:
+//   - []stagedImport: Added imports with their line numbers
+func addedImports(root string, f stagedFile) []stagedImport {
+	if len(f.added) == 0 {
+		return nil
+	}
+	staged, showErr := execGit.ShowFile(
+		root, "", cfgDrift.StagedPathPrefix+f.path,
+	)
+	if showErr != nil {
+		return nil
+	}
+	before := make(map[string]bool)
+	if head, headErr := execGit.ShowFile(
+		root, cfgGit.RefHead, cfgDrift.StagedPathPrefix+f.path,
+	); headErr == nil {
+		for _, imp := range sourceImports(f.path, head) {
+			before[imp.path] = true
+		}
+	}
+
+	var out []stagedImport
+	for _, imp := range sourceImports(f.path, staged) {
+		if _, ok := f.added[imp.line]; ok && !before[imp.path] {
+			out = append(out, imp)
+		}
+	}
+	return out
+}
+
+// sourceImports lists the imports in a source file.
+//
+// Go files are parsed so grouped import blocks are understood;
+// TypeScript, JavaScript and Python imports are read line by
+// line. Other files have no imports.
+//
+// Parameters:
+//   - name: File path, used to pick the language
+//   - src: File content
+//
+// Returns:
+//   - []stagedImport: Imports with their 1-based line numbers
+func sourceImports(name string, src []byte) []stagedImport {
+	ext := path.Ext(name)
+	var out []stagedImport
+	switch {
+	case ext == file.ExtGo:
+		fset := token.NewFileSet()
+		parsed, parseErr := parser.ParseFile(
+			fset, name, src, parser.ImportsOnly,
+		)
+		if parseErr != nil {
+			return nil
+		}
+		for _, spec := range parsed.Imports {
+			imp, unquoteErr := strconv.Unquote(spec.Path.Value)
+			if unquoteErr == nil {
+				out = append(out, stagedImport{
+					line: fset.Position(spec.Path.Pos()).Line,
+					path: imp,
+				})
+			}
+		}
+	case slices.Contains(cfgDrift.SymbolExtTS, ext):
+		for i, line := range strings.Split(string(src), cfgToken.NewlineLF) {
+			for _, m := range regex.ImportTS.FindAllStringSubmatch(line, -1) {
+				out = append(out, stagedImport{line: i + 1, path: m[1]})
+			}
+		}
+	case slices.Contains(cfgDrift.SymbolExtPy, ext):
+		for i, line := range strings.Split(string(src), cfgToken.NewlineLF) {
+			m := regex.ImportPy.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			imp := m[1]
+			if imp == "" {
+				imp = m[2]
+			}
+			out = append(out, stagedImport{line: i + 1, path: imp})
+		}
+	}
+	return out
+}
+
+// matchImport reports whether an import path matches a
+// forbidden_imports glob. Python modules are matched on their
+// dotted segments, everything else on slash-separated segments.
+//
+// Parameters:
+//   - pattern: Import glob (e.g. "net/http", "github.com/x/**")
+//   - imp: Imported path or module
+//   - name: Importing file, used to pick the separator
+//
+// Returns:
+//   - bool: True on a match
+func matchImport(pattern, imp, name string) bool {
+	sep := cfgToken.Slash
+	if slices.Contains(cfgDrift.SymbolExtPy, path.Ext(name)) {
+		sep = cfgToken.Dot
+	}
+	return matchSegments(
+		strings.Split(pattern, sep), strings.Split(imp, sep),
+	)
+}
+
+// untestedPackages finds Go packages introduced by the staged
+// changes that have no test file in the index.
+//
+// A package is new when every Go file in its directory is a
+// staged addition.
+//
+// Parameters:
+//   - root: Project root
+//   - files: Staged files
+//
+// Returns:
+//   - []string: Package directories, sorted
+func untestedPackages(root string, files []stagedFile) []string {
+	status := make(map[string]string, len(files))
+	dirs := make(map[string]bool)
+	for _, f := range files {
+		status[f.path] = f.status
+		if path.Ext(f.path) == file.ExtGo && f.status == cfgGit.StatusAdded {
+			dirs[path.Dir(f.path)] = true
+		}
+	}
+
+	var out []string
+	for d := range dirs {
+		listed, lsErr := execGit.IndexFiles(root, d)
+		if lsErr != nil {
+			continue
+		}
+		isNew, hasTest := true, false
+		for _, p := range strings.Fields(string(listed)) {
+			if path.Dir(p) != d || path.Ext(p) != file.ExtGo {
+				continue
+			}
+			if status[p] != cfgGit.StatusAdded {
+				isNew = false
+			}
+			if strings.HasSuffix(p, cfgDrift.GoTestSuffix) {
+				hasTest = true
+			}
+		}
+		if isNew && !hasTest {
+			out = append(out, d)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// sortedLines returns the keys of an added-lines map in order.
+//
+// Parameters:
+//   - added: Line numbers mapped to text
+//
+// Returns:
+//   - []int: Ascending line numbers
+func sortedLines(added map[int]string) []int {
+	lines := make([]int, 0, len(added))
+	for n := range added {
+		lines = append(lines, n)
+	}
+	sort.Ints(lines)
+	return lines
+}
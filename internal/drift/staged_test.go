@@ -0,0 +1,171 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+const stagedRules = "# Constitution\n\n" +
+	"- [ ] Never break the build\n\n" +
+	"```ctx-rules\n" +
+	"forbidden_imports:\n" +
+	"  - import: net/http\n" +
+	"    paths: [\"internal/core/**\"]\n" +
+	"  - import: requests\n" +
+	"forbidden_paths: [\"*.pem\", \"vendor/**\"]\n" +
+	"require_tests: true\n" +
+	"max_file_size: 96\n" +
+	"banned_patterns:\n" +
+	"  - name: no_todo\n" +
+	"    pattern: 'TODO\\(nobody\\)'\n" +
+	"```\n"
+
+func gitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	full := append([]string{
+		"-c", "user.email=t@example.com", "-c", "user.name=t",
+	}, args...)
+	c := exec.Command("git", full...) //nolint:gosec // test input
+	c.Dir = dir
+	if out, runErr := c.CombinedOutput(); runErr != nil {
+		t.Fatalf("git %v: %v\n%s", args, runErr, out)
+	}
+}
+
+func stagedContext(t *testing.T, constitution string) *entity.Context {
+	t.Helper()
+	ctx := declareChecks(t, "", nil)
+	ctx.Files = []entity.FileInfo{
+		{Name: "CONSTITUTION.md", Content: []byte(constitution)},
+	}
+	return ctx
+}
+
+func TestDetectStaged(t *testing.T) {
+	ctx := stagedContext(t, stagedRules)
+	root := filepath.Dir(ctx.Dir)
+	write := func(rel, content string) {
+		t.Helper()
+		mustMkdir(t, filepath.Dir(filepath.Join(root, rel)))
+		mustWriteFile(t, filepath.Join(root, rel), content, 0o600)
+	}
+
+	write("internal/core/old.go",
+		"package core\n\nimport \"net/http\"\n\nvar _ = http.Get\n")
+	write("internal/core/old_test.go", "package core\n")
+	gitIn(t, root, "init", "-q")
+	gitIn(t, root, "add", "-A")
+	gitIn(t, root, "commit", "-qm", "init")
+
+	// Existing import untouched; a new one added in scope.
+	write("internal/core/old.go", "package core\n\nimport (\n"+
+		"\t\"net/http\"\n\t\"os\"\n)\n\nvar _ = http.Get\nvar _ = os.Exit\n")
+	write("internal/core/api.go",
+		"package core\n\nimport \"net/http\"\n\nvar _ = http.Head\n")
+	write("internal/web/ok.go",
+		"package web\n\nimport \"net/http\"\n\nvar _ = http.Get\n")
+	write("internal/web/ok_test.go", "package web\n")
+	write("internal/fresh/fresh.go", "package fresh\n")
+	write("tools/fetch.py", "import os\nimport requests\n")
+	write("certs/server.pem", "x\n")
+	write("notes.txt", "short\n// TODO(nobody): fix\n")
+	write("big.txt", strings.Repeat("x", 100)+"\n")
+	gitIn(t, root, "add", "-A")
+
+	report, err := DetectStaged(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]bool{}
+	for _, v := range report.Violations {
+		if v.Type != cfgDrift.IssueConstitutionRule {
+			t.Errorf("unexpected type %q", v.Type)
+		}
+		got[v.Rule+" "+v.File] = true
+	}
+	want := []string{
+		"forbidden_import internal/core/api.go",
+		"forbidden_import tools/fetch.py",
+		"forbidden_path certs/server.pem",
+		"require_tests internal/fresh",
+		"max_file_size big.txt",
+		"no_todo notes.txt",
+	}
+	for _, w := range want {
+		if !got[w] {
+			t.Errorf("missing violation %q in %+v", w, report.Violations)
+		}
+	}
+	if len(report.Violations) != len(want) {
+		t.Errorf("got %d violations, want %d: %+v",
+			len(report.Violations), len(want), report.Violations)
+	}
+	for _, v := range report.Violations {
+		if v.Rule == "no_todo" && v.Line != 2 {
+			t.Errorf("banned pattern line = %d, want 2", v.Line)
+		}
+	}
+}
+
+func TestDetectStagedNoRules(t *testing.T) {
+	ctx := stagedContext(t, "# Constitution\n\n- [ ] Be kind\n")
+	report, err := DetectStaged(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Violations) != 0 ||
+		!checkPassed(report, cfgDrift.CheckConstitutionRules) {
+		t.Errorf("expected a clean pass, got %+v", report)
+	}
+}
+
+func TestCheckConstitutionRules(t *testing.T) {
+	ctx := stagedContext(t,
+		"```ctx-rules\nbanned_patterns:\n  - pattern: '('\n```\n")
+	report := &Report{}
+	checkConstitutionRules(ctx, report)
+	if !hasIssue(report.Warnings, cfgDrift.IssueInvalidRules) {
+		t.Errorf("expected invalid_rules warning, got %+v", report)
+	}
+	if _, err := DetectStaged(ctx); err == nil {
+		t.Error("DetectStaged should fail on an invalid rule block")
+	}
+
+	report = &Report{}
+	checkConstitutionRules(stagedContext(t, stagedRules), report)
+	if !checkPassed(report, cfgDrift.CheckConstitutionRules) {
+		t.Errorf("valid rule block should pass, got %+v", report)
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*.pem", "certs/server.pem", true},
+		{"vendor/**", "vendor/a/b.go", true},
+		{"vendor/**", "src/vendor/a.go", false},
+		{"internal/**/types.go", "internal/types.go", true},
+		{"internal/**/types.go", "internal/a/b/types.go", true},
+		{"internal/*/doc.go", "internal/a/b/doc.go", false},
+	}
+	for _, tt := range tests {
+		if got := matchGlob(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("matchGlob(%q, %q) = %v, want %v",
+				tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// newSymbolIndex returns an empty symbol table.
+//
+// Returns:
+//   - *symbolIndex: Index ready for the language indexers
+func newSymbolIndex() *symbolIndex {
+	return &symbolIndex{
+		names:    map[string]bool{},
+		members:  map[string]map[string]bool{},
+		shadowed: map[string]bool{},
+	}
+}
+
+// qualifier registers a qualifier so references through it are
+// judged even before any member is added.
+//
+// Parameters:
+//   - qual: Package, module, type, or class name
+//
+// Returns:
+//   - map[string]bool: The qualifier's member set
+func (x *symbolIndex) qualifier(qual string) map[string]bool {
+	m, ok := x.members[qual]
+	if !ok {
+		m = map[string]bool{}
+		x.members[qual] = m
+	}
+	return m
+}
+
+// add records a name declared under a qualifier.
+//
+// Parameters:
+//   - qual: Package, module, type, or class name
+//   - name: Declared name
+func (x *symbolIndex) add(qual, name string) {
+	x.qualifier(qual)[name] = true
+	x.names[name] = true
+}
+
+// resolve looks up a quoted identifier.
+//
+// Dotted names are judged by their last two parts: the qualifier
+// must be known to the index (otherwise the reference points
+// outside the repository and is accepted), and a last part that
+// is a file extension marks a file name, which is accepted too.
+// Bare names are only
+// judged when written as a call and spelled like a declared
+// identifier (mixed case or an underscore), which keeps plain
+// words and builtins such as `len()` out of the report.
+//
+// Parameters:
+//   - name: Identifier, dotted parts included
+//   - call: True when written as a call
+//
+// Returns:
+//   - string: Closest existing identifier, empty when none is
+//     near enough
+//   - bool: True when the identifier resolves or is not judged
+func (x *symbolIndex) resolve(name string, call bool) (string, bool) {
+	parts := strings.Split(name, cfgDrift.SymbolSep)
+	if len(parts) == 1 {
+		if !call || !declaredSpelling(name) || x.names[name] {
+			return "", true
+		}
+		return closest(name, x.names), false
+	}
+
+	qual, member := parts[len(parts)-2], parts[len(parts)-1]
+	if cfgDrift.SymbolFileExts[member] {
+		return "", true
+	}
+	members, known := x.members[qual]
+	if !known || x.shadowed[qual] || members[member] {
+		return "", true
+	}
+	best := closest(member, members)
+	if best == "" {
+		return "", false
+	}
+	prefix := strings.Join(parts[:len(parts)-1], cfgDrift.SymbolSep)
+	return prefix + cfgDrift.SymbolSep + best, false
+}
+
+// declaredSpelling reports whether a bare name is spelled like a
+// program identifier rather than a word.
+//
+// Parameters:
+//   - name: Bare identifier
+//
+// Returns:
+//   - bool: True for names with an underscore or an upper-case
+//     letter
+func declaredSpelling(name string) bool {
+	return strings.Contains(name, token.Underscore) ||
+		strings.ToLower(name) != name
+}
+
+// closest returns the candidate with the smallest edit distance
+// to name, within half the longer length.
+//
+// Parameters:
+//   - name: Unresolved name
+//   - candidates: Set of existing names
+//
+// Returns:
+//   - string: Best candidate, or empty when none is near enough;
+//     ties go to the lexically first candidate
+func closest(name string, candidates map[string]bool) string {
+	keys := make([]string, 0, len(candidates))
+	for k := range candidates {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	best, bestDist := "", -1
+	for _, k := range keys {
+		d := editDistance(name, k)
+		limit := max(len(name), len(k)) / cfgDrift.SuggestDivisor
+		if d > limit {
+			continue
+		}
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = k, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two
+// strings, counted in bytes.
+//
+// Parameters:
+//   - a: First string
+//   - b: Second string
+//
+// Returns:
+//   - int: Minimum number of single-byte edits turning a into b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// moduleName derives the qualifier for a TypeScript, JavaScript,
+// or Python source file: its name without extension, or the
+// directory name for index and __init__ files.
+//
+// Parameters:
+//   - path: Source file path
+//
+// Returns:
+//   - string: Module qualifier
+func moduleName(path string) string {
+	base := filepath.Base(path)
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	if stem == cfgDrift.ModuleIndex || stem == cfgDrift.ModuleInit {
+		return filepath.Base(filepath.Dir(path))
+	}
+	return stem
+}
@@ -0,0 +1,257 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/tools/go/packages"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+)
+
+// indexGo adds every package-level Go declaration under root to
+// the index: functions, types, constants, and variables under
+// their package name, and methods, struct fields, and interface
+// methods under their type name.
+//
+// A package name only qualifies references when some file imports
+// the package under that name and no variable, parameter, or
+// receiver shares it: in `cmd.Printf`, cmd is a local *cobra.Command,
+// not the repo's cmd package.
+//
+// Runs only when root holds a go.mod. Load failures (no Go
+// toolchain, broken module) leave the index without Go symbols.
+//
+// Parameters:
+//   - root: Project root
+//   - idx: Index to fill (modified in place)
+func indexGo(root string, idx *symbolIndex) {
+	if _, statErr := os.Stat(
+		filepath.Join(root, cfgDrift.GoModFile),
+	); statErr != nil {
+		return
+	}
+
+	pkgs, loadErr := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles |
+			packages.NeedCompiledGoFiles | packages.NeedSyntax,
+		Dir: root,
+	}, cfgDrift.GoPackagesPattern)
+	if loadErr != nil {
+		return
+	}
+	names := make(map[string]string, len(pkgs))
+	for _, p := range pkgs {
+		names[p.PkgPath] = p.Name
+	}
+	imported := map[string]bool{}
+	vars := map[string]bool{}
+	for _, p := range pkgs {
+		idx.qualifier(p.Name)
+		for _, f := range p.Syntax {
+			indexGoFile(p.Name, f, idx)
+			goImportNames(f, names, imported)
+			goVarNames(f, vars)
+		}
+	}
+	for _, p := range pkgs {
+		if !imported[p.Name] || vars[p.Name] {
+			idx.shadowed[p.Name] = true
+		}
+	}
+
+	std, stdErr := packages.Load(&packages.Config{
+		Mode: packages.NeedName, Dir: root,
+	}, cfgDrift.StdPattern)
+	if stdErr != nil {
+		return
+	}
+	for _, p := range std {
+		idx.shadowed[p.Name] = true
+	}
+}
+
+// goImportNames records the names under which a file refers to
+// the repository's own packages.
+//
+// Parameters:
+//   - f: Parsed file
+//   - names: Package path to package name for loaded packages
+//   - imported: Set of import names (modified in place)
+func goImportNames(
+	f *ast.File, names map[string]string, imported map[string]bool,
+) {
+	for _, imp := range f.Imports {
+		if imp.Name != nil {
+			imported[imp.Name.Name] = true
+			continue
+		}
+		path, unquoteErr := strconv.Unquote(imp.Path.Value)
+		if unquoteErr != nil {
+			continue
+		}
+		if name, ok := names[path]; ok {
+			imported[name] = true
+		}
+	}
+}
+
+// goVarNames records every variable, parameter, result, and
+// receiver name declared in a file.
+//
+// Parameters:
+//   - f: Parsed file
+//   - vars: Set of declared value names (modified in place)
+func goVarNames(f *ast.File, vars map[string]bool) {
+	addIdent := func(e ast.Expr) {
+		if id, ok := e.(*ast.Ident); ok {
+			vars[id.Name] = true
+		}
+	}
+	addFields := func(fl *ast.FieldList) {
+		if fl == nil {
+			return
+		}
+		for _, field := range fl.List {
+			for _, n := range field.Names {
+				vars[n.Name] = true
+			}
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch d := n.(type) {
+		case *ast.FuncDecl:
+			addFields(d.Recv)
+		case *ast.FuncType:
+			addFields(d.Params)
+			addFields(d.Results)
+		case *ast.AssignStmt:
+			if d.Tok == token.DEFINE {
+				for _, lhs := range d.Lhs {
+					addIdent(lhs)
+				}
+			}
+		case *ast.RangeStmt:
+			if d.Tok == token.DEFINE {
+				addIdent(d.Key)
+				addIdent(d.Value)
+			}
+		case *ast.ValueSpec:
+			for _, name := range d.Names {
+				vars[name.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+// indexGoFile adds the top-level declarations of one file.
+//
+// Parameters:
+//   - pkg: Package name
+//   - f: Parsed file
+//   - idx: Index to fill (modified in place)
+func indexGoFile(pkg string, f *ast.File, idx *symbolIndex) {
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				idx.add(pkg, d.Name.Name)
+				continue
+			}
+			if recv := goTypeName(d.Recv.List[0].Type); recv != "" {
+				idx.add(recv, d.Name.Name)
+			}
+		case *ast.GenDecl:
+			indexGoGenDecl(pkg, d, idx)
+		}
+	}
+}
+
+// indexGoGenDecl adds the types, constants, and variables of one
+// declaration group.
+//
+// Parameters:
+//   - pkg: Package name
+//   - d: Declaration group
+//   - idx: Index to fill (modified in place)
+func indexGoGenDecl(pkg string, d *ast.GenDecl, idx *symbolIndex) {
+	if d.Tok == token.IMPORT {
+		return
+	}
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			idx.add(pkg, s.Name.Name)
+			indexGoType(s.Name.Name, s.Type, idx)
+		case *ast.ValueSpec:
+			for _, n := range s.Names {
+				idx.add(pkg, n.Name)
+			}
+		}
+	}
+}
+
+// indexGoType adds a type's struct fields or interface methods.
+//
+// Parameters:
+//   - name: Type name
+//   - expr: Type expression
+//   - idx: Index to fill (modified in place)
+func indexGoType(name string, expr ast.Expr, idx *symbolIndex) {
+	idx.qualifier(name)
+	var fields *ast.FieldList
+	switch t := expr.(type) {
+	case *ast.StructType:
+		fields = t.Fields
+	case *ast.InterfaceType:
+		fields = t.Methods
+	default:
+		return
+	}
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			if embedded := goTypeName(field.Type); embedded != "" {
+				idx.add(name, embedded)
+			}
+			continue
+		}
+		for _, n := range field.Names {
+			idx.add(name, n.Name)
+		}
+	}
+}
+
+// goTypeName returns the bare type name of a receiver or embedded
+// field, unwrapping pointers, generics, and package qualifiers.
+//
+// Parameters:
+//   - expr: Type expression
+//
+// Returns:
+//   - string: Type name, or empty when the expression has none
+func goTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return goTypeName(t.X)
+	case *ast.IndexExpr:
+		return goTypeName(t.X)
+	case *ast.IndexListExpr:
+		return goTypeName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	default:
+		return ""
+	}
+}
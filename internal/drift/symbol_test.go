@@ -0,0 +1,184 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+func TestSymbolIndexResolve(t *testing.T) {
+	idx := newSymbolIndex()
+	idx.add("store", "Append")
+	idx.add("store", "Open")
+	idx.add("Store", "Close")
+	idx.add("main", "loadConfig")
+	idx.shadowed["fs"] = true
+	idx.add("fs", "Helper")
+	idx.add("zensical", "Toml")
+
+	tests := []struct {
+		name       string
+		call       bool
+		wantOK     bool
+		suggestion string
+	}{
+		{"store.Append", false, true, ""},
+		{"store.AppendBatch", false, false, "store.Append"},
+		{"pkg.store.Opn", false, false, "pkg.store.Open"},
+		{"db.Store.Close", false, true, ""},
+		{"os.Getenv", false, true, ""},
+		{"fs.WalkDir", false, true, ""},
+		{"loadConfig", true, true, ""},
+		{"loadConfg", true, false, "loadConfig"},
+		{"loadConfg", false, true, ""},
+		{"len", true, true, ""},
+		{"store.Zzzzzzzz", false, false, ""},
+		{"zensical.toml", false, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := idx.resolve(tt.name, tt.call)
+			if ok != tt.wantOK || got != tt.suggestion {
+				t.Errorf("resolve(%q) = %q, %v; want %q, %v",
+					tt.name, got, ok, tt.suggestion, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestIndexTextLanguages(t *testing.T) {
+	idx := newSymbolIndex()
+	indexTSLines("api", strings.Split(`import x from "y";
+export async function fetchUser(id: string) {
+  if (id) {
+    return id;
+  }
+}
+export class Client {
+  private token: string;
+  async request(path: string) {}
+}
+export const API_URL = "x";
+`, "\n"), idx)
+	indexPyLines("models", strings.Split(`MAX_ITEMS = 10
+
+class User:
+    def save(self):
+        def inner():
+            pass
+
+def load_user():
+    pass
+`, "\n"), idx)
+
+	for _, want := range [][2]string{
+		{"api", "fetchUser"}, {"api", "Client"}, {"api", "API_URL"},
+		{"Client", "request"}, {"Client", "token"},
+		{"models", "MAX_ITEMS"}, {"models", "User"},
+		{"models", "load_user"}, {"User", "save"},
+	} {
+		if !idx.members[want[0]][want[1]] {
+			t.Errorf("missing %s.%s", want[0], want[1])
+		}
+	}
+	if idx.members["Client"]["if"] || idx.members["models"]["inner"] {
+		t.Error("indexed a keyword or nested function")
+	}
+}
+
+func TestCheckSymbols(t *testing.T) {
+	ctx := declareChecks(t, "", nil)
+	root := filepath.Dir(ctx.Dir)
+	mustWriteFile(t, filepath.Join(root, "go.mod"),
+		"module example.com/demo\n\ngo 1.22\n", 0o644)
+	mustMkdir(t, filepath.Join(root, "store"))
+	mustWriteFile(t, filepath.Join(root, "store", "store.go"),
+		"package store\n\ntype Log struct{ Path string }\n\n"+
+			"func (l *Log) Append(s string) {}\n\nfunc Open() *Log "+
+			"{ return nil }\n", 0o644)
+
+	ctx.Files = []entity.FileInfo{
+		{Name: "CONVENTIONS.md", Content: []byte(
+			"Open logs with `store.Open` and write with\n" +
+				"`Log.AppendBatch`; see `Log.Path`.\n")},
+		{Name: "TASKS.md", Content: []byte("- [ ] add `store.Rotate`\n")},
+	}
+
+	report := &Report{}
+	checkSymbols(ctx, report)
+
+	if len(report.Warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %+v", report.Warnings)
+	}
+	w := report.Warnings[0]
+	if w.Type != cfgDrift.IssueUnknownSymbol || w.Line != 2 ||
+		w.Symbol != "Log.AppendBatch" || w.Suggestion != "Log.Append" {
+		t.Errorf("unexpected warning %+v", w)
+	}
+	if checkPassed(report, cfgDrift.CheckSymbols) {
+		t.Error("check should not pass with an unknown symbol")
+	}
+}
+
+func TestCheckSymbolsPackageQualifiers(t *testing.T) {
+	ctx := declareChecks(t, "", nil)
+	root := filepath.Dir(ctx.Dir)
+	files := map[string]string{
+		"go.mod":         "module example.com/demo\n\ngo 1.22\n",
+		"store/store.go": "package store\n\nfunc Open() {}\n",
+		"cmd/cmd.go":     "package cmd\n\nfunc Run() {}\n",
+		"orphan/o.go":    "package orphan\n\nfunc Keep() {}\n",
+		"app/app.go": "package app\n\nimport (\n" +
+			"\t\"example.com/demo/cmd\"\n" +
+			"\t\"example.com/demo/store\"\n)\n\n" +
+			"func use(cmd string) { _ = cmd; store.Open() }\n\n" +
+			"var _ = cmd.Run\n",
+	}
+	for name, body := range files {
+		mustMkdir(t, filepath.Dir(filepath.Join(root, name)))
+		mustWriteFile(t, filepath.Join(root, name), body, 0o644)
+	}
+
+	ctx.Files = []entity.FileInfo{
+		{Name: "CONVENTIONS.md", Content: []byte(
+			"Print with `cmd.Printf`, keep `orphan.Gone`,\n" +
+				"edit `store.toml`, and call `store.Opn`.\n")},
+	}
+
+	report := &Report{}
+	checkSymbols(ctx, report)
+
+	if len(report.Warnings) != 1 ||
+		report.Warnings[0].Symbol != "store.Opn" {
+		t.Fatalf("expected only store.Opn, got %+v", report.Warnings)
+	}
+}
+
+func TestDetectSymbolsOptIn(t *testing.T) {
+	ctx := declareChecks(t, "", nil)
+	if checkPassed(Detect(ctx), cfgDrift.CheckSymbols) ||
+		checkPassed(DetectFull(ctx), cfgDrift.CheckSymbols) {
+		t.Error("symbol_references ran without opting in")
+	}
+
+	ctx = declareChecks(t, `drift:
+  checks:
+    symbol_references:
+      enabled: true
+`, nil)
+	if checkPassed(Detect(ctx), cfgDrift.CheckSymbols) {
+		t.Error("Detect ran the opt-in symbol_references check")
+	}
+	if !checkPassed(DetectFull(ctx), cfgDrift.CheckSymbols) {
+		t.Error("DetectFull skipped the enabled symbol_references check")
+	}
+}
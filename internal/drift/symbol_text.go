@@ -0,0 +1,148 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+)
+
+// indexText walks root and adds TypeScript, JavaScript, and
+// Python declarations to the index with ctags-style line
+// matching. Hidden directories and build output are skipped.
+//
+// Parameters:
+//   - root: Project root
+//   - idx: Index to fill (modified in place)
+func indexText(root string, idx *symbolIndex) {
+	_ = filepath.WalkDir(root, func(
+		path string, d fs.DirEntry, walkErr error,
+	) error {
+		if walkErr != nil {
+			return nil
+		}
+		name := d.Name()
+		if d.IsDir() {
+			hidden := path != root &&
+				strings.HasPrefix(name, token.PrefixDot)
+			if hidden || cfgDrift.SymbolSkipDirs[name] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := filepath.Ext(name)
+		switch {
+		case slices.Contains(cfgDrift.SymbolExtTS, ext):
+			indexLines(path, idx, indexTSLines)
+		case slices.Contains(cfgDrift.SymbolExtPy, ext):
+			indexLines(path, idx, indexPyLines)
+		}
+		return nil
+	})
+}
+
+// indexLines reads one source file and hands its lines to a
+// language indexer. Unreadable files are skipped.
+//
+// Parameters:
+//   - path: Source file path
+//   - idx: Index to fill (modified in place)
+//   - index: Language indexer
+func indexLines(
+	path string, idx *symbolIndex,
+	index func(module string, lines []string, idx *symbolIndex),
+) {
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if readErr != nil {
+		return
+	}
+	lines := strings.Split(string(data), token.NewlineLF)
+	index(moduleName(path), lines, idx)
+}
+
+// indexTSLines adds top-level declarations under the module name
+// and class members under the class name.
+//
+// Parameters:
+//   - module: Module qualifier
+//   - lines: File lines
+//   - idx: Index to fill (modified in place)
+func indexTSLines(module string, lines []string, idx *symbolIndex) {
+	class := ""
+	for _, line := range lines {
+		line = strings.TrimRight(line, token.TrimCR)
+		if class != "" {
+			if line == cfgDrift.ClassEnd {
+				class = ""
+				continue
+			}
+			m := regex.SymbolTSMethod.FindStringSubmatch(line)
+			if m != nil && !cfgDrift.SymbolKeywords[m[1]] {
+				idx.add(class, m[1])
+			}
+			continue
+		}
+		m := regex.SymbolTSDecl.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		idx.add(module, m[2])
+		if m[1] != "" {
+			idx.qualifier(m[2])
+			if !strings.HasSuffix(line, cfgDrift.ClassEnd) {
+				class = m[2]
+			}
+		}
+	}
+}
+
+// indexPyLines adds module-level functions, classes, and
+// upper-case constants under the module name and methods under
+// their top-level class.
+//
+// Parameters:
+//   - module: Module qualifier
+//   - lines: File lines
+//   - idx: Index to fill (modified in place)
+func indexPyLines(module string, lines []string, idx *symbolIndex) {
+	class := ""
+	for _, line := range lines {
+		line = strings.TrimRight(line, token.TrimCR)
+		m := regex.SymbolPyDef.FindStringSubmatch(line)
+		if m == nil {
+			top := line != "" &&
+				strings.TrimLeft(line, token.Whitespace) == line &&
+				!strings.HasPrefix(line, token.PrefixComment)
+			if top {
+				class = ""
+				if c := regex.SymbolPyConst.FindStringSubmatch(line); c != nil {
+					idx.add(module, c[1])
+				}
+			}
+			continue
+		}
+		if m[1] != "" {
+			if class != "" {
+				idx.add(class, m[3])
+			}
+			continue
+		}
+		idx.add(module, m[3])
+		class = ""
+		if m[2] != "" {
+			class = m[3]
+			idx.qualifier(class)
+		}
+	}
+}
@@ -6,7 +6,12 @@
 
 package drift
 
-import cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+import (
+	"regexp"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
 
 // Issue represents a detected drift issue.
 //
@@ -20,13 +25,20 @@ import cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
 //   - Message: Human-readable description of the issue
 //   - Path: Referenced path that caused the issue, if applicable
 //   - Rule: Constitution rule that was violated, if applicable
+//   - Layer: Label of the inherited layer the content came from,
+//     empty for the project's own content
+//   - Symbol: Code identifier that caused the issue, if applicable
+//   - Suggestion: Closest existing identifier, if one was found
 type Issue struct {
-	File    string             `json:"file"`
-	Line    int                `json:"line,omitempty"`
-	Type    cfgDrift.IssueType `json:"type"`
-	Message string             `json:"message"`
-	Path    string             `json:"path,omitempty"`
-	Rule    string             `json:"rule,omitempty"`
+	File       string             `json:"file"`
+	Line       int                `json:"line,omitempty"`
+	Type       cfgDrift.IssueType `json:"type"`
+	Message    string             `json:"message"`
+	Path       string             `json:"path,omitempty"`
+	Rule       string             `json:"rule,omitempty"`
+	Layer      string             `json:"layer,omitempty"`
+	Symbol     string             `json:"symbol,omitempty"`
+	Suggestion string             `json:"suggestion,omitempty"`
 }
 
 // Report represents the complete drift detection report.
@@ -42,3 +54,122 @@ type Report struct {
 	Violations []Issue              `json:"violations"`
 	Passed     []cfgDrift.CheckName `json:"passed"`
 }
+
+// check is one entry in the built-in drift check registry.
+//
+// Fields:
+//   - name: Check name, also the key for .ctxrc overrides
+//   - run: Appends the check's findings to a report
+type check struct {
+	name cfgDrift.CheckName
+	run  func(ctx *entity.Context, report *Report)
+}
+
+// scriptIssue is one issue printed by an external check.
+//
+// Fields:
+//   - Issue: The reported issue; an empty Type defaults to
+//     the check name
+//   - Severity: "violation" to report the issue as a
+//     violation; anything else reports a warning
+type scriptIssue struct {
+	Issue
+	Severity cfgDrift.StatusType `json:"severity"`
+}
+
+// symbolRef is a code identifier quoted in a context file.
+//
+// Fields:
+//   - file: Context file name
+//   - line: 1-based line number
+//   - name: Identifier, dotted parts included
+//   - call: True when written as a call (name followed by "()")
+type symbolRef struct {
+	file string
+	line int
+	name string
+	call bool
+}
+
+// symbolIndex is the repository symbol table used to resolve
+// identifiers quoted in context files.
+//
+// Fields:
+//   - names: Every declared name, unqualified
+//   - members: Qualifier (package, module, type, or class) to
+//     the names declared under it
+//   - shadowed: Qualifiers that also name a standard library
+//     package, or Go packages that are never imported under their
+//     name or share it with a variable; references through them
+//     are not judged
+type symbolIndex struct {
+	names    map[string]bool
+	members  map[string]map[string]bool
+	shadowed map[string]bool
+}
+
+// constitutionRules is the structured rule block parsed from the
+// ctx-rules fence in CONSTITUTION.md.
+//
+// Fields:
+//   - ForbiddenImports: Imports newly added code must not use
+//   - ForbiddenPaths: Globs no staged file may match
+//   - RequireTests: New Go packages must stage a test file
+//   - MaxFileSize: Largest staged file in bytes; 0 disables
+//   - BannedPatterns: Regexes added lines must not match
+type constitutionRules struct {
+	ForbiddenImports []forbiddenImport `yaml:"forbidden_imports"`
+	ForbiddenPaths   []string          `yaml:"forbidden_paths"`
+	RequireTests     bool              `yaml:"require_tests"`
+	MaxFileSize      int64             `yaml:"max_file_size"`
+	BannedPatterns   []bannedPattern   `yaml:"banned_patterns"`
+}
+
+// forbiddenImport disallows an import, optionally only in some
+// files.
+//
+// Fields:
+//   - Import: Import path glob (e.g. "os/exec", "lodash/**")
+//   - Paths: File globs the rule applies to; empty means all
+//   - Except: File globs exempt from the rule
+type forbiddenImport struct {
+	Import string   `yaml:"import"`
+	Paths  []string `yaml:"paths"`
+	Except []string `yaml:"except"`
+}
+
+// bannedPattern disallows text in added lines.
+//
+// Fields:
+//   - Name: Rule name reported on violations
+//   - Pattern: Go regular expression
+//   - Paths: File globs the rule applies to; empty means all
+//   - re: Compiled Pattern
+type bannedPattern struct {
+	Name    string   `yaml:"name"`
+	Pattern string   `yaml:"pattern"`
+	Paths   []string `yaml:"paths"`
+	re      *regexp.Regexp
+}
+
+// stagedFile is one file in the staged diff.
+//
+// Fields:
+//   - path: Path relative to the project root
+//   - status: git status letter (A, M, R, C, T)
+//   - added: Added line numbers mapped to their text
+type stagedFile struct {
+	path   string
+	status string
+	added  map[int]string
+}
+
+// stagedImport is an import added by a staged change.
+//
+// Fields:
+//   - line: 1-based line number in the staged file
+//   - path: Imported package or module
+type stagedImport struct {
+	line int
+	path string
+}
@@ -53,6 +53,7 @@ type EntryParams struct {
 //   - Lesson: Lesson flag for learnings
 //   - Application: Application flag for learnings
 //   - Share: Also publish to the ctx Hub
+//   - Redact: Replace possible secrets instead of refusing the entry
 type AddConfig struct {
 	Priority    string
 	Section     string
@@ -66,6 +67,7 @@ type AddConfig struct {
 	Lesson      string
 	Application string
 	Share       bool
+	Redact      bool
 }
 
 // EntryOpts holds optional fields for entry creation via MCP.
@@ -13,11 +13,14 @@ package entity
 //   - Files: All loaded context files with their metadata
 //   - TotalTokens: Sum of estimated tokens across all files
 //   - TotalSize: Sum of file sizes in bytes
+//   - Layers: Inherited context sources merged into Files, in
+//     .ctxrc order (empty without an inherit list)
 type Context struct {
-	Dir         string
-	Files       []FileInfo
-	TotalTokens int
-	TotalSize   int64
+	Dir         string         `json:"dir"`
+	Files       []FileInfo     `json:"files"`
+	TotalTokens int            `json:"total_tokens"`
+	TotalSize   int64          `json:"total_size"`
+	Layers      []ContextLayer `json:"layers,omitempty"`
 }
 
 // File returns the FileInfo with the given name, or nil if not found.
@@ -60,7 +60,9 @@
 //   - **`event.go`**:      [EventQueryOpts] and event log
 //     types used by `ctx hook event`.
 //   - **`notify.go`**:     [NotifyPayload], [TemplateRef],
-//     the webhook delivery payloads.
+//     the webhook delivery payloads, [NotifyChannel], and
+//     the outbox types [OutboxItem], [DeliveryRecord] and
+//     [FlushResult].
 //   - **`task.go`**:       task-related domain types
 //     (priority, completion state, snapshot shapes).
 //   - **`mcp_session.go`**, **`mcp_deps.go`**,
@@ -0,0 +1,25 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// DriftCheck describes one drift check for `ctx drift --list-checks`.
+//
+// Fields:
+//   - Name: Check name, also the key under drift.checks in .ctxrc
+//   - Source: "builtin" or "external"
+//   - Enabled: False when .ctxrc disables the check
+//   - Severity: Severity override from .ctxrc; empty when the
+//     check reports at its own levels
+//   - Path: Executable path for external checks; empty for
+//     built-in ones
+type DriftCheck struct {
+	Name     string `json:"name"`
+	Source   string `json:"source"`
+	Enabled  bool   `json:"enabled"`
+	Severity string `json:"severity,omitempty"`
+	Path     string `json:"path,omitempty"`
+}
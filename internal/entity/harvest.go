@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// HarvestCandidate is a decision, learning or task proposed by
+// ctx journal harvest from a session transcript.
+//
+// Fields:
+//   - Params: Entry to write through the add path; provenance
+//     is filled in by the caller
+//   - Turn: 1-based index of the message the candidate came from
+//   - Role: Role of that message (user or assistant)
+//   - Keywords: Classifier keywords that matched; empty for
+//     explicit markers
+//   - Explicit: True when the session marked the entry with a
+//     <context-update> or <decision>-style tag
+//   - Hash: Content hash used to deduplicate candidates
+type HarvestCandidate struct {
+	Params   EntryParams
+	Turn     int
+	Role     string
+	Keywords []string
+	Explicit bool
+	Hash     string
+}
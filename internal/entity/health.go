@@ -0,0 +1,93 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "time"
+
+// HealthComponents holds the per-component health scores, each
+// 0-100.
+//
+// Fields:
+//   - Drift: Drift violations and warnings
+//   - Staleness: Share of context files not flagged stale
+//   - Velocity: Tasks completed in the last week
+//   - Index: Share of entries covered by index tables
+//   - Tokens: Context size against the token budget
+type HealthComponents struct {
+	Drift     int `json:"drift"`
+	Staleness int `json:"staleness"`
+	Velocity  int `json:"velocity"`
+	Index     int `json:"index"`
+	Tokens    int `json:"tokens"`
+}
+
+// HealthSignals holds the raw measurements behind a health score.
+//
+// Fields:
+//   - Violations: Drift violations
+//   - Warnings: Drift warnings other than stale files
+//   - StaleFiles: Context files flagged stale
+//   - Files: Non-empty context files
+//   - TasksCompleted: Checked tasks in TASKS.md
+//   - TasksPending: Unchecked tasks in TASKS.md
+//   - CompletedWeek: Tasks completed in the last week
+//   - Entries: Decision and learning entries
+//   - Unindexed: Entries missing from their index table
+//   - Tokens: Estimated context tokens
+//   - Budget: Configured token budget
+type HealthSignals struct {
+	Violations     int `json:"violations"`
+	Warnings       int `json:"warnings"`
+	StaleFiles     int `json:"stale_files"`
+	Files          int `json:"files"`
+	TasksCompleted int `json:"tasks_completed"`
+	TasksPending   int `json:"tasks_pending"`
+	CompletedWeek  int `json:"completed_week"`
+	Entries        int `json:"entries"`
+	Unindexed      int `json:"unindexed"`
+	Tokens         int `json:"tokens"`
+	Budget         int `json:"budget"`
+}
+
+// HealthRecord is one computed health score, as stored in the
+// history file.
+//
+// Fields:
+//   - Time: When the score was computed
+//   - Score: Weighted overall score, 0-100
+//   - Components: Per-component scores
+//   - Signals: Raw measurements
+type HealthRecord struct {
+	Time       time.Time        `json:"time"`
+	Score      int              `json:"score"`
+	Components HealthComponents `json:"components"`
+	Signals    HealthSignals    `json:"signals"`
+}
+
+// HealthWeek is one week of health history.
+//
+// Fields:
+//   - Start: First day of the week (YYYY-MM-DD, Monday)
+//   - Runs: Number of records in the week
+//   - Last: The week's final record; nil when Runs is 0
+type HealthWeek struct {
+	Start string        `json:"start"`
+	Runs  int           `json:"runs"`
+	Last  *HealthRecord `json:"last"`
+}
+
+// HealthTrend is the JSON form of `ctx status --trend`.
+//
+// Fields:
+//   - Weeks: Number of weeks covered
+//   - Current: The record computed by this run
+//   - History: One entry per week, oldest first
+type HealthTrend struct {
+	Weeks   int          `json:"weeks"`
+	Current HealthRecord `json:"current"`
+	History []HealthWeek `json:"history"`
+}
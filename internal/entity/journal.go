@@ -13,6 +13,7 @@ package entity
 //   - Date: Date string (YYYY-MM-DD)
 //   - Time: Time string (HH:MM, optional)
 //   - Project: Project name
+//   - Branch: Git branch
 //   - SessionID: Claude Code session UUID
 //   - Model: Model ID used in session
 //   - TokensIn: Input tokens consumed
@@ -22,11 +23,13 @@ package entity
 //   - Topics: Topic tags for indexing
 //   - KeyFiles: Files referenced in the session
 //   - Summary: One-paragraph summary
+//   - Redacted: True when the redaction pipeline ran on import
 type JournalFrontmatter struct {
 	Title     string   `yaml:"title"`
 	Date      string   `yaml:"date"`
 	Time      string   `yaml:"time,omitempty"`
 	Project   string   `yaml:"project,omitempty"`
+	Branch    string   `yaml:"branch,omitempty"`
 	SessionID string   `yaml:"session_id,omitempty"`
 	Model     string   `yaml:"model,omitempty"`
 	TokensIn  int      `yaml:"tokens_in,omitempty"`
@@ -36,6 +39,7 @@ type JournalFrontmatter struct {
 	Topics    []string `yaml:"topics"`
 	KeyFiles  []string `yaml:"key_files"`
 	Summary   string   `yaml:"summary,omitempty"`
+	Redacted  bool     `yaml:"redacted,omitempty"`
 }
 
 // JournalEntry represents a parsed journal file.
@@ -58,6 +62,8 @@ type JournalFrontmatter struct {
 //   - Outcome: Session outcome
 //   - KeyFiles: Referenced file paths
 //   - Summary: One-paragraph summary
+//   - Redacted: True when the entry (or, for a continuation
+//     part, its first part) was redacted on import
 type JournalEntry struct {
 	Filename   string
 	Title      string
@@ -76,6 +82,7 @@ type JournalEntry struct {
 	Outcome    string
 	KeyFiles   []string
 	Summary    string
+	Redacted   bool
 }
 
 // GroupedIndex holds entries aggregated by a string key, sorted by count desc
@@ -142,3 +149,38 @@ type TypeData struct {
 	Name    string
 	Entries []JournalEntry
 }
+
+// JournalHit is one ranked result of ctx journal search.
+//
+// Fields:
+//   - Filename: Journal file name
+//   - Title: Entry title
+//   - Date: Session date (YYYY-MM-DD)
+//   - Time: Session start time
+//   - Tool: AI tool that recorded the session
+//   - Turn: Turn header without the heading marker, e.g.
+//     "2. Assistant (10:00:00)"
+//   - Score: BM25 score (0 for filter-only queries)
+//   - Lines: The matching line with its context
+type JournalHit struct {
+	Filename string           `json:"filename"`
+	Title    string           `json:"title"`
+	Date     string           `json:"date"`
+	Time     string           `json:"time,omitempty"`
+	Tool     string           `json:"tool,omitempty"`
+	Turn     string           `json:"turn"`
+	Score    float64          `json:"score"`
+	Lines    []JournalHitLine `json:"lines"`
+}
+
+// JournalHitLine is one line of a search result snippet.
+//
+// Fields:
+//   - Num: 1-based line number in the journal file
+//   - Text: Line content
+//   - Match: True for the line that matched the query
+type JournalHitLine struct {
+	Num   int    `json:"num"`
+	Text  string `json:"text"`
+	Match bool   `json:"match,omitempty"`
+}
@@ -0,0 +1,27 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// ContextLayer describes one inherited context source merged
+// into a [Context].
+//
+// Fields:
+//   - Label: Provenance label shown in merged files
+//   - Dir: Context directory for path layers; empty for git layers
+//   - Root: Project root used to resolve path references in the
+//     layer's content; empty for git layers
+//   - Ref: Git ref for git layers; empty for path layers
+//   - Files: Names of context files the layer contributed to
+//   - Error: Why the layer could not be loaded; empty on success
+type ContextLayer struct {
+	Label string   `json:"label"`
+	Dir   string   `json:"dir"`
+	Root  string   `json:"root"`
+	Ref   string   `json:"ref,omitempty"`
+	Files []string `json:"files"`
+	Error string   `json:"error,omitempty"`
+}
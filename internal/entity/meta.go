@@ -15,18 +15,21 @@ import "time"
 //   - Path: Full path to the file
 //   - Size: File size in bytes
 //   - ModTime: Last modification time
-//   - Content: Raw file content
+//   - Content: Raw file content (base64 in JSON)
 //   - IsEmpty: True if the file has no meaningful content
 //     (only headers/whitespace)
 //   - Tokens: Estimated token count for the content
 //   - Summary: Brief description generated from the content
+//   - Layer: Label of the inherited layer the whole file came
+//     from; empty for local files (including merged ones)
 type FileInfo struct {
-	Name    string
-	Path    string
-	Size    int64
-	ModTime time.Time
-	Content []byte
-	IsEmpty bool
-	Tokens  int
-	Summary string
+	Name    string    `json:"name"`
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	Content []byte    `json:"content"`
+	IsEmpty bool      `json:"is_empty"`
+	Tokens  int       `json:"tokens"`
+	Summary string    `json:"summary"`
+	Layer   string    `json:"layer,omitempty"`
 }
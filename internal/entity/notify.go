@@ -83,3 +83,76 @@ func NewNotifyPayload(
 		Project:   projectName,
 	}
 }
+
+// NotifyChannel is one named notification channel. Its URL,
+// token and password live in .context/.notify-channels.enc,
+// never in .ctxrc.
+//
+// Fields:
+//   - Name: Channel name, the key of its secrets
+//   - Type: Provider (webhook, slack, discord, matrix, ntfy, email)
+//   - Events: Event filter; empty means no events (opt-in)
+//   - Room: Matrix room ID
+//   - From: Email sender address
+//   - To: Email recipient addresses
+type NotifyChannel struct {
+	Name   string   `yaml:"name"`
+	Type   string   `yaml:"type"`
+	Events []string `yaml:"events"`
+	Room   string   `yaml:"room"`
+	From   string   `yaml:"from"`
+	To     []string `yaml:"to"`
+}
+
+// OutboxItem is one queued notification delivery, stored as a
+// file in the notify outbox.
+//
+// Fields:
+//   - ID: Delivery ID, also the file name
+//   - Channel: Channel name; empty for the .notify.enc webhook
+//   - Payload: The notification to deliver
+//   - Attempts: Attempts made so far
+//   - NextAttempt: Earliest time of the next retry
+//   - LastError: Error of the most recent attempt
+type OutboxItem struct {
+	ID          string        `json:"id"`
+	Channel     string        `json:"channel,omitempty"`
+	Payload     NotifyPayload `json:"payload"`
+	Attempts    int           `json:"attempts"`
+	NextAttempt time.Time     `json:"next_attempt"`
+	LastError   string        `json:"last_error,omitempty"`
+}
+
+// DeliveryRecord is one line of the notification delivery log.
+//
+// Fields:
+//   - Time: When the attempt finished
+//   - ID: Delivery ID
+//   - Channel: Channel name; empty for the .notify.enc webhook
+//   - Event: Notification event
+//   - Status: sent, queued, failed or dead
+//   - Attempt: Attempt number, starting at 1
+//   - Error: Failure reason, empty on success
+type DeliveryRecord struct {
+	Time    time.Time `json:"time"`
+	ID      string    `json:"id"`
+	Channel string    `json:"channel,omitempty"`
+	Event   string    `json:"event"`
+	Status  string    `json:"status"`
+	Attempt int       `json:"attempt"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// FlushResult counts the outcome of one outbox flush.
+//
+// Fields:
+//   - Sent: Deliveries that succeeded
+//   - Failed: Deliveries that failed and stay queued
+//   - Dead: Deliveries dead-lettered by this flush
+//   - Pending: Deliveries left in the outbox
+type FlushResult struct {
+	Sent    int
+	Failed  int
+	Dead    int
+	Pending int
+}
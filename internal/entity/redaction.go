@@ -0,0 +1,21 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// Redaction reports one placeholder written by the journal
+// redaction pipeline. The original value is never recorded.
+//
+// Fields:
+//   - Placeholder: Text that replaced the value, e.g. <EMAIL_1>
+//   - Kind: Redaction kind (secret, email, home, host, or the
+//     project pattern name)
+//   - Count: Number of occurrences replaced in the session
+type Redaction struct {
+	Placeholder string `json:"placeholder" yaml:"placeholder"`
+	Kind        string `json:"kind" yaml:"kind"`
+	Count       int    `json:"count" yaml:"count"`
+}
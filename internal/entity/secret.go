@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// SecretFinding is one possible secret found by the content
+// scanner.
+//
+// Fields:
+//   - Rule: Name of the rule that matched
+//   - Line: 1-based line of the secret's first character
+//   - Start: Byte offset where the secret starts
+//   - End: Byte offset just past the secret
+//   - Preview: The secret with its middle masked, safe to print
+type SecretFinding struct {
+	Rule    string `json:"rule"`
+	Line    int    `json:"line"`
+	Start   int    `json:"start"`
+	End     int    `json:"end"`
+	Preview string `json:"preview"`
+}
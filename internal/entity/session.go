@@ -47,6 +47,10 @@ import "time"
 //   - FirstUserMsg: Preview text of first user message
 //   - Model: Primary model used in the session
 //   - Entrypoint: How CC was launched (cli, ide, sdk-ts, sdk-py)
+//
+// Redaction:
+//   - Redacted: True once the redaction pipeline has run
+//   - Redactions: Placeholders written by the pipeline
 type Session struct {
 	ID   string `json:"id"`
 	Slug string `json:"slug,omitempty"`
@@ -73,6 +77,9 @@ type Session struct {
 	FirstUserMsg string `json:"first_user_msg,omitempty"`
 	Model        string `json:"model,omitempty"`
 	Entrypoint   string `json:"entrypoint,omitempty"`
+
+	Redacted   bool        `json:"redacted,omitempty"`
+	Redactions []Redaction `json:"redactions,omitempty"`
 }
 
 // UserMessages returns only user messages from the session.
@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import "time"
+
+// Snapshot is the manifest of one captured .context/ tree.
+//
+// Fields:
+//   - ID: Timestamp-derived identifier (e.g. 20261019-153000)
+//   - Name: Optional user-supplied label
+//   - Created: Capture time
+//   - Files: Captured files in path order
+type Snapshot struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name,omitempty"`
+	Created time.Time      `json:"created"`
+	Files   []SnapshotFile `json:"files"`
+}
+
+// SnapshotFile is one file recorded in a [Snapshot].
+//
+// Fields:
+//   - Path: Slash-separated path relative to .context/
+//   - Hash: Hex SHA-256 of the content; names the stored object
+//   - Size: Content length in bytes
+type SnapshotFile struct {
+	Path string `json:"path"`
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// SnapshotChange is one file difference between two trees.
+//
+// Fields:
+//   - Path: Slash-separated path relative to .context/
+//   - Status: Added, removed, or modified marker
+//   - Old: File on the old side; nil when added
+//   - New: File on the new side; nil when removed
+type SnapshotChange struct {
+	Path   string
+	Status string
+	Old    *SnapshotFile
+	New    *SnapshotFile
+}
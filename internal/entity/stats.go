@@ -0,0 +1,62 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+// StatsGroup aggregates the sessions that share one value of
+// the grouping dimension in ctx journal stats.
+//
+// Fields:
+//   - Key: Group value (date, ISO week, branch, model or tool)
+//   - Sessions: Number of sessions
+//   - Turns: Total conversation turns
+//   - TokensIn: Total input tokens
+//   - TokensOut: Total output tokens
+//   - Cost: Estimated spend in USD for priced sessions
+//   - Unpriced: Sessions with tokens but no price-table entry
+//   - Errors: Sessions with at least one tool error
+//   - ErrorRate: Errors divided by Sessions
+//   - AvgTurns: Mean turns per session
+//   - AvgMinutes: Mean session duration in minutes
+type StatsGroup struct {
+	Key        string  `json:"key"`
+	Sessions   int     `json:"sessions"`
+	Turns      int     `json:"turns"`
+	TokensIn   int     `json:"tokens_in"`
+	TokensOut  int     `json:"tokens_out"`
+	Cost       float64 `json:"cost_usd"`
+	Unpriced   int     `json:"unpriced_sessions,omitempty"`
+	Errors     int     `json:"sessions_with_errors"`
+	ErrorRate  float64 `json:"error_rate"`
+	AvgTurns   float64 `json:"avg_turns"`
+	AvgMinutes float64 `json:"avg_minutes"`
+}
+
+// ToolCount is one row of the most-used tools list.
+//
+// Fields:
+//   - Name: Tool name as recorded (e.g. "Read", "Bash")
+//   - Count: Number of invocations
+type ToolCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// JournalStats is the ctx journal stats report.
+//
+// Fields:
+//   - By: Grouping dimension (day, week, branch, model, tool)
+//   - Groups: One row per group value
+//   - Total: All sessions combined
+//   - Tools: Most-used tools, busiest first
+//   - Unpriced: Models that had tokens but no price entry
+type JournalStats struct {
+	By       string       `json:"by"`
+	Groups   []StatsGroup `json:"groups"`
+	Total    StatsGroup   `json:"total"`
+	Tools    []ToolCount  `json:"top_tools"`
+	Unpriced []string     `json:"unpriced_models,omitempty"`
+}
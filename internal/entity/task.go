@@ -53,3 +53,34 @@ func (b *TaskBlock) ParentTaskText() string {
 	}
 	return ""
 }
+
+// TaskNode is one task line in the TASKS.md dependency graph.
+//
+// Fields:
+//   - ID: Name from the #id: tag (empty when the task has none)
+//   - Label: Task text without inline #tags
+//   - Line: Zero-based line index in TASKS.md
+//   - Done: The task is checked
+//   - After: Raw #after: references
+//   - Blocks: Raw #blocks: references
+//   - Deps: Indexes into TaskGraph.Nodes of the tasks this one
+//     waits for, from its own #after: and other tasks' #blocks:
+type TaskNode struct {
+	ID     string
+	Label  string
+	Line   int
+	Done   bool
+	After  []string
+	Blocks []string
+	Deps   []int
+}
+
+// TaskGraph is the dependency graph of every task in TASKS.md.
+//
+// Fields:
+//   - Nodes: One node per task line, in file order
+//   - IDs: Index of the first node carrying each #id:
+type TaskGraph struct {
+	Nodes []TaskNode
+	IDs   map[string]int
+}
@@ -43,6 +43,11 @@
 //     `--commit` are required when
 //     `provenance_required` enables them in
 //     `.ctxrc`.
+//   - **Task dependencies**: a task's `#id:`,
+//     `#after:` and `#blocks:` tags must not repeat
+//     an existing ID, name an unknown one, or close
+//     a cycle ([internal/task/graph.CheckAdded]).
+//     Checked by [Write], since it needs TASKS.md.
 //   - **No secrets**: body is scanned against
 //     [internal/config/token.SecretPatterns]; a
 //     match aborts with a typed error so the user
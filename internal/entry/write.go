@@ -21,6 +21,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/index"
 	"github.com/ActiveMemory/ctx/internal/io"
 	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/task/graph"
 )
 
 // Write formats and writes an entry to the appropriate context file.
@@ -34,7 +35,8 @@ import (
 //
 // Returns:
 //   - error: Non-nil if the type is unknown, the file
-//     doesn't exist, or write fails
+//     doesn't exist, a task's #id:/#after:/#blocks: tags
+//     are inconsistent with TASKS.md, or write fails
 func Write(params entity.EntryParams) error {
 	fType := strings.ToLower(params.Type)
 
@@ -73,6 +75,11 @@ func Write(params entity.EntryParams) error {
 			params.Content, params.Priority,
 			params.SessionID, params.Branch, params.Commit,
 		)
+		if depErr := graph.CheckAdded(
+			string(existing), formatted,
+		); depErr != nil {
+			return depErr
+		}
 	case entry.Learning:
 		formatted = format.Learning(
 			params.Content, params.Context, params.Lesson, params.Application,
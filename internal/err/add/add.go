@@ -102,3 +102,18 @@ func MissingFields(entryType string, missing []string) error {
 		entryType, strings.Join(missing, token.CommaSpace),
 	)
 }
+
+// SecretFound returns an error when entry text looks like it
+// contains a credential.
+//
+// Parameters:
+//   - rule: Name of the secret rule that matched
+//   - preview: Masked preview of the matched value
+//
+// Returns:
+//   - error: Formatted error pointing at the allowlist and --redact
+func SecretFound(rule, preview string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrAddSecretFound), rule, preview,
+	)
+}
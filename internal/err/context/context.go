@@ -262,3 +262,27 @@ func NotDeclared(candidates []string) error {
 		)
 	}
 }
+
+// LayerSelf returns an error for an inherit entry that resolves to
+// the project's own context directory.
+//
+// Parameters:
+//   - label: the layer label from .ctxrc
+//
+// Returns:
+//   - error: "inherit <label>: points at this project's own ..."
+func LayerSelf(label string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrContextLayerSelf), label)
+}
+
+// LayerEmpty returns an error for an inherit entry that yields no
+// context files (missing directory, wrong path, or unknown ref).
+//
+// Parameters:
+//   - label: the layer label from .ctxrc
+//
+// Returns:
+//   - error: "inherit <label>: no context files found"
+func LayerEmpty(label string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrContextLayerEmpty), label)
+}
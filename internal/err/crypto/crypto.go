@@ -207,3 +207,136 @@ func WriteKey(cause error) error {
 		desc.Text(text.DescKeyErrCryptoWriteKey), cause,
 	)
 }
+
+// InvalidPublicKey returns an error for a malformed recipient
+// public key.
+//
+// Parameters:
+//   - key: the rejected key text.
+//
+// Returns:
+//   - error: "invalid public key <key>: ..."
+func InvalidPublicKey(key string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrCryptoInvalidPublicKey), key,
+	)
+}
+
+// MalformedEnvelope returns an error for an envelope whose
+// header cannot be parsed.
+//
+// Returns:
+//   - error: "malformed envelope header"
+func MalformedEnvelope() error {
+	return errors.New(desc.Text(text.DescKeyErrCryptoMalformedEnvelope))
+}
+
+// NotRecipient returns an error when no envelope stanza opens
+// with the caller's identity.
+//
+// Returns:
+//   - error: "not a recipient of this envelope"
+func NotRecipient() error {
+	return errors.New(desc.Text(text.DescKeyErrCryptoNotRecipient))
+}
+
+// NoRecipients returns an error when sealing for an empty
+// recipient list.
+//
+// Returns:
+//   - error: "no recipients to encrypt for"
+func NoRecipients() error {
+	return errors.New(desc.Text(text.DescKeyErrCryptoNoRecipients))
+}
+
+// NoIdentityAt returns an error when a shared scratchpad is
+// read without an identity file.
+//
+// Parameters:
+//   - path: the identity path that was checked.
+//
+// Returns:
+//   - error: "scratchpad is shared with recipients but no
+//     identity at <path>..."
+func NoIdentityAt(path string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrCryptoNoIdentityAt), path,
+	)
+}
+
+// RotationPending returns an error when a previous key
+// rotation did not finish.
+//
+// Parameters:
+//   - journal: path of the rotation journal.
+//
+// Returns:
+//   - error: "a key rotation was interrupted (<journal>)..."
+func RotationPending(journal string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrCryptoRotationPending), journal,
+	)
+}
+
+// NoRotation returns an error when --resume or --rollback is
+// used with no rotation in progress.
+//
+// Returns:
+//   - error: "no key rotation in progress"
+func NoRotation() error {
+	return errors.New(desc.Text(text.DescKeyErrCryptoNoRotation))
+}
+
+// RotationCommitted returns an error when rolling back a
+// rotation whose key swap has already begun.
+//
+// Returns:
+//   - error: "rotation already committed; use --resume"
+func RotationCommitted() error {
+	return errors.New(
+		desc.Text(text.DescKeyErrCryptoRotationCommitted),
+	)
+}
+
+// SharedKey returns an error when rotating a key that other
+// projects may use without naming them.
+//
+// Parameters:
+//   - keyPath: path of the shared key.
+//
+// Returns:
+//   - error: "<keyPath> is shared by every project without ..."
+func SharedKey(keyPath string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrCryptoSharedKey), keyPath,
+	)
+}
+
+// NoProject returns an error when a --project path is not a
+// directory.
+//
+// Parameters:
+//   - path: the path given.
+//
+// Returns:
+//   - error: "<path>: not a project or context directory"
+func NoProject(path string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrCryptoNoProject), path,
+	)
+}
+
+// Undecryptable returns an error when a file cannot be decrypted
+// with either side of a rotation.
+//
+// Parameters:
+//   - path: the file that failed.
+//   - cause: the decryption error.
+//
+// Returns:
+//   - error: "<path>: not encrypted with the current key: <cause>"
+func Undecryptable(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrCryptoUndecryptable), path, cause,
+	)
+}
@@ -8,6 +8,7 @@ package drift
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
@@ -22,3 +23,64 @@ func Violations() error {
 		desc.Text(text.DescKeyErrValidateDriftViolations),
 	)
 }
+
+// InvalidRules wraps a failure to parse the ctx-rules block in
+// CONSTITUTION.md.
+//
+// Parameters:
+//   - cause: YAML or regular expression error
+//
+// Returns:
+//   - error: "CONSTITUTION.md ctx-rules block: <cause>"
+func InvalidRules(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrValidateDriftInvalidRules), cause,
+	)
+}
+
+// HookExists returns an error when a pre-commit hook not
+// installed by ctx is already present.
+//
+// Parameters:
+//   - path: Path of the existing hook
+//
+// Returns:
+//   - error: "pre-commit hook already exists at <path> ..."
+func HookExists(path string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrDriftHookExists), path)
+}
+
+// HookWrite wraps a pre-commit hook write failure.
+//
+// Parameters:
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "write pre-commit hook: <cause>"
+func HookWrite(cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrDriftHookWrite), cause)
+}
+
+// GitDir wraps a failure to locate the git directory.
+//
+// Parameters:
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "git rev-parse --git-dir: <cause>"
+func GitDir(cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrDriftGitDir), cause)
+}
+
+// UnknownAction returns an error for an unrecognized hook action.
+//
+// Parameters:
+//   - action: the action the user passed
+//
+// Returns:
+//   - error: "unknown action <action> (expected enable or disable)"
+func UnknownAction(action string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrDriftUnknownAction), action,
+	)
+}
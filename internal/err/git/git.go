@@ -35,3 +35,16 @@ func NotInRepo(cause error) error {
 		desc.Text(text.DescKeyErrGitNotInGitRepo), cause,
 	)
 }
+
+// Command reports a failed git command with git's own output,
+// keeping the exit status as the cause.
+//
+// Parameters:
+//   - output: trimmed git output explaining the failure
+//   - cause: the underlying exec error
+//
+// Returns:
+//   - error: "<output> (<cause>)"
+func Command(output string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrGitCommand), output, cause)
+}
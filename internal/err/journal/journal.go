@@ -158,6 +158,46 @@ func UnknownStage(stage, valid string) error {
 	)
 }
 
+// SearchSince returns an error for an unparseable since: filter in
+// a journal search query.
+//
+// Parameters:
+//   - value: the rejected since value
+//
+// Returns:
+//   - error: "invalid since: <value> (use YYYY-MM-DD or Nd, ...)"
+func SearchSince(value string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrJournalSearchSince), value,
+	)
+}
+
+// StatsBy returns an error for an unknown stats grouping.
+//
+// Parameters:
+//   - by: the rejected --by value
+//   - valid: comma-separated list of valid groupings
+//
+// Returns:
+//   - error: "unknown --by <by>; valid: <valid>"
+func StatsBy(by, valid string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrJournalStatsBy), by, valid)
+}
+
+// StatsFormat returns an error for an unknown stats output format.
+//
+// Parameters:
+//   - format: the rejected --format value
+//   - valid: comma-separated list of valid formats
+//
+// Returns:
+//   - error: "unknown --format <format>; valid: <valid>"
+func StatsFormat(format, valid string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrJournalStatsFormat), format, valid,
+	)
+}
+
 // StageNotSet returns an error when a journal stage has not been set.
 //
 // Parameters:
@@ -73,3 +73,112 @@ func SendNotification(cause error) error {
 		desc.Text(text.DescKeyErrNotifySendNotification), cause,
 	)
 }
+
+// UnknownChannel returns an error for a channel name missing
+// from .ctxrc.
+//
+// Parameters:
+//   - name: the channel name given.
+//
+// Returns:
+//   - error: "unknown notification channel <name>: ..."
+func UnknownChannel(name string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyUnknownChannel), name,
+	)
+}
+
+// UnknownType returns an error for a channel whose provider
+// type is not supported.
+//
+// Parameters:
+//   - name: the channel name.
+//   - kind: the configured type.
+//
+// Returns:
+//   - error: "channel <name> has unknown type <kind>"
+func UnknownType(name, kind string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyUnknownType), name, kind,
+	)
+}
+
+// NoChannelSecret returns an error for a channel whose secrets
+// were never entered.
+//
+// Parameters:
+//   - name: the channel name.
+//
+// Returns:
+//   - error: "channel <name> is not set up: run ..."
+func NoChannelSecret(name string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyNoChannelSecret), name, name,
+	)
+}
+
+// FieldEmpty returns an error for a blank required secret field.
+//
+// Parameters:
+//   - field: the field name.
+//
+// Returns:
+//   - error: "<field> cannot be empty"
+func FieldEmpty(field string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyFieldEmpty), field,
+	)
+}
+
+// DeliveryStatus returns an error for a non-2xx provider response.
+//
+// Parameters:
+//   - code: the HTTP status code.
+//
+// Returns:
+//   - error: "delivery failed: HTTP <code>"
+func DeliveryStatus(code int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyDeliveryStatus), code,
+	)
+}
+
+// EmailAddresses returns an error for an email channel without
+// a sender or recipients.
+//
+// Parameters:
+//   - name: the channel name.
+//
+// Returns:
+//   - error: "channel <name> needs from and to addresses"
+func EmailAddresses(name string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyEmailAddresses), name,
+	)
+}
+
+// LoadChannels wraps a failure to read channel secrets.
+//
+// Parameters:
+//   - cause: the underlying error.
+//
+// Returns:
+//   - error: "load channel secrets: <cause>"
+func LoadChannels(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifyLoadChannels), cause,
+	)
+}
+
+// SaveChannel wraps a failure to write channel secrets.
+//
+// Parameters:
+//   - cause: the underlying error.
+//
+// Returns:
+//   - error: "save channel secrets: <cause>"
+func SaveChannel(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrNotifySaveChannel), cause,
+	)
+}
@@ -160,3 +160,113 @@ func FileTooLarge(size, max int) error {
 		desc.Text(text.DescKeyErrPadFileTooLarge), size, max,
 	)
 }
+
+// RecipientsNotEncrypted returns an error when recipients are
+// changed on an unencrypted scratchpad.
+//
+// Returns:
+//   - error: "recipients need an encrypted scratchpad..."
+func RecipientsNotEncrypted() error {
+	return errors.New(
+		desc.Text(text.DescKeyErrPadRecipientsNotEncrypted),
+	)
+}
+
+// RecipientExists returns an error when a public key is
+// already a recipient.
+//
+// Parameters:
+//   - key: the duplicate public key.
+//
+// Returns:
+//   - error: "<key> is already a recipient"
+func RecipientExists(key string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadRecipientExists), key,
+	)
+}
+
+// RecipientNotFound returns an error when no recipient matches
+// a key or name.
+//
+// Parameters:
+//   - query: the key or name given.
+//
+// Returns:
+//   - error: "no recipient matches <query>"
+func RecipientNotFound(query string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadRecipientNotFound), query,
+	)
+}
+
+// RecipientSelf returns an error when removing the current user's
+// own key would leave the pad readable only by others.
+//
+// Parameters:
+//   - label: the matched recipient's label.
+//
+// Returns:
+//   - error: "<label> is your own key; ..."
+func RecipientSelf(label string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadRecipientSelf), label,
+	)
+}
+
+// RecipientAmbiguous returns an error when a name matches more
+// than one recipient.
+//
+// Parameters:
+//   - query: the name given.
+//   - n: number of matching recipients.
+//
+// Returns:
+//   - error: "<query> matches N recipients; use the public key"
+func RecipientAmbiguous(query string, n int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadRecipientAmbiguous), query, n,
+	)
+}
+
+// InvalidExpiry returns an error for an unparseable --expires
+// value.
+//
+// Parameters:
+//   - value: the value given.
+//
+// Returns:
+//   - error: "invalid expiry <value>: use YYYY-MM-DD or ..."
+func InvalidExpiry(value string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadInvalidExpiry), value,
+	)
+}
+
+// InvalidCategory returns an error for a category containing
+// characters that cannot be stored in entry metadata.
+//
+// Parameters:
+//   - value: the category given.
+//
+// Returns:
+//   - error: "invalid category <value>: use letters, ..."
+func InvalidCategory(value string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadInvalidCategory), value,
+	)
+}
+
+// MergeConflicts returns the error the git merge driver exits
+// with when entries were changed on both sides.
+//
+// Parameters:
+//   - n: the number of conflicting entries.
+//
+// Returns:
+//   - error: "scratchpad entries changed on both sides: <n>; ..."
+func MergeConflicts(n int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrPadMergeConflicts), n,
+	)
+}
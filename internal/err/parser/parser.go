@@ -145,3 +145,38 @@ func ParseFile(path string, cause error) error {
 		desc.Text(text.DescKeyErrValidateParseFile), path, cause,
 	)
 }
+
+// SQLiteNotFound returns an error when the sqlite3 tool needed
+// to read a SQLite session store is not installed.
+//
+// Returns:
+//   - error: "sqlite3 not found in PATH ..."
+func SQLiteNotFound() error {
+	return errors.New(desc.Text(text.DescKeyErrParserSQLiteNotFound))
+}
+
+// Query wraps a failed SQLite query.
+//
+// Parameters:
+//   - cause: the underlying error from sqlite3
+//
+// Returns:
+//   - error: "query: <cause>"
+func Query(cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrParserQuery), cause)
+}
+
+// SessionNotInFile returns an error when a session found through
+// the index is missing from its source file on reparse.
+//
+// Parameters:
+//   - id: the session ID
+//   - path: the source file
+//
+// Returns:
+//   - error: "session <id> no longer in <path>"
+func SessionNotInFile(id, path string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrParserSessionNotInFile), id, path,
+	)
+}
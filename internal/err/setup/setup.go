@@ -66,3 +66,17 @@ func SyncSteering(cause error) error {
 		desc.Text(text.DescKeyErrSetupSyncSteering), cause,
 	)
 }
+
+// GitConfig wraps a failure to write a git config key in setup.
+//
+// Parameters:
+//   - key: the git config key
+//   - cause: the underlying git error
+//
+// Returns:
+//   - error: "git config <key>: <cause>"
+func GitConfig(key string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSetupGitConfig), key, cause,
+	)
+}
@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot defines the typed error constructors
+// for the context snapshot store.
+//
+// # Domain
+//
+// Errors fall into two categories:
+//
+//   - **Store IO**: the store could not be read,
+//     written, or parsed, or an object no longer
+//     matches its hash. Constructors: [Read],
+//     [Write], [Parse], [Corrupt].
+//   - **Lookup**: no snapshot or file matches the
+//     request. Constructors: [NotFound], [None],
+//     [FileNotFound].
+//
+// # Wrapping Strategy
+//
+// IO constructors wrap their cause with fmt.Errorf
+// %w so callers can inspect the underlying error.
+// All user-facing text is resolved through
+// [internal/assets/read/desc].
+//
+// # Concurrency
+//
+// Pure constructors. Concurrent callers never race.
+package snapshot
@@ -0,0 +1,96 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Read wraps a failure to read the snapshot store.
+//
+// Parameters:
+//   - cause: the underlying read error.
+//
+// Returns:
+//   - error: "read snapshot store: <cause>"
+func Read(cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSnapshotRead), cause)
+}
+
+// Write wraps a failure to write the snapshot store.
+//
+// Parameters:
+//   - cause: the underlying write error.
+//
+// Returns:
+//   - error: "write snapshot store: <cause>"
+func Write(cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSnapshotWrite), cause)
+}
+
+// Parse wraps a failure to parse a snapshot manifest.
+//
+// Parameters:
+//   - id: the snapshot ID.
+//   - cause: the underlying parse error.
+//
+// Returns:
+//   - error: "parse snapshot <id>: <cause>"
+func Parse(id string, cause error) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSnapshotParse), id, cause)
+}
+
+// Corrupt returns an error when a stored object no longer
+// matches its hash.
+//
+// Parameters:
+//   - hash: the expected object hash.
+//
+// Returns:
+//   - error: "snapshot object <hash> does not match its hash"
+func Corrupt(hash string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSnapshotCorrupt), hash)
+}
+
+// NotFound returns an error when no snapshot matches a
+// reference.
+//
+// Parameters:
+//   - ref: the snapshot ID, name, or date that was requested.
+//
+// Returns:
+//   - error: "no snapshot matches <ref>"
+func NotFound(ref string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrSnapshotNotFound), ref)
+}
+
+// None returns an error when the store has no snapshots.
+//
+// Returns:
+//   - error: "no snapshots yet. Run 'ctx snapshot create' first"
+func None() error {
+	return errors.New(desc.Text(text.DescKeyErrSnapshotNone))
+}
+
+// FileNotFound returns an error when a snapshot does not
+// contain the requested file.
+//
+// Parameters:
+//   - id: the snapshot ID.
+//   - path: the requested file path.
+//
+// Returns:
+//   - error: "snapshot <id> has no file <path>"
+func FileNotFound(id, path string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrSnapshotFileNotFound), id, path,
+	)
+}
@@ -11,7 +11,7 @@
 //
 // # Domain
 //
-// Errors fall into three categories:
+// Errors fall into four categories:
 //
 //   - **File IO**: TASKS.md does not exist, or
 //     reading/writing it failed. Constructors:
@@ -24,6 +24,11 @@
 //     [NoMatch].
 //   - **Archive**: there are no completed tasks
 //     to archive. Constructor: [NoneCompleted].
+//   - **Dependencies**: #id:, #after: and #blocks:
+//     metadata is inconsistent, or ctx task graph
+//     got an unknown format. Constructors:
+//     [DuplicateID], [UnknownDep], [Cycle],
+//     [GraphFormat], [GraphProblems].
 //
 // # Wrapping Strategy
 //
@@ -0,0 +1,75 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// DuplicateID returns an error when two tasks carry the same #id:.
+//
+// Parameters:
+//   - id: the repeated task ID
+//
+// Returns:
+//   - error: "duplicate task id <id>"
+func DuplicateID(id string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrTaskDuplicateID), id)
+}
+
+// UnknownDep returns an error when a #after: or #blocks: tag names
+// a task ID that does not exist.
+//
+// Parameters:
+//   - name: the referencing task (its ID or text)
+//   - ref: the unknown task ID
+//
+// Returns:
+//   - error: "<name>: unknown task id <ref>"
+func UnknownDep(name, ref string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrTaskUnknownDep), name, ref)
+}
+
+// Cycle returns an error for a dependency cycle between tasks.
+//
+// Parameters:
+//   - path: the tasks on the cycle, already joined for display
+//
+// Returns:
+//   - error: "dependency cycle: <path>"
+func Cycle(path string) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrTaskCycle), path)
+}
+
+// GraphFormat returns an error for an unsupported graph format.
+//
+// Parameters:
+//   - format: the rejected value
+//   - valid: comma-separated list of accepted formats
+//
+// Returns:
+//   - error: "unknown graph format <format> (use <valid>)"
+func GraphFormat(format, valid string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTaskGraphFormat), format, valid,
+	)
+}
+
+// GraphProblems returns the summary error of ctx task graph when
+// the dependency metadata has problems.
+//
+// Parameters:
+//   - count: number of problems reported
+//
+// Returns:
+//   - error: "<count> task dependency problem(s)"
+func GraphProblems(count int) error {
+	return fmt.Errorf(desc.Text(text.DescKeyErrTaskGraphProblems), count)
+}
@@ -11,12 +11,12 @@
 //
 // # Domain
 //
-// Errors fall into four categories:
+// Errors fall into these categories:
 //
-//   - **Git operations**: git rev-parse or git
-//     log failed, or a commit ref could not be
-//     resolved. Constructors: [GitDir], [GitLog],
-//     [ResolveCommit].
+//   - **Git operations**: git rev-parse, git log
+//     or git blame failed, or a commit ref could not
+//     be resolved. Constructors: [Blame], [GitDir],
+//     [GitLog], [ResolveCommit].
 //   - **Hook management**: a non-ctx hook already
 //     exists, or writing the hook script failed.
 //     Constructors: [HookExists], [HookWrite].
@@ -24,6 +24,15 @@
 //     trace history or override file failed.
 //     Constructors: [WriteHistory],
 //     [WriteOverride].
+//   - **Git notes**: writing, pushing, fetching or
+//     merging refs/notes/ctx failed. Constructors:
+//     [NoteWrite], [NotesPush], [NotesFetch],
+//     [NotesMerge].
+//   - **External titles**: ctx trace sync got a
+//     non-2xx or unreadable API response, or could
+//     not write the cache. Constructors:
+//     [TitleStatus], [TitleDecode], [TitleField],
+//     [TitlesWrite].
 //   - **Validation**: the --note flag is missing,
 //     or an unknown action was provided.
 //     Constructors: [NoteRequired],
@@ -40,6 +40,20 @@ func GitLog(cause error) error {
 	)
 }
 
+// Blame wraps a git blame failure.
+//
+// Parameters:
+//   - path: file that was blamed
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "git blame <path>: <cause>"
+func Blame(path string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceBlame), path, cause,
+	)
+}
+
 // HookExists returns an error when a non-ctx hook already exists.
 //
 // Parameters:
@@ -128,3 +142,111 @@ func WriteOverride(cause error) error {
 		desc.Text(text.DescKeyErrTraceWriteOverride), cause,
 	)
 }
+
+// NoteWrite wraps a failure to write a git note.
+//
+// Parameters:
+//   - commit: abbreviated hash of the annotated commit
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "write git note for <commit>: <cause>"
+func NoteWrite(commit string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceNoteWrite), commit, cause,
+	)
+}
+
+// NotesPush wraps a rejected or failed notes push.
+//
+// Parameters:
+//   - remote: remote name or URL
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "push refs/notes/ctx to <remote>: <cause>"
+func NotesPush(remote string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceNotesPush), remote, cause,
+	)
+}
+
+// NotesFetch wraps a failed notes fetch.
+//
+// Parameters:
+//   - remote: remote name or URL
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "fetch refs/notes/ctx from <remote>: <cause>"
+func NotesFetch(remote string, cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceNotesFetch), remote, cause,
+	)
+}
+
+// NotesMerge wraps a failed merge of fetched notes.
+//
+// Parameters:
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "merge fetched notes: <cause>"
+func NotesMerge(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceNotesMerge), cause,
+	)
+}
+
+// TitleDecode wraps a title API response that is not JSON.
+//
+// Parameters:
+//   - cause: the underlying decode error
+//
+// Returns:
+//   - error: "decode title response: <cause>"
+func TitleDecode(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceTitleDecode), cause,
+	)
+}
+
+// TitleField returns an error when a title API response lacks
+// the configured title field.
+//
+// Parameters:
+//   - field: dotted path of the title field
+//
+// Returns:
+//   - error: "response has no string field <field>"
+func TitleField(field string) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceTitleField), field,
+	)
+}
+
+// TitleStatus returns an error for a non-2xx title API response.
+//
+// Parameters:
+//   - code: the HTTP status code
+//
+// Returns:
+//   - error: "title request failed: HTTP <code>"
+func TitleStatus(code int) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceTitleStatus), code,
+	)
+}
+
+// TitlesWrite wraps a failure to write the title cache.
+//
+// Parameters:
+//   - cause: the underlying error
+//
+// Returns:
+//   - error: "write title cache: <cause>"
+func TitlesWrite(cause error) error {
+	return fmt.Errorf(
+		desc.Text(text.DescKeyErrTraceTitlesWrite), cause,
+	)
+}
@@ -18,6 +18,10 @@
 //
 //	out, err := git.Run("log", "--oneline")
 //
+// RunCombined does the same but returns stderr with
+// stdout, for commands whose failures need git's own
+// explanation (push, fetch, notes merge).
+//
 // # Repository Queries
 //
 // Root returns the repository root directory for the
@@ -47,4 +51,13 @@
 //
 //	hash := git.ShortHead()
 //	branch := git.CurrentBranch()
+//
+// # Notes and Remotes
+//
+// NoteShow, NoteAppend and NoteMerge read and write one
+// notes ref; Push and Fetch move a refspec to or from a
+// remote.
+//
+//	out, err := git.NoteShow("refs/notes/ctx", hash)
+//	out, err := git.Push("origin", "refs/notes/ctx")
 package git
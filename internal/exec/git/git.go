@@ -7,11 +7,14 @@
 package git
 
 import (
+	"fmt"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
 	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/token"
 	errGit "github.com/ActiveMemory/ctx/internal/err/git"
 )
 
@@ -32,6 +35,44 @@ func Run(args ...string) ([]byte, error) {
 	return exec.Command(cfgGit.Binary, args...).Output()
 }
 
+// RunCombined executes a git command and returns stdout and
+// stderr together, so a failure carries git's own explanation
+// (rejected pushes, missing remote refs).
+//
+// Parameters:
+//   - args: git subcommand and flags
+//
+// Returns:
+//   - []byte: combined output
+//   - error: non-nil if git is not found or the command fails
+func RunCombined(args ...string) ([]byte, error) {
+	if _, lookErr := exec.LookPath(cfgGit.Binary); lookErr != nil {
+		return nil, errGit.NotFound()
+	}
+	//nolint:gosec // G204: args are validated by callers
+	return exec.Command(cfgGit.Binary, args...).CombinedOutput()
+}
+
+// RunInput executes a git command with input on stdin and
+// returns its stdout output.
+//
+// Parameters:
+//   - input: text written to git's stdin
+//   - args: git subcommand and flags
+//
+// Returns:
+//   - []byte: raw git stdout
+//   - error: non-nil if git is not found or the command fails
+func RunInput(input string, args ...string) ([]byte, error) {
+	if _, lookErr := exec.LookPath(cfgGit.Binary); lookErr != nil {
+		return nil, errGit.NotFound()
+	}
+	//nolint:gosec // G204: args are validated by callers
+	c := exec.Command(cfgGit.Binary, args...)
+	c.Stdin = strings.NewReader(input)
+	return c.Output()
+}
+
 // Root returns the repository root directory for the current
 // working directory.
 //
@@ -121,6 +162,18 @@ func CurrentBranch() string {
 	return strings.TrimSpace(string(out))
 }
 
+// UserName returns the configured git author name.
+//
+// Returns:
+//   - string: user.name, or empty if unset or on error
+func UserName() string {
+	out, err := Run(cfgGit.Config, cfgGit.ConfigUserName)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
 // DiffTreeHead returns the list of files changed in HEAD.
 //
 // Returns:
@@ -132,3 +185,115 @@ func DiffTreeHead() ([]byte, error) {
 		cfgGit.FlagNameOnly, cfgGit.FlagRecursive, cfgGit.RefHead,
 	)
 }
+
+// ShowFile returns the content of a file at a git ref.
+//
+// Parameters:
+//   - dir: repository directory to run in
+//   - ref: commit-ish (e.g. "origin/main")
+//   - path: slash-separated path inside the repository
+//
+// Returns:
+//   - []byte: file content at ref
+//   - error: non-nil if git is not found or the object does not
+//     exist
+func ShowFile(dir, ref, path string) ([]byte, error) {
+	object := ref + cfgGit.ObjectSep + path
+	return Run(cfgGit.FlagChangeDir, dir, cfgGit.Show, object)
+}
+
+// Renames lists every rename in the history of the repository
+// holding dir, newest first, as --name-status lines with paths
+// relative to dir.
+//
+// Parameters:
+//   - dir: directory to run in; paths are relative to it
+//
+// Returns:
+//   - []byte: lines of "R<score>\t<old>\t<new>"
+//   - error: non-nil if git is not found or dir is not in a
+//     repository
+func Renames(dir string) ([]byte, error) {
+	return Run(
+		cfgGit.FlagChangeDir, dir, cfgGit.Log,
+		cfgGit.FlagNameStatus, cfgGit.FlagFindRenames,
+		cfgGit.FlagOnlyRenames, cfgGit.FormatEmpty, cfgGit.FlagRelative,
+	)
+}
+
+// StagedNameStatus lists the files staged for commit with their
+// status letter, paths relative to dir.
+//
+// Parameters:
+//   - dir: directory to run in
+//
+// Returns:
+//   - []byte: --name-status lines ("M\tpath", "R100\told\tnew")
+//   - error: non-nil if git is not found or dir is not in a
+//     repository
+func StagedNameStatus(dir string) ([]byte, error) {
+	return Run(
+		cfgGit.FlagChangeDir, dir, cfgGit.Diff, cfgGit.FlagCached,
+		cfgGit.FlagNameStatus, cfgGit.FlagFindRenames,
+		cfgGit.FlagRelative,
+	)
+}
+
+// StagedDiff returns the staged changes as a unified diff with no
+// context lines, paths relative to dir.
+//
+// Parameters:
+//   - dir: directory to run in
+//
+// Returns:
+//   - []byte: diff text
+//   - error: non-nil if git is not found or the command fails
+func StagedDiff(dir string) ([]byte, error) {
+	return Run(
+		cfgGit.FlagChangeDir, dir, cfgGit.Diff, cfgGit.FlagCached,
+		cfgGit.FlagNoContext, cfgGit.FlagNoColor, cfgGit.FlagNoExtDiff,
+		cfgGit.FlagFindRenames, cfgGit.FlagRelative,
+	)
+}
+
+// IndexFiles lists the index entries matching a pathspec,
+// including files staged but not yet committed.
+//
+// Parameters:
+//   - dir: directory to run in; paths are relative to it
+//   - pathspec: git pathspec to filter by
+//
+// Returns:
+//   - []byte: newline-separated paths
+//   - error: non-nil if git is not found or the command fails
+func IndexFiles(dir, pathspec string) ([]byte, error) {
+	return Run(
+		cfgGit.FlagChangeDir, dir, cfgGit.LsFiles,
+		cfgGit.FlagPathSep, pathspec,
+	)
+}
+
+// Blame returns porcelain blame output for a file, optionally
+// limited to a line range.
+//
+// Parameters:
+//   - path: file to blame
+//   - start: first line of the range; 0 blames the whole file
+//   - end: last line of the range; 0 means start to end of file
+//
+// Returns:
+//   - []byte: git blame --porcelain output
+//   - error: non-nil if git is not found, the file is not
+//     tracked, or the range is out of bounds
+func Blame(path string, start, end int) ([]byte, error) {
+	args := []string{cfgGit.Blame, cfgGit.FlagPorcelain}
+	if start > 0 {
+		lineRange := strconv.Itoa(start) + token.Comma
+		if end > 0 {
+			lineRange = fmt.Sprintf(cfgGit.LineRangeFormat, start, end)
+		}
+		args = append(args, cfgGit.FlagLineRange, lineRange)
+	}
+	args = append(args, cfgGit.FlagPathSep, path)
+	return Run(args...)
+}
@@ -0,0 +1,153 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"strings"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// NoteShow returns the note attached to a commit under a notes
+// ref.
+//
+// Parameters:
+//   - ref: Notes ref (e.g. "refs/notes/ctx")
+//   - commit: Commit-ish the note is attached to
+//
+// Returns:
+//   - []byte: Note text
+//   - error: non-nil if git is not found or there is no note
+func NoteShow(ref, commit string) ([]byte, error) {
+	return Run(
+		cfgGit.Notes, cfgGit.FlagNotesRef, ref, cfgGit.NotesShow, commit,
+	)
+}
+
+// NoteAppend appends a paragraph to the note attached to a
+// commit, creating the note when there is none.
+//
+// Parameters:
+//   - ref: Notes ref
+//   - commit: Commit-ish to annotate
+//   - text: Paragraph to append
+//
+// Returns:
+//   - []byte: Combined git output
+//   - error: non-nil if git is not found or the command fails
+func NoteAppend(ref, commit, text string) ([]byte, error) {
+	return RunCombined(
+		cfgGit.Notes, cfgGit.FlagNotesRef, ref, cfgGit.NotesAppend,
+		cfgGit.FlagMessage, text, commit,
+	)
+}
+
+// NoteMerge merges another notes ref into ref with the given
+// strategy.
+//
+// Parameters:
+//   - ref: Notes ref to merge into
+//   - strategy: git notes merge strategy (e.g. "cat_sort_uniq")
+//   - from: Notes ref to merge from
+//
+// Returns:
+//   - []byte: Combined git output
+//   - error: non-nil if git is not found or the merge fails
+func NoteMerge(ref, strategy, from string) ([]byte, error) {
+	return RunCombined(
+		cfgGit.Notes, cfgGit.FlagNotesRef, ref, cfgGit.NotesMerge,
+		cfgGit.FlagQuiet, cfgGit.FlagStrategy, strategy, from,
+	)
+}
+
+// Push pushes a refspec to a remote.
+//
+// Parameters:
+//   - remote: Remote name or URL
+//   - refspec: Refspec to push
+//
+// Returns:
+//   - []byte: Combined git output
+//   - error: non-nil if git is not found or the push is rejected
+func Push(remote, refspec string) ([]byte, error) {
+	return RunCombined(cfgGit.Push, cfgGit.FlagQuiet, remote, refspec)
+}
+
+// Fetch fetches a refspec from a remote.
+//
+// Parameters:
+//   - remote: Remote name or URL
+//   - refspec: Refspec to fetch
+//
+// Returns:
+//   - []byte: Combined git output
+//   - error: non-nil if git is not found or the fetch fails
+func Fetch(remote, refspec string) ([]byte, error) {
+	return RunCombined(cfgGit.Fetch, cfgGit.FlagQuiet, remote, refspec)
+}
+
+// CommitExists reports whether a hash names a commit in the
+// current repository.
+//
+// Parameters:
+//   - hash: Full or abbreviated commit hash
+//
+// Returns:
+//   - bool: True when the commit exists
+func CommitExists(hash string) bool {
+	_, runErr := Run(
+		cfgGit.RevParse, cfgGit.FlagVerify, cfgGit.FlagQuiet,
+		hash+cfgGit.PeelCommit,
+	)
+	return runErr == nil
+}
+
+// RefExists reports whether a ref (e.g. "refs/notes/ctx")
+// exists in the current repository.
+//
+// Parameters:
+//   - ref: Full ref name
+//
+// Returns:
+//   - bool: True when the ref resolves
+func RefExists(ref string) bool {
+	_, runErr := Run(
+		cfgGit.RevParse, cfgGit.FlagVerify, cfgGit.FlagQuiet, ref,
+	)
+	return runErr == nil
+}
+
+// NoteList lists every note under a notes ref as
+// "<note-object> <annotated-object>" lines.
+//
+// Parameters:
+//   - ref: Notes ref
+//
+// Returns:
+//   - []byte: git notes list output
+//   - error: non-nil if git is not found or the command fails
+func NoteList(ref string) ([]byte, error) {
+	return Run(cfgGit.Notes, cfgGit.FlagNotesRef, ref, cfgGit.NotesList)
+}
+
+// CatFileBatch reads many objects through a single
+// git cat-file --batch process.
+//
+// Parameters:
+//   - ids: Object names to read
+//
+// Returns:
+//   - []byte: "<oid> <type> <size>" headers each followed by
+//     the object content and a newline
+//   - error: non-nil if git is not found or the command fails
+func CatFileBatch(ids []string) ([]byte, error) {
+	return RunInput(
+		strings.Join(ids, token.NewlineLF)+token.NewlineLF,
+		cfgGit.CatFile, cfgGit.FlagBatch,
+	)
+}
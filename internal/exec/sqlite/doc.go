@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package sqlite wraps the sqlite3 command-line tool.
+//
+// ctx reads a few third-party SQLite stores (Cursor's chat
+// database) without linking a SQLite driver. Query runs a
+// read-only query through sqlite3 and returns its JSON
+// output. LookPath is checked on every call, so a missing
+// tool surfaces as a typed error rather than an exec failure.
+//
+//	out, err := sqlite.Query("/path/state.vscdb", "SELECT 1")
+package sqlite
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package sqlite
+
+import (
+	"os/exec"
+
+	cfgSqlite "github.com/ActiveMemory/ctx/internal/config/sqlite"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+)
+
+// Query runs a read-only query against a SQLite database.
+//
+// Parameters:
+//   - db: path to the database file
+//   - query: SQL to run
+//
+// Returns:
+//   - []byte: result rows as a JSON array of objects; empty
+//     when the query returns no rows
+//   - error: non-nil if sqlite3 is not installed or the query
+//     fails
+func Query(db, query string) ([]byte, error) {
+	if _, lookErr := exec.LookPath(cfgSqlite.Binary); lookErr != nil {
+		return nil, errParser.SQLiteNotFound()
+	}
+	//nolint:gosec // G204: queries are constants, db is a discovered path
+	out, runErr := exec.Command(
+		cfgSqlite.Binary, cfgSqlite.FlagReadonly, cfgSqlite.FlagJSON,
+		db, query,
+	).Output()
+	if runErr != nil {
+		return nil, errParser.Query(runErr)
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	cfgFmt "github.com/ActiveMemory/ctx/internal/config/format"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// Unified renders a line diff between two texts in unified
+// format with [cfgFmt.DiffContext] lines of context.
+//
+// Parameters:
+//   - oldLabel: Name shown on the --- line
+//   - newLabel: Name shown on the +++ line
+//   - a: Old text
+//   - b: New text
+//
+// Returns:
+//   - string: The diff, or empty when the texts are equal
+func Unified(oldLabel, newLabel, a, b string) string {
+	if a == b {
+		return ""
+	}
+	oldLines := splitLines(a)
+	newLines := splitLines(b)
+	ops := editScript(oldLines, newLines)
+
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, cfgFmt.DiffOldHeader, oldLabel)
+	_, _ = fmt.Fprintf(&sb, cfgFmt.DiffNewHeader, newLabel)
+
+	for start := 0; start < len(ops); {
+		// Find the next change.
+		for start < len(ops) && ops[start].kind == token.Space {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		lo := max(start-cfgFmt.DiffContext, 0)
+		// Extend the hunk while changes are within 2*context.
+		hi := start
+		for i := start; i < len(ops); i++ {
+			if ops[i].kind != token.Space {
+				hi = i
+				continue
+			}
+			if i-hi > 2*cfgFmt.DiffContext {
+				break
+			}
+		}
+		hi = min(hi+cfgFmt.DiffContext+1, len(ops))
+		writeHunk(&sb, ops[lo:hi])
+		start = hi
+	}
+	return sb.String()
+}
@@ -36,6 +36,16 @@
 //   - **[Number](n)**: thousands-grouped integer
 //     formatting ("1,234,567"). Uses comma regardless
 //     of locale (ctx is English-only at present).
+//   - **[Unified](oldLabel, newLabel, a, b)**: line
+//     diff of two texts in unified format, with
+//     three lines of context per hunk.
+//   - **[Sparkline](values, max)**: one block
+//     character per value, scaled against max;
+//     negative values render as a gap.
+//   - **[ShellQuote](s)**: single-quotes s for a
+//     POSIX shell, escaping embedded quotes, so paths
+//     written into scripts and git config survive
+//     spaces and apostrophes.
 //
 // # Design Choices
 //
@@ -0,0 +1,105 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	cfgFmt "github.com/ActiveMemory/ctx/internal/config/format"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// splitLines splits text into lines without the trailing
+// empty element a final newline produces.
+//
+// Parameters:
+//   - s: Text to split
+//
+// Returns:
+//   - []string: Lines, or nil for empty text
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	trimmed := strings.TrimSuffix(s, token.NewlineLF)
+	return strings.Split(trimmed, token.NewlineLF)
+}
+
+// editScript computes a minimal line edit script from a to b
+// using a longest-common-subsequence table.
+//
+// Parameters:
+//   - a: Old lines
+//   - b: New lines
+//
+// Returns:
+//   - []diffOp: Keep, delete, and insert operations in order
+func editScript(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{token.Space, a[i], i + 1, j + 1})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{token.Dash, a[i], i + 1, j + 1})
+			i++
+		default:
+			ops = append(ops, diffOp{token.Plus, b[j], i + 1, j + 1})
+			j++
+		}
+	}
+	return ops
+}
+
+// writeHunk writes one hunk header and its lines.
+//
+// Parameters:
+//   - sb: Destination builder
+//   - ops: Operations covered by the hunk
+func writeHunk(sb *strings.Builder, ops []diffOp) {
+	var oldCount, newCount int
+	for _, op := range ops {
+		if op.kind != token.Plus {
+			oldCount++
+		}
+		if op.kind != token.Dash {
+			newCount++
+		}
+	}
+	oldStart, newStart := ops[0].oldNo, ops[0].newNo
+	// An empty side starts at the line before the hunk.
+	if oldCount == 0 {
+		oldStart--
+	}
+	if newCount == 0 {
+		newStart--
+	}
+	_, _ = fmt.Fprintf(
+		sb, cfgFmt.DiffHunkHeader, oldStart, oldCount, newStart, newCount,
+	)
+	for _, op := range ops {
+		sb.WriteString(op.kind + op.line + token.NewlineLF)
+	}
+}
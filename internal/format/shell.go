@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/shell"
+)
+
+// ShellQuote wraps s in single quotes, escaping any embedded single
+// quote as close-escape-reopen (`'` followed by `\'` followed by `'`).
+// The resulting string is safe to paste into any POSIX-compatible
+// shell regardless of s's contents.
+//
+// Parameters:
+//   - s: raw value (typically a filesystem path).
+//
+// Returns:
+//   - string: single-quoted, escape-safe shell literal.
+func ShellQuote(s string) string {
+	return shell.SingleQuote +
+		strings.ReplaceAll(s, shell.SingleQuote, shell.SingleQuoteEscaped) +
+		shell.SingleQuote
+}
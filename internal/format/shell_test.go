@@ -0,0 +1,34 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []string{
+		"/home/u/.context",
+		"/home/u/my project/.context",
+		"/home/o'brien/.context",
+		"/tmp/$HOME `id` \"x\"/.context",
+	}
+	for _, value := range tests {
+		t.Run(value, func(t *testing.T) {
+			out, runErr := exec.Command(
+				"sh", "-c", "printf %s "+ShellQuote(value),
+			).Output()
+			if runErr != nil {
+				t.Fatalf("sh: %v", runErr)
+			}
+			if string(out) != value {
+				t.Errorf("round trip = %q, want %q", out, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import (
+	"strings"
+
+	cfgFmt "github.com/ActiveMemory/ctx/internal/config/format"
+)
+
+// Sparkline renders values as a row of block characters.
+//
+// Each value is scaled against max onto the eight levels in
+// cfgFmt.SparkRunes. Negative values mark missing data and
+// render as cfgFmt.SparkGap.
+//
+// Parameters:
+//   - values: Values to plot, oldest first
+//   - max: Value that maps to the tallest block; must be positive
+//
+// Returns:
+//   - string: One character per value
+func Sparkline(values []int, max int) string {
+	levels := []rune(cfgFmt.SparkRunes)
+	top := len(levels) - 1
+	var sb strings.Builder
+	for _, v := range values {
+		if v < 0 || max <= 0 {
+			sb.WriteString(cfgFmt.SparkGap)
+			continue
+		}
+		i := min(v, max) * top / max
+		sb.WriteRune(levels[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+import "testing"
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		max    int
+		want   string
+	}{
+		{"empty", nil, 100, ""},
+		{"range", []int{0, 50, 100}, 100, "▁▄█"},
+		{"clamped", []int{150}, 100, "█"},
+		{"gap", []int{100, -1, 0}, 100, "█ ▁"},
+		{"zero max", []int{5}, 0, " "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sparkline(tt.values, tt.max)
+			if got != tt.want {
+				t.Errorf("Sparkline(%v, %d) = %q, want %q",
+					tt.values, tt.max, got, tt.want)
+			}
+		})
+	}
+}
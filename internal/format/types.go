@@ -0,0 +1,16 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package format
+
+// diffOp is one line of an edit script.
+type diffOp struct {
+	// kind is " " (keep), "-" (delete), or "+" (insert).
+	kind string
+	line string
+	// oldNo and newNo are 1-based positions before the op.
+	oldNo, newNo int
+}
@@ -52,6 +52,8 @@
 //   - [SafePost] sends an HTTP POST with scheme
 //     validation (http/https only), redirect cap
 //     (max 3), and caller-specified timeout.
+//   - [SafeDo] sends a prepared request (any method,
+//     extra headers) with the same protections.
 //
 // # Limitations
 //
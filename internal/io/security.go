@@ -17,7 +17,6 @@ import (
 	cfgFs "github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
 	errFs "github.com/ActiveMemory/ctx/internal/err/fs"
-	errHTTP "github.com/ActiveMemory/ctx/internal/err/http"
 	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
 )
 
@@ -198,9 +197,6 @@ func TouchFile(path string) {
 	}
 }
 
-// maxRedirects caps the number of HTTP redirects the client will follow.
-const maxRedirects = 3
-
 // SafePost sends an HTTP POST with the given content type and body.
 //
 // Designed for static endpoint URLs that originate from trusted,
@@ -242,17 +238,29 @@ func SafePost(
 		return nil, schemeErr
 	}
 
-	client := &http.Client{
-		Timeout: timeout,
-		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
-			if len(via) >= maxRedirects {
-				return errHTTP.TooManyRedirects()
-			}
-			return nil
-		},
-	}
+	//nolint:gosec // URL originates from trusted, encrypted storage;
+	// scheme validated above
+	return redirectCapped(timeout).Post(
+		rawURL, contentType, bytes.NewReader(body),
+	)
+}
 
+// SafeDo sends a prepared HTTP request with the same protections
+// as [SafePost]. Use it when the endpoint needs a method other
+// than POST or extra headers (bearer tokens, ntfy metadata).
+//
+// Parameters:
+//   - req: request to a trusted, user-configured endpoint
+//   - timeout: per-request timeout (includes redirect hops)
+//
+// Returns:
+//   - *http.Response: the HTTP response (caller must close Body)
+//   - error: on scheme validation failure, redirect cap, or HTTP error
+func SafeDo(req *http.Request, timeout time.Duration) (*http.Response, error) {
+	if schemeErr := validateHTTPScheme(req.URL.String()); schemeErr != nil {
+		return nil, schemeErr
+	}
 	//nolint:gosec // URL originates from trusted, encrypted storage;
 	// scheme validated above
-	return client.Post(rawURL, contentType, bytes.NewReader(body))
+	return redirectCapped(timeout).Do(req)
 }
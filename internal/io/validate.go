@@ -7,9 +7,11 @@
 package io
 
 import (
+	"net/http"
 	"net/url"
 	"path/filepath"
 	"strings"
+	"time"
 
 	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
 	cfgIo "github.com/ActiveMemory/ctx/internal/config/io"
@@ -78,3 +80,26 @@ func validateHTTPScheme(rawURL string) error {
 	}
 	return nil
 }
+
+// maxRedirects caps the number of HTTP redirects the client will follow.
+const maxRedirects = 3
+
+// redirectCapped returns an HTTP client that gives up after
+// [maxRedirects] redirects.
+//
+// Parameters:
+//   - timeout: per-request timeout (includes redirect hops)
+//
+// Returns:
+//   - *http.Client: client for trusted, user-configured endpoints
+func redirectCapped(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errHTTP.TooManyRedirects()
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,24 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package harvest proposes context entries from a parsed AI
+// session for ctx journal harvest.
+//
+// [Extract] walks a session's messages. Explicit markers are
+// taken as written: <context-update> tags in the format ctx
+// watch applies, and bare <decision>, <learning>, <task> and
+// <convention> tags. The remaining prose is split into
+// paragraphs and list blocks with memory.Entries and classified
+// with memory.Classify, so the classify_rules in .ctxrc decide
+// what counts as a decision, learning or task. Decisions and
+// learnings get their required context, rationale or lesson,
+// and consequence or application filled with placeholders that
+// name the session, so every candidate passes ctx add
+// validation once provenance is set.
+//
+// [Fresh] drops candidates already recorded in their target
+// context file. Writing is left to the caller.
+package harvest
@@ -0,0 +1,192 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package harvest
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/cli/watch/core/stream"
+	"github.com/ActiveMemory/ctx/internal/config/cli"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/config/harvest"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/config/watch"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/memory"
+)
+
+// markers returns the entries a message marks explicitly, in
+// the order they appear: <context-update> tags first, then
+// bare <decision>-style tags. Unknown entry types are ignored.
+//
+// Parameters:
+//   - msg: Message text
+//   - source: Context placeholder naming the session
+//
+// Returns:
+//   - []entity.HarvestCandidate: Explicit candidates
+func markers(msg, source string) []entity.HarvestCandidate {
+	var out []entity.HarvestCandidate
+	add := func(p entity.EntryParams) {
+		p.Type = strings.ToLower(p.Type)
+		p.Content = strings.TrimSpace(p.Content)
+		if _, ok := entry.CtxFile(p.Type); !ok || p.Content == "" {
+			return
+		}
+		out = append(out, entity.HarvestCandidate{
+			Params:   complete(p, p.Content, source),
+			Explicit: true,
+		})
+	}
+
+	for _, m := range regex.SystemContextUpdate.FindAllStringSubmatch(
+		msg, -1,
+	) {
+		if len(m) < watch.ContextUpdateMinGroups {
+			continue
+		}
+		tag := m[1]
+		add(entity.EntryParams{
+			Type:        stream.ExtractAttribute(tag, cli.AttrType),
+			Content:     m[2],
+			Section:     stream.ExtractAttribute(tag, cli.AttrSection),
+			Context:     stream.ExtractAttribute(tag, cli.AttrContext),
+			Lesson:      stream.ExtractAttribute(tag, cli.AttrLesson),
+			Application: stream.ExtractAttribute(tag, cli.AttrApplication),
+			Rationale:   stream.ExtractAttribute(tag, cli.AttrRationale),
+			Consequence: stream.ExtractAttribute(tag, cli.AttrConsequence),
+		})
+	}
+	for _, m := range regex.HarvestTag.FindAllStringSubmatch(msg, -1) {
+		add(entity.EntryParams{Type: m[1], Content: m[2]})
+	}
+	return out
+}
+
+// keywords classifies each paragraph or list block of prose
+// with memory.Classify and keeps the harvestable targets.
+//
+// Parameters:
+//   - msg: Message prose, as returned by [prose]
+//   - source: Context placeholder naming the session
+//
+// Returns:
+//   - []entity.HarvestCandidate: Keyword candidates
+func keywords(msg, source string) []entity.HarvestCandidate {
+	var out []entity.HarvestCandidate
+	for _, e := range memory.Entries(msg) {
+		class := memory.Classify(e)
+		if !slices.Contains(harvest.Targets, class.Target) {
+			continue
+		}
+		title := memory.Title(e.Text)
+		if len(title) < harvest.MinContentLen {
+			continue
+		}
+		p := entity.EntryParams{Type: class.Target, Content: clip(title)}
+		out = append(out, entity.HarvestCandidate{
+			Params:   complete(p, memory.Body(e.Text), source),
+			Keywords: class.Keywords,
+		})
+	}
+	return out
+}
+
+// prose strips what should never become a candidate from a
+// message: explicit markers (harvested separately), system
+// reminders, and fenced code blocks.
+//
+// Parameters:
+//   - msg: Message text
+//
+// Returns:
+//   - string: Remaining prose
+func prose(msg string) string {
+	msg = regex.SystemContextUpdate.ReplaceAllString(msg, "")
+	msg = regex.HarvestTag.ReplaceAllString(msg, "")
+	msg = regex.SystemReminder.ReplaceAllString(msg, "")
+
+	var kept []string
+	fenced := false
+	for _, line := range strings.Split(msg, token.NewlineLF) {
+		if regex.CodeFenceLine.MatchString(line) {
+			fenced = !fenced
+			continue
+		}
+		if !fenced {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, token.NewlineLF)
+}
+
+// complete fills the structured fields ctx add requires with
+// the body text and review placeholders, keeping any value the
+// session already supplied.
+//
+// Parameters:
+//   - p: Entry with Type and Content set
+//   - body: Text to use as rationale or lesson
+//   - source: Context placeholder naming the session
+//
+// Returns:
+//   - entity.EntryParams: Entry ready for review
+func complete(p entity.EntryParams, body, source string) entity.EntryParams {
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+	review := desc.Text(text.DescKeyJournalHarvestReview)
+	switch p.Type {
+	case entry.Decision:
+		fill(&p.Context, source)
+		fill(&p.Rationale, body)
+		fill(&p.Consequence, review)
+	case entry.Learning:
+		fill(&p.Context, source)
+		fill(&p.Lesson, body)
+		fill(&p.Application, review)
+	}
+	return p
+}
+
+// clip shortens a title to harvest.MaxContentLen at a word
+// boundary.
+//
+// Parameters:
+//   - title: Candidate title
+//
+// Returns:
+//   - string: Title, clipped with an ellipsis when too long
+func clip(title string) string {
+	if len(title) <= harvest.MaxContentLen {
+		return title
+	}
+	cut := title[:harvest.MaxContentLen]
+	if i := strings.LastIndex(cut, token.Space); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + token.Ellipsis
+}
+
+// hash identifies a candidate by type and case-folded title.
+//
+// Parameters:
+//   - p: Candidate entry
+//
+// Returns:
+//   - string: Content hash
+func hash(p entity.EntryParams) string {
+	return memory.EntryHash(
+		p.Type + token.Colon + strings.ToLower(p.Content),
+	)
+}
@@ -0,0 +1,100 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package harvest
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/entry"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// Extract proposes the decisions, learnings and tasks found in
+// a session's messages.
+//
+// Explicit markers (<context-update> tags as understood by
+// ctx watch, and bare <decision>-style tags) are taken as
+// written. The remaining prose is split into paragraphs and
+// list blocks and run through memory.Classify, so .ctxrc
+// classify_rules apply. Fenced code and system reminders are
+// ignored. Candidates are returned in transcript order with
+// duplicates (same type and title) removed.
+//
+// Parameters:
+//   - s: Session with messages loaded
+//
+// Returns:
+//   - []entity.HarvestCandidate: Deduplicated candidates
+func Extract(s *entity.Session) []entity.HarvestCandidate {
+	name := s.Slug
+	if name == "" {
+		name = s.ID
+	}
+	source := fmt.Sprintf(desc.Text(text.DescKeyJournalHarvestSource), name)
+
+	seen := make(map[string]bool)
+	var out []entity.HarvestCandidate
+	for i, m := range s.Messages {
+		if m.Text == "" {
+			continue
+		}
+		found := markers(m.Text, source)
+		found = append(found, keywords(prose(m.Text), source)...)
+		for _, c := range found {
+			c.Turn = i + 1
+			c.Role = m.Role
+			c.Hash = hash(c.Params)
+			if seen[c.Hash] {
+				continue
+			}
+			seen[c.Hash] = true
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Fresh drops candidates whose title already appears in their
+// target context file, so a re-harvested session only proposes
+// what is new.
+//
+// Parameters:
+//   - contextDir: Context directory holding DECISIONS.md etc.
+//   - candidates: Candidates from [Extract]
+//
+// Returns:
+//   - []entity.HarvestCandidate: Candidates not yet recorded
+//   - int: Number of candidates dropped
+func Fresh(
+	contextDir string, candidates []entity.HarvestCandidate,
+) ([]entity.HarvestCandidate, int) {
+	files := make(map[string]string)
+	var out []entity.HarvestCandidate
+	for _, c := range candidates {
+		name, ok := entry.CtxFile(c.Params.Type)
+		if !ok {
+			continue
+		}
+		content, loaded := files[name]
+		if !loaded {
+			// A missing file has nothing recorded yet.
+			data, _ := io.SafeReadUserFile(filepath.Join(contextDir, name))
+			content = strings.ToLower(string(data))
+			files[name] = content
+		}
+		if strings.Contains(content, strings.ToLower(c.Params.Content)) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out, len(candidates) - len(out)
+}
@@ -0,0 +1,155 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package harvest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// declare points CTX_DIR at a temp project with an empty .ctxrc
+// and returns its context directory.
+func declare(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	ctxDir := filepath.Join(tmpDir, ".context")
+	if mkErr := os.MkdirAll(ctxDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if wErr := os.WriteFile(
+		filepath.Join(tmpDir, ".ctxrc"), nil, 0o600,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+	testctx.Declare(t, tmpDir)
+	return ctxDir
+}
+
+func session(texts ...string) *entity.Session {
+	s := &entity.Session{ID: "abc12345", Slug: "brave-otter"}
+	for i, text := range texts {
+		role := "assistant"
+		if i%2 == 0 {
+			role = "user"
+		}
+		s.Messages = append(s.Messages, entity.Message{Role: role, Text: text})
+	}
+	return s
+}
+
+func TestExtract_ExplicitMarkers(t *testing.T) {
+	declare(t)
+	s := session(
+		"ok",
+		`Done. <context-update type="learning" context="CI flakes" `+
+			`lesson="Pin the runner image">Unpinned runners break builds`+
+			`</context-update>`+"\n\n<decision>Use SQLite for the index</decision>",
+	)
+
+	got := Extract(s)
+	if len(got) != 2 {
+		t.Fatalf("got %d candidates, want 2: %+v", len(got), got)
+	}
+
+	l := got[0]
+	if !l.Explicit || l.Params.Type != "learning" || l.Turn != 2 {
+		t.Errorf("learning = %+v", l)
+	}
+	if l.Params.Context != "CI flakes" ||
+		l.Params.Lesson != "Pin the runner image" {
+		t.Errorf("tag attributes not kept: %+v", l.Params)
+	}
+	if l.Params.Application == "" {
+		t.Error("missing application placeholder")
+	}
+
+	d := got[1]
+	if d.Params.Type != "decision" ||
+		d.Params.Content != "Use SQLite for the index" {
+		t.Errorf("decision = %+v", d.Params)
+	}
+	if !strings.Contains(d.Params.Context, "brave-otter") {
+		t.Errorf("context %q does not name the session", d.Params.Context)
+	}
+	if d.Params.Rationale == "" || d.Params.Consequence == "" {
+		t.Errorf("placeholders not filled: %+v", d.Params)
+	}
+}
+
+func TestExtract_Keywords(t *testing.T) {
+	declare(t)
+	s := session(
+		"We need to add retries to the webhook client.",
+		"Thanks!\n\n"+
+			"The gotcha was that the parser drops trailing blank lines.\n\n"+
+			"```go\n// we decided to use this instead of that\n```\n\n"+
+			"<system-reminder>the user decided nothing here</system-reminder>",
+	)
+
+	got := Extract(s)
+	if len(got) != 2 {
+		t.Fatalf("got %d candidates, want 2: %+v", len(got), got)
+	}
+	if got[0].Params.Type != "task" || got[0].Explicit ||
+		len(got[0].Keywords) == 0 {
+		t.Errorf("first = %+v", got[0])
+	}
+	if got[1].Params.Type != "learning" || got[1].Turn != 2 {
+		t.Errorf("second = %+v", got[1])
+	}
+}
+
+func TestExtract_Dedup(t *testing.T) {
+	declare(t)
+	s := session(
+		"<task>Add retries to the webhook client</task>",
+		"<task>add retries to the webhook client</task>",
+	)
+	if got := Extract(s); len(got) != 1 {
+		t.Errorf("got %d candidates, want 1", len(got))
+	}
+}
+
+func TestExtract_SkipsShortAndUnknown(t *testing.T) {
+	declare(t)
+	s := session(
+		"Should do.",
+		`<context-update type="note">We decided to ship the new `+
+			`parser on Friday</context-update>`,
+	)
+	if got := Extract(s); len(got) != 0 {
+		t.Errorf("got %+v, want none", got)
+	}
+}
+
+func TestFresh(t *testing.T) {
+	ctxDir := declare(t)
+	if wErr := os.WriteFile(
+		filepath.Join(ctxDir, "DECISIONS.md"),
+		[]byte("# Decisions\n\n## [2026-01-01] Use SQLite for the index\n"),
+		0o600,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+
+	s := session(
+		"<decision>Use SQLite for the index</decision>" +
+			"<decision>Keep the CLI flag-compatible</decision>",
+	)
+	fresh, known := Fresh(ctxDir, Extract(s))
+	if known != 1 || len(fresh) != 1 {
+		t.Fatalf("fresh=%+v known=%d", fresh, known)
+	}
+	if fresh[0].Params.Content != "Keep the CLI flag-compatible" {
+		t.Errorf("kept %q", fresh[0].Params.Content)
+	}
+}
@@ -0,0 +1,116 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	cfgAider "github.com/ActiveMemory/ctx/internal/config/aider"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// NewAider creates a new Aider transcript parser.
+//
+// Returns:
+//   - *Aider: a new parser instance
+func NewAider() *Aider {
+	return &Aider{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Aider tool identifier
+func (p *Aider) Tool() string {
+	return session.ToolAider
+}
+
+// Matches returns true if the file is an Aider chat history.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is named .aider.chat.history.md
+func (p *Aider) Matches(path string) bool {
+	return filepath.Base(path) == cfgAider.FileHistory
+}
+
+// ParseFile reads an Aider chat history and returns one session
+// per "# aider chat started at" header.
+//
+// The transcript records only the session start, so every
+// message carries that time. The working directory is the
+// directory holding the file.
+//
+// Parameters:
+//   - path: path to .aider.chat.history.md
+//
+// Returns:
+//   - []*entity.Session: the sessions in file order
+//   - error: any error encountered while reading
+func (p *Aider) ParseFile(path string) ([]*entity.Session, error) {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return nil, errParser.ReadFile(readErr)
+	}
+	abs, absErr := filepath.Abs(path)
+	if absErr != nil {
+		abs = path
+	}
+
+	var sessions []*entity.Session
+	var start time.Time
+	var lines []string
+	inSession := false
+	flush := func() {
+		if !inSession {
+			return
+		}
+		if s := p.buildSession(start, lines, abs); s != nil {
+			sessions = append(sessions, s)
+		}
+	}
+	text := strings.ReplaceAll(string(data), token.NewlineCRLF, token.NewlineLF)
+	for _, line := range strings.Split(text, token.NewlineLF) {
+		if rest, ok := strings.CutPrefix(line, cfgAider.HeaderPrefix); ok {
+			flush()
+			start, _ = time.ParseInLocation(
+				cfgAider.TimeLayout, strings.TrimSpace(rest), time.Local,
+			)
+			lines = nil
+			inSession = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+	flush()
+	return sessions, nil
+}
+
+// ParseLine is not meaningful for Aider transcripts, which are
+// parsed as a whole. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Aider) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// Ensure Aider implements Session.
+var _ Session = (*Aider)(nil)
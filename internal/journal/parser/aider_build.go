@@ -0,0 +1,238 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	cfgAider "github.com/ActiveMemory/ctx/internal/config/aider"
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// buildSession converts the lines of one Aider session into a
+// Session.
+//
+// User input lines become user messages and unquoted lines the
+// assistant's reply. Quoted output supplies the model, the token
+// report of the preceding reply, and the edits, commits and
+// shell commands Aider ran, which become tool uses; output
+// following a shell command becomes its result. Code blocks in
+// replies are kept verbatim.
+//
+// Parameters:
+//   - start: Session start from the header
+//   - lines: Lines after the header, up to the next one
+//   - sourcePath: Absolute path to the transcript
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no
+//     messages
+func (p *Aider) buildSession(
+	start time.Time, lines []string, sourcePath string,
+) *entity.Session {
+	sum := sha256.Sum256([]byte(sourcePath))
+	s := &entity.Session{
+		ID: start.Format(cfgAider.IDTimeLayout) + cfgAider.IDSep +
+			hex.EncodeToString(sum[:])[:cfgAider.IDHashLen],
+		Tool:       session.ToolAider,
+		SourceFile: sourcePath,
+		CWD:        filepath.Dir(sourcePath),
+		StartTime:  start,
+	}
+
+	var user, reply []string
+	var run *entity.ToolResult
+	flushUser := func() {
+		if text := aiderText(user); text != "" {
+			s.Messages = append(s.Messages, entity.Message{
+				Timestamp: start, Role: claude.RoleUser, Text: text,
+			})
+		}
+		user = nil
+	}
+	flushReply := func() {
+		if text := aiderText(reply); text != "" {
+			s.Messages = append(s.Messages, entity.Message{
+				Timestamp: start, Role: claude.RoleAssistant, Text: text,
+			})
+		}
+		reply = nil
+	}
+
+	inFence := false
+	for _, line := range lines {
+		fence := strings.HasPrefix(strings.TrimSpace(line), cfgAider.Fence)
+		if inFence || fence {
+			if fence {
+				inFence = !inFence
+			}
+			flushUser()
+			run = nil
+			reply = append(reply, line)
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, cfgAider.UserPrefix); ok {
+			flushReply()
+			run = nil
+			user = append(user, strings.TrimSpace(rest))
+			continue
+		}
+		rest, quoted := strings.CutPrefix(line, cfgAider.OutputPrefix)
+		if line == cfgAider.OutputEmpty {
+			rest, quoted = "", true
+		}
+		if !quoted {
+			if len(user) > 0 && strings.TrimSpace(line) == "" {
+				continue
+			}
+			flushUser()
+			run = nil
+			reply = append(reply, line)
+			continue
+		}
+		flushUser()
+		flushReply()
+		out := strings.TrimSpace(rest)
+		if tool, input, isTool := aiderAction(out); isTool {
+			run = p.addAction(s, start, tool, input)
+			continue
+		}
+		switch {
+		case strings.HasPrefix(out, cfgAider.ModelPrefix):
+			if s.Model == "" {
+				model := strings.TrimPrefix(out, cfgAider.ModelPrefix)
+				model, _, _ = strings.Cut(model, cfgAider.ModelSep)
+				s.Model = model
+			}
+		case regex.AiderTokens.MatchString(out):
+			m := regex.AiderTokens.FindStringSubmatch(out)
+			if last := lastAssistant(s.Messages); last != nil {
+				last.TokensIn += aiderCount(
+					m[regex.AiderSentNum], m[regex.AiderSentSuffix],
+				)
+				last.TokensOut += aiderCount(
+					m[regex.AiderRecvNum], m[regex.AiderRecvSuffix],
+				)
+			}
+		case run != nil:
+			if run.Content != "" {
+				run.Content += token.NewlineLF
+			}
+			run.Content += out
+		}
+	}
+	flushUser()
+	flushReply()
+
+	if len(s.Messages) == 0 {
+		return nil
+	}
+	finishSession(s)
+	return s
+}
+
+// addAction records an Aider action as a tool use.
+//
+// Shell commands also get an empty result that their output
+// is collected into.
+//
+// Parameters:
+//   - s: Session being built (modified in place)
+//   - ts: Timestamp for new messages
+//   - tool: Action name
+//   - input: Action argument
+//
+// Returns:
+//   - *entity.ToolResult: Result to collect output into, or nil
+func (p *Aider) addAction(
+	s *entity.Session, ts time.Time, tool, input string,
+) *entity.ToolResult {
+	n := 0
+	for _, m := range s.Messages {
+		n += len(m.ToolUses)
+	}
+	id := tool + cfgAider.IDSep + strconv.Itoa(n+1)
+	msg := assistantTail(s, ts)
+	msg.ToolUses = append(msg.ToolUses, entity.ToolUse{
+		ID: id, Name: tool, Input: input,
+	})
+	if tool != cfgAider.ToolRun {
+		return nil
+	}
+	res := resultTail(s, ts)
+	res.ToolResults = append(res.ToolResults, entity.ToolResult{ToolUseID: id})
+	return &res.ToolResults[len(res.ToolResults)-1]
+}
+
+// aiderAction recognizes an edit, commit or shell command in a
+// line of Aider output.
+//
+// Parameters:
+//   - out: Output line without the quote marker
+//
+// Returns:
+//   - string: Tool name
+//   - string: Tool input (file, commit, or command)
+//   - bool: True if the line reports an action
+func aiderAction(out string) (string, string, bool) {
+	actions := []struct{ prefix, tool string }{
+		{cfgAider.EditPrefix, cfgAider.ToolEdit},
+		{cfgAider.CommitPrefix, cfgAider.ToolCommit},
+		{cfgAider.RunPrefix, cfgAider.ToolRun},
+	}
+	for _, a := range actions {
+		if rest, ok := strings.CutPrefix(out, a.prefix); ok {
+			return a.tool, rest, true
+		}
+	}
+	return "", "", false
+}
+
+// aiderText joins buffered lines, dropping surrounding blank
+// lines.
+//
+// Parameters:
+//   - lines: Buffered lines
+//
+// Returns:
+//   - string: The text, or empty if it is blank
+func aiderText(lines []string) string {
+	return strings.TrimSpace(strings.Join(lines, token.NewlineLF))
+}
+
+// aiderCount converts a token count like "2.3" with suffix "k"
+// into a number.
+//
+// Parameters:
+//   - num: Decimal number
+//   - suffix: "", "k", or "M"
+//
+// Returns:
+//   - int: Token count, or 0 if num is invalid
+func aiderCount(num, suffix string) int {
+	n, parseErr := strconv.ParseFloat(num, 64)
+	if parseErr != nil {
+		return 0
+	}
+	switch suffix {
+	case cfgAider.SuffixThousand:
+		n *= cfgAider.Thousand
+	case cfgAider.SuffixMillion:
+		n *= cfgAider.Million
+	}
+	return int(math.Round(n))
+}
@@ -0,0 +1,135 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	cfgCline "github.com/ActiveMemory/ctx/internal/config/cline"
+	cfgCopilot "github.com/ActiveMemory/ctx/internal/config/copilot"
+	cfgCursor "github.com/ActiveMemory/ctx/internal/config/cursor"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// NewCline creates a new Cline task parser.
+//
+// Returns:
+//   - *Cline: a new parser instance
+func NewCline() *Cline {
+	return &Cline{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Cline tool identifier
+func (p *Cline) Tool() string {
+	return session.ToolCline
+}
+
+// Matches returns true if the file is the conversation of a
+// Cline task (tasks/<id>/api_conversation_history.json).
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is a Cline task conversation
+func (p *Cline) Matches(path string) bool {
+	return filepath.Base(path) == cfgCline.FileAPIHistory &&
+		filepath.Base(filepath.Dir(filepath.Dir(path))) == cfgCline.DirTasks
+}
+
+// ParseFile reads a Cline task and returns its session.
+//
+// The conversation supplies the messages; the task's UI events
+// supply timestamps and per-request token counts, and its
+// metadata the model. Both are optional.
+//
+// Parameters:
+//   - path: path to api_conversation_history.json
+//
+// Returns:
+//   - []*entity.Session: the parsed session (at most one)
+//   - error: any error encountered while reading or decoding
+func (p *Cline) ParseFile(path string) ([]*entity.Session, error) {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return nil, errParser.ReadFile(readErr)
+	}
+	var history []claudeRawContent
+	if unmarshalErr := json.Unmarshal(data, &history); unmarshalErr != nil {
+		return nil, errParser.Unmarshal(unmarshalErr)
+	}
+	taskDir := filepath.Dir(path)
+
+	var events []clineRawUIMessage
+	if ui, uiErr := io.SafeReadUserFile(
+		filepath.Join(taskDir, cfgCline.FileUIMessages),
+	); uiErr == nil {
+		_ = json.Unmarshal(ui, &events)
+	}
+	var meta clineRawMetadata
+	if md, mdErr := io.SafeReadUserFile(
+		filepath.Join(taskDir, cfgCline.FileMetadata),
+	); mdErr == nil {
+		_ = json.Unmarshal(md, &meta)
+	}
+
+	s := p.buildSession(history, events, meta, path)
+	if s == nil {
+		return nil, nil
+	}
+	return []*entity.Session{s}, nil
+}
+
+// ParseLine is not meaningful for Cline tasks, which are single
+// JSON documents. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Cline) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// ClineSessionDirs returns the Cline task directories of VS
+// Code (stable and Insiders) and Cursor.
+//
+// Returns:
+//   - []string: tasks directories found on the system
+func ClineSessionDirs() []string {
+	appData := vsCodeAppData()
+	if appData == "" {
+		return nil
+	}
+	var dirs []string
+	editors := []string{
+		cfgCopilot.AppCode, cfgCopilot.AppCodeInsiders, cfgCursor.AppName,
+	}
+	for _, editor := range editors {
+		dir := filepath.Join(
+			appData, editor, cfgCopilot.DirUser, cfgCursor.DirGlobalStorage,
+			cfgCline.ExtensionID, cfgCline.DirTasks,
+		)
+		if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// Ensure Cline implements Session.
+var _ Session = (*Cline)(nil)
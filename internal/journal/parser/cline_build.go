@@ -0,0 +1,109 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	cfgCline "github.com/ActiveMemory/ctx/internal/config/cline"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// buildSession converts a Cline task into a Session.
+//
+// Messages are converted like Claude Code messages. Each
+// assistant reply is paired, in order, with an api_req_started
+// event, which dates it and the user message before it and
+// gives its token counts. The <task> tags and environment
+// details Cline adds to user messages are dropped once the
+// working directory has been read from them.
+//
+// Parameters:
+//   - history: The task conversation
+//   - events: The task's UI events (may be empty)
+//   - meta: The task metadata (may be empty)
+//   - sourcePath: Path to api_conversation_history.json
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no
+//     messages
+func (p *Cline) buildSession(
+	history []claudeRawContent, events []clineRawUIMessage,
+	meta clineRawMetadata, sourcePath string,
+) *entity.Session {
+	s := &entity.Session{
+		ID:         filepath.Base(filepath.Dir(sourcePath)),
+		Tool:       session.ToolCline,
+		SourceFile: sourcePath,
+	}
+	if len(meta.ModelUsage) > 0 {
+		s.Model = meta.ModelUsage[0].ModelID
+	}
+
+	var requests []clineRawUIMessage
+	for _, ev := range events {
+		if ev.Say == cfgCline.SayAPIReqStarted {
+			requests = append(requests, ev)
+		}
+	}
+
+	converter := NewClaudeCode()
+	next := 0
+	for _, raw := range history {
+		msg := converter.convertMessage(claudeRawMessage{
+			Type: raw.Role, Message: raw,
+		})
+		if msg.BelongsToUser() && msg.Text != "" {
+			if s.CWD == "" {
+				if m := regex.ClineCWD.FindStringSubmatch(msg.Text); m != nil {
+					s.CWD = m[1]
+				}
+			}
+			text := regex.ClineEnvDetails.ReplaceAllString(msg.Text, "")
+			text = regex.ClineTaskTag.ReplaceAllString(text, "")
+			msg.Text = strings.TrimSpace(text)
+		}
+		if next < len(requests) {
+			msg.Timestamp = time.UnixMilli(requests[next].TS).UTC()
+		}
+		if msg.BelongsToAssistant() && next < len(requests) {
+			var req clineRawRequest
+			if json.Unmarshal([]byte(requests[next].Text), &req) == nil {
+				msg.TokensIn = req.TokensIn
+				msg.TokensOut = req.TokensOut
+			}
+			next++
+		}
+		if msg.Role != claude.RoleUser && msg.Role != claude.RoleAssistant {
+			continue
+		}
+		s.Messages = append(s.Messages, msg)
+	}
+
+	if len(s.Messages) == 0 {
+		return nil
+	}
+	finishSession(s)
+	if len(events) > 0 {
+		first := time.UnixMilli(events[0].TS).UTC()
+		last := time.UnixMilli(events[len(events)-1].TS).UTC()
+		if first.Before(s.StartTime) || s.StartTime.IsZero() {
+			s.StartTime = first
+		}
+		if last.After(s.EndTime) {
+			s.EndTime = last
+		}
+		s.Duration = s.EndTime.Sub(s.StartTime)
+	}
+	return s
+}
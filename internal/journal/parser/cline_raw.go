@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+// clineRawUIMessage is one event of a task's ui_messages.json.
+type clineRawUIMessage struct {
+	TS   int64  `json:"ts"`
+	Type string `json:"type"`
+	Say  string `json:"say"`
+	Text string `json:"text"`
+}
+
+// clineRawRequest is the payload of an api_req_started event.
+type clineRawRequest struct {
+	TokensIn  int `json:"tokensIn"`
+	TokensOut int `json:"tokensOut"`
+}
+
+// clineRawMetadata is a task's task_metadata.json.
+type clineRawMetadata struct {
+	ModelUsage []struct {
+		ModelID string `json:"model_id"`
+	} `json:"model_usage"`
+}
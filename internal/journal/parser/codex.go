@@ -0,0 +1,165 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cfgCodex "github.com/ActiveMemory/ctx/internal/config/codex"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgParser "github.com/ActiveMemory/ctx/internal/config/parser"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// NewCodex creates a new Codex CLI session parser.
+//
+// Returns:
+//   - *Codex: a new parser instance
+func NewCodex() *Codex {
+	return &Codex{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Codex CLI tool identifier
+func (p *Codex) Tool() string {
+	return session.ToolCodex
+}
+
+// Matches returns true if the file is a Codex CLI rollout.
+//
+// Rollout files are JSONL files named rollout-*.jsonl whose
+// first line is a session_meta record.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is a Codex CLI rollout
+func (p *Codex) Matches(path string) bool {
+	base := filepath.Base(path)
+	if !strings.HasPrefix(base, cfgCodex.FilePrefix) ||
+		!strings.HasSuffix(base, file.ExtJSONL) {
+		return false
+	}
+	f, openErr := io.SafeOpenUserFile(path)
+	if openErr != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(
+		make([]byte, 0, cfgParser.BufInitSize), cfgParser.BufMaxSizeSchema,
+	)
+	if !scanner.Scan() {
+		return false
+	}
+	var line codexRawLine
+	if unmarshalErr := json.Unmarshal(
+		scanner.Bytes(), &line,
+	); unmarshalErr != nil {
+		return false
+	}
+	return line.Type == cfgCodex.LineSessionMeta
+}
+
+// ParseFile reads a Codex CLI rollout and returns its session.
+//
+// Each rollout holds one session. Messages, reasoning summaries,
+// tool calls and their outputs become messages in order; the
+// last token_count event supplies the session's token totals.
+//
+// Parameters:
+//   - path: path to the rollout file
+//
+// Returns:
+//   - []*entity.Session: the parsed session (at most one)
+//   - error: any error encountered while reading
+func (p *Codex) ParseFile(path string) ([]*entity.Session, error) {
+	f, openErr := io.SafeOpenUserFile(path)
+	if openErr != nil {
+		return nil, errParser.OpenFile(openErr)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			warn.Warn(cfgWarn.Close, path, closeErr)
+		}
+	}()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(
+		make([]byte, 0, cfgParser.BufInitSize), cfgParser.BufMaxSizeSchema,
+	)
+	var lines []codexRawLine
+	for scanner.Scan() {
+		var line codexRawLine
+		if unmarshalErr := json.Unmarshal(
+			scanner.Bytes(), &line,
+		); unmarshalErr != nil {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if scanErr := scanner.Err(); scanErr != nil {
+		return nil, errParser.ScanFile(scanErr)
+	}
+
+	s := p.buildSession(lines, path)
+	if s == nil {
+		return nil, nil
+	}
+	return []*entity.Session{s}, nil
+}
+
+// ParseLine is not meaningful for Codex rollouts, which are
+// parsed as a whole. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Codex) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// CodexSessionDirs returns the directory where Codex CLI keeps
+// its rollouts: $CODEX_HOME/sessions, or ~/.codex/sessions.
+//
+// Returns:
+//   - []string: the sessions directory, if it exists
+func CodexSessionDirs() []string {
+	home := os.Getenv(cfgCodex.EnvHome)
+	if home == "" {
+		userHome, homeErr := os.UserHomeDir()
+		if homeErr != nil {
+			return nil
+		}
+		home = filepath.Join(userHome, cfgCodex.DirHome)
+	}
+	dir := filepath.Join(home, cfgCodex.DirSessions)
+	if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+		return []string{dir}
+	}
+	return nil
+}
+
+// Ensure Codex implements Session.
+var _ Session = (*Codex)(nil)
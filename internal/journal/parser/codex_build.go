@@ -0,0 +1,225 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	cfgCodex "github.com/ActiveMemory/ctx/internal/config/codex"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// buildSession converts the lines of a Codex rollout into a
+// Session.
+//
+// Reasoning summaries are held until the next assistant item
+// and attached as its thinking. Tool calls join the preceding
+// assistant message; tool outputs are grouped into tool-result
+// messages, the same shape Claude Code transcripts use.
+//
+// Parameters:
+//   - lines: decoded rollout lines in file order
+//   - sourcePath: path to the rollout file
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no
+//     messages
+func (p *Codex) buildSession(
+	lines []codexRawLine, sourcePath string,
+) *entity.Session {
+	s := &entity.Session{
+		ID:         strings.TrimSuffix(filepath.Base(sourcePath), file.ExtJSONL),
+		Tool:       session.ToolCodex,
+		SourceFile: sourcePath,
+	}
+	var thinking []string
+
+	for _, line := range lines {
+		switch line.Type {
+		case cfgCodex.LineSessionMeta:
+			var meta codexRawMeta
+			if json.Unmarshal(line.Payload, &meta) != nil {
+				continue
+			}
+			if meta.ID != "" {
+				s.ID = meta.ID
+			}
+			s.CWD = meta.CWD
+			if meta.Git != nil {
+				s.GitBranch = meta.Git.Branch
+			}
+		case cfgCodex.LineTurnContext:
+			var tc codexRawTurnContext
+			if json.Unmarshal(line.Payload, &tc) != nil {
+				continue
+			}
+			if s.Model == "" {
+				s.Model = tc.Model
+			}
+			if s.CWD == "" {
+				s.CWD = tc.CWD
+			}
+		case cfgCodex.LineEventMsg:
+			var ev codexRawEvent
+			if json.Unmarshal(line.Payload, &ev) != nil ||
+				ev.Type != cfgCodex.EventTokenCount || ev.Info == nil {
+				continue
+			}
+			s.TotalTokensIn = ev.Info.Total.InputTokens
+			s.TotalTokensOut = ev.Info.Total.OutputTokens
+			if last := lastAssistant(s.Messages); last != nil {
+				last.TokensIn += ev.Info.Last.InputTokens
+				last.TokensOut += ev.Info.Last.OutputTokens
+			}
+		case cfgCodex.LineResponseItem:
+			var item codexRawItem
+			if json.Unmarshal(line.Payload, &item) != nil {
+				continue
+			}
+			thinking = p.addItem(s, line, item, thinking)
+		}
+	}
+
+	if len(s.Messages) == 0 {
+		return nil
+	}
+	finishSession(s)
+	return s
+}
+
+// addItem appends one response item to the session.
+//
+// Parameters:
+//   - s: Session being built (modified in place)
+//   - line: Envelope of the item, for its timestamp
+//   - item: Decoded response item
+//   - thinking: Reasoning summaries not yet attached
+//
+// Returns:
+//   - []string: Reasoning summaries still pending
+func (p *Codex) addItem(
+	s *entity.Session, line codexRawLine, item codexRawItem,
+	thinking []string,
+) []string {
+	switch item.Type {
+	case cfgCodex.ItemReasoning:
+		for _, part := range item.Summary {
+			thinking = append(thinking, part.Text)
+		}
+		return thinking
+
+	case cfgCodex.ItemMessage:
+		text := codexText(item.Content)
+		switch item.Role {
+		case claude.RoleUser:
+			if text == "" || codexInjected(text) {
+				return thinking
+			}
+		case claude.RoleAssistant:
+		default:
+			return thinking
+		}
+		msg := entity.Message{
+			Timestamp: line.Timestamp,
+			Role:      item.Role,
+			Text:      text,
+		}
+		if item.Role == claude.RoleAssistant {
+			msg.Thinking = strings.Join(thinking, token.NewlineLF)
+			thinking = nil
+		}
+		s.Messages = append(s.Messages, msg)
+
+	case cfgCodex.ItemFunctionCall, cfgCodex.ItemCustomToolCall:
+		input := item.Arguments
+		if input == "" {
+			input = item.Input
+		}
+		msg := assistantTail(s, line.Timestamp)
+		msg.ToolUses = append(msg.ToolUses, entity.ToolUse{
+			ID: item.CallID, Name: item.Name, Input: input,
+		})
+		if len(thinking) > 0 {
+			msg.Thinking = strings.Join(thinking, token.NewlineLF)
+			thinking = nil
+		}
+
+	case cfgCodex.ItemFunctionCallOutput, cfgCodex.ItemCustomToolCallOutput:
+		content, isErr := codexOutput(item.Output)
+		msg := resultTail(s, line.Timestamp)
+		msg.ToolResults = append(msg.ToolResults, entity.ToolResult{
+			ToolUseID: item.CallID, Content: content, IsError: isErr,
+		})
+	}
+	return thinking
+}
+
+// codexText joins the text parts of a message.
+//
+// Parameters:
+//   - parts: Content parts
+//
+// Returns:
+//   - string: Texts joined by newlines
+func codexText(parts []codexRawContent) string {
+	texts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part.Text != "" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, token.NewlineLF)
+}
+
+// codexInjected reports whether a user message was written by
+// Codex itself (environment or instruction context).
+//
+// Parameters:
+//   - text: Message text
+//
+// Returns:
+//   - bool: True for injected context
+func codexInjected(text string) bool {
+	trimmed := strings.TrimSpace(text)
+	for _, prefix := range cfgCodex.InjectedPrefixes {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// codexOutput decodes a tool output.
+//
+// Codex stores shell output as a JSON string that itself holds
+// {"output": ..., "metadata": {"exit_code": N}}; other tools
+// store plain text.
+//
+// Parameters:
+//   - raw: Output field of the item
+//
+// Returns:
+//   - string: Output text
+//   - bool: True when the command exited non-zero
+func codexOutput(raw json.RawMessage) (string, bool) {
+	var text string
+	if json.Unmarshal(raw, &text) != nil {
+		text = string(raw)
+	}
+	var structured codexRawOutput
+	if json.Unmarshal([]byte(text), &structured) == nil &&
+		structured.Output != "" {
+		return structured.Output, structured.Metadata.ExitCode != 0
+	}
+	return text, false
+}
@@ -0,0 +1,99 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// codexRawLine is one line of a Codex CLI rollout file.
+//
+// Fields:
+//   - Timestamp: When the line was written
+//   - Type: Line type (session_meta, turn_context, ...)
+//   - Payload: Type-specific body
+type codexRawLine struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// codexRawMeta is the session_meta payload.
+type codexRawMeta struct {
+	ID  string       `json:"id"`
+	CWD string       `json:"cwd"`
+	Git *codexRawGit `json:"git,omitempty"`
+}
+
+// codexRawGit is the git state recorded at session start.
+type codexRawGit struct {
+	Branch string `json:"branch"`
+}
+
+// codexRawTurnContext is the turn_context payload.
+type codexRawTurnContext struct {
+	CWD   string `json:"cwd"`
+	Model string `json:"model"`
+}
+
+// codexRawItem is a response_item payload.
+//
+// Fields:
+//   - Type: message, reasoning, function_call, ...
+//   - Role: user or assistant (message items)
+//   - Content: Text parts (message items)
+//   - Summary: Reasoning summary parts (reasoning items)
+//   - Name: Tool name (call items)
+//   - Arguments: JSON arguments (function_call)
+//   - Input: Free-form input (custom_tool_call)
+//   - CallID: Links a call to its output
+//   - Output: String output, possibly JSON-encoded
+type codexRawItem struct {
+	Type      string            `json:"type"`
+	Role      string            `json:"role,omitempty"`
+	Content   []codexRawContent `json:"content,omitempty"`
+	Summary   []codexRawContent `json:"summary,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Arguments string            `json:"arguments,omitempty"`
+	Input     string            `json:"input,omitempty"`
+	CallID    string            `json:"call_id,omitempty"`
+	Output    json.RawMessage   `json:"output,omitempty"`
+}
+
+// codexRawContent is one text part of a message or reasoning
+// summary.
+type codexRawContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// codexRawOutput is the structured form of a tool output.
+type codexRawOutput struct {
+	Output   string `json:"output"`
+	Metadata struct {
+		ExitCode int `json:"exit_code"`
+	} `json:"metadata"`
+}
+
+// codexRawEvent is an event_msg payload.
+type codexRawEvent struct {
+	Type string             `json:"type"`
+	Info *codexRawTokenInfo `json:"info,omitempty"`
+}
+
+// codexRawTokenInfo carries cumulative and per-turn token usage.
+type codexRawTokenInfo struct {
+	Total codexRawUsage `json:"total_token_usage"`
+	Last  codexRawUsage `json:"last_token_usage"`
+}
+
+// codexRawUsage is a token usage report.
+type codexRawUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
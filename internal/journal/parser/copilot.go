@@ -10,11 +10,9 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
-	"runtime"
 	"strings"
 
 	cfgCopilot "github.com/ActiveMemory/ctx/internal/config/copilot"
-	"github.com/ActiveMemory/ctx/internal/config/env"
 	"github.com/ActiveMemory/ctx/internal/config/file"
 	"github.com/ActiveMemory/ctx/internal/config/session"
 	"github.com/ActiveMemory/ctx/internal/entity"
@@ -195,23 +193,7 @@ func (p *Copilot) ParseLine(_ []byte) (*entity.Message, string, error) {
 func CopilotSessionDirs() []string {
 	var dirs []string
 
-	appData := os.Getenv(cfgCopilot.EnvAppData)
-	if runtime.GOOS != env.OSWindows {
-		// On macOS/Linux, VS Code stores data in different locations
-		home, homeErr := os.UserHomeDir()
-		if homeErr != nil {
-			return nil
-		}
-		switch runtime.GOOS {
-		case cfgCopilot.OSDarwin:
-			appData = filepath.Join(
-				home, cfgCopilot.DirLibrary,
-				cfgCopilot.DirAppSupport)
-		default: // Linux
-			appData = filepath.Join(home, cfgCopilot.DirDotConfig)
-		}
-	}
-
+	appData := vsCodeAppData()
 	if appData == "" {
 		return nil
 	}
@@ -9,6 +9,7 @@ package parser
 import (
 	"encoding/json"
 	"net/url"
+	"os"
 	"path/filepath"
 	"runtime"
 
@@ -85,3 +86,26 @@ func fileURIToPath(uri string) string {
 
 	return filepath.FromSlash(decoded)
 }
+
+// vsCodeAppData returns the per-user application data directory
+// that VS Code and its forks (Cursor) store their User/ data in.
+//
+// Returns:
+//   - string: %APPDATA% on Windows, ~/Library/Application Support
+//     on macOS, ~/.config elsewhere; empty if it cannot be
+//     determined
+func vsCodeAppData() string {
+	if runtime.GOOS == env.OSWindows {
+		return os.Getenv(cfgCopilot.EnvAppData)
+	}
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		return ""
+	}
+	if runtime.GOOS == cfgCopilot.OSDarwin {
+		return filepath.Join(
+			home, cfgCopilot.DirLibrary, cfgCopilot.DirAppSupport,
+		)
+	}
+	return filepath.Join(home, cfgCopilot.DirDotConfig)
+}
@@ -0,0 +1,122 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+
+	cfgCopilot "github.com/ActiveMemory/ctx/internal/config/copilot"
+	cfgCursor "github.com/ActiveMemory/ctx/internal/config/cursor"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// NewCursor creates a new Cursor session parser.
+//
+// Returns:
+//   - *Cursor: a new parser instance
+func NewCursor() *Cursor {
+	return &Cursor{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Cursor tool identifier
+func (p *Cursor) Tool() string {
+	return session.ToolCursor
+}
+
+// Matches returns true if the file is Cursor's global state
+// database (globalStorage/state.vscdb).
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is Cursor's chat store
+func (p *Cursor) Matches(path string) bool {
+	return filepath.Base(path) == cfgCursor.FileStateDB &&
+		filepath.Base(filepath.Dir(path)) == cfgCursor.DirGlobalStorage
+}
+
+// ParseFile reads every conversation in a Cursor state database.
+//
+// Conversations are matched to their workspace folder through
+// the workspaceStorage databases next to the global one.
+// Reading requires the sqlite3 command-line tool.
+//
+// Parameters:
+//   - path: path to globalStorage/state.vscdb
+//
+// Returns:
+//   - []*entity.Session: one session per non-empty conversation
+//   - error: non-nil if sqlite3 is missing or a query fails
+func (p *Cursor) ParseFile(path string) ([]*entity.Session, error) {
+	composerRows, composerErr := cursorRows(path, cfgCursor.QueryComposers)
+	if composerErr != nil {
+		return nil, composerErr
+	}
+	bubbleRows, bubbleErr := cursorRows(path, cfgCursor.QueryBubbles)
+	if bubbleErr != nil {
+		return nil, bubbleErr
+	}
+
+	bubbles := make(map[string]string, len(bubbleRows))
+	for _, row := range bubbleRows {
+		id := strings.TrimPrefix(row.Key, cfgCursor.KeyBubblePrefix)
+		bubbles[id] = row.Value
+	}
+	cwds := p.workspaceFolders(path)
+
+	var sessions []*entity.Session
+	for _, row := range composerRows {
+		if s := p.buildSession(row.Value, bubbles, cwds, path); s != nil {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions, nil
+}
+
+// ParseLine is not meaningful for Cursor's database. Returns nil
+// for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Cursor) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// CursorSessionDirs returns Cursor's globalStorage directory,
+// which holds the state database with its conversations.
+//
+// Returns:
+//   - []string: the directory, if it exists
+func CursorSessionDirs() []string {
+	appData := vsCodeAppData()
+	if appData == "" {
+		return nil
+	}
+	dir := filepath.Join(
+		appData, cfgCursor.AppName,
+		cfgCopilot.DirUser, cfgCursor.DirGlobalStorage,
+	)
+	if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+		return []string{dir}
+	}
+	return nil
+}
+
+// Ensure Cursor implements Session.
+var _ Session = (*Cursor)(nil)
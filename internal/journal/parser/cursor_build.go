@@ -0,0 +1,250 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	cfgCopilot "github.com/ActiveMemory/ctx/internal/config/copilot"
+	cfgCursor "github.com/ActiveMemory/ctx/internal/config/cursor"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/exec/sqlite"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// cursorRows runs a key/value query against a state database.
+//
+// Parameters:
+//   - db: Path to the database
+//   - query: One of the cfgCursor queries
+//
+// Returns:
+//   - []cursorRawRow: Result rows
+//   - error: Non-nil if the query fails or its output is invalid
+func cursorRows(db, query string) ([]cursorRawRow, error) {
+	out, queryErr := sqlite.Query(db, query)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	var rows []cursorRawRow
+	if unmarshalErr := json.Unmarshal(out, &rows); unmarshalErr != nil {
+		return nil, errParser.Unmarshal(unmarshalErr)
+	}
+	return rows, nil
+}
+
+// workspaceFolders maps conversation IDs to the folder of the
+// workspace they were held in.
+//
+// Each workspaceStorage/<hash>/ directory pairs a workspace.json
+// naming the folder with a state.vscdb listing the workspace's
+// conversations. Unreadable workspaces are skipped.
+//
+// Parameters:
+//   - globalDB: Path to globalStorage/state.vscdb
+//
+// Returns:
+//   - map[string]string: Conversation ID to workspace folder
+func (p *Cursor) workspaceFolders(globalDB string) map[string]string {
+	cwds := make(map[string]string)
+	userDir := filepath.Dir(filepath.Dir(globalDB))
+	wsRoot := filepath.Join(userDir, cfgCopilot.DirWorkspace)
+	entries, readErr := os.ReadDir(wsRoot)
+	if readErr != nil {
+		return cwds
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		wsDir := filepath.Join(wsRoot, entry.Name())
+		data, wsErr := io.SafeReadUserFile(
+			filepath.Join(wsDir, cfgCopilot.FileWorkspace),
+		)
+		if wsErr != nil {
+			continue
+		}
+		var ws copilotRawWorkspace
+		if json.Unmarshal(data, &ws) != nil {
+			continue
+		}
+		folder := fileURIToPath(ws.Folder)
+		if folder == "" {
+			continue
+		}
+		rows, queryErr := cursorRows(
+			filepath.Join(wsDir, cfgCursor.FileStateDB),
+			cfgCursor.QueryWorkspaceComposers,
+		)
+		if queryErr != nil {
+			continue
+		}
+		for _, row := range rows {
+			var list cursorRawWorkspaceComposers
+			if json.Unmarshal([]byte(row.Value), &list) != nil {
+				continue
+			}
+			for _, c := range list.AllComposers {
+				cwds[c.ComposerID] = folder
+			}
+		}
+	}
+	return cwds
+}
+
+// buildSession converts one conversation into a Session.
+//
+// Parameters:
+//   - header: JSON of the conversation header
+//   - bubbles: Bubble JSON keyed by "<composer>:<bubble>"
+//   - cwds: Conversation ID to workspace folder
+//   - sourcePath: Path to the global database
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if the
+//     conversation has no messages
+func (p *Cursor) buildSession(
+	header string, bubbles, cwds map[string]string, sourcePath string,
+) *entity.Session {
+	var c cursorRawComposer
+	if json.Unmarshal([]byte(header), &c) != nil || c.ComposerID == "" {
+		return nil
+	}
+	s := &entity.Session{
+		ID:         c.ComposerID,
+		Slug:       c.Name,
+		Tool:       session.ToolCursor,
+		SourceFile: sourcePath,
+		CWD:        cwds[c.ComposerID],
+		StartTime:  cursorTime(c.CreatedAt),
+		EndTime:    cursorTime(c.LastUpdated),
+	}
+	if c.ModelConfig != nil {
+		s.Model = c.ModelConfig.ModelName
+	}
+
+	for _, b := range p.bubbles(c, bubbles) {
+		ts := cursorTime(b.CreatedAt)
+		if ts.IsZero() {
+			ts = s.StartTime
+		}
+		msg := entity.Message{ID: b.BubbleID, Timestamp: ts, Text: b.Text}
+		switch b.Type {
+		case cfgCursor.BubbleUser:
+			if b.Text == "" {
+				continue
+			}
+			msg.Role = claude.RoleUser
+			s.Messages = append(s.Messages, msg)
+			continue
+		case cfgCursor.BubbleAssistant:
+			msg.Role = claude.RoleAssistant
+		default:
+			continue
+		}
+		if b.Thinking != nil {
+			msg.Thinking = b.Thinking.Text
+		}
+		if b.TokenCount != nil {
+			msg.TokensIn = b.TokenCount.InputTokens
+			msg.TokensOut = b.TokenCount.OutputTokens
+		}
+		if b.Tool == nil {
+			if msg.Text != "" || msg.Thinking != "" {
+				s.Messages = append(s.Messages, msg)
+			}
+			continue
+		}
+		msg.ToolUses = []entity.ToolUse{{
+			ID: b.Tool.ToolCallID, Name: b.Tool.Name, Input: b.Tool.RawArgs,
+		}}
+		s.Messages = append(s.Messages, msg, entity.Message{
+			Timestamp: ts,
+			Role:      claude.RoleUser,
+			ToolResults: []entity.ToolResult{{
+				ToolUseID: b.Tool.ToolCallID,
+				Content:   b.Tool.Result,
+				IsError:   b.Tool.Status == cfgCursor.ToolStatusError,
+			}},
+		})
+	}
+
+	if len(s.Messages) == 0 {
+		return nil
+	}
+	finishSession(s)
+	return s
+}
+
+// bubbles returns a conversation's messages in order.
+//
+// Parameters:
+//   - c: Conversation header
+//   - stored: Bubble JSON keyed by "<composer>:<bubble>"
+//
+// Returns:
+//   - []cursorRawBubble: Inline or stored bubbles, in
+//     conversation order; missing bubbles are skipped
+func (p *Cursor) bubbles(
+	c cursorRawComposer, stored map[string]string,
+) []cursorRawBubble {
+	if len(c.Headers) == 0 {
+		return c.Conversation
+	}
+	out := make([]cursorRawBubble, 0, len(c.Headers))
+	for _, h := range c.Headers {
+		data, ok := stored[c.ComposerID+cfgCursor.KeySep+h.BubbleID]
+		if !ok {
+			continue
+		}
+		var b cursorRawBubble
+		if json.Unmarshal([]byte(data), &b) != nil {
+			continue
+		}
+		if b.BubbleID == "" {
+			b.BubbleID = h.BubbleID
+		}
+		if b.Type == 0 {
+			b.Type = h.Type
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// cursorTime decodes a Cursor timestamp, stored either as Unix
+// milliseconds or as an RFC 3339 string.
+//
+// Parameters:
+//   - raw: Timestamp field
+//
+// Returns:
+//   - time.Time: The time in UTC, or zero if absent or invalid
+func cursorTime(raw json.RawMessage) time.Time {
+	var ms int64
+	if json.Unmarshal(raw, &ms) == nil && ms > 0 {
+		return time.UnixMilli(ms).UTC()
+	}
+	var text string
+	if json.Unmarshal(raw, &text) != nil {
+		return time.Time{}
+	}
+	t, parseErr := time.Parse(time.RFC3339, text)
+	if parseErr != nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
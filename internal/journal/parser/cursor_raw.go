@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import "encoding/json"
+
+// cursorRawRow is one key/value row returned by sqlite3 -json.
+type cursorRawRow struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// cursorRawComposer is a Cursor conversation header.
+//
+// Newer Cursor versions list the bubbles in Headers and store
+// them under separate keys; older versions inline them in
+// Conversation.
+type cursorRawComposer struct {
+	ComposerID   string            `json:"composerId"`
+	Name         string            `json:"name"`
+	CreatedAt    json.RawMessage   `json:"createdAt"`
+	LastUpdated  json.RawMessage   `json:"lastUpdatedAt"`
+	Headers      []cursorRawHeader `json:"fullConversationHeadersOnly"`
+	Conversation []cursorRawBubble `json:"conversation"`
+	ModelConfig  *struct {
+		ModelName string `json:"modelName"`
+	} `json:"modelConfig"`
+}
+
+// cursorRawHeader references one bubble of a conversation.
+type cursorRawHeader struct {
+	BubbleID string `json:"bubbleId"`
+	Type     int    `json:"type"`
+}
+
+// cursorRawBubble is one message of a Cursor conversation.
+type cursorRawBubble struct {
+	BubbleID   string          `json:"bubbleId"`
+	Type       int             `json:"type"`
+	Text       string          `json:"text"`
+	CreatedAt  json.RawMessage `json:"createdAt"`
+	TokenCount *struct {
+		InputTokens  int `json:"inputTokens"`
+		OutputTokens int `json:"outputTokens"`
+	} `json:"tokenCount"`
+	Thinking *struct {
+		Text string `json:"text"`
+	} `json:"thinking"`
+	Tool *cursorRawTool `json:"toolFormerData"`
+}
+
+// cursorRawTool is the tool call carried by an assistant bubble.
+type cursorRawTool struct {
+	ToolCallID string `json:"toolCallId"`
+	Name       string `json:"name"`
+	RawArgs    string `json:"rawArgs"`
+	Result     string `json:"result"`
+	Status     string `json:"status"`
+}
+
+// cursorRawWorkspaceComposers is a workspace's conversation
+// list.
+type cursorRawWorkspaceComposers struct {
+	AllComposers []struct {
+		ComposerID string `json:"composerId"`
+	} `json:"allComposers"`
+}
@@ -21,6 +21,18 @@
 //     the workspace state directory.
 //   - **Copilot CLI** writes a different, JSON-with-metadata layout
 //     under its own home tree.
+//   - **Codex CLI** writes one JSONL rollout per session under
+//     `~/.codex/sessions/YYYY/MM/DD/`.
+//   - **Gemini CLI** writes one JSON document per session under
+//     `~/.gemini/tmp/<project-hash>/chats/`; the working
+//     directory is recovered from the project hash.
+//   - **Cursor** keeps every conversation in the SQLite database
+//     `User/globalStorage/state.vscdb`, read with the `sqlite3`
+//     command-line tool.
+//   - **Aider** appends every session to
+//     `.aider.chat.history.md` in the project root.
+//   - **Cline** keeps one directory per task under the editor's
+//     globalStorage, in Anthropic message format.
 //   - **MarkdownSession** is the round-trip format ctx itself
 //     produces when an enriched journal entry is *re-imported*; it
 //     parses the YAML frontmatter + body that
@@ -46,7 +58,8 @@
 //     so callers can surface them to the user.
 //
 // Tool-specific constructors ([NewClaudeCode], [NewCopilot],
-// [NewCopilotCLI], [NewMarkdownSession]) are exported for callers
+// [NewCopilotCLI], [NewCodex], [NewGemini], [NewCursor],
+// [NewAider], [NewCline], [NewMarkdownSession]) are exported for callers
 // that need to operate on a known format directly (tests, format
 // converters, the schema validator).
 //
@@ -59,7 +72,8 @@
 // parser whether it `Matches(path)`. Implementations may check
 // extension, directory shape, or peek at the first line; order in
 // the slice matters when a file could plausibly match more than one
-// (in practice, the four formats are disjoint).
+// (Aider's transcript is Markdown, so [NewAider] is registered
+// ahead of [NewMarkdownSession]; the other formats are disjoint).
 //
 // **Adding a new tool**: implement the four interface methods on a
 // new type, then append a constructor call to `registeredParsers`
@@ -0,0 +1,77 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// finishSession fills the rollups every parser shares once the
+// messages are in place: project name, timing, turn count,
+// first-message preview, token totals, and the error flag.
+//
+// Only user messages with text count as turns, so tool-result
+// carriers do not inflate the count. Token totals are summed
+// from the messages unless the parser already set them from a
+// session-level report.
+//
+// Parameters:
+//   - s: Session to complete (modified in place)
+func finishSession(s *entity.Session) {
+	if s.Project == "" && s.CWD != "" {
+		s.Project = filepath.Base(s.CWD)
+	}
+	sumTokens := s.TotalTokensIn == 0 && s.TotalTokensOut == 0
+	for _, msg := range s.Messages {
+		if !msg.Timestamp.IsZero() {
+			if s.StartTime.IsZero() || msg.Timestamp.Before(s.StartTime) {
+				s.StartTime = msg.Timestamp
+			}
+			if msg.Timestamp.After(s.EndTime) {
+				s.EndTime = msg.Timestamp
+			}
+		}
+		if msg.BelongsToUser() && msg.Text != "" {
+			s.TurnCount++
+			if s.FirstUserMsg == "" {
+				s.FirstUserMsg = preview(msg.Text)
+			}
+		}
+		if sumTokens {
+			s.TotalTokensIn += msg.TokensIn
+			s.TotalTokensOut += msg.TokensOut
+		}
+		for _, tr := range msg.ToolResults {
+			if tr.IsError {
+				s.HasErrors = true
+			}
+		}
+	}
+	if s.EndTime.Before(s.StartTime) {
+		s.EndTime = s.StartTime
+	}
+	s.Duration = s.EndTime.Sub(s.StartTime)
+	s.TotalTokens = s.TotalTokensIn + s.TotalTokensOut
+}
+
+// preview truncates text to the session preview length.
+//
+// Parameters:
+//   - text: Message text
+//
+// Returns:
+//   - string: Text, cut at session.PreviewMaxLen with an ellipsis
+func preview(text string) string {
+	if len(text) > session.PreviewMaxLen {
+		return text[:session.PreviewMaxLen] + token.Ellipsis
+	}
+	return text
+}
@@ -0,0 +1,116 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	cfgGemini "github.com/ActiveMemory/ctx/internal/config/gemini"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// NewGemini creates a new Gemini CLI session parser.
+//
+// Returns:
+//   - *Gemini: a new parser instance
+func NewGemini() *Gemini {
+	return &Gemini{}
+}
+
+// Tool returns the tool identifier for this parser.
+//
+// Returns:
+//   - string: the Gemini CLI tool identifier
+func (p *Gemini) Tool() string {
+	return session.ToolGemini
+}
+
+// Matches returns true if the file is a Gemini CLI chat log.
+//
+// Chat logs are JSON files named session-*.json inside a
+// chats/ directory.
+//
+// Parameters:
+//   - path: file path to check
+//
+// Returns:
+//   - bool: true if the file is a Gemini CLI chat log
+func (p *Gemini) Matches(path string) bool {
+	base := filepath.Base(path)
+	return strings.HasPrefix(base, cfgGemini.FilePrefix) &&
+		strings.HasSuffix(base, file.ExtJSON) &&
+		filepath.Base(filepath.Dir(path)) == cfgGemini.DirChats
+}
+
+// ParseFile reads a Gemini CLI chat log and returns its session.
+//
+// The log does not record the working directory; sessions are
+// tied to a project through the project hash instead (see
+// FindSessionsForCWD).
+//
+// Parameters:
+//   - path: path to the chat log
+//
+// Returns:
+//   - []*entity.Session: the parsed session (at most one)
+//   - error: any error encountered while reading or decoding
+func (p *Gemini) ParseFile(path string) ([]*entity.Session, error) {
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return nil, errParser.ReadFile(readErr)
+	}
+	var raw geminiRawSession
+	if unmarshalErr := json.Unmarshal(data, &raw); unmarshalErr != nil {
+		return nil, errParser.Unmarshal(unmarshalErr)
+	}
+	s := p.buildSession(raw, path)
+	if s == nil {
+		return nil, nil
+	}
+	return []*entity.Session{s}, nil
+}
+
+// ParseLine is not meaningful for Gemini chat logs, which are
+// single JSON documents. Returns nil for all lines.
+//
+// Parameters:
+//   - line: the raw line bytes (unused)
+//
+// Returns:
+//   - *entity.Message: always nil
+//   - string: always empty
+//   - error: always nil
+func (p *Gemini) ParseLine(_ []byte) (*entity.Message, string, error) {
+	return nil, "", nil
+}
+
+// GeminiSessionDirs returns the directory holding Gemini CLI's
+// per-project data: ~/.gemini/tmp.
+//
+// Returns:
+//   - []string: the directory, if it exists
+func GeminiSessionDirs() []string {
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		return nil
+	}
+	dir := filepath.Join(home, cfgGemini.DirHome, cfgGemini.DirTmp)
+	if info, statErr := io.SafeStat(dir); statErr == nil && info.IsDir() {
+		return []string{dir}
+	}
+	return nil
+}
+
+// Ensure Gemini implements Session.
+var _ Session = (*Gemini)(nil)
@@ -0,0 +1,202 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	cfgGemini "github.com/ActiveMemory/ctx/internal/config/gemini"
+	"github.com/ActiveMemory/ctx/internal/config/session"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// buildSession converts a Gemini CLI session document into a
+// Session.
+//
+// Each model reply becomes an assistant message carrying its
+// thoughts and tool calls; the recorded tool results follow as a
+// tool-result message.
+//
+// Parameters:
+//   - raw: Decoded session document
+//   - sourcePath: Path to the chat log
+//
+// Returns:
+//   - *entity.Session: the built session, or nil if it has no
+//     messages
+func (p *Gemini) buildSession(
+	raw geminiRawSession, sourcePath string,
+) *entity.Session {
+	id := raw.SessionID
+	if id == "" {
+		id = strings.TrimSuffix(filepath.Base(sourcePath), filepath.Ext(sourcePath))
+	}
+	s := &entity.Session{
+		ID:         id,
+		Tool:       session.ToolGemini,
+		SourceFile: sourcePath,
+		StartTime:  raw.StartTime,
+		EndTime:    raw.LastUpdated,
+	}
+
+	for _, m := range raw.Messages {
+		switch m.Type {
+		case cfgGemini.MsgUser:
+			text := geminiContent(m.Content)
+			if text == "" {
+				continue
+			}
+			s.Messages = append(s.Messages, entity.Message{
+				ID: m.ID, Timestamp: m.Timestamp,
+				Role: claude.RoleUser, Text: text,
+			})
+		case cfgGemini.MsgGemini:
+			p.addReply(s, m)
+		}
+	}
+
+	if len(s.Messages) == 0 {
+		return nil
+	}
+	finishSession(s)
+	return s
+}
+
+// addReply appends a model reply and its tool results.
+//
+// Thought tokens are billed as output, so they count toward the
+// message's output tokens.
+//
+// Parameters:
+//   - s: Session being built (modified in place)
+//   - m: The model reply
+func (p *Gemini) addReply(s *entity.Session, m geminiRawMessage) {
+	if s.Model == "" {
+		s.Model = m.Model
+	}
+	msg := entity.Message{
+		ID:        m.ID,
+		Timestamp: m.Timestamp,
+		Role:      claude.RoleAssistant,
+		Text:      geminiContent(m.Content),
+	}
+	thoughts := make([]string, 0, len(m.Thoughts))
+	for _, t := range m.Thoughts {
+		thoughts = append(thoughts, strings.TrimSpace(
+			t.Subject+token.NewlineLF+t.Description,
+		))
+	}
+	msg.Thinking = strings.Join(thoughts, token.NewlineLF+token.NewlineLF)
+	if m.Tokens != nil {
+		msg.TokensIn = m.Tokens.Input
+		msg.TokensOut = m.Tokens.Output + m.Tokens.Thoughts
+	}
+
+	var results []entity.ToolResult
+	for _, call := range m.ToolCalls {
+		msg.ToolUses = append(msg.ToolUses, entity.ToolUse{
+			ID: call.ID, Name: call.Name, Input: string(call.Args),
+		})
+		results = append(results, entity.ToolResult{
+			ToolUseID: call.ID,
+			Content:   geminiResult(call),
+			IsError:   call.Status == cfgGemini.ToolStatusError,
+		})
+	}
+	s.Messages = append(s.Messages, msg)
+	if len(results) > 0 {
+		s.Messages = append(s.Messages, entity.Message{
+			Timestamp: m.Timestamp, Role: claude.RoleUser,
+			ToolResults: results,
+		})
+	}
+}
+
+// geminiContent extracts the text of a message, which is either
+// a plain string or a list of parts.
+//
+// Parameters:
+//   - raw: Content field of the message
+//
+// Returns:
+//   - string: Message text
+func geminiContent(raw json.RawMessage) string {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text
+	}
+	var parts []geminiRawPart
+	if json.Unmarshal(raw, &parts) != nil {
+		return ""
+	}
+	texts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part.Text != "" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, token.NewlineLF)
+}
+
+// geminiResult extracts the output of a tool call.
+//
+// The display text is preferred; otherwise the output (or error)
+// of the function responses is used.
+//
+// Parameters:
+//   - call: The recorded tool call
+//
+// Returns:
+//   - string: Tool output text
+func geminiResult(call geminiRawToolCall) string {
+	if call.ResultDisplay != "" {
+		return call.ResultDisplay
+	}
+	var responses []geminiRawResponse
+	if json.Unmarshal(call.Result, &responses) != nil {
+		return string(call.Result)
+	}
+	outputs := make([]string, 0, len(responses))
+	for _, r := range responses {
+		resp := r.FunctionResponse.Response
+		switch {
+		case resp.Output != "":
+			outputs = append(outputs, resp.Output)
+		case resp.Error != "":
+			outputs = append(outputs, resp.Error)
+		}
+	}
+	return strings.Join(outputs, token.NewlineLF)
+}
+
+// geminiProjectMatches reports whether a Gemini CLI session
+// belongs to the project at cwd.
+//
+// Gemini CLI names each project directory after the SHA-256 of
+// the project root, so the session's grandparent directory is
+// compared against the hash of cwd.
+//
+// Parameters:
+//   - s: Session to check
+//   - cwd: Project root
+//
+// Returns:
+//   - bool: True when the session was recorded in cwd
+func geminiProjectMatches(s *entity.Session, cwd string) bool {
+	if s.Tool != session.ToolGemini || cwd == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(cwd))
+	projectDir := filepath.Dir(filepath.Dir(s.SourceFile))
+	return filepath.Base(projectDir) == hex.EncodeToString(sum[:])
+}
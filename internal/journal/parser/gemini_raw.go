@@ -0,0 +1,76 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// geminiRawSession is a Gemini CLI session document.
+type geminiRawSession struct {
+	SessionID   string             `json:"sessionId"`
+	ProjectHash string             `json:"projectHash"`
+	StartTime   time.Time          `json:"startTime"`
+	LastUpdated time.Time          `json:"lastUpdated"`
+	Messages    []geminiRawMessage `json:"messages"`
+}
+
+// geminiRawMessage is one message of a Gemini CLI session.
+type geminiRawMessage struct {
+	ID        string              `json:"id"`
+	Timestamp time.Time           `json:"timestamp"`
+	Type      string              `json:"type"`
+	Content   json.RawMessage     `json:"content"`
+	Thoughts  []geminiRawThought  `json:"thoughts"`
+	Tokens    *geminiRawTokens    `json:"tokens"`
+	Model     string              `json:"model"`
+	ToolCalls []geminiRawToolCall `json:"toolCalls"`
+}
+
+// geminiRawPart is one part of a multi-part message content.
+type geminiRawPart struct {
+	Text string `json:"text"`
+}
+
+// geminiRawThought is one reasoning step of a model reply.
+type geminiRawThought struct {
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+// geminiRawTokens is the token report of a model reply.
+type geminiRawTokens struct {
+	Input    int `json:"input"`
+	Output   int `json:"output"`
+	Cached   int `json:"cached"`
+	Thoughts int `json:"thoughts"`
+	Tool     int `json:"tool"`
+	Total    int `json:"total"`
+}
+
+// geminiRawToolCall is a tool call made by a model reply,
+// recorded together with its result.
+type geminiRawToolCall struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Args          json.RawMessage `json:"args"`
+	Result        json.RawMessage `json:"result"`
+	ResultDisplay string          `json:"resultDisplay"`
+	Status        string          `json:"status"`
+	Timestamp     time.Time       `json:"timestamp"`
+}
+
+// geminiRawResponse is one element of a tool call result.
+type geminiRawResponse struct {
+	FunctionResponse struct {
+		Response struct {
+			Output string `json:"output"`
+			Error  string `json:"error"`
+		} `json:"response"`
+	} `json:"functionResponse"`
+}
@@ -0,0 +1,245 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/aider"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+// checkGolden compares sessions with testdata/<name>.golden.json,
+// rewriting it when -update is set. Paths under root are made
+// relative so the golden file is machine-independent.
+func checkGolden(
+	t *testing.T, name, root string, sessions []*entity.Session,
+) {
+	t.Helper()
+	for _, s := range sessions {
+		for _, p := range []*string{&s.SourceFile, &s.CWD} {
+			if rel, relErr := filepath.Rel(root, *p); relErr == nil &&
+				!strings.HasPrefix(rel, "..") {
+				*p = filepath.ToSlash(rel)
+			}
+		}
+	}
+	got, marshalErr := json.MarshalIndent(sessions, "", "  ")
+	if marshalErr != nil {
+		t.Fatal(marshalErr)
+	}
+	got = append(got, '\n')
+
+	golden := filepath.Join("testdata", name+".golden.json")
+	if *update {
+		if writeErr := os.WriteFile(golden, got, 0o600); writeErr != nil {
+			t.Fatal(writeErr)
+		}
+		return
+	}
+	want, readErr := os.ReadFile(golden)
+	if readErr != nil {
+		t.Fatalf("read golden (run with -update): %v", readErr)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s sessions differ from %s:\n%s", name, golden, got)
+	}
+}
+
+// parseGolden parses one fixture with the given parser and checks
+// the result against its golden file.
+func parseGolden(t *testing.T, name string, p Session, path string) {
+	t.Helper()
+	if !p.Matches(path) {
+		t.Fatalf("%s parser does not match %s", name, path)
+	}
+	sessions, parseErr := p.ParseFile(path)
+	if parseErr != nil {
+		t.Fatalf("ParseFile: %v", parseErr)
+	}
+	abs, absErr := filepath.Abs("testdata")
+	if absErr != nil {
+		t.Fatal(absErr)
+	}
+	checkGolden(t, name, abs, sessions)
+}
+
+func TestCodex_Golden(t *testing.T) {
+	parseGolden(t, "codex", NewCodex(), filepath.Join(
+		"testdata", "codex", "sessions", "2026", "03", "01",
+		"rollout-2026-03-01T10-00-00-7f3a.jsonl",
+	))
+}
+
+func TestCodex_Matches(t *testing.T) {
+	dir := t.TempDir()
+	other := filepath.Join(dir, "rollout-x.jsonl")
+	if writeErr := os.WriteFile(
+		other, []byte(`{"type":"user","message":{}}`+"\n"), 0o600,
+	); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	if NewCodex().Matches(other) {
+		t.Error("rollout without session_meta should not match")
+	}
+	if NewCodex().Matches(filepath.Join(dir, "session.jsonl")) {
+		t.Error("file without rollout- prefix should not match")
+	}
+}
+
+func TestGemini_Golden(t *testing.T) {
+	matches, globErr := filepath.Glob(filepath.Join(
+		"testdata", "gemini", "tmp", "*", "chats", "session-*.json",
+	))
+	if globErr != nil || len(matches) != 1 {
+		t.Fatalf("expected one gemini fixture, got %v (%v)", matches, globErr)
+	}
+	parseGolden(t, "gemini", NewGemini(), matches[0])
+}
+
+func TestGeminiProjectMatches(t *testing.T) {
+	matches, _ := filepath.Glob(filepath.Join(
+		"testdata", "gemini", "tmp", "*", "chats", "session-*.json",
+	))
+	if len(matches) != 1 {
+		t.Fatalf("expected one gemini fixture, got %v", matches)
+	}
+	sessions, parseErr := NewGemini().ParseFile(matches[0])
+	if parseErr != nil || len(sessions) != 1 {
+		t.Fatalf("ParseFile: %v, %d sessions", parseErr, len(sessions))
+	}
+	if !geminiProjectMatches(sessions[0], "/home/dev/stargazer") {
+		t.Error("expected session to match its project root")
+	}
+	if geminiProjectMatches(sessions[0], "/home/dev/other") {
+		t.Error("expected session not to match another directory")
+	}
+}
+
+func TestCursor_Golden(t *testing.T) {
+	if _, lookErr := exec.LookPath("sqlite3"); lookErr != nil {
+		t.Skip("sqlite3 not installed")
+	}
+	root := t.TempDir()
+	user := filepath.Join(root, "Cursor", "User")
+	global := filepath.Join(user, "globalStorage", "state.vscdb")
+	ws := filepath.Join(user, "workspaceStorage", "a1b2c3")
+	loadSQL(t, global, filepath.Join("testdata", "cursor", "global.sql"))
+	loadSQL(t, filepath.Join(ws, "state.vscdb"),
+		filepath.Join("testdata", "cursor", "workspace.sql"))
+	wsJSON, readErr := os.ReadFile(
+		filepath.Join("testdata", "cursor", "workspace.json"),
+	)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if writeErr := os.WriteFile(
+		filepath.Join(ws, "workspace.json"), wsJSON, 0o600,
+	); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+
+	p := NewCursor()
+	if !p.Matches(global) {
+		t.Fatalf("cursor parser does not match %s", global)
+	}
+	if p.Matches(filepath.Join(ws, "state.vscdb")) {
+		t.Error("workspace database should not match")
+	}
+	sessions, parseErr := p.ParseFile(global)
+	if parseErr != nil {
+		t.Fatalf("ParseFile: %v", parseErr)
+	}
+	checkGolden(t, "cursor", root, sessions)
+}
+
+// loadSQL creates the SQLite database at db from a SQL script.
+func loadSQL(t *testing.T, db, script string) {
+	t.Helper()
+	if mkErr := os.MkdirAll(filepath.Dir(db), 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	sql, readErr := os.ReadFile(script)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	cmd := exec.Command("sqlite3", db)
+	cmd.Stdin = bytes.NewReader(sql)
+	if out, runErr := cmd.CombinedOutput(); runErr != nil {
+		t.Fatalf("sqlite3 %s: %v\n%s", script, runErr, out)
+	}
+}
+
+func TestAider_Golden(t *testing.T) {
+	local := time.Local
+	time.Local = time.UTC
+	t.Cleanup(func() { time.Local = local })
+
+	path := filepath.Join("testdata", "aider", ".aider.chat.history.md")
+	p := NewAider()
+	if !p.Matches(path) {
+		t.Fatalf("aider parser does not match %s", path)
+	}
+	sessions, parseErr := p.ParseFile(path)
+	if parseErr != nil {
+		t.Fatalf("ParseFile: %v", parseErr)
+	}
+	// IDs end in a hash of the absolute path; mask it.
+	for _, s := range sessions {
+		s.ID = s.ID[:len(s.ID)-aider.IDHashLen] + "HASH"
+	}
+	abs, absErr := filepath.Abs("testdata")
+	if absErr != nil {
+		t.Fatal(absErr)
+	}
+	checkGolden(t, "aider", abs, sessions)
+}
+
+func TestCline_Golden(t *testing.T) {
+	parseGolden(t, "cline", NewCline(), filepath.Join(
+		"testdata", "cline", "tasks", "1772359200000",
+		"api_conversation_history.json",
+	))
+}
+
+func TestParseFile_DispatchesAgents(t *testing.T) {
+	tests := []struct {
+		path string
+		tool string
+	}{
+		{filepath.Join("testdata", "aider", ".aider.chat.history.md"), "aider"},
+		{filepath.Join(
+			"testdata", "cline", "tasks", "1772359200000",
+			"api_conversation_history.json",
+		), "cline"},
+		{filepath.Join(
+			"testdata", "codex", "sessions", "2026", "03", "01",
+			"rollout-2026-03-01T10-00-00-7f3a.jsonl",
+		), "codex"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tool, func(t *testing.T) {
+			sessions, parseErr := ParseFile(tt.path)
+			if parseErr != nil {
+				t.Fatalf("ParseFile: %v", parseErr)
+			}
+			if len(sessions) == 0 || sessions[0].Tool != tt.tool {
+				t.Errorf("expected %s sessions, got %v", tt.tool, sessions)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgParser "github.com/ActiveMemory/ctx/internal/config/parser"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/log/warn"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// openIndex loads the session index of the current project.
+//
+// The index lives in .context/state/. When there is no context
+// directory, caching is disabled and nil is returned; sessionsOf
+// and save accept a nil receiver. A missing, unreadable, or
+// outdated index starts empty.
+//
+// Returns:
+//   - *sessionIndex: The loaded index, or nil when disabled
+func openIndex() *sessionIndex {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return nil
+	}
+	if info, statErr := os.Stat(ctxDir); statErr != nil || !info.IsDir() {
+		return nil
+	}
+	idx := &sessionIndex{
+		Version: cfgParser.IndexVersion,
+		Entries: make(map[string]indexEntry),
+		path:    filepath.Join(ctxDir, dir.State, cfgParser.FileIndex),
+		seen:    make(map[string]bool),
+	}
+	data, readErr := io.SafeReadUserFile(idx.path)
+	if readErr != nil {
+		return idx
+	}
+	var loaded sessionIndex
+	if json.Unmarshal(data, &loaded) != nil ||
+		loaded.Version != cfgParser.IndexVersion || loaded.Entries == nil {
+		idx.dirty = true
+		return idx
+	}
+	idx.Entries = loaded.Entries
+	return idx
+}
+
+// scan walks root and returns the sessions of every file in it,
+// reusing cached results for unchanged files.
+//
+// Subagent directories are skipped, as in ScanDirectory.
+// Unreadable entries and files that fail to parse are skipped.
+//
+// Parameters:
+//   - root: Absolute directory to walk
+//
+// Returns:
+//   - []*entity.Session: Session metadata, without messages
+func (idx *sessionIndex) scan(root string) []*entity.Session {
+	var all []*entity.Session
+	subagentPath := string(filepath.Separator) + cfgParser.DirSubagents +
+		string(filepath.Separator)
+	_ = filepath.Walk(root, func(
+		path string, info os.FileInfo, walkErr error,
+	) error {
+		if walkErr != nil {
+			return nil
+		}
+		if info.IsDir() {
+			if info.Name() == cfgParser.DirSubagents {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.Contains(path, subagentPath) {
+			return nil
+		}
+		all = append(all, idx.sessionsOf(path, info)...)
+		return nil
+	})
+	return all
+}
+
+// sessionsOf returns the sessions of one file.
+//
+// The cached entry is used when the file's size and modification
+// time are unchanged; otherwise the file is parsed and the entry
+// replaced. Callers get copies, so they may modify the sessions
+// without touching the index.
+//
+// Parameters:
+//   - path: Absolute file path
+//   - info: The file's current stat
+//
+// Returns:
+//   - []*entity.Session: Session metadata, without messages; nil
+//     when no parser matches or parsing fails
+func (idx *sessionIndex) sessionsOf(
+	path string, info os.FileInfo,
+) []*entity.Session {
+	if idx != nil {
+		idx.seen[path] = true
+		entry, ok := idx.Entries[path]
+		if ok && entry.Size == info.Size() &&
+			entry.ModTime.Equal(info.ModTime()) {
+			return copySessions(entry.Sessions)
+		}
+	}
+
+	var sessions []*entity.Session
+	for _, p := range registeredParsers {
+		if !p.Matches(path) {
+			continue
+		}
+		parsed, parseErr := p.ParseFile(path)
+		if parseErr != nil {
+			// Not cached: a file being written may parse next time.
+			return nil
+		}
+		sessions = parsed
+		break
+	}
+
+	meta := make([]*entity.Session, 0, len(sessions))
+	for _, s := range sessions {
+		stripped := *s
+		stripped.Messages = nil
+		meta = append(meta, &stripped)
+	}
+	if idx != nil {
+		idx.Entries[path] = indexEntry{
+			Size: info.Size(), ModTime: info.ModTime(), Sessions: meta,
+		}
+		idx.dirty = true
+	}
+	return copySessions(meta)
+}
+
+// save drops entries for deleted files and writes the index
+// atomically if it changed.
+//
+// Failures are reported as warnings: the index is a cache and
+// discovery must not fail because of it.
+func (idx *sessionIndex) save() {
+	if idx == nil {
+		return
+	}
+	for path := range idx.Entries {
+		if idx.seen[path] {
+			continue
+		}
+		if _, statErr := os.Stat(path); errors.Is(statErr, os.ErrNotExist) {
+			delete(idx.Entries, path)
+			idx.dirty = true
+		}
+	}
+	if !idx.dirty {
+		return
+	}
+
+	data, marshalErr := json.Marshal(idx)
+	if marshalErr != nil {
+		warn.Warn(cfgWarn.Marshal, marshalErr)
+		return
+	}
+	data = append(data, token.NewlineLF[0])
+	stateDir := filepath.Dir(idx.path)
+	if mkErr := io.SafeMkdirAll(stateDir, fs.PermExec); mkErr != nil {
+		warn.Warn(cfgWarn.Mkdir, stateDir, mkErr)
+		return
+	}
+	tmp := idx.path + file.ExtTmp
+	if writeErr := io.SafeWriteFile(tmp, data, fs.PermFile); writeErr != nil {
+		warn.Warn(cfgWarn.Write, tmp, writeErr)
+		return
+	}
+	if renameErr := os.Rename(tmp, idx.path); renameErr != nil {
+		warn.Warn(cfgWarn.Rename, tmp, renameErr)
+	}
+}
+
+// copySessions returns shallow copies of sessions.
+//
+// Parameters:
+//   - sessions: Sessions to copy
+//
+// Returns:
+//   - []*entity.Session: New session values with the same fields
+func copySessions(sessions []*entity.Session) []*entity.Session {
+	out := make([]*entity.Session, 0, len(sessions))
+	for _, s := range sessions {
+		c := *s
+		out = append(out, &c)
+	}
+	return out
+}
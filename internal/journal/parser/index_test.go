@@ -0,0 +1,183 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/env"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// indexFixture isolates session discovery in a temp home with a
+// context directory and one Codex rollout in a sessions dir.
+func indexFixture(t *testing.T) (sessionsDir, rollout, indexPath string) {
+	t.Helper()
+	root := t.TempDir()
+	t.Setenv("HOME", filepath.Join(root, "home"))
+	t.Setenv("CODEX_HOME", "")
+	ctxDir := filepath.Join(root, "proj", ".context")
+	if mkErr := os.MkdirAll(ctxDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	t.Setenv(env.CtxDir, ctxDir)
+
+	sessionsDir = filepath.Join(root, "sessions")
+	if mkErr := os.MkdirAll(sessionsDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	data, readErr := os.ReadFile(filepath.Join(
+		"testdata", "codex", "sessions", "2026", "03", "01",
+		"rollout-2026-03-01T10-00-00-7f3a.jsonl",
+	))
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	rollout = filepath.Join(sessionsDir, "rollout-a.jsonl")
+	if writeErr := os.WriteFile(rollout, data, 0o600); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	return sessionsDir, rollout, filepath.Join(
+		ctxDir, "state", "session-index.json",
+	)
+}
+
+func readIndex(t *testing.T, path string) sessionIndex {
+	t.Helper()
+	data, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Fatalf("read index: %v", readErr)
+	}
+	var idx sessionIndex
+	if unmarshalErr := json.Unmarshal(data, &idx); unmarshalErr != nil {
+		t.Fatalf("decode index: %v", unmarshalErr)
+	}
+	return idx
+}
+
+func TestFindSessions_Index(t *testing.T) {
+	sessionsDir, rollout, indexPath := indexFixture(t)
+
+	sessions, findErr := FindSessions(sessionsDir)
+	if findErr != nil || len(sessions) != 1 {
+		t.Fatalf("FindSessions: %v, %d sessions", findErr, len(sessions))
+	}
+	if sessions[0].Messages != nil {
+		t.Error("expected metadata-only session")
+	}
+	if sessions[0].TotalTokens != 11320 {
+		t.Errorf("TotalTokens = %d, want 11320", sessions[0].TotalTokens)
+	}
+
+	// An unchanged file is served from the index, not re-parsed.
+	idx := readIndex(t, indexPath)
+	entry, ok := idx.Entries[rollout]
+	if !ok {
+		t.Fatalf("index has no entry for %s: %v", rollout, idx.Entries)
+	}
+	entry.Sessions[0].FirstUserMsg = "from index"
+	data, _ := json.Marshal(idx)
+	if writeErr := os.WriteFile(indexPath, data, 0o600); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	sessions, _ = FindSessions(sessionsDir)
+	if len(sessions) != 1 || sessions[0].FirstUserMsg != "from index" {
+		t.Fatalf("expected cached session, got %+v", sessions)
+	}
+
+	// A changed file is re-parsed.
+	later := time.Now().Add(time.Minute)
+	if chErr := os.Chtimes(rollout, later, later); chErr != nil {
+		t.Fatal(chErr)
+	}
+	sessions, _ = FindSessions(sessionsDir)
+	if len(sessions) != 1 ||
+		sessions[0].FirstUserMsg != "Why does the build fail?" {
+		t.Fatalf("expected re-parsed session, got %+v", sessions)
+	}
+
+	// Messages are loaded on demand.
+	if loaded := LoadMessages(sessions); len(loaded) != 1 {
+		t.Fatalf("LoadMessages kept %d sessions, want 1", len(loaded))
+	}
+	if len(sessions[0].Messages) != 6 {
+		t.Errorf("got %d messages, want 6", len(sessions[0].Messages))
+	}
+
+	// Deleted files leave the index.
+	if rmErr := os.Remove(rollout); rmErr != nil {
+		t.Fatal(rmErr)
+	}
+	if sessions, _ = FindSessions(sessionsDir); len(sessions) != 0 {
+		t.Errorf("expected no sessions, got %d", len(sessions))
+	}
+	if _, ok := readIndex(t, indexPath).Entries[rollout]; ok {
+		t.Error("expected deleted file to be pruned from the index")
+	}
+}
+
+func TestFindSessions_NoContextDir(t *testing.T) {
+	sessionsDir, _, indexPath := indexFixture(t)
+	t.Setenv(env.CtxDir, "")
+
+	sessions, findErr := FindSessions(sessionsDir)
+	if findErr != nil || len(sessions) != 1 {
+		t.Fatalf("FindSessions: %v, %d sessions", findErr, len(sessions))
+	}
+	if _, statErr := os.Stat(indexPath); !os.IsNotExist(statErr) {
+		t.Error("expected no index without a context directory")
+	}
+}
+
+func TestLoadMessages_Missing(t *testing.T) {
+	sessionsDir, _, _ := indexFixture(t)
+	sessions, _ := FindSessions(sessionsDir)
+	if len(sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(sessions))
+	}
+	sessions[0].ID = "gone"
+	if loaded := LoadMessages(sessions); len(loaded) != 0 {
+		t.Errorf("expected the missing session to be skipped, got %d",
+			len(loaded))
+	}
+	if loadErr := LoadSession(sessions[0]); loadErr == nil {
+		t.Error("expected an error for a session missing from its file")
+	}
+}
+
+func TestLoadMessages_SkipsBadFiles(t *testing.T) {
+	sessionsDir, _, _ := indexFixture(t)
+	sessions, _ := FindSessions(sessionsDir)
+	if len(sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(sessions))
+	}
+	good := sessions[0]
+
+	corruptPath := filepath.Join(sessionsDir, "rollout-corrupt.jsonl")
+	if writeErr := os.WriteFile(
+		corruptPath, []byte("{\"type\":\"session_meta\",\n"), 0o600,
+	); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	corrupt := &entity.Session{ID: "corrupt", SourceFile: corruptPath}
+	deleted := &entity.Session{
+		ID: "deleted", SourceFile: filepath.Join(sessionsDir, "gone.jsonl"),
+	}
+
+	loaded := LoadMessages([]*entity.Session{corrupt, good, deleted})
+	if len(loaded) != 1 || loaded[0] != good {
+		t.Fatalf("LoadMessages kept %+v, want only the valid session",
+			loaded)
+	}
+	if len(good.Messages) == 0 {
+		t.Error("valid session has no messages")
+	}
+}
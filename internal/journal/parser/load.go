@@ -0,0 +1,75 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// LoadMessages fills in the messages of sessions returned by
+// FindSessions and FindSessionsForCWD, which carry metadata only.
+//
+// Each source file is parsed once, however many of its sessions
+// are requested. Sessions that already have messages are left
+// untouched. A session whose source file is unreadable, malformed,
+// or deleted, or no longer contains it, is skipped with a warning
+// so one bad file never aborts a whole stats, site, or import run.
+//
+// Parameters:
+//   - sessions: Sessions to complete (modified in place)
+//
+// Returns:
+//   - []*entity.Session: The sessions that have messages, in
+//     input order
+func LoadMessages(sessions []*entity.Session) []*entity.Session {
+	byFile := make(map[string][]*entity.Session)
+	var order []string
+	for _, s := range sessions {
+		if len(s.Messages) > 0 {
+			continue
+		}
+		if _, ok := byFile[s.SourceFile]; !ok {
+			order = append(order, s.SourceFile)
+		}
+		byFile[s.SourceFile] = append(byFile[s.SourceFile], s)
+	}
+
+	skipped := make(map[*entity.Session]bool)
+	for _, path := range order {
+		for s, loadErr := range loadFile(path, byFile[path]) {
+			warn.Warn(cfgWarn.SessionSkipped, s.ID, loadErr)
+			skipped[s] = true
+		}
+	}
+
+	loaded := make([]*entity.Session, 0, len(sessions))
+	for _, s := range sessions {
+		if !skipped[s] {
+			loaded = append(loaded, s)
+		}
+	}
+	return loaded
+}
+
+// LoadSession fills in the messages of a single session, failing
+// instead of skipping when it cannot be loaded.
+//
+// Parameters:
+//   - s: Session to complete (modified in place)
+//
+// Returns:
+//   - error: Non-nil if the source file cannot be parsed or no
+//     longer contains the session
+func LoadSession(s *entity.Session) error {
+	if len(s.Messages) > 0 {
+		return nil
+	}
+	failed := loadFile(s.SourceFile, []*entity.Session{s})
+	return failed[s]
+}
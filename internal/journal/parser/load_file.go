@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errParser "github.com/ActiveMemory/ctx/internal/err/parser"
+)
+
+// loadFile parses one source file and copies the messages of the
+// requested sessions from it.
+//
+// Parameters:
+//   - path: Source file shared by the sessions
+//   - sessions: Sessions to complete (modified in place)
+//
+// Returns:
+//   - map[*entity.Session]error: The sessions that could not be
+//     loaded, with the reason
+func loadFile(
+	path string, sessions []*entity.Session,
+) map[*entity.Session]error {
+	failed := make(map[*entity.Session]error)
+	parsed, parseErr := ParseFile(path)
+	if parseErr != nil {
+		for _, s := range sessions {
+			failed[s] = errParser.FileError(path, parseErr)
+		}
+		return failed
+	}
+	byID := make(map[string]*entity.Session, len(parsed))
+	for _, p := range parsed {
+		byID[p.ID] = p
+	}
+	for _, s := range sessions {
+		full, ok := byID[s.ID]
+		if !ok {
+			failed[s] = errParser.SessionNotInFile(s.ID, path)
+			continue
+		}
+		s.Messages = full.Messages
+	}
+	return failed
+}
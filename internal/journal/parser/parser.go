@@ -23,6 +23,13 @@ var registeredParsers = []Session{
 	NewClaudeCode(),
 	NewCopilot(),
 	NewCopilotCLI(),
+	NewCodex(),
+	NewGemini(),
+	NewCursor(),
+	NewCline(),
+	// Aider must precede MarkdownSession: its transcript is a
+	// .md file too.
+	NewAider(),
 	NewMarkdownSession(),
 }
 
@@ -135,14 +142,19 @@ func ScanDirectoryWithErrors(dir string) ([]*entity.Session, []error, error) {
 //
 // It checks:
 //  1. ~/.claude/projects/ (Claude Code default)
-//  2. The specified directory (if provided)
+//  2. The session stores of the other supported tools
+//  3. The specified directory (if provided)
+//
+// Only files changed since the last call are parsed; the rest
+// come from the session index in .context/state/. Sessions carry
+// metadata only; use LoadMessages to read their messages.
 //
 // Parameters:
 //   - additionalDirs: Optional additional directories to scan
 //
 // Returns:
 //   - []*entity.Session: Deduplicated sessions sorted by
-//     start time (newest first)
+//     start time (newest first), without messages
 //   - error: Non-nil if scanning fails (partial results may still be returned)
 func FindSessions(additionalDirs ...string) ([]*entity.Session, error) {
 	return findSessionsWithFilter(nil, additionalDirs...)
@@ -156,6 +168,11 @@ func FindSessions(additionalDirs ...string) ([]*entity.Session, error) {
 //     the same remote
 //  2. Path relative to home - e.g., "WORKSPACE/ctx" matches across users
 //  3. Exact CWD match - fallback for non-git, non-home paths
+//  4. Project hash match - for Gemini CLI sessions, which record
+//     only the SHA-256 of their project root; a matching session
+//     takes cwd as its working directory
+//
+// Like FindSessions, it returns metadata only.
 //
 // Parameters:
 //   - cwd: Working directory to filter by
@@ -191,6 +208,16 @@ func FindSessionsForCWD(
 		}
 
 		// 3. Fallback to an exact match
-		return s.CWD == cwd
+		if s.CWD == cwd {
+			return true
+		}
+
+		// 4. Gemini CLI project hash
+		if s.CWD == "" && geminiProjectMatches(s, cwd) {
+			s.CWD = cwd
+			s.Project = filepath.Base(cwd)
+			return true
+		}
+		return false
 	}, additionalDirs...)
 }
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"sort"
 
+	cfgAider "github.com/ActiveMemory/ctx/internal/config/aider"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
 	"github.com/ActiveMemory/ctx/internal/entity"
 )
@@ -18,10 +19,17 @@ import (
 // findSessionsWithFilter scans common locations and additional directories
 // for session files, applying an optional filter.
 //
-// It checks ~/.claude/projects/ (Claude Code default) and any additional
+// It checks ~/.claude/projects/ (Claude Code default), the session
+// stores of the other supported agents, and any additional
 // directories provided. Results are deduplicated by session ID and sorted
 // by start time (newest first).
 //
+// Parsed metadata is cached in .context/state/session-index.json,
+// keyed by path, size and modification time, so only new or
+// changed files are parsed. Sessions served through the index
+// carry metadata only; call LoadMessages for their messages.
+// Without a context directory every file is parsed in full.
+//
 // Parameters:
 //   - filter: Optional function to filter sessions (nil includes all)
 //   - additionalDirs: Optional additional directories to scan
@@ -35,19 +43,29 @@ func findSessionsWithFilter(
 	var allSessions []*entity.Session
 	scannedDirs := make(map[string]bool)
 
+	idx := openIndex()
+	defer idx.save()
+
 	// scanOnce scans a directory only if it hasn't been scanned yet.
 	scanOnce := func(dirPath string) {
 		resolved, symlinkErr := filepath.EvalSymlinks(dirPath)
 		if symlinkErr != nil {
 			resolved = filepath.Clean(dirPath)
 		}
+		if abs, absErr := filepath.Abs(resolved); absErr == nil {
+			resolved = abs
+		}
 		if scannedDirs[resolved] {
 			return
 		}
 		if info, statErr := os.Stat(resolved); statErr == nil && info.IsDir() {
 			scannedDirs[resolved] = true
-			sessions, _ := ScanDirectory(resolved)
-			allSessions = append(allSessions, sessions...)
+			if idx == nil {
+				sessions, _ := ScanDirectory(resolved)
+				allSessions = append(allSessions, sessions...)
+				return
+			}
+			allSessions = append(allSessions, idx.scan(resolved)...)
 		}
 	}
 
@@ -67,9 +85,26 @@ func findSessionsWithFilter(
 		scanOnce(sessionDir)
 	}
 
-	// Check .context/sessions/ in the current working directory
+	// Check the stores of other agents: Codex CLI rollouts,
+	// Gemini CLI chats, Cursor's state database, Cline tasks
+	agentDirs := [][]string{
+		CodexSessionDirs(), GeminiSessionDirs(),
+		CursorSessionDirs(), ClineSessionDirs(),
+	}
+	for _, sessionDirs := range agentDirs {
+		for _, sessionDir := range sessionDirs {
+			scanOnce(sessionDir)
+		}
+	}
+
+	// Check .context/sessions/ and Aider's transcript in the
+	// current working directory
 	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
 		scanOnce(filepath.Join(cwd, dir.Context, dir.Sessions))
+		history := filepath.Join(cwd, cfgAider.FileHistory)
+		if info, statErr := os.Stat(history); statErr == nil {
+			allSessions = append(allSessions, idx.sessionsOf(history, info)...)
+		}
 	}
 
 	// Check additional directories
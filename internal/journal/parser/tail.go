@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/claude"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// assistantTail returns the last message when it is an
+// assistant message, or appends a new one.
+//
+// Parameters:
+//   - s: Session being built (modified in place)
+//   - ts: Timestamp for a new message
+//
+// Returns:
+//   - *entity.Message: Assistant message to attach tool uses to
+func assistantTail(s *entity.Session, ts time.Time) *entity.Message {
+	if n := len(s.Messages); n > 0 &&
+		s.Messages[n-1].BelongsToAssistant() {
+		return &s.Messages[n-1]
+	}
+	s.Messages = append(s.Messages, entity.Message{
+		Timestamp: ts, Role: claude.RoleAssistant,
+	})
+	return &s.Messages[len(s.Messages)-1]
+}
+
+// resultTail returns the last message when it only carries tool
+// results, or appends a new one.
+//
+// Parameters:
+//   - s: Session being built (modified in place)
+//   - ts: Timestamp for a new message
+//
+// Returns:
+//   - *entity.Message: User-role message to attach results to
+func resultTail(s *entity.Session, ts time.Time) *entity.Message {
+	if n := len(s.Messages); n > 0 {
+		last := &s.Messages[n-1]
+		if last.BelongsToUser() && last.Text == "" &&
+			len(last.ToolResults) > 0 {
+			return last
+		}
+	}
+	s.Messages = append(s.Messages, entity.Message{
+		Timestamp: ts, Role: claude.RoleUser,
+	})
+	return &s.Messages[len(s.Messages)-1]
+}
+
+// lastAssistant returns the most recent assistant message.
+//
+// Parameters:
+//   - msgs: Messages so far
+//
+// Returns:
+//   - *entity.Message: The message, or nil if there is none
+func lastAssistant(msgs []entity.Message) *entity.Message {
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if msgs[i].BelongsToAssistant() {
+			return &msgs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+CREATE TABLE ItemTable (key TEXT UNIQUE ON CONFLICT REPLACE, value BLOB);
+CREATE TABLE cursorDiskKV (key TEXT UNIQUE ON CONFLICT REPLACE, value BLOB);
+INSERT INTO cursorDiskKV VALUES ('composerData:c-101', '{"_v":3,"composerId":"c-101","name":"Fix flaky orbit test","createdAt":1772539200000,"lastUpdatedAt":1772539260000,"fullConversationHeadersOnly":[{"bubbleId":"b1","type":1},{"bubbleId":"b2","type":2},{"bubbleId":"b3","type":2}],"modelConfig":{"modelName":"claude-4-sonnet"}}');
+INSERT INTO cursorDiskKV VALUES ('bubbleId:c-101:b1', '{"_v":2,"type":1,"bubbleId":"b1","text":"TestOrbit fails one run in ten. Why?","createdAt":"2026-03-03T12:00:00.000Z"}');
+INSERT INTO cursorDiskKV VALUES ('bubbleId:c-101:b2', '{"_v":2,"type":2,"bubbleId":"b2","text":"","createdAt":"2026-03-03T12:00:20.000Z","thinking":{"text":"The test probably depends on map order."},"tokenCount":{"inputTokens":2500,"outputTokens":60},"toolFormerData":{"tool":5,"toolCallId":"tc-1","name":"read_file","rawArgs":"{\"target_file\":\"orbit_test.go\"}","result":"for k := range bodies {","status":"completed"}}');
+INSERT INTO cursorDiskKV VALUES ('bubbleId:c-101:b3', '{"_v":2,"type":2,"bubbleId":"b3","text":"The test ranges over a map; sort the keys first.","createdAt":"2026-03-03T12:01:00.000Z","tokenCount":{"inputTokens":2700,"outputTokens":45}}');
+INSERT INTO cursorDiskKV VALUES ('composerData:c-102', '{"_v":3,"composerId":"c-102","createdAt":1772539300000,"fullConversationHeadersOnly":[]}');
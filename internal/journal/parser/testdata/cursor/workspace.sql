@@ -0,0 +1,2 @@
+CREATE TABLE ItemTable (key TEXT UNIQUE ON CONFLICT REPLACE, value BLOB);
+INSERT INTO ItemTable VALUES ('composer.composerData', '{"allComposers":[{"composerId":"c-101","type":"head"}],"selectedComposerIds":["c-101"]}');
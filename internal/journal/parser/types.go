@@ -9,6 +9,8 @@ package parser
 import (
 	"encoding/json"
 	"time"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
 )
 
 // ClaudeCode parses Claude Code JSONL session files.
@@ -32,6 +34,42 @@ type Copilot struct{}
 // JSONL-formatted messages similar to Claude Code's format.
 type CopilotCLI struct{}
 
+// Codex parses OpenAI Codex CLI rollout files.
+//
+// Codex CLI writes one JSONL "rollout" per session under
+// ~/.codex/sessions/YYYY/MM/DD/ (or $CODEX_HOME/sessions/).
+// Each line wraps a session_meta, turn_context, response_item,
+// or event_msg payload.
+type Codex struct{}
+
+// Gemini parses Gemini CLI chat logs.
+//
+// Gemini CLI records each session as one JSON document under
+// ~/.gemini/tmp/<project-hash>/chats/, holding the messages,
+// their tool calls, and per-message token counts.
+type Gemini struct{}
+
+// Cursor parses Cursor's SQLite chat store.
+//
+// Cursor keeps agent conversations in the global state.vscdb
+// database; every conversation in it becomes a session. The
+// database is read with the sqlite3 command-line tool.
+type Cursor struct{}
+
+// Aider parses Aider's .aider.chat.history.md transcripts.
+//
+// Aider appends every session to one Markdown file in the
+// project root; each "# aider chat started at" header starts a
+// new session.
+type Aider struct{}
+
+// Cline parses Cline task directories.
+//
+// Cline keeps each task in its own directory under the VS Code
+// extension's globalStorage, with the conversation in Anthropic
+// message format and token usage in the UI event log.
+type Cline struct{}
+
 // MarkdownSession parses Markdown session files written by AI agents.
 //
 // This parser handles the tool-agnostic session format used by non-Claude
@@ -187,3 +225,33 @@ type section struct {
 	heading string
 	body    string
 }
+
+// sessionIndex is the on-disk cache of parsed session metadata,
+// stored in .context/state/session-index.json.
+//
+// Fields:
+//   - Version: Index format (cfgParser.IndexVersion)
+//   - Entries: Cached results keyed by absolute file path
+type sessionIndex struct {
+	Version int                   `json:"version"`
+	Entries map[string]indexEntry `json:"entries"`
+
+	path  string
+	dirty bool
+	seen  map[string]bool
+}
+
+// indexEntry caches the sessions parsed from one file.
+//
+// A file no parser matched is cached with no sessions, so it is
+// not probed again until it changes.
+//
+// Fields:
+//   - Size: File size when parsed
+//   - ModTime: File modification time when parsed
+//   - Sessions: Session metadata, without messages
+type indexEntry struct {
+	Size     int64             `json:"size"`
+	ModTime  time.Time         `json:"mtime"`
+	Sessions []*entity.Session `json:"sessions,omitempty"`
+}
@@ -0,0 +1,181 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/redact"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/secret"
+)
+
+// newRedactor prepares the configured kinds for one session.
+//
+// Built-in kinds come from rc.RedactKinds in pipeline order;
+// project patterns follow. Invalid patterns are skipped
+// (ctx config validate reports them).
+//
+// Returns:
+//   - *redactor: Empty placeholder state
+func newRedactor() *redactor {
+	var kinds []kind
+	for _, name := range rc.RedactKinds() {
+		switch name {
+		case redact.KindSecret:
+			kinds = append(kinds, kind{name: name, find: secretSpans})
+		case redact.KindEmail:
+			kinds = append(kinds, kind{
+				name: name, find: matcher{pattern: regex.RedactEmail}.spans,
+			})
+		case redact.KindHome:
+			kinds = append(kinds, kind{
+				name: name, find: matcher{pattern: regex.RedactHome}.spans,
+			})
+		case redact.KindHost:
+			kinds = append(kinds, kind{name: name, find: hostSpans})
+		}
+	}
+	for _, p := range rc.RedactPatterns() {
+		re, compileErr := regex.Compile(p.Pattern)
+		if compileErr != nil || p.Pattern == "" || p.Name == "" {
+			continue
+		}
+		kinds = append(kinds, kind{
+			name: p.Name,
+			find: matcher{pattern: re, group: min(re.NumSubexp(), 1)}.spans,
+		})
+	}
+	return &redactor{
+		kinds:  kinds,
+		values: map[string]string{},
+		next:   map[string]int{},
+		index:  map[string]int{},
+	}
+}
+
+// apply runs every kind over text in order.
+//
+// Parameters:
+//   - text: Text to redact
+//
+// Returns:
+//   - string: Text with each match replaced by its placeholder
+func (r *redactor) apply(text string) string {
+	if text == "" {
+		return text
+	}
+	for _, k := range r.kinds {
+		spans := k.find(text)
+		if len(spans) == 0 {
+			continue
+		}
+		var b strings.Builder
+		last := 0
+		for _, sp := range spans {
+			if sp[0] < last {
+				continue
+			}
+			b.WriteString(text[last:sp[0]])
+			b.WriteString(r.placeholder(k.name, text[sp[0]:sp[1]]))
+			last = sp[1]
+		}
+		b.WriteString(text[last:])
+		text = b.String()
+	}
+	return text
+}
+
+// placeholder returns the stable placeholder for a value and
+// counts the occurrence in the report.
+//
+// Parameters:
+//   - kindName: Kind that matched
+//   - value: Matched text
+//
+// Returns:
+//   - string: Placeholder such as <EMAIL_1>
+func (r *redactor) placeholder(kindName, value string) string {
+	key := kindName + redact.KeySep + value
+	ph, ok := r.values[key]
+	if !ok {
+		r.next[kindName]++
+		ph = fmt.Sprintf(
+			redact.PlaceholderFormat,
+			strings.ToUpper(kindName), r.next[kindName],
+		)
+		r.values[key] = ph
+		r.index[ph] = len(r.report)
+		r.report = append(r.report, entity.Redaction{
+			Placeholder: ph, Kind: kindName,
+		})
+	}
+	r.report[r.index[ph]].Count++
+	return ph
+}
+
+// spans returns the matched value ranges of the pattern.
+//
+// Parameters:
+//   - text: Text to search
+//
+// Returns:
+//   - []span: Ranges of the configured group, in order
+func (m matcher) spans(text string) []span {
+	var out []span
+	for _, loc := range m.pattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[2*m.group], loc[2*m.group+1]
+		if start < 0 || start == end {
+			continue
+		}
+		out = append(out, span{start, end})
+	}
+	return out
+}
+
+// secretSpans returns the ranges of possible secrets found by
+// the shared secret scanner.
+//
+// Parameters:
+//   - text: Text to scan
+//
+// Returns:
+//   - []span: Finding ranges, in order
+func secretSpans(text string) []span {
+	var out []span
+	for _, f := range secret.Scan(text) {
+		out = append(out, span{f.Start, f.End})
+	}
+	return out
+}
+
+// hostSpans returns the ranges of hostnames under one of the
+// configured internal domains.
+//
+// Parameters:
+//   - text: Text to search
+//
+// Returns:
+//   - []span: Hostname ranges, in order
+func hostSpans(text string) []span {
+	suffixes := rc.RedactHosts()
+	var out []span
+	for _, loc := range regex.RedactHost.FindAllStringIndex(text, -1) {
+		host := strings.ToLower(text[loc[0]:loc[1]])
+		for _, s := range suffixes {
+			s = strings.ToLower(strings.TrimPrefix(s, redact.HostSep))
+			if strings.HasSuffix(host, redact.HostSep+s) {
+				out = append(out, span{loc[0], loc[1]})
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,22 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package redact rewrites parsed sessions so that secrets and
+// identifying details never reach the journal.
+//
+// [Session] runs the configured kinds over every message text,
+// thinking block, tool input and tool result, in a fixed order:
+// secrets (the shared secret scanner), email addresses, home
+// directory prefixes, hostnames under an internal domain, then
+// project patterns from redaction.patterns in .ctxrc.
+//
+// Each distinct value is replaced by a placeholder such as
+// <EMAIL_1>. Numbering is per session and per kind, and a value
+// keeps its placeholder everywhere in the session, so a reader
+// can still follow which address or host a conversation is
+// about. The session records the placeholders it wrote (never
+// the values) so the importer can report them in frontmatter.
+package redact
@@ -0,0 +1,43 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Session redacts a session in place and records the
+// placeholders it wrote.
+//
+// Message text, thinking, plan content, tool inputs and tool
+// results are rewritten, then the first user message preview
+// and working directory so derived titles match the body.
+// Redacted is set even when nothing matched, marking the
+// session as having passed through the pipeline.
+//
+// Parameters:
+//   - s: Session with messages loaded
+func Session(s *entity.Session) {
+	r := newRedactor()
+	for i := range s.Messages {
+		m := &s.Messages[i]
+		m.Text = r.apply(m.Text)
+		m.Thinking = r.apply(m.Thinking)
+		m.PlanContent = r.apply(m.PlanContent)
+		m.ToolUseResult = r.apply(m.ToolUseResult)
+		for j := range m.ToolUses {
+			m.ToolUses[j].Input = r.apply(m.ToolUses[j].Input)
+		}
+		for j := range m.ToolResults {
+			m.ToolResults[j].Content = r.apply(m.ToolResults[j].Content)
+		}
+	}
+	s.FirstUserMsg = r.apply(s.FirstUserMsg)
+	s.CWD = r.apply(s.CWD)
+	s.Redacted = true
+	s.Redactions = r.report
+}
@@ -0,0 +1,154 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// ghToken is assembled at run time so the source file itself
+// does not trip secret scanners.
+var ghToken = "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+
+// declare points CTX_DIR at a temp project with the given .ctxrc.
+func declare(t *testing.T, rcContent string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	if mkErr := os.MkdirAll(
+		filepath.Join(tmpDir, ".context"), 0o750,
+	); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if rcContent != "" {
+		if wErr := os.WriteFile(
+			filepath.Join(tmpDir, ".ctxrc"), []byte(rcContent), 0o600,
+		); wErr != nil {
+			t.Fatal(wErr)
+		}
+	}
+	testctx.Declare(t, tmpDir)
+}
+
+func TestSession_StablePlaceholders(t *testing.T) {
+	declare(t, "")
+	s := &entity.Session{
+		CWD:          "/home/alice/src/app",
+		FirstUserMsg: "mail bob@example.com",
+		Messages: []entity.Message{
+			{Role: "user", Text: "mail bob@example.com about db1.prod.internal"},
+			{
+				Role: "assistant",
+				Text: "Sent to bob@example.com; cc carol@example.com",
+				ToolUses: []entity.ToolUse{
+					{Input: `{"path":"/home/alice/src/app/main.go"}`},
+				},
+				ToolResults: []entity.ToolResult{
+					{Content: "token=" + ghToken + " from db1.prod.internal"},
+				},
+			},
+		},
+	}
+	Session(s)
+
+	if got := s.Messages[0].Text; got !=
+		"mail <EMAIL_1> about <HOST_1>" {
+		t.Errorf("message 0 = %q", got)
+	}
+	if got := s.Messages[1].Text; got !=
+		"Sent to <EMAIL_1>; cc <EMAIL_2>" {
+		t.Errorf("message 1 = %q", got)
+	}
+	if got := s.Messages[1].ToolUses[0].Input; got !=
+		`{"path":"<HOME_1>/src/app/main.go"}` {
+		t.Errorf("tool input = %q", got)
+	}
+	if got := s.Messages[1].ToolResults[0].Content; got !=
+		"token=<SECRET_1> from <HOST_1>" {
+		t.Errorf("tool result = %q", got)
+	}
+	if s.FirstUserMsg != "mail <EMAIL_1>" {
+		t.Errorf("FirstUserMsg = %q", s.FirstUserMsg)
+	}
+	if s.CWD != "<HOME_1>/src/app" {
+		t.Errorf("CWD = %q", s.CWD)
+	}
+
+	if !s.Redacted {
+		t.Error("Redacted = false, want true")
+	}
+	want := map[string]entity.Redaction{
+		"<EMAIL_1>":  {Kind: "email", Count: 3},
+		"<EMAIL_2>":  {Kind: "email", Count: 1},
+		"<HOST_1>":   {Kind: "host", Count: 2},
+		"<HOME_1>":   {Kind: "home", Count: 2},
+		"<SECRET_1>": {Kind: "secret", Count: 1},
+	}
+	if len(s.Redactions) != len(want) {
+		t.Fatalf("Redactions = %+v", s.Redactions)
+	}
+	for _, r := range s.Redactions {
+		w, ok := want[r.Placeholder]
+		if !ok || r.Kind != w.Kind || r.Count != w.Count {
+			t.Errorf("redaction %+v, want %+v", r, w)
+		}
+		if strings.Contains(r.Placeholder, "example.com") {
+			t.Errorf("report leaks a value: %+v", r)
+		}
+	}
+}
+
+func TestSession_Configured(t *testing.T) {
+	declare(t, `redaction:
+  kinds: [email]
+  hosts: [corp.example.com]
+  patterns:
+    - name: ticket
+      pattern: 'ACME-[0-9]+'
+`)
+	s := &entity.Session{Messages: []entity.Message{{
+		Text: "ACME-42 on git.corp.example.com by /Users/bob, " +
+			"ask eve@example.com",
+	}}}
+	Session(s)
+
+	want := "<TICKET_1> on git.corp.example.com by /Users/bob, " +
+		"ask <EMAIL_1>"
+	if got := s.Messages[0].Text; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
+
+func TestSession_HostSuffix(t *testing.T) {
+	declare(t, `redaction:
+  hosts: [corp.example.com]
+`)
+	s := &entity.Session{Messages: []entity.Message{{
+		Text: "git.corp.example.com vs example.com and main.go",
+	}}}
+	Session(s)
+
+	want := "<HOST_1> vs example.com and main.go"
+	if got := s.Messages[0].Text; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
+
+func TestSession_Clean(t *testing.T) {
+	declare(t, "")
+	s := &entity.Session{Messages: []entity.Message{{Text: "nothing here"}}}
+	Session(s)
+
+	if !s.Redacted || len(s.Redactions) != 0 {
+		t.Errorf("Redacted = %v, Redactions = %v", s.Redacted, s.Redactions)
+	}
+}
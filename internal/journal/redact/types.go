@@ -0,0 +1,53 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"regexp"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// span is a half-open byte range [start, end) to replace.
+type span [2]int
+
+// kind is one redaction rule prepared for a session.
+//
+// Fields:
+//   - name: Kind reported in frontmatter
+//   - find: Returns the spans to replace in a text
+type kind struct {
+	name string
+	find func(text string) []span
+}
+
+// matcher finds the spans of a compiled pattern.
+//
+// Fields:
+//   - pattern: Compiled expression
+//   - group: Capture group holding the value (0 for the whole
+//     match)
+type matcher struct {
+	pattern *regexp.Regexp
+	group   int
+}
+
+// redactor holds the placeholder state of one session.
+//
+// Fields:
+//   - kinds: Rules in pipeline order
+//   - values: Placeholder by kind and value
+//   - next: Last counter used per kind
+//   - report: Placeholders in order of first use
+//   - index: Position in report by placeholder
+type redactor struct {
+	kinds  []kind
+	values map[string]string
+	next   map[string]int
+	report []entity.Redaction
+	index  map[string]int
+}
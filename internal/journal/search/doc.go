@@ -0,0 +1,29 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package search implements ctx journal search over the
+// Markdown entries in .context/journal/.
+//
+// # Index
+//
+// The unit of search is a conversation turn (a "### N. Role
+// (HH:MM:SS)" section). [Update] keeps an inverted index in
+// .context/state/ that maps each term to the turns containing
+// it, with per-file size and modification time so only new,
+// changed, and deleted entries are re-read. Session metadata
+// (title, date, tool, branch, model, topics) and the files each
+// turn touched through Read, Write, and Edit tool uses are
+// stored alongside.
+//
+// # Query
+//
+// [Find] parses the query, selects turns containing every word
+// and phrase, drops sessions rejected by field filters, and
+// ranks what is left with BM25. Phrases are verified against
+// the turn text. A query made only of filters lists matching
+// sessions, newest first. Each hit carries the first matching
+// line with surrounding context.
+package search
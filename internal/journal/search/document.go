@@ -0,0 +1,143 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// parseDocument extracts metadata and turns from a journal
+// entry.
+//
+// Frontmatter supplies title, date, time, branch, model, and
+// topics; the metadata table fills in the tool (and branch or
+// model when frontmatter lacks them); the first H1 is the title
+// fallback. Text before the first turn header is not indexed.
+//
+// Parameters:
+//   - content: Journal file content
+//
+// Returns:
+//   - *document: Parsed metadata and turns
+//   - []map[string]int: Term frequencies per turn
+func parseDocument(content string) (*document, []map[string]int) {
+	lines := strings.Split(content, token.NewlineLF)
+	doc := &document{}
+	body := 0
+	if len(lines) > 0 && lines[0] == token.Separator {
+		for i := 1; i < len(lines); i++ {
+			if lines[i] != token.Separator {
+				continue
+			}
+			var fm entity.JournalFrontmatter
+			if yaml.Unmarshal(
+				[]byte(strings.Join(lines[1:i], token.NewlineLF)), &fm,
+			) == nil {
+				doc.Title, doc.Date, doc.Time = fm.Title, fm.Date, fm.Time
+				doc.Branch, doc.Model = fm.Branch, fm.Model
+				for _, t := range fm.Topics {
+					doc.Tags = append(doc.Tags, strings.ToLower(t))
+				}
+			}
+			body = i + 1
+			break
+		}
+	}
+
+	var freqs []map[string]int
+	cur := -1
+	for i := body; i < len(lines); i++ {
+		line := lines[i]
+		if hm := regex.TurnHeader.FindStringSubmatch(line); hm != nil {
+			if cur >= 0 {
+				doc.Turns[cur].End = i
+			}
+			doc.Turns = append(doc.Turns, turn{
+				Header: strings.TrimPrefix(line, token.HeadingLevelThreeStart),
+				Start:  i,
+			})
+			freqs = append(freqs, map[string]int{})
+			cur++
+			continue
+		}
+		trimmed := strings.TrimSpace(line)
+		if cur < 0 {
+			doc.meta(trimmed)
+			continue
+		}
+		if fm := regex.JournalToolFile.FindStringSubmatch(trimmed); fm != nil &&
+			!slices.Contains(doc.Turns[cur].Files, fm[2]) {
+			doc.Turns[cur].Files = append(doc.Turns[cur].Files, fm[2])
+		}
+		for _, t := range terms(line) {
+			freqs[cur][t]++
+			doc.Turns[cur].Length++
+		}
+	}
+	if cur >= 0 {
+		doc.Turns[cur].End = len(lines)
+	}
+	return doc, freqs
+}
+
+// meta fills document fields from a line of the entry header:
+// the H1 title and metadata table rows.
+//
+// Parameters:
+//   - line: Trimmed line before the first turn
+func (d *document) meta(line string) {
+	if d.Title == "" && strings.HasPrefix(line, token.HeadingLevelOneStart) {
+		d.Title = strings.TrimPrefix(line, token.HeadingLevelOneStart)
+		return
+	}
+	row := regex.JournalMetaRow.FindStringSubmatch(line)
+	if row == nil {
+		return
+	}
+	label, value := row[1], row[2]
+	switch {
+	case label == desc.Text(text.DescKeyLabelMetaTool):
+		d.Tool = value
+	case label == desc.Text(text.DescKeyLabelMetaBranch) && d.Branch == "":
+		d.Branch = value
+	case label == desc.Text(text.DescKeyLabelMetaModel) && d.Model == "":
+		d.Model = value
+	case label == desc.Text(text.DescKeyLabelMetaDate) && d.Date == "":
+		d.Date = value
+	case label == desc.Text(text.DescKeyLabelMetaTime) && d.Time == "":
+		d.Time = value
+	}
+}
+
+// terms splits text into lower-cased index terms.
+//
+// Parameters:
+//   - s: Text to split
+//
+// Returns:
+//   - []string: Words of at least cfgSearch.MinTokenLen runes
+func terms(s string) []string {
+	words := regex.SearchTerm.FindAllString(strings.ToLower(s), -1)
+	out := words[:0]
+	for _, w := range words {
+		if utf8.RuneCountInString(w) >= cfgSearch.MinTokenLen {
+			out = append(out, w)
+		}
+	}
+	return out
+}
@@ -0,0 +1,186 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/log/warn"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// openIndex loads the search index of the current project.
+//
+// A missing, unreadable, or outdated index starts empty and is
+// rebuilt by refresh.
+//
+// Returns:
+//   - *index: Loaded or empty index
+//   - error: Non-nil when the context directory is not declared
+func openIndex() (*index, error) {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return nil, ctxErr
+	}
+	idx := &index{
+		Version: cfgSearch.IndexVersion,
+		Files:   make(map[string]*document),
+		Terms:   make(map[string][]posting),
+		path:    filepath.Join(ctxDir, dir.State, cfgSearch.FileIndex),
+	}
+	data, readErr := io.SafeReadUserFile(idx.path)
+	if readErr != nil {
+		return idx, nil
+	}
+	var loaded index
+	if json.Unmarshal(data, &loaded) != nil ||
+		loaded.Version != cfgSearch.IndexVersion ||
+		loaded.Files == nil || loaded.Terms == nil {
+		idx.dirty = true
+		return idx, nil
+	}
+	idx.Files, idx.Terms = loaded.Files, loaded.Terms
+	return idx, nil
+}
+
+// refresh re-indexes new and changed journal files and drops
+// deleted ones.
+//
+// Files are compared by size and modification time. Files that
+// cannot be read are left out until they can.
+//
+// Parameters:
+//   - journalDir: Path to .context/journal/
+//
+// Returns:
+//   - updated: Number of files (re)indexed
+//   - removed: Number of files dropped
+//   - err: Non-nil when the directory cannot be listed
+func (idx *index) refresh(journalDir string) (updated, removed int, err error) {
+	entries, readErr := os.ReadDir(journalDir)
+	if readErr != nil {
+		return 0, 0, readErr
+	}
+	present := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, file.ExtMarkdown) {
+			continue
+		}
+		info, infoErr := e.Info()
+		if infoErr != nil {
+			continue
+		}
+		present[name] = true
+		if d, ok := idx.Files[name]; ok && d.Size == info.Size() &&
+			d.ModTime.Equal(info.ModTime()) {
+			continue
+		}
+		content, contentErr := io.SafeReadUserFile(
+			filepath.Join(journalDir, name),
+		)
+		if contentErr != nil {
+			continue
+		}
+		idx.remove(name)
+		doc, freqs := parseDocument(string(content))
+		doc.Size, doc.ModTime = info.Size(), info.ModTime()
+		idx.add(name, doc, freqs)
+		updated++
+	}
+	for name := range idx.Files {
+		if !present[name] {
+			idx.remove(name)
+			removed++
+		}
+	}
+	return updated, removed, nil
+}
+
+// add stores a document and its postings.
+//
+// Parameters:
+//   - name: Journal file name
+//   - doc: Parsed document
+//   - freqs: Term frequencies per turn, parallel to doc.Turns
+func (idx *index) add(name string, doc *document, freqs []map[string]int) {
+	idx.Files[name] = doc
+	for i, tf := range freqs {
+		for term, n := range tf {
+			idx.Terms[term] = append(
+				idx.Terms[term], posting{File: name, Turn: i, Freq: n},
+			)
+		}
+	}
+	idx.dirty = true
+}
+
+// remove drops a document and its postings.
+//
+// Parameters:
+//   - name: Journal file name
+func (idx *index) remove(name string) {
+	if _, ok := idx.Files[name]; !ok {
+		return
+	}
+	delete(idx.Files, name)
+	for term, list := range idx.Terms {
+		kept := list[:0]
+		for _, p := range list {
+			if p.File != name {
+				kept = append(kept, p)
+			}
+		}
+		if len(kept) == 0 {
+			delete(idx.Terms, term)
+			continue
+		}
+		idx.Terms[term] = kept
+	}
+	idx.dirty = true
+}
+
+// save writes the index atomically if it changed.
+//
+// Failures are reported as warnings: the index is a cache and
+// is rebuilt on the next run.
+func (idx *index) save() {
+	if !idx.dirty {
+		return
+	}
+	data, marshalErr := json.Marshal(idx)
+	if marshalErr != nil {
+		warn.Warn(cfgWarn.Marshal, marshalErr)
+		return
+	}
+	data = append(data, token.NewlineLF[0])
+	stateDir := filepath.Dir(idx.path)
+	if mkErr := io.SafeMkdirAll(stateDir, fs.PermExec); mkErr != nil {
+		warn.Warn(cfgWarn.Mkdir, stateDir, mkErr)
+		return
+	}
+	tmp := idx.path + file.ExtTmp
+	if writeErr := io.SafeWriteFile(tmp, data, fs.PermFile); writeErr != nil {
+		warn.Warn(cfgWarn.Write, tmp, writeErr)
+		return
+	}
+	if renameErr := os.Rename(tmp, idx.path); renameErr != nil {
+		warn.Warn(cfgWarn.Rename, tmp, renameErr)
+		return
+	}
+	idx.dirty = false
+}
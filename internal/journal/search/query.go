@@ -0,0 +1,105 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	errJournal "github.com/ActiveMemory/ctx/internal/err/journal"
+)
+
+// parseQuery splits a raw query into terms, phrases, and filters.
+//
+// Quoted text is a phrase; its words are also required terms.
+// #tag filters by topic; tool:, branch:, model:, since:, and
+// file: filter by metadata. Any other field:value is searched
+// as plain text.
+//
+// Parameters:
+//   - raw: Query as typed by the user
+//
+// Returns:
+//   - query: Parsed query
+//   - error: Non-nil if a since: value is not a date or Nd
+func parseQuery(raw string) (query, error) {
+	var q query
+	for _, part := range regex.SearchQueryPart.FindAllString(raw, -1) {
+		if strings.HasPrefix(part, cfgSearch.Quote) {
+			words := terms(strings.Trim(part, cfgSearch.Quote))
+			if len(words) == 0 {
+				continue
+			}
+			q.terms = append(q.terms, words...)
+			if len(words) > 1 {
+				q.phrases = append(q.phrases, strings.Join(words, token.Space))
+			}
+			continue
+		}
+		if tag, ok := strings.CutPrefix(part, cfgSearch.TagPrefix); ok &&
+			tag != "" {
+			q.tags = append(q.tags, strings.ToLower(tag))
+			continue
+		}
+		field, value, found := strings.Cut(part, cfgSearch.FieldSep)
+		if found && value != "" {
+			switch strings.ToLower(field) {
+			case cfgSearch.FieldTool:
+				q.tool = value
+				continue
+			case cfgSearch.FieldBranch:
+				q.branch = value
+				continue
+			case cfgSearch.FieldModel:
+				q.model = strings.ToLower(value)
+				continue
+			case cfgSearch.FieldFile:
+				q.file = value
+				continue
+			case cfgSearch.FieldSince:
+				since, sinceErr := parseSince(value)
+				if sinceErr != nil {
+					return q, sinceErr
+				}
+				q.since = since
+				continue
+			}
+		}
+		q.terms = append(q.terms, terms(part)...)
+	}
+	slices.Sort(q.terms)
+	q.terms = slices.Compact(q.terms)
+	return q, nil
+}
+
+// parseSince converts a since: value into a start date.
+//
+// Parameters:
+//   - value: YYYY-MM-DD or Nd (N days ago)
+//
+// Returns:
+//   - time.Time: Start of the accepted range
+//   - error: Non-nil if the value matches neither form
+func parseSince(value string) (time.Time, error) {
+	if m := regex.SearchSinceDays.FindStringSubmatch(value); m != nil {
+		days, _ := strconv.Atoi(m[1])
+		return time.Now().AddDate(0, 0, -days), nil
+	}
+	t, parseErr := time.ParseInLocation(
+		cfgTime.DateFormat, value, time.Local,
+	)
+	if parseErr != nil {
+		return time.Time{}, errJournal.SearchSince(value)
+	}
+	return t, nil
+}
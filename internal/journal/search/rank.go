@@ -0,0 +1,320 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"math"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/file"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSearch "github.com/ActiveMemory/ctx/internal/config/search"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+)
+
+// base returns the document that carries session metadata for
+// a file: continuation parts (-pN) inherit from part one.
+//
+// Parameters:
+//   - name: Journal file name
+//
+// Returns:
+//   - *document: The base part if indexed, otherwise the file
+func (idx *index) base(name string) *document {
+	if regex.MultiPart.MatchString(name) {
+		base := regex.MultiPart.ReplaceAllString(name, file.ExtMarkdown)
+		if d, ok := idx.Files[base]; ok {
+			return d
+		}
+	}
+	return idx.Files[name]
+}
+
+// accepts reports whether a file passes the query's filters.
+//
+// Parameters:
+//   - name: Journal file name
+//   - q: Parsed query
+//
+// Returns:
+//   - bool: True if every metadata filter matches
+func (idx *index) accepts(name string, q query) bool {
+	m := idx.base(name)
+	if q.tool != "" && !strings.EqualFold(m.Tool, q.tool) {
+		return false
+	}
+	if q.branch != "" && !strings.EqualFold(m.Branch, q.branch) {
+		return false
+	}
+	if q.model != "" && !strings.Contains(strings.ToLower(m.Model), q.model) {
+		return false
+	}
+	if !q.since.IsZero() && m.Date < q.since.Format(cfgTime.DateFormat) {
+		return false
+	}
+	for _, tag := range q.tags {
+		if !slices.Contains(m.Tags, tag) {
+			return false
+		}
+	}
+	return q.file == "" || touchedTurn(idx.Files[name], q.file) >= 0
+}
+
+// touchedTurn finds the first turn whose tool uses touched a
+// matching file.
+//
+// Parameters:
+//   - d: Indexed document
+//   - path: Substring of the file path
+//
+// Returns:
+//   - int: Turn index, or -1 if no turn touched the file
+func touchedTurn(d *document, path string) int {
+	for i, t := range d.Turns {
+		for _, f := range t.Files {
+			if strings.Contains(f, path) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// score ranks turns that contain every query term with BM25.
+//
+// Parameters:
+//   - q: Parsed query with at least one term
+//   - ok: Files that passed the filters
+//
+// Returns:
+//   - map[turnRef]float64: Score per matching turn
+func (idx *index) score(
+	q query, ok map[string]bool,
+) map[turnRef]float64 {
+	total, length := 0, 0
+	for _, d := range idx.Files {
+		for _, t := range d.Turns {
+			total++
+			length += t.Length
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	avg := math.Max(float64(length)/float64(total), 1)
+
+	scores := make(map[turnRef]float64)
+	hits := make(map[turnRef]int)
+	for _, term := range q.terms {
+		list := idx.Terms[term]
+		if len(list) == 0 {
+			return nil
+		}
+		df := float64(len(list))
+		idf := math.Log(1 + (float64(total)-df+0.5)/(df+0.5))
+		for _, p := range list {
+			if !ok[p.File] {
+				continue
+			}
+			ref := turnRef{file: p.File, turn: p.Turn}
+			tl := float64(idx.Files[p.File].Turns[p.Turn].Length)
+			tf := float64(p.Freq)
+			scores[ref] += idf * tf * (cfgSearch.BM25K1 + 1) /
+				(tf + cfgSearch.BM25K1*
+					(1-cfgSearch.BM25B+cfgSearch.BM25B*tl/avg))
+			hits[ref]++
+		}
+	}
+	for ref := range scores {
+		if hits[ref] < len(q.terms) {
+			delete(scores, ref)
+		}
+	}
+	return scores
+}
+
+// find runs a parsed query against the index.
+//
+// Parameters:
+//   - journalDir: Journal directory the index covers
+//   - q: Parsed query
+//   - limit: Maximum number of hits
+//   - ctxLines: Lines of context around the matching line
+//
+// Returns:
+//   - []entity.JournalHit: Hits, best first
+func (idx *index) find(
+	journalDir string, q query, limit, ctxLines int,
+) []entity.JournalHit {
+	ok := make(map[string]bool)
+	for name := range idx.Files {
+		if idx.accepts(name, q) {
+			ok[name] = true
+		}
+	}
+
+	scores := make(map[turnRef]float64)
+	if len(q.terms) == 0 {
+		for name := range ok {
+			d := idx.Files[name]
+			if len(d.Turns) == 0 {
+				continue
+			}
+			t := 0
+			if q.file != "" {
+				t = touchedTurn(d, q.file)
+			}
+			scores[turnRef{file: name, turn: t}] = 0
+		}
+	} else {
+		scores = idx.score(q, ok)
+	}
+
+	lines := make(map[string][]string)
+	read := func(name string) []string {
+		if l, cached := lines[name]; cached {
+			return l
+		}
+		data, readErr := io.SafeReadUserFile(filepath.Join(journalDir, name))
+		if readErr != nil {
+			lines[name] = nil
+			return nil
+		}
+		lines[name] = strings.Split(string(data), token.NewlineLF)
+		return lines[name]
+	}
+
+	refs := make([]turnRef, 0, len(scores))
+	for ref := range scores {
+		t := idx.Files[ref.file].Turns[ref.turn]
+		l := read(ref.file)
+		if t.End > len(l) {
+			continue
+		}
+		if len(q.phrases) > 0 && !hasPhrases(l[t.Start:t.End], q.phrases) {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		a, b := refs[i], refs[j]
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		ma, mb := idx.base(a.file), idx.base(b.file)
+		if ma.Date+ma.Time != mb.Date+mb.Time {
+			return ma.Date+ma.Time > mb.Date+mb.Time
+		}
+		if a.file != b.file {
+			return a.file > b.file
+		}
+		return a.turn < b.turn
+	})
+	if limit > 0 && len(refs) > limit {
+		refs = refs[:limit]
+	}
+
+	hits := make([]entity.JournalHit, 0, len(refs))
+	for _, ref := range refs {
+		d, m := idx.Files[ref.file], idx.base(ref.file)
+		t := d.Turns[ref.turn]
+		hits = append(hits, entity.JournalHit{
+			Filename: ref.file,
+			Title:    m.Title,
+			Date:     m.Date,
+			Time:     m.Time,
+			Tool:     m.Tool,
+			Turn:     t.Header,
+			Score:    scores[ref],
+			Lines:    snippet(read(ref.file), t, q, ctxLines),
+		})
+	}
+	return hits
+}
+
+// hasPhrases reports whether a turn contains every phrase as a
+// consecutive run of terms.
+//
+// Parameters:
+//   - turnLines: Lines of the turn
+//   - phrases: Space-joined term sequences
+//
+// Returns:
+//   - bool: True if all phrases occur
+func hasPhrases(turnLines, phrases []string) bool {
+	words := terms(strings.Join(turnLines, token.NewlineLF))
+	stream := token.Space + strings.Join(words, token.Space) + token.Space
+	for _, p := range phrases {
+		if !strings.Contains(stream, token.Space+p+token.Space) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether a line contains a query term or, for
+// filter-only file queries, the touched path.
+//
+// Parameters:
+//   - line: Journal line
+//   - q: Parsed query
+//
+// Returns:
+//   - bool: True if the line matches
+func matches(line string, q query) bool {
+	if len(q.terms) == 0 {
+		return q.file != "" && strings.Contains(line, q.file)
+	}
+	for _, w := range terms(line) {
+		if slices.Contains(q.terms, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// snippet cuts the first matching line of a turn with its
+// context; the turn header stands in when no line matches.
+//
+// Parameters:
+//   - l: Lines of the journal file
+//   - t: Turn to cut from
+//   - q: Parsed query
+//   - ctxLines: Lines of context on each side
+//
+// Returns:
+//   - []entity.JournalHitLine: Snippet lines
+func snippet(
+	l []string, t turn, q query, ctxLines int,
+) []entity.JournalHitLine {
+	if t.End > len(l) {
+		return nil
+	}
+	at := t.Start
+	for i := t.Start + 1; i < t.End; i++ {
+		if matches(l[i], q) {
+			at = i
+			break
+		}
+	}
+	from, to := max(t.Start, at-ctxLines), min(t.End-1, at+ctxLines)
+	out := make([]entity.JournalHitLine, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		out = append(out, entity.JournalHitLine{
+			Num:   i + 1,
+			Text:  l[i],
+			Match: i > t.Start && matches(l[i], q),
+		})
+	}
+	return out
+}
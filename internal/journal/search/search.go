@@ -0,0 +1,63 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import "github.com/ActiveMemory/ctx/internal/entity"
+
+// Update brings the search index in line with the journal
+// directory, re-indexing only files whose size or modification
+// time changed and dropping files that no longer exist.
+//
+// Parameters:
+//   - journalDir: Path to .context/journal/
+//
+// Returns:
+//   - updated: Number of files (re)indexed
+//   - removed: Number of files dropped from the index
+//   - err: Non-nil if the context or journal directory is unreadable
+func Update(journalDir string) (updated, removed int, err error) {
+	idx, openErr := openIndex()
+	if openErr != nil {
+		return 0, 0, openErr
+	}
+	updated, removed, err = idx.refresh(journalDir)
+	if err != nil {
+		return updated, removed, err
+	}
+	idx.save()
+	return updated, removed, nil
+}
+
+// Find searches the journal. The index is refreshed first, so
+// results reflect entries edited since the last sync.
+//
+// Parameters:
+//   - journalDir: Path to .context/journal/
+//   - raw: Query string (terms, "phrases", #tags, field:value)
+//   - limit: Maximum number of hits (0 for all)
+//   - context: Lines of context around each matching line
+//
+// Returns:
+//   - []entity.JournalHit: Ranked hits, best first
+//   - error: Non-nil on an invalid query or unreadable journal
+func Find(
+	journalDir, raw string, limit, context int,
+) ([]entity.JournalHit, error) {
+	q, queryErr := parseQuery(raw)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	idx, openErr := openIndex()
+	if openErr != nil {
+		return nil, openErr
+	}
+	if _, _, refreshErr := idx.refresh(journalDir); refreshErr != nil {
+		return nil, refreshErr
+	}
+	idx.save()
+	return idx.find(journalDir, q, limit, context), nil
+}
@@ -0,0 +1,263 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+const entryRate = `---
+date: "2026-03-02"
+time: "10:00:00"
+branch: "feature/limits"
+model: "claude-sonnet-4"
+title: "Rate limiter"
+topics:
+  - Performance
+---
+
+# Rate limiter
+
+<table>
+<tr><td><strong>Tool</strong></td><td>claude-code</td></tr>
+</table>
+
+### 1. User (10:00:00)
+
+Add a rate limit to the API gateway.
+
+### 2. Assistant (10:00:05)
+
+🔧 **Read: internal/gateway/limit.go**
+
+The token bucket refills every second.
+The rate limit now applies per client.
+`
+
+const entryCache = `---
+date: "2026-03-01"
+title: "Cache warmup"
+---
+
+# Cache warmup
+
+<table>
+<tr><td><strong>Tool</strong></td><td>aider</td></tr>
+</table>
+
+### 1. User (09:00:00)
+
+Why is the cache cold after deploy? Limit the warmup.
+
+### 2. Assistant (09:01:00)
+
+🔧 **Edit: internal/cache/warm.go**
+
+Warm the cache on startup.
+`
+
+// setup writes journal entries into a fresh project and returns
+// the journal directory.
+func setup(t *testing.T, entries map[string]string) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	journalDir := filepath.Join(tmpDir, ".context", "journal")
+	if mkErr := os.MkdirAll(journalDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	testctx.Declare(t, tmpDir)
+	for name, content := range entries {
+		writeEntry(t, journalDir, name, content)
+	}
+	return journalDir
+}
+
+func writeEntry(t *testing.T, journalDir, name, content string) {
+	t.Helper()
+	if wErr := os.WriteFile(
+		filepath.Join(journalDir, name), []byte(content), 0o600,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+}
+
+func defaultEntries() map[string]string {
+	return map[string]string{
+		"2026-03-02-rate-limiter-aaaa1111.md": entryRate,
+		"2026-03-01-cache-warmup-bbbb2222.md": entryCache,
+	}
+}
+
+func TestFind_RanksMatchingTurn(t *testing.T) {
+	journalDir := setup(t, defaultEntries())
+
+	hits, err := Find(journalDir, "rate limit", 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("got %d hits, want 2 turns of the rate entry", len(hits))
+	}
+	for _, h := range hits {
+		if h.Filename != "2026-03-02-rate-limiter-aaaa1111.md" {
+			t.Errorf("unexpected file %s", h.Filename)
+		}
+		if h.Tool != "claude-code" || h.Title != "Rate limiter" {
+			t.Errorf("metadata = %q/%q", h.Tool, h.Title)
+		}
+		if h.Score <= 0 {
+			t.Errorf("score = %v, want > 0", h.Score)
+		}
+	}
+
+	var matched bool
+	for _, l := range hits[0].Lines {
+		if l.Match {
+			matched = true
+		}
+	}
+	if !matched {
+		t.Errorf("snippet has no matching line: %+v", hits[0].Lines)
+	}
+}
+
+func TestFind_Phrase(t *testing.T) {
+	journalDir := setup(t, defaultEntries())
+
+	hits, err := Find(journalDir, `"token bucket"`, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Turn != "2. Assistant (10:00:05)" {
+		t.Fatalf("phrase hits = %+v", hits)
+	}
+	if hits[0].Lines[0].Text != "The token bucket refills every second." {
+		t.Errorf("snippet = %q", hits[0].Lines[0].Text)
+	}
+
+	hits, err = Find(journalDir, `"bucket token"`, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 0 {
+		t.Errorf("reversed phrase matched: %+v", hits)
+	}
+}
+
+func TestFind_Filters(t *testing.T) {
+	journalDir := setup(t, defaultEntries())
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"limit tool:aider", "2026-03-01-cache-warmup-bbbb2222.md"},
+		{"limit branch:feature/limits", "2026-03-02-rate-limiter-aaaa1111.md"},
+		{"limit model:sonnet", "2026-03-02-rate-limiter-aaaa1111.md"},
+		{"limit #performance", "2026-03-02-rate-limiter-aaaa1111.md"},
+		{"file:cache/warm.go", "2026-03-01-cache-warmup-bbbb2222.md"},
+		{"limit since:2026-03-02", "2026-03-02-rate-limiter-aaaa1111.md"},
+	}
+	for _, tt := range tests {
+		hits, err := Find(journalDir, tt.query, 0, 0)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if len(hits) == 0 {
+			t.Errorf("%s: no hits", tt.query)
+			continue
+		}
+		for _, h := range hits {
+			if h.Filename != tt.want {
+				t.Errorf("%s: got %s, want %s", tt.query, h.Filename, tt.want)
+			}
+		}
+	}
+}
+
+func TestFind_FileOnlyPicksTouchingTurn(t *testing.T) {
+	journalDir := setup(t, defaultEntries())
+
+	hits, err := Find(journalDir, "file:gateway/limit.go", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Turn != "2. Assistant (10:00:05)" {
+		t.Fatalf("hits = %+v", hits)
+	}
+	if !hits[0].Lines[0].Match {
+		t.Errorf("tool line not marked: %+v", hits[0].Lines)
+	}
+}
+
+func TestFind_InvalidSince(t *testing.T) {
+	journalDir := setup(t, defaultEntries())
+	if _, err := Find(journalDir, "since:yesterday", 0, 0); err == nil {
+		t.Fatal("expected error for since:yesterday")
+	}
+	if _, err := Find(journalDir, "limit since:30d", 0, 0); err != nil {
+		t.Fatalf("since:30d: %v", err)
+	}
+}
+
+func TestUpdate_Incremental(t *testing.T) {
+	journalDir := setup(t, defaultEntries())
+
+	updated, removed, err := Update(journalDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 2 || removed != 0 {
+		t.Fatalf("first update = %d/%d, want 2/0", updated, removed)
+	}
+
+	updated, removed, err = Update(journalDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 0 || removed != 0 {
+		t.Fatalf("second update = %d/%d, want 0/0", updated, removed)
+	}
+
+	name := "2026-03-01-cache-warmup-bbbb2222.md"
+	writeEntry(t, journalDir, name, entryCache+"\nPrefetch the hot keys.\n")
+	later := time.Now().Add(time.Minute)
+	if tErr := os.Chtimes(
+		filepath.Join(journalDir, name), later, later,
+	); tErr != nil {
+		t.Fatal(tErr)
+	}
+	if err = os.Remove(filepath.Join(
+		journalDir, "2026-03-02-rate-limiter-aaaa1111.md",
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, removed, err = Update(journalDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 1 || removed != 1 {
+		t.Fatalf("third update = %d/%d, want 1/1", updated, removed)
+	}
+
+	hits, err := Find(journalDir, "prefetch", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 {
+		t.Errorf("new text not indexed: %+v", hits)
+	}
+	if hits, _ = Find(journalDir, "bucket", 0, 0); len(hits) != 0 {
+		t.Errorf("removed entry still indexed: %+v", hits)
+	}
+}
@@ -0,0 +1,109 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import "time"
+
+// index is the persisted inverted index.
+//
+// Fields:
+//   - Version: Format version (cfgSearch.IndexVersion)
+//   - Files: Indexed documents by journal file name
+//   - Terms: Postings by term
+//   - path: Index file location
+//   - dirty: True when the index must be written
+type index struct {
+	Version int                  `json:"version"`
+	Files   map[string]*document `json:"files"`
+	Terms   map[string][]posting `json:"terms"`
+	path    string
+	dirty   bool
+}
+
+// document is one indexed journal file.
+//
+// Fields:
+//   - Size: File size when indexed
+//   - ModTime: Modification time when indexed
+//   - Title: Entry title
+//   - Date: Session date (YYYY-MM-DD)
+//   - Time: Session start time
+//   - Tool: AI tool from the metadata table
+//   - Branch: Git branch
+//   - Model: Model ID
+//   - Tags: Topics from frontmatter, lower-cased
+//   - Turns: Conversation turns in file order
+type document struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mtime"`
+	Title   string    `json:"title,omitempty"`
+	Date    string    `json:"date,omitempty"`
+	Time    string    `json:"time,omitempty"`
+	Tool    string    `json:"tool,omitempty"`
+	Branch  string    `json:"branch,omitempty"`
+	Model   string    `json:"model,omitempty"`
+	Tags    []string  `json:"tags,omitempty"`
+	Turns   []turn    `json:"turns"`
+}
+
+// turn is one conversation turn of a document.
+//
+// Fields:
+//   - Header: Turn header without the heading marker
+//   - Start: 0-based line of the header
+//   - End: 0-based line just past the turn
+//   - Length: Number of terms in the turn
+//   - Files: Files touched by tool uses in the turn
+type turn struct {
+	Header string   `json:"header"`
+	Start  int      `json:"start"`
+	End    int      `json:"end"`
+	Length int      `json:"len"`
+	Files  []string `json:"files,omitempty"`
+}
+
+// posting records how often a term occurs in one turn.
+//
+// Fields:
+//   - File: Journal file name
+//   - Turn: Index into the document's turns
+//   - Freq: Occurrences of the term in the turn
+type posting struct {
+	File string `json:"f"`
+	Turn int    `json:"t"`
+	Freq int    `json:"n"`
+}
+
+// query is a parsed search query.
+//
+// Fields:
+//   - terms: Words every matching turn must contain
+//   - phrases: Lower-cased phrases every matching turn must
+//     contain verbatim
+//   - tool, branch, model, file: Field filters (empty = any)
+//   - since: Earliest session date (zero = any)
+//   - tags: Topic tags every matching session must carry
+type query struct {
+	terms   []string
+	phrases []string
+	tool    string
+	branch  string
+	model   string
+	file    string
+	since   time.Time
+	tags    []string
+}
+
+// turnRef identifies one turn of one document.
+//
+// Fields:
+//   - file: Journal file name
+//   - turn: Index into the document's turns
+type turnRef struct {
+	file string
+	turn int
+}
@@ -0,0 +1,19 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package stats aggregates parsed AI sessions into the cost
+// and usage report behind ctx journal stats.
+//
+// [Compute] groups sessions by day, ISO week, branch, model,
+// or AI tool and sums turns, tokens, errors, and duration per
+// group. Spend is estimated from the prices table in .ctxrc
+// (see rc.Price); sessions whose model has no entry are
+// counted as unpriced rather than free. The report also lists
+// the most-used tools across all sessions.
+//
+// [Headers] and [Cells] render a group as display strings so
+// the terminal table and the journal site page agree.
+package stats
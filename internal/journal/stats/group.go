@@ -0,0 +1,89 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
+	cfgTime "github.com/ActiveMemory/ctx/internal/config/time"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// groupKey returns the group value of a session.
+//
+// Parameters:
+//   - s: Session to classify
+//   - by: Grouping dimension
+//
+// Returns:
+//   - string: Group value, or the "none" label when empty
+func groupKey(s *entity.Session, by string) string {
+	var key string
+	switch by {
+	case cfgStats.ByDay:
+		if !s.StartTime.IsZero() {
+			key = s.StartTime.Local().Format(cfgTime.DateFormat)
+		}
+	case cfgStats.ByWeek:
+		if !s.StartTime.IsZero() {
+			year, week := s.StartTime.Local().ISOWeek()
+			key = fmt.Sprintf(cfgStats.WeekFormat, year, week)
+		}
+	case cfgStats.ByBranch:
+		key = s.GitBranch
+	case cfgStats.ByModel:
+		key = s.Model
+	case cfgStats.ByTool:
+		key = s.Tool
+	}
+	if key == "" {
+		return desc.Text(text.DescKeyLabelStatsNone)
+	}
+	return key
+}
+
+// sessionCost estimates the spend of one session.
+//
+// Parameters:
+//   - s: Session with token totals and model
+//
+// Returns:
+//   - float64: Estimated USD, 0 when unpriced
+//   - bool: False when the session used tokens but its model
+//     has no price entry
+func sessionCost(s *entity.Session) (float64, bool) {
+	in, out, ok := rc.Price(s.Model)
+	if !ok {
+		return 0, s.TotalTokensIn+s.TotalTokensOut == 0
+	}
+	return (in*float64(s.TotalTokensIn) + out*float64(s.TotalTokensOut)) /
+		cfgStats.TokensPerPrice, true
+}
+
+// averaged fills the derived rates of a group.
+//
+// Parameters:
+//   - g: Group with sums filled in
+//   - minutes: Total session minutes in the group
+//
+// Returns:
+//   - entity.StatsGroup: The group with ErrorRate, AvgTurns,
+//     and AvgMinutes set
+func averaged(g entity.StatsGroup, minutes float64) entity.StatsGroup {
+	if g.Sessions == 0 {
+		return g
+	}
+	n := float64(g.Sessions)
+	g.ErrorRate = float64(g.Errors) / n
+	g.AvgTurns = float64(g.Turns) / n
+	g.AvgMinutes = minutes / n
+	return g
+}
@@ -0,0 +1,164 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/format"
+)
+
+// Compute builds the stats report for a set of sessions.
+//
+// Day and week groups are listed oldest first; branch, model,
+// and tool groups are listed by estimated cost, then tokens.
+//
+// Parameters:
+//   - sessions: Parsed sessions to aggregate
+//   - by: Grouping dimension (one of cfgStats.By)
+//
+// Returns:
+//   - entity.JournalStats: Groups, totals, and top tools
+func Compute(sessions []*entity.Session, by string) entity.JournalStats {
+	groups := make(map[string]*entity.StatsGroup)
+	minutes := make(map[string]float64)
+	total := entity.StatsGroup{Key: desc.Text(text.DescKeyLabelStatsTotal)}
+	var totalMinutes float64
+	tools := make(map[string]int)
+	unpriced := make(map[string]bool)
+
+	for _, s := range sessions {
+		key := groupKey(s, by)
+		g, ok := groups[key]
+		if !ok {
+			g = &entity.StatsGroup{Key: key}
+			groups[key] = g
+		}
+		cost, priced := sessionCost(s)
+		if !priced && s.Model != "" {
+			unpriced[s.Model] = true
+		}
+		for _, target := range []*entity.StatsGroup{g, &total} {
+			target.Sessions++
+			target.Turns += s.TurnCount
+			target.TokensIn += s.TotalTokensIn
+			target.TokensOut += s.TotalTokensOut
+			target.Cost += cost
+			if !priced {
+				target.Unpriced++
+			}
+			if s.HasErrors {
+				target.Errors++
+			}
+		}
+		minutes[key] += s.Duration.Minutes()
+		totalMinutes += s.Duration.Minutes()
+		for _, tu := range s.AllToolUses() {
+			tools[tu.Name]++
+		}
+	}
+
+	report := entity.JournalStats{
+		By:       by,
+		Total:    averaged(total, totalMinutes),
+		Unpriced: slices.Sorted(maps.Keys(unpriced)),
+	}
+	for key, g := range groups {
+		report.Groups = append(report.Groups, averaged(*g, minutes[key]))
+	}
+	slices.SortFunc(report.Groups, func(a, b entity.StatsGroup) int {
+		if by == cfgStats.ByDay || by == cfgStats.ByWeek {
+			return cmp.Compare(a.Key, b.Key)
+		}
+		return cmp.Or(
+			cmp.Compare(b.Cost, a.Cost),
+			cmp.Compare(b.TokensIn+b.TokensOut, a.TokensIn+a.TokensOut),
+			cmp.Compare(b.Sessions, a.Sessions),
+			cmp.Compare(a.Key, b.Key),
+		)
+	})
+
+	for name, n := range tools {
+		report.Tools = append(report.Tools, entity.ToolCount{
+			Name: name, Count: n,
+		})
+	}
+	slices.SortFunc(report.Tools, func(a, b entity.ToolCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+	if len(report.Tools) > cfgStats.TopTools {
+		report.Tools = report.Tools[:cfgStats.TopTools]
+	}
+	return report
+}
+
+// Headers returns the column headers for a stats table.
+//
+// Parameters:
+//   - by: Grouping dimension, which labels the first column
+//
+// Returns:
+//   - []string: One header per column of [Cells]
+func Headers(by string) []string {
+	keys := map[string]string{
+		cfgStats.ByDay:    text.DescKeyLabelStatsByDay,
+		cfgStats.ByWeek:   text.DescKeyLabelStatsByWeek,
+		cfgStats.ByBranch: text.DescKeyLabelStatsByBranch,
+		cfgStats.ByModel:  text.DescKeyLabelStatsByModel,
+		cfgStats.ByTool:   text.DescKeyLabelStatsByTool,
+	}
+	return []string{
+		desc.Text(keys[by]),
+		desc.Text(text.DescKeyLabelStatsColSessions),
+		desc.Text(text.DescKeyLabelStatsColTurns),
+		desc.Text(text.DescKeyLabelStatsColTokensIn),
+		desc.Text(text.DescKeyLabelStatsColTokensOut),
+		desc.Text(text.DescKeyLabelStatsColCost),
+		desc.Text(text.DescKeyLabelStatsColErrors),
+		desc.Text(text.DescKeyLabelStatsColAvgTurns),
+		desc.Text(text.DescKeyLabelStatsColAvgMinutes),
+	}
+}
+
+// Cells formats one group as display strings.
+//
+// Cost is shown as "-" when no session in the group was
+// priced, so a missing price is never mistaken for zero spend.
+//
+// Parameters:
+//   - g: Group to format
+//
+// Returns:
+//   - []string: One cell per column of [Headers]
+func Cells(g entity.StatsGroup) []string {
+	cost := token.Dash
+	if g.Unpriced < g.Sessions {
+		cost = fmt.Sprintf(desc.Text(text.DescKeyWriteFormatUSD), g.Cost)
+	}
+	return []string{
+		g.Key,
+		format.Number(g.Sessions),
+		format.Number(g.Turns),
+		format.Tokens(g.TokensIn),
+		format.Tokens(g.TokensOut),
+		cost,
+		fmt.Sprintf(
+			desc.Text(text.DescKeyWriteFormatPercent),
+			g.ErrorRate*cfgStats.PercentMultiplier,
+		),
+		fmt.Sprintf(desc.Text(text.DescKeyWriteFormatDecimal), g.AvgTurns),
+		fmt.Sprintf(desc.Text(text.DescKeyWriteFormatWhole), g.AvgMinutes),
+	}
+}
@@ -0,0 +1,134 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// declare points CTX_DIR at a temp project with the given .ctxrc.
+func declare(t *testing.T, rcContent string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	if mkErr := os.MkdirAll(
+		filepath.Join(tmpDir, ".context"), 0o750,
+	); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if wErr := os.WriteFile(
+		filepath.Join(tmpDir, ".ctxrc"), []byte(rcContent), 0o600,
+	); wErr != nil {
+		t.Fatal(wErr)
+	}
+	testctx.Declare(t, tmpDir)
+}
+
+func session(
+	start string, model, branch string, in, out int, errs bool,
+	tools ...string,
+) *entity.Session {
+	ts, _ := time.ParseInLocation("2006-01-02 15:04", start, time.Local)
+	msg := entity.Message{Role: "assistant"}
+	for _, name := range tools {
+		msg.ToolUses = append(msg.ToolUses, entity.ToolUse{Name: name})
+	}
+	return &entity.Session{
+		Tool:           "claude-code",
+		StartTime:      ts,
+		Duration:       30 * time.Minute,
+		TurnCount:      4,
+		Model:          model,
+		GitBranch:      branch,
+		TotalTokensIn:  in,
+		TotalTokensOut: out,
+		HasErrors:      errs,
+		Messages:       []entity.Message{msg},
+	}
+}
+
+func sessions() []*entity.Session {
+	return []*entity.Session{
+		session("2026-03-02 09:00", "claude-sonnet-4-20250514", "main",
+			1_000_000, 100_000, false, "Read", "Read", "Edit"),
+		session("2026-03-02 14:00", "claude-opus-4-1", "feature",
+			200_000, 20_000, true, "Bash"),
+		session("2026-03-09 10:00", "gpt-4o", "",
+			50_000, 5_000, false, "Read"),
+	}
+}
+
+func near(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func TestCompute_ByDay(t *testing.T) {
+	declare(t, `prices:
+  claude-sonnet-4: { input: 3, output: 15 }
+  claude-opus: { input: 15, output: 75 }
+`)
+	report := Compute(sessions(), cfgStats.ByDay)
+
+	if len(report.Groups) != 2 {
+		t.Fatalf("groups = %+v, want 2 days", report.Groups)
+	}
+	day := report.Groups[0]
+	if day.Key != "2026-03-02" || day.Sessions != 2 {
+		t.Fatalf("first group = %+v", day)
+	}
+	// sonnet: 3 + 1.5; opus: 3 + 1.5
+	if !near(day.Cost, 9) {
+		t.Errorf("cost = %v, want 9", day.Cost)
+	}
+	if !near(day.ErrorRate, 0.5) || !near(day.AvgMinutes, 30) {
+		t.Errorf("rates = %v / %v", day.ErrorRate, day.AvgMinutes)
+	}
+
+	if report.Total.Sessions != 3 || report.Total.Unpriced != 1 {
+		t.Errorf("total = %+v", report.Total)
+	}
+	if len(report.Unpriced) != 1 || report.Unpriced[0] != "gpt-4o" {
+		t.Errorf("unpriced = %v", report.Unpriced)
+	}
+	if report.Tools[0].Name != "Read" || report.Tools[0].Count != 3 {
+		t.Errorf("top tool = %+v", report.Tools[0])
+	}
+}
+
+func TestCompute_ByWeekAndBranch(t *testing.T) {
+	declare(t, "")
+	weekly := Compute(sessions(), cfgStats.ByWeek)
+	if len(weekly.Groups) != 2 || weekly.Groups[0].Key != "2026-W10" {
+		t.Errorf("weeks = %+v", weekly.Groups)
+	}
+
+	branches := Compute(sessions(), cfgStats.ByBranch)
+	keys := map[string]bool{}
+	for _, g := range branches.Groups {
+		keys[g.Key] = true
+	}
+	if !keys["main"] || !keys["feature"] || !keys["(none)"] {
+		t.Errorf("branch keys = %v", keys)
+	}
+}
+
+func TestCells_UnpricedShowsDash(t *testing.T) {
+	declare(t, "")
+	report := Compute(sessions()[2:], cfgStats.ByModel)
+	cells := Cells(report.Groups[0])
+	if cells[5] != "-" {
+		t.Errorf("cost cell = %q, want -", cells[5])
+	}
+	if len(cells) != len(Headers(cfgStats.ByModel)) {
+		t.Errorf("cells and headers differ in length")
+	}
+}
@@ -40,7 +40,8 @@
 //
 // # Types
 //
-// Pending holds the one-based index and content text
-// of a pending top-level task discovered during
-// iteration.
+// Pending holds the one-based index, TASKS.md line
+// and content text of a pending top-level task
+// discovered during iteration. The line lets callers
+// match it against [internal/task/graph.BlockedLines].
 package task
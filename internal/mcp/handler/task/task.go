@@ -29,7 +29,7 @@ func ForEachPending(lines []string, fn func(Pending) bool) {
 	inCompletedSection := false
 	idx := 0
 
-	for _, line := range lines {
+	for i, line := range lines {
 		if strings.HasPrefix(line, desc.Text(text.DescKeyHeadingCompleted)) {
 			inCompletedSection = true
 			continue
@@ -52,7 +52,9 @@ func ForEachPending(lines []string, fn func(Pending) bool) {
 		}
 
 		idx++
-		if fn(Pending{Index: idx, Content: task.Content(match)}) {
+		if fn(Pending{
+			Index: idx, Line: i, Content: task.Content(match),
+		}) {
 			return
 		}
 	}
@@ -10,8 +10,10 @@ package task
 //
 // Fields:
 //   - Index: Zero-based position in the task list
+//   - Line: Zero-based line index in TASKS.md
 //   - Content: Full task line text
 type Pending struct {
 	Index   int
+	Line    int
 	Content string
 }
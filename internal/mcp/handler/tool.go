@@ -32,6 +32,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/journal/parser"
 	"github.com/ActiveMemory/ctx/internal/mcp/handler/task"
 	"github.com/ActiveMemory/ctx/internal/mcp/server/stat"
+	"github.com/ActiveMemory/ctx/internal/task/graph"
 	"github.com/ActiveMemory/ctx/internal/tidy"
 )
 
@@ -431,13 +432,15 @@ func Compact(d *entity.MCPDeps, archive bool) (string, error) {
 	return sb.String(), nil
 }
 
-// Next suggests the next pending task.
+// Next suggests the next pending task that is not blocked by
+// #after:/#blocks: dependencies on other pending tasks.
 //
 // Parameters:
 //   - d: runtime dependencies carrying the context directory
 //
 // Returns:
-//   - string: next pending task or all-complete message
+//   - string: next unblocked task, or an all-blocked or
+//     all-complete message
 //   - error: context load error
 func Next(d *entity.MCPDeps) (string, error) {
 	ctx, loadErr := load.Do(d.ContextDir)
@@ -451,9 +454,15 @@ func Next(d *entity.MCPDeps) (string, error) {
 	}
 
 	lines := strings.Split(string(tasksFile.Content), token.NewlineLF)
+	blocked := graph.BlockedLines(string(tasksFile.Content))
 
 	var result string
+	waiting := false
 	task.ForEachPending(lines, func(pt task.Pending) bool {
+		if blocked[pt.Line] {
+			waiting = true
+			return false
+		}
 		result = fmt.Sprintf(
 			desc.Text(text.DescKeyMCPNextTaskFormat),
 			pt.Index, pt.Content,
@@ -464,6 +473,9 @@ func Next(d *entity.MCPDeps) (string, error) {
 	if result != "" {
 		return result, nil
 	}
+	if waiting {
+		return desc.Text(text.DescKeyMCPAllTasksBlocked), nil
+	}
 
 	return desc.Text(text.DescKeyMCPAllTasksComplete), nil
 }
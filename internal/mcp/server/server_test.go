@@ -773,6 +773,47 @@ func TestToolNext(t *testing.T) {
 	}
 }
 
+func TestToolNextSkipsBlocked(t *testing.T) {
+	srv, contextDir := newTestServer(t)
+
+	call := func(tasksContent string) string {
+		t.Helper()
+		if err := os.WriteFile(
+			filepath.Join(contextDir, ctx.Task),
+			[]byte(tasksContent), 0o644,
+		); err != nil {
+			t.Fatalf("write tasks: %v", err)
+		}
+		resp := request(t, srv, "tools/call", proto.CallToolParams{
+			Name: "ctx_next",
+		})
+		if resp.Error != nil {
+			t.Fatalf("unexpected error: %v", resp.Error.Message)
+		}
+		raw, _ := json.Marshal(resp.Result)
+		var result proto.CallToolResult
+		if err := json.Unmarshal(raw, &result); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		return result.Content[0].Text
+	}
+
+	text := call("# Tasks\n\n" +
+		"- [ ] Login screen #after:api\n" +
+		"- [ ] Build API #id:api #after:schema\n" +
+		"- [x] Design schema #id:schema\n")
+	if !strings.Contains(text, "(#2): Build API") {
+		t.Errorf("expected first unblocked task with its number, got: %s", text)
+	}
+
+	text = call("# Tasks\n\n" +
+		"- [ ] A #id:a #after:b\n" +
+		"- [ ] B #id:b #after:a\n")
+	if !strings.Contains(text, "ctx task graph") {
+		t.Errorf("expected all-blocked message, got: %s", text)
+	}
+}
+
 func TestToolNextAllComplete(t *testing.T) {
 	srv, contextDir := newTestServer(t)
 
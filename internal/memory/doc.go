@@ -38,7 +38,8 @@
 //     to the matching .context/ file based on keyword
 //     heuristics from .ctxrc classify_rules.
 //   - **promote** ([Promote]): writes a classified
-//     entry to its target .context/ file.
+//     entry to its target .context/ file, titled by
+//     [Title] with the rest of the entry from [Body].
 //   - **publish** ([Publish], [SelectContent],
 //     [MergePublished], [RemovePublished]): the
 //     inverse direction: promotes .context/ entries
@@ -169,42 +169,3 @@ func (r *PublishResult) trimToBudget(budget int) {
 		r.Decisions = r.Decisions[:len(r.Decisions)-1]
 	}
 }
-
-// extractTitle returns the first meaningful line of an entry, cleaned
-// of Markdown heading markers and list item prefixes.
-//
-// Parameters:
-//   - text: raw entry text
-//
-// Returns:
-//   - string: cleaned first line
-func extractTitle(text string) string {
-	line := strings.SplitN(text, token.NewlineLF, 2)[0]
-	line = strings.TrimSpace(line)
-	// Strip heading markers
-	line = strings.TrimLeft(line, token.PrefixHeading)
-	line = strings.TrimSpace(line)
-	// Strip list item markers
-	if strings.HasPrefix(line, token.PrefixListDash) {
-		line = line[len(token.PrefixListDash):]
-	} else if strings.HasPrefix(line, token.PrefixListStar) {
-		line = line[len(token.PrefixListStar):]
-	}
-	return strings.TrimSpace(line)
-}
-
-// extractBody returns everything after the first line, or the first
-// line itself if there is only one line.
-//
-// Parameters:
-//   - text: raw entry text
-//
-// Returns:
-//   - string: body content after the title
-func extractBody(text string) string {
-	parts := strings.SplitN(text, token.NewlineLF, 2)
-	if len(parts) < 2 || strings.TrimSpace(parts[1]) == "" {
-		return extractTitle(text)
-	}
-	return strings.TrimSpace(parts[1])
-}
@@ -27,7 +27,7 @@ import (
 func Promote(e Entry, classification Classification) error {
 	// Extract a title from the entry text
 	// (first line, trimmed of Markdown markers)
-	title := extractTitle(e.Text)
+	title := Title(e.Text)
 
 	params := entity.EntryParams{
 		Type:    classification.Target,
@@ -37,12 +37,12 @@ func Promote(e Entry, classification Classification) error {
 	switch classification.Target {
 	case entry.Decision:
 		params.Context = desc.Text(text.DescKeyMemoryImportSource)
-		params.Rationale = extractBody(e.Text)
+		params.Rationale = Body(e.Text)
 		params.Consequence = desc.Text(text.DescKeyMemoryImportReview)
 
 	case entry.Learning:
 		params.Context = desc.Text(text.DescKeyMemoryImportSource)
-		params.Lesson = extractBody(e.Text)
+		params.Lesson = Body(e.Text)
 		params.Application = desc.Text(text.DescKeyMemoryImportReview)
 
 	case entry.Task:
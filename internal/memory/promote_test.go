@@ -138,7 +138,7 @@ func TestPromote_Task(t *testing.T) {
 	}
 }
 
-func TestExtractTitle(t *testing.T) {
+func TestTitle(t *testing.T) {
 	tests := []struct {
 		input string
 		want  string
@@ -151,9 +151,9 @@ func TestExtractTitle(t *testing.T) {
 		{"## Multi\nline entry", "Multi"},
 	}
 	for _, tt := range tests {
-		got := extractTitle(tt.input)
+		got := Title(tt.input)
 		if got != tt.want {
-			t.Errorf("extractTitle(%q) = %q, want %q", tt.input, got, tt.want)
+			t.Errorf("Title(%q) = %q, want %q", tt.input, got, tt.want)
 		}
 	}
 }
@@ -0,0 +1,127 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"encoding/json"
+	"strings"
+
+	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// LoadSecrets reads and decrypts the channel secrets from
+// .context/.notify-channels.enc.
+//
+// Returns (nil, nil) when the key or the encrypted file is
+// missing, like [LoadWebhook].
+//
+// Returns:
+//   - map[string]Secret: Secrets keyed by channel name
+//   - error: Non-nil on resolver, decryption or decode failure
+func LoadSecrets() (map[string]Secret, error) {
+	plaintext, readErr := readSecret(cfgCrypto.NotifyChannelsEnc)
+	if readErr != nil {
+		return nil, errNotify.LoadChannels(readErr)
+	}
+	if len(plaintext) == 0 {
+		return nil, nil
+	}
+	var secrets map[string]Secret
+	if decodeErr := json.Unmarshal(plaintext, &secrets); decodeErr != nil {
+		return nil, errNotify.LoadChannels(decodeErr)
+	}
+	return secrets, nil
+}
+
+// SaveSecret stores the secrets of one channel, keeping the
+// others, and re-encrypts .context/.notify-channels.enc.
+//
+// Parameters:
+//   - name: Channel name
+//   - s: Channel secrets
+//
+// Returns:
+//   - error: Non-nil if the existing secrets cannot be read
+//     or the file cannot be written
+func SaveSecret(name string, s Secret) error {
+	secrets, loadErr := LoadSecrets()
+	if loadErr != nil {
+		return loadErr
+	}
+	if secrets == nil {
+		secrets = make(map[string]Secret)
+	}
+	secrets[name] = s
+
+	data, marshalErr := json.Marshal(secrets)
+	if marshalErr != nil {
+		return errNotify.SaveChannel(marshalErr)
+	}
+	if writeErr := writeSecret(
+		cfgCrypto.NotifyChannelsEnc, data,
+	); writeErr != nil {
+		return errNotify.SaveChannel(writeErr)
+	}
+	return nil
+}
+
+// FindChannel looks up a channel declared in .ctxrc by name.
+//
+// Parameters:
+//   - name: Channel name
+//
+// Returns:
+//   - entity.NotifyChannel: The channel
+//   - bool: False when no channel has that name
+func FindChannel(name string) (entity.NotifyChannel, bool) {
+	for _, ch := range rc.NotifyChannels() {
+		if ch.Name == name {
+			return ch, true
+		}
+	}
+	return entity.NotifyChannel{}, false
+}
+
+// Deliver sends a payload to one channel in its provider's
+// native format. Unlike [Send], it reports failures, including
+// non-2xx HTTP responses.
+//
+// Parameters:
+//   - ch: Channel from .ctxrc
+//   - s: The channel's secrets
+//   - p: Payload to deliver
+//
+// Returns:
+//   - error: Non-nil on unknown type, missing settings or
+//     delivery failure
+func Deliver(
+	ch entity.NotifyChannel, s Secret, p entity.NotifyPayload,
+) error {
+	if strings.TrimSpace(s.URL) == "" {
+		return errNotify.FieldEmpty(cfgNotify.FieldURL)
+	}
+	switch ch.Type {
+	case cfgNotify.TypeWebhook:
+		return postJSON(s.URL, p)
+	case cfgNotify.TypeSlack:
+		return postJSON(s.URL, slackBody(p))
+	case cfgNotify.TypeDiscord:
+		return postJSON(s.URL, discordBody(p))
+	case cfgNotify.TypeMatrix:
+		return sendMatrix(ch, s, p)
+	case cfgNotify.TypeNtfy:
+		return sendNtfy(s, p)
+	case cfgNotify.TypeEmail:
+		return sendEmail(ch, s, p)
+	default:
+		return errNotify.UnknownType(ch.Name, ch.Type)
+	}
+}
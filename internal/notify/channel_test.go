@@ -0,0 +1,295 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// capture records the last request a test server received.
+type capture struct {
+	method string
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func captureServer(t *testing.T, status int) (*httptest.Server, *capture) {
+	t.Helper()
+	c := &capture{}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			c.method = r.Method
+			c.path = r.URL.EscapedPath()
+			c.header = r.Header.Clone()
+			c.body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(status)
+		},
+	))
+	t.Cleanup(ts.Close)
+	return ts, c
+}
+
+func samplePayload() entity.NotifyPayload {
+	return entity.NotifyPayload{
+		Event:     "loop",
+		Message:   "Loop completed after 5 iterations",
+		Detail:    &entity.TemplateRef{Hook: "loop", Variant: "done"},
+		SessionID: "abc123",
+		Timestamp: "2026-03-01T10:00:00Z",
+		Project:   "demo",
+	}
+}
+
+func TestDeliver_Slack(t *testing.T) {
+	ts, c := captureServer(t, http.StatusOK)
+	ch := entity.NotifyChannel{Name: "team", Type: "slack"}
+
+	if err := Deliver(ch, Secret{URL: ts.URL}, samplePayload()); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	var msg slackMessage
+	if err := json.Unmarshal(c.body, &msg); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	if msg.Text != "[ctx] demo: loop" {
+		t.Errorf("text = %q", msg.Text)
+	}
+	if len(msg.Blocks) != 3 {
+		t.Fatalf("blocks = %d, want 3", len(msg.Blocks))
+	}
+	if msg.Blocks[0].Type != "header" ||
+		msg.Blocks[1].Text.Text != "Loop completed after 5 iterations" {
+		t.Errorf("unexpected blocks: %+v", msg.Blocks)
+	}
+	ctxLine := msg.Blocks[2].Elements[0].Text
+	if ctxLine != "session abc123 · loop/done" {
+		t.Errorf("context = %q", ctxLine)
+	}
+}
+
+func TestDeliver_Discord(t *testing.T) {
+	ts, c := captureServer(t, http.StatusNoContent)
+	ch := entity.NotifyChannel{Name: "ops", Type: "discord"}
+
+	if err := Deliver(ch, Secret{URL: ts.URL}, samplePayload()); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	var msg discordMessage
+	if err := json.Unmarshal(c.body, &msg); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	if msg.Username != "ctx" || len(msg.Embeds) != 1 {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+	e := msg.Embeds[0]
+	if e.Title != "[ctx] demo: loop" ||
+		e.Timestamp != "2026-03-01T10:00:00Z" ||
+		e.Footer == nil {
+		t.Errorf("unexpected embed: %+v", e)
+	}
+}
+
+func TestDeliver_Matrix(t *testing.T) {
+	ts, c := captureServer(t, http.StatusOK)
+	ch := entity.NotifyChannel{
+		Name: "room", Type: "matrix", Room: "!abc:example.org",
+	}
+	s := Secret{URL: ts.URL + "/", Token: "tok"}
+
+	if err := Deliver(ch, s, samplePayload()); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if c.method != http.MethodPut {
+		t.Errorf("method = %s, want PUT", c.method)
+	}
+	prefix := "/_matrix/client/v3/rooms/%21abc:example.org" +
+		"/send/m.room.message/ctx-"
+	if !strings.HasPrefix(c.path, prefix) {
+		t.Errorf("path = %s", c.path)
+	}
+	if got := c.header.Get("Authorization"); got != "Bearer tok" {
+		t.Errorf("Authorization = %q", got)
+	}
+	var msg matrixMessage
+	if err := json.Unmarshal(c.body, &msg); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	if msg.MsgType != "m.text" ||
+		!strings.Contains(msg.FormattedBody, "<strong>[ctx] demo: loop</strong>") {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+}
+
+func TestDeliver_MatrixNeedsRoom(t *testing.T) {
+	ch := entity.NotifyChannel{Name: "room", Type: "matrix"}
+	err := Deliver(ch, Secret{URL: "http://x", Token: "t"}, samplePayload())
+	if err == nil || !strings.Contains(err.Error(), "room") {
+		t.Fatalf("Deliver() error = %v, want room error", err)
+	}
+}
+
+func TestDeliver_Ntfy(t *testing.T) {
+	ts, c := captureServer(t, http.StatusOK)
+	ch := entity.NotifyChannel{Name: "phone", Type: "ntfy"}
+	p := samplePayload()
+	p.Event = "key-rotation"
+
+	if err := Deliver(ch, Secret{URL: ts.URL}, p); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if got := c.header.Get("Title"); got != "[ctx] demo: key-rotation" {
+		t.Errorf("Title = %q", got)
+	}
+	if got := c.header.Get("Tags"); got != "key-rotation" {
+		t.Errorf("Tags = %q", got)
+	}
+	if got := c.header.Get("Priority"); got != "high" {
+		t.Errorf("Priority = %q, want high", got)
+	}
+	if c.header.Get("Authorization") != "" {
+		t.Error("Authorization set without a token")
+	}
+	if !strings.HasPrefix(string(c.body), p.Message) {
+		t.Errorf("body = %q", c.body)
+	}
+}
+
+func TestDeliver_StatusError(t *testing.T) {
+	ts, _ := captureServer(t, http.StatusForbidden)
+	ch := entity.NotifyChannel{Name: "hook", Type: "webhook"}
+
+	err := Deliver(ch, Secret{URL: ts.URL}, samplePayload())
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("Deliver() error = %v, want HTTP 403", err)
+	}
+}
+
+func TestDeliver_UnknownType(t *testing.T) {
+	ch := entity.NotifyChannel{Name: "x", Type: "pager"}
+	if err := Deliver(
+		ch, Secret{URL: "http://x"}, samplePayload(),
+	); err == nil {
+		t.Fatal("expected error for unknown type")
+	}
+}
+
+func TestEmailMessage(t *testing.T) {
+	ch := entity.NotifyChannel{
+		Name: "mail", Type: "email",
+		From: "ctx@example.org",
+		To:   []string{"a@example.org", "b@example.org"},
+	}
+	p := samplePayload()
+	p.Project = "demo\r\nBcc: evil@example.org"
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	msg := string(emailMessage(ch, p, now))
+
+	for _, want := range []string{
+		"From: ctx@example.org\r\n",
+		"To: a@example.org, b@example.org\r\n",
+		"Subject: [ctx] demo Bcc: evil@example.org: loop\r\n",
+		"Date: Sun, 01 Mar 2026 10:00:00 +0000\r\n",
+		"\r\n\r\nLoop completed after 5 iterations\r\n",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("message missing %q:\n%s", want, msg)
+		}
+	}
+	if strings.Contains(msg, "\r\nBcc:") {
+		t.Error("header injection not neutralized")
+	}
+}
+
+func TestDeliver_EmailNeedsAddresses(t *testing.T) {
+	ch := entity.NotifyChannel{Name: "mail", Type: "email"}
+	err := Deliver(ch, Secret{URL: "localhost:25"}, samplePayload())
+	if err == nil || !strings.Contains(err.Error(), "from and to") {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+}
+
+func TestSaveSecret_RoundTrip(t *testing.T) {
+	_, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	if err := SaveSecret("a", Secret{URL: "https://a"}); err != nil {
+		t.Fatalf("SaveSecret(a) error = %v", err)
+	}
+	if err := SaveSecret("b", Secret{URL: "https://b", Token: "t"}); err != nil {
+		t.Fatalf("SaveSecret(b) error = %v", err)
+	}
+
+	secrets, err := LoadSecrets()
+	if err != nil {
+		t.Fatalf("LoadSecrets() error = %v", err)
+	}
+	if secrets["a"].URL != "https://a" || secrets["b"].Token != "t" {
+		t.Errorf("secrets = %+v", secrets)
+	}
+}
+
+func TestSend_RoutesByChannelEvents(t *testing.T) {
+	tempDir, cleanup := setupTestDir(t)
+	defer cleanup()
+
+	loopSrv, loopReq := captureServer(t, http.StatusOK)
+	keySrv, keyReq := captureServer(t, http.StatusOK)
+
+	rcContent := `notify:
+  channels:
+    - name: builds
+      type: slack
+      events: [loop]
+    - name: security
+      type: ntfy
+      events: [key-rotation]
+    - name: unconfigured
+      type: discord
+      events: [loop]
+`
+	_ = os.WriteFile(
+		filepath.Join(tempDir, ".ctxrc"), []byte(rcContent), 0o600,
+	)
+	rc.Reset()
+
+	_ = SaveSecret("builds", Secret{URL: loopSrv.URL})
+	_ = SaveSecret("security", Secret{URL: keySrv.URL})
+
+	if err := Send("loop", "done", "", nil); err != nil {
+		t.Fatalf("Send(loop) error = %v", err)
+	}
+	if loopReq.body == nil || keyReq.body != nil {
+		t.Fatalf("loop routed wrong: builds=%v security=%v",
+			loopReq.body != nil, keyReq.body != nil)
+	}
+
+	loopReq.body = nil
+	if err := Send("key-rotation", "rotate", "", nil); err != nil {
+		t.Fatalf("Send(key-rotation) error = %v", err)
+	}
+	if loopReq.body != nil || keyReq.body == nil {
+		t.Fatalf("key-rotation routed wrong: builds=%v security=%v",
+			loopReq.body != nil, keyReq.body != nil)
+	}
+}
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// discordBody builds a webhook message with one embed holding
+// the title, message and timestamp; the session and template
+// reference go in the footer.
+//
+// Parameters:
+//   - p: Payload
+//
+// Returns:
+//   - discordMessage: Webhook body
+func discordBody(p entity.NotifyPayload) discordMessage {
+	embed := discordEmbed{
+		Title:       title(p),
+		Description: p.Message,
+		Color:       cfgNotify.DiscordColor,
+		Timestamp:   p.Timestamp,
+	}
+	if line := contextLine(p); line != "" {
+		embed.Footer = &discordFooter{Text: line}
+	}
+	return discordMessage{
+		Username: cfgNotify.DiscordUsername,
+		Embeds:   []discordEmbed{embed},
+	}
+}
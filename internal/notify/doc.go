@@ -6,13 +6,14 @@
 
 // Package notify implements **fire-and-forget webhook
 // notifications**: ctx posts a small JSON payload to a
-// user-configured URL when something interesting happens
+// user-configured URL, and rich messages to any named
+// channels, when something interesting happens
 // (loop completion, hook nudge, version mismatch, key-rotation
 // reminder, etc.) and never blocks the caller waiting for the
 // response.
 //
 // The package is what backs `ctx hook notify`,
-// `ctx hook notify setup`, and `ctx hook notify test` on the
+// `ctx hook notify setup`, `test`, `flush` and `log` on the
 // CLI side, plus the in-process callers like the autonomous
 // loop runner.
 //
@@ -23,26 +24,74 @@
 //     `.context/.notify.enc`. The same per-machine key
 //     protects the scratchpad; a fresh key is generated and
 //     saved on first use if none exists.
-//  2. **Send** ([Send]) loads + decrypts the URL via
-//     [LoadWebhook], gates on the configured event filter
-//     via [EventAllowed], builds an [entity.NotifyPayload],
-//     and ships it to [PostJSON].
+//  2. **Send** ([Send]) gates on the configured event
+//     filters via [EventAllowed], builds an
+//     [entity.NotifyPayload], loads + decrypts the URL via
+//     [LoadWebhook] and ships it to [PostJSON], then hands
+//     the payload to every subscribed channel via
+//     [Deliver].
 //  3. **PostJSON** does the actual HTTP: short timeout,
-//     `Content-Type: application/json`, single attempt, no
-//     retry. The intent is "best-effort signal", not "guaranteed
-//     delivery".
+//     `Content-Type: application/json`, single attempt. The
+//     intent is "best-effort signal", not "guaranteed
+//     delivery"; retries are opt-in through the outbox.
 //
 // All three functions return cleanly when nothing is
 // configured: `("", nil)` from [LoadWebhook] when either
 // the key or the encrypted URL file is missing, and a
 // silent noop from [Send].
 //
+// # Channels
+//
+// `notify.channels` in `.ctxrc` declares named channels,
+// each with a provider `type`, its own `events` filter and
+// non-secret settings (Matrix `room`, email `from`/`to`).
+// Their secrets ([Secret]: URL, token, SMTP credentials)
+// are stored by [SaveSecret] as one encrypted JSON map in
+// `.context/.notify-channels.enc`, under the same key as
+// `.notify.enc`, and read back by [LoadSecrets].
+//
+// [Deliver] renders the payload natively per provider:
+//
+//   - `webhook`: the generic JSON payload.
+//   - `slack`: Block Kit header, section and context.
+//   - `discord`: one embed with title, timestamp, footer.
+//   - `matrix`: an `m.text` room message with an HTML
+//     body, PUT with a bearer token.
+//   - `ntfy`: plain text with Title, Tags and Priority
+//     headers; key-rotation reminders are high priority.
+//   - `email`: a plain-text message over SMTP, with
+//     STARTTLS when offered and PLAIN auth when a user
+//     is set.
+//
+// [Send] skips channels without secrets and drops their
+// errors; [Deliver] itself reports them, which is what
+// `ctx hook notify test --channel` relies on.
+//
+// # Outbox
+//
+// With `notify.outbox: true`, [Send] records every attempt
+// in `.context/state/notify-log.jsonl` ([DeliveryLog]) and
+// writes failed deliveries, one JSON file each, to
+// `.context/state/notify-outbox/`. Items hold the channel
+// name and payload, never secrets, which are resolved again
+// on every retry. [Flush] retries them with exponential
+// backoff: opportunistically after any successful delivery
+// and from the heartbeat hook, which claims the interval with
+// [ClaimFlush] and flushes in a detached process (only items
+// that are due, a few at a time), or all at once from
+// `ctx hook notify flush`. After
+// `notify.max_attempts` attempts an item moves to `dead/`.
+// [Outbox] lists both queues.
+//
 // # Event Filter
 //
 // `notify.events` in `.ctxrc` is **opt-in**: empty list
 // means **no events fire** (not "all events"). Recognized
-// events: `loop`, `nudge`, `relay`, `heartbeat`. The filter
-// is enforced by [EventAllowed].
+// events: `loop`, `nudge`, `relay`, `heartbeat`,
+// `key-rotation`. The filter is enforced by [EventAllowed];
+// each channel has its own `events` list, so `loop`
+// completions and key-rotation reminders can go to
+// different channels.
 //
 // **`ctx hook notify test` bypasses the filter** as a
 // special case so users can verify connectivity without
@@ -63,14 +112,18 @@
 //
 // The encryption key is shared by both `ctx pad` and
 // `ctx hook notify`. Rotating it (every
-// `key_rotation_days`, default 90) requires re-running
-// `ctx pad init` *and* `ctx hook notify setup`. The
+// `key_rotation_days`, default 90) with `ctx key rotate`
+// re-encrypts `.notify.enc` and `.notify-channels.enc`
+// along with the scratchpad. The
 // rotation nudge fires from
 // `internal/cli/system/cmd/check_version`.
 //
 // # Concurrency
 //
 // All exported functions are safe to call concurrently;
-// they hold no module-level state. The HTTP client is the
+// they hold no module-level state. Outbox items are claimed
+// by an atomic rename before a retry, so concurrent flushes
+// never deliver the same item twice; a claim left by a
+// crashed process is released after five minutes. The HTTP client is the
 // stdlib default, connection-pooled and goroutine-safe.
 package notify
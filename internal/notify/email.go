@@ -0,0 +1,153 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
+)
+
+// headerValue collapses whitespace, including line breaks, so a
+// value cannot inject extra message headers.
+//
+// Parameters:
+//   - s: Raw header value
+//
+// Returns:
+//   - string: Single-line value
+func headerValue(s string) string {
+	return strings.Join(strings.Fields(s), token.Space)
+}
+
+// emailMessage renders an RFC 5322 plain-text message.
+//
+// Parameters:
+//   - ch: Channel; From and To are the addresses
+//   - p: Payload
+//   - now: Date header time
+//
+// Returns:
+//   - []byte: Headers, blank line and body with CRLF endings
+func emailMessage(
+	ch entity.NotifyChannel, p entity.NotifyPayload, now time.Time,
+) []byte {
+	var b strings.Builder
+	header := func(name, value string) {
+		_, _ = fmt.Fprintf(&b, cfgNotify.EmailHeaderFormat,
+			name, headerValue(value),
+		)
+	}
+	header(cfgNotify.EmailFrom, ch.From)
+	header(cfgNotify.EmailTo,
+		strings.Join(ch.To, cfgNotify.EmailRecipientSep),
+	)
+	header(cfgNotify.EmailSubject, title(p))
+	header(cfgNotify.EmailDate, now.Format(time.RFC1123Z))
+	header(cfgNotify.EmailMIMEVersion, cfgNotify.EmailMIMEVersionValue)
+	header(cfgNotify.HeaderContentType, cfgNotify.MimeText)
+	b.WriteString(cfgNotify.EmailLineBreak)
+	b.WriteString(p.Message)
+	b.WriteString(cfgNotify.EmailLineBreak)
+	if line := contextLine(p); line != "" {
+		b.WriteString(cfgNotify.EmailLineBreak)
+		b.WriteString(line)
+		b.WriteString(cfgNotify.EmailLineBreak)
+	}
+	return []byte(b.String())
+}
+
+// sendEmail delivers the message over SMTP. The connection is
+// upgraded with STARTTLS when the server offers it, and
+// authenticated with PLAIN when a user is set.
+//
+// Parameters:
+//   - ch: Channel; From and To are the addresses
+//   - s: Secrets; URL is the server host:port, User and
+//     Password the optional credentials
+//   - p: Payload
+//
+// Returns:
+//   - error: Non-nil on missing addresses or any SMTP failure
+func sendEmail(
+	ch entity.NotifyChannel, s Secret, p entity.NotifyPayload,
+) error {
+	if ch.From == "" || len(ch.To) == 0 {
+		return errNotify.EmailAddresses(ch.Name)
+	}
+	host, _, splitErr := net.SplitHostPort(s.URL)
+	if splitErr != nil {
+		return splitErr
+	}
+
+	timeout := cfgNotify.EmailDialTimeout * time.Second
+	conn, dialErr := net.DialTimeout(
+		cfgNotify.EmailNetwork, s.URL, timeout,
+	)
+	if dialErr != nil {
+		return dialErr
+	}
+	if deadlineErr := conn.SetDeadline(
+		time.Now().Add(timeout),
+	); deadlineErr != nil {
+		_ = conn.Close()
+		return deadlineErr
+	}
+
+	c, clientErr := smtp.NewClient(conn, host)
+	if clientErr != nil {
+		_ = conn.Close()
+		return clientErr
+	}
+	defer func() { _ = c.Close() }()
+
+	if ok, _ := c.Extension(cfgNotify.EmailStartTLS); ok {
+		tlsErr := c.StartTLS(&tls.Config{
+			ServerName: host, MinVersion: tls.VersionTLS12,
+		})
+		if tlsErr != nil {
+			return tlsErr
+		}
+	}
+	if s.User != "" {
+		auth := smtp.PlainAuth("", s.User, s.Password, host)
+		if authErr := c.Auth(auth); authErr != nil {
+			return authErr
+		}
+	}
+
+	if mailErr := c.Mail(ch.From); mailErr != nil {
+		return mailErr
+	}
+	for _, to := range ch.To {
+		if rcptErr := c.Rcpt(to); rcptErr != nil {
+			return rcptErr
+		}
+	}
+	w, dataErr := c.Data()
+	if dataErr != nil {
+		return dataErr
+	}
+	if _, writeErr := w.Write(
+		emailMessage(ch, p, time.Now()),
+	); writeErr != nil {
+		_ = w.Close()
+		return writeErr
+	}
+	if closeErr := w.Close(); closeErr != nil {
+		return closeErr
+	}
+	return c.Quit()
+}
@@ -0,0 +1,49 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// title renders the headline of a message: project and event.
+//
+// Parameters:
+//   - p: Payload
+//
+// Returns:
+//   - string: "[ctx] <project>: <event>"
+func title(p entity.NotifyPayload) string {
+	return fmt.Sprintf(cfgNotify.TitleFormat, p.Project, p.Event)
+}
+
+// contextLine renders the session and template reference of a
+// payload on one line.
+//
+// Parameters:
+//   - p: Payload
+//
+// Returns:
+//   - string: The joined fields, or "" when both are absent
+func contextLine(p entity.NotifyPayload) string {
+	var parts []string
+	if p.SessionID != "" {
+		parts = append(parts,
+			fmt.Sprintf(cfgNotify.SessionFormat, p.SessionID),
+		)
+	}
+	if p.Detail != nil {
+		parts = append(parts, fmt.Sprintf(
+			cfgNotify.DetailFormat, p.Detail.Hook, p.Detail.Variant,
+		))
+	}
+	return strings.Join(parts, cfgNotify.Separator)
+}
@@ -0,0 +1,91 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
+)
+
+// matrixBody builds an m.text event with a plain body and an
+// HTML formatted body.
+//
+// Parameters:
+//   - p: Payload
+//
+// Returns:
+//   - matrixMessage: Event content
+func matrixBody(p entity.NotifyPayload) matrixMessage {
+	head := title(p)
+	text := p.Message
+	if line := contextLine(p); line != "" {
+		text += token.NewlineLF + line
+	}
+	return matrixMessage{
+		MsgType: cfgNotify.MatrixMsgType,
+		Body:    head + token.NewlineLF + text,
+		Format:  cfgNotify.MatrixFormat,
+		FormattedBody: fmt.Sprintf(
+			cfgNotify.MatrixHTMLFormat,
+			html.EscapeString(head), html.EscapeString(text),
+		),
+	}
+}
+
+// sendMatrix puts a room message through the client-server
+// API, authenticated with the channel's access token.
+//
+// Parameters:
+//   - ch: Channel; Room names the target room
+//   - s: Secrets; URL is the homeserver, Token the access token
+//   - p: Payload
+//
+// Returns:
+//   - error: Non-nil when the room or token is missing or
+//     delivery fails
+func sendMatrix(
+	ch entity.NotifyChannel, s Secret, p entity.NotifyPayload,
+) error {
+	if ch.Room == "" {
+		return errNotify.FieldEmpty(cfgNotify.FieldRoom)
+	}
+	if s.Token == "" {
+		return errNotify.FieldEmpty(cfgNotify.FieldToken)
+	}
+	body, marshalErr := json.Marshal(matrixBody(p))
+	if marshalErr != nil {
+		return errNotify.MarshalPayload(marshalErr)
+	}
+	endpoint := fmt.Sprintf(cfgNotify.MatrixSendPath,
+		strings.TrimRight(s.URL, cfgHTTP.PathSepStr),
+		url.PathEscape(ch.Room),
+		fmt.Sprintf(cfgNotify.MatrixTxnFormat, time.Now().UnixNano()),
+	)
+	req, reqErr := http.NewRequest(
+		http.MethodPut, endpoint, bytes.NewReader(body),
+	)
+	if reqErr != nil {
+		return reqErr
+	}
+	req.Header.Set(cfgNotify.HeaderContentType, cfgHTTP.MimeJSON)
+	req.Header.Set(cfgHTTP.HeaderAuthorization,
+		fmt.Sprintf(cfgHTTP.BearerFormat, s.Token),
+	)
+	return do(req)
+}
@@ -7,21 +7,13 @@
 package notify
 
 import (
-	"encoding/json"
 	"net/http"
-	"os"
-	"path/filepath"
 	"time"
 
 	cfgCrypto "github.com/ActiveMemory/ctx/internal/config/crypto"
-	"github.com/ActiveMemory/ctx/internal/config/fs"
 	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
-	"github.com/ActiveMemory/ctx/internal/config/project"
-	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
-	"github.com/ActiveMemory/ctx/internal/crypto"
 	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/io"
-	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
 	"github.com/ActiveMemory/ctx/internal/rc"
 )
 
@@ -44,37 +36,10 @@ import (
 //   - error: non-nil on any resolver failure or decryption failure;
 //     missing key / encrypted file are silent
 func LoadWebhook() (string, error) {
-	kp, kpErr := rc.KeyPath()
-	if kpErr != nil {
-		return "", kpErr
-	}
-	ctxDir, pathErr := rc.ContextDir()
-	if pathErr != nil {
-		return "", pathErr
-	}
-	encPath := filepath.Join(ctxDir, cfgCrypto.NotifyEnc)
-
-	key, loadErr := crypto.LoadKey(kp)
-	if loadErr != nil {
-		if os.IsNotExist(loadErr) {
-			return "", nil
-		}
-		return "", nil
-	}
-
-	ciphertext, readErr := io.SafeReadUserFile(encPath)
+	plaintext, readErr := readSecret(cfgCrypto.NotifyEnc)
 	if readErr != nil {
-		if os.IsNotExist(readErr) {
-			return "", nil
-		}
-		return "", nil
+		return "", readErr
 	}
-
-	plaintext, decryptErr := crypto.Decrypt(key, ciphertext)
-	if decryptErr != nil {
-		return "", decryptErr
-	}
-
 	return string(plaintext), nil
 }
 
@@ -88,40 +53,7 @@ func LoadWebhook() (string, error) {
 // Returns:
 //   - error: non-nil if key generation, encryption, or file write fails
 func SaveWebhook(url string) error {
-	kp, kpErr := rc.KeyPath()
-	if kpErr != nil {
-		return kpErr
-	}
-	ctxDir, ctxErr := rc.ContextDir()
-	if ctxErr != nil {
-		return ctxErr
-	}
-	encPath := filepath.Join(ctxDir, cfgCrypto.NotifyEnc)
-
-	key, loadErr := crypto.LoadKey(kp)
-	if loadErr != nil {
-		// Key doesn't exist: generate one.
-		var genErr error
-		key, genErr = crypto.GenerateKey()
-		if genErr != nil {
-			return genErr
-		}
-		if mkdirErr := io.SafeMkdirAll(
-			filepath.Dir(kp), fs.PermKeyDir,
-		); mkdirErr != nil {
-			return mkdirErr
-		}
-		if saveErr := crypto.SaveKey(kp, key); saveErr != nil {
-			return saveErr
-		}
-	}
-
-	ciphertext, encryptErr := crypto.Encrypt(key, []byte(url))
-	if encryptErr != nil {
-		return encryptErr
-	}
-
-	return io.SafeWriteFile(encPath, ciphertext, fs.PermSecret)
+	return writeSecret(cfgCrypto.NotifyEnc, []byte(url))
 }
 
 // EventAllowed reports whether the given event passes the filter.
@@ -146,10 +78,13 @@ func EventAllowed(event string, allowed []string) bool {
 	return false
 }
 
-// Send fires a webhook notification. It is a silent noop when:
-//   - no webhook URL is configured
-//   - the event is not in the allowed list
-//   - the HTTP request fails (fire-and-forget)
+// Send fires a notification to the legacy webhook and to every
+// named channel subscribed to the event. It is a silent noop when:
+//   - neither the webhook filter nor any channel accepts the event
+//   - no webhook URL or channel secret is configured
+//   - delivery fails (fire-and-forget), unless notify.outbox is
+//     enabled: then the failure is queued for retry and a
+//     successful delivery flushes the deliveries that are due
 //
 // Parameters:
 //   - event: notification category (e.g. "relay", "nudge")
@@ -160,37 +95,19 @@ func EventAllowed(event string, allowed []string) bool {
 // Returns:
 //   - error: Delivery error, or nil if sent successfully or silently skipped
 func Send(event, message, sessionID string, detail *entity.TemplateRef) error {
-	if !EventAllowed(event, rc.NotifyEvents()) {
-		return nil
-	}
-
-	url, webhookErr := LoadWebhook()
-	if webhookErr != nil || url == "" {
+	webhook := EventAllowed(event, rc.NotifyEvents())
+	channels := channelsFor(event)
+	if !webhook && len(channels) == 0 {
 		return nil
 	}
 
-	projectName := project.FallbackName
-	if cwd, cwdErr := os.Getwd(); cwdErr == nil {
-		projectName = filepath.Base(cwd)
-	} else {
-		logWarn.Warn(cfgWarn.Getwd, cwdErr)
-	}
-
 	payload := entity.NewNotifyPayload(
-		event, message, sessionID, projectName, detail,
+		event, message, sessionID, projectName(), detail,
 	)
-
-	body, marshalErr := json.Marshal(payload)
-	if marshalErr != nil {
-		return nil
-	}
-
-	resp, postErr := PostJSON(url, body)
-	if postErr != nil {
-		return nil // fire-and-forget
-	}
-	if closeErr := resp.Body.Close(); closeErr != nil {
-		logWarn.Warn(cfgWarn.CloseResponse, closeErr)
+	// A delivery that went through means we are online: a good
+	// moment to retry what was queued while we were not.
+	if dispatch(targets(webhook, channels), payload) && rc.NotifyOutbox() {
+		_, _ = Flush(false)
 	}
 
 	return nil
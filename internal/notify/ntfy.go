@@ -0,0 +1,58 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	cfgHook "github.com/ActiveMemory/ctx/internal/config/hook"
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// sendNtfy publishes the message to an ntfy topic, carrying the
+// title, event tag and priority in headers. Key rotation
+// reminders are sent at high priority.
+//
+// Parameters:
+//   - s: Secrets; URL is the topic URL, Token an optional
+//     access token
+//   - p: Payload
+//
+// Returns:
+//   - error: Non-nil on delivery failure
+func sendNtfy(s Secret, p entity.NotifyPayload) error {
+	text := p.Message
+	if line := contextLine(p); line != "" {
+		text += token.NewlineLF + line
+	}
+	req, reqErr := http.NewRequest(
+		http.MethodPost, s.URL, strings.NewReader(text),
+	)
+	if reqErr != nil {
+		return reqErr
+	}
+
+	priority := cfgNotify.NtfyPriorityDefault
+	if p.Event == cfgHook.NotifyChannelKeyRotation {
+		priority = cfgNotify.NtfyPriorityHigh
+	}
+	req.Header.Set(cfgNotify.HeaderContentType, cfgNotify.MimeText)
+	req.Header.Set(cfgNotify.NtfyTitle, title(p))
+	req.Header.Set(cfgNotify.NtfyTags, p.Event)
+	req.Header.Set(cfgNotify.NtfyPriority, priority)
+	if s.Token != "" {
+		req.Header.Set(cfgHTTP.HeaderAuthorization,
+			fmt.Sprintf(cfgHTTP.BearerFormat, s.Token),
+		)
+	}
+	return do(req)
+}
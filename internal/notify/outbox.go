@@ -0,0 +1,155 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// Flush retries queued deliveries from the outbox.
+//
+// With all false, only deliveries whose backoff has elapsed
+// are tried, at most [cfgNotify.FlushBatch] of them; this is
+// the opportunistic flush [Send] runs after a successful
+// delivery. With all true, every queued delivery is tried
+// now. A delivery that fails for the notify.max_attempts-th
+// time is dead-lettered. Flush works whether or not
+// notify.outbox is enabled, so a queue left behind can
+// always be drained.
+//
+// Parameters:
+//   - all: Try every queued delivery, ignoring backoff
+//
+// Returns:
+//   - entity.FlushResult: Counts of sent, failed, dead-lettered
+//     and still pending deliveries
+//   - error: Non-nil when the outbox cannot be listed
+func Flush(all bool) (entity.FlushResult, error) {
+	var r entity.FlushResult
+	dir, dirErr := outboxDir()
+	if dirErr != nil {
+		return r, dirErr
+	}
+	items, listErr := listItems(dir)
+	if listErr != nil {
+		return r, listErr
+	}
+
+	now := time.Now()
+	maxAttempts := rc.NotifyMaxAttempts()
+	tried := 0
+	for _, item := range items {
+		due := !item.NextAttempt.After(now)
+		if !all && (!due || tried >= cfgNotify.FlushBatch) {
+			r.Pending++
+			continue
+		}
+		claimed, ok := claim(dir, item.ID)
+		if !ok {
+			continue // another flush has it
+		}
+		tried++
+		retry(dir, claimed, maxAttempts, &r)
+	}
+	return r, nil
+}
+
+// ClaimFlush reports whether an opportunistic [Flush] is due:
+// notify.outbox is enabled, the queue is not empty, and no flush
+// was claimed in the last [cfgNotify.FlushInterval] seconds. A
+// true result stamps the interval, so one caller goes on to
+// flush.
+//
+// [Send] only flushes after a delivery goes through, so a queue
+// filled while offline would otherwise wait for the next event
+// that happens to be delivered. The heartbeat hook calls this on
+// every prompt and, when it returns true, runs the flush in a
+// detached process; a stamp file in the outbox directory keeps
+// the call cheap.
+//
+// Returns:
+//   - bool: True when the caller should flush
+func ClaimFlush() bool {
+	if !rc.NotifyOutbox() {
+		return false
+	}
+	dir, dirErr := outboxDir()
+	if dirErr != nil {
+		return false
+	}
+	stamp := filepath.Join(dir, cfgNotify.FileFlushStamp)
+	interval := cfgNotify.FlushInterval * time.Second
+	if info, statErr := os.Stat(stamp); statErr == nil &&
+		time.Since(info.ModTime()) < interval {
+		return false
+	}
+	items, listErr := listItems(dir)
+	if listErr != nil || len(items) == 0 {
+		return false
+	}
+	io.TouchFile(stamp)
+	return true
+}
+
+// Outbox lists the queued and the dead-lettered deliveries.
+//
+// Returns:
+//   - []entity.OutboxItem: Queued deliveries, oldest first
+//   - []entity.OutboxItem: Dead-lettered deliveries, oldest first
+//   - error: Non-nil when a directory exists but cannot be read
+func Outbox() ([]entity.OutboxItem, []entity.OutboxItem, error) {
+	dir, dirErr := outboxDir()
+	if dirErr != nil {
+		return nil, nil, dirErr
+	}
+	pending, listErr := listItems(dir)
+	if listErr != nil {
+		return nil, nil, listErr
+	}
+	dead, deadErr := listItems(filepath.Join(dir, cfgNotify.OutboxDeadDir))
+	if deadErr != nil {
+		return nil, nil, deadErr
+	}
+	return pending, dead, nil
+}
+
+// DeliveryLog reads the most recent delivery log entries,
+// including the rotated log.
+//
+// Parameters:
+//   - last: Number of entries to keep; 0 keeps all
+//
+// Returns:
+//   - []entity.DeliveryRecord: Entries, oldest first
+//   - error: Non-nil when a log exists but cannot be read
+func DeliveryLog(last int) ([]entity.DeliveryRecord, error) {
+	stateDir, dirErr := stateDir()
+	if dirErr != nil {
+		return nil, dirErr
+	}
+	var records []entity.DeliveryRecord
+	for _, name := range []string{
+		cfgNotify.FileDeliveryLogPrev, cfgNotify.FileDeliveryLog,
+	} {
+		part, readErr := readLog(filepath.Join(stateDir, name))
+		if readErr != nil && !os.IsNotExist(readErr) {
+			return nil, readErr
+		}
+		records = append(records, part...)
+	}
+	if last > 0 && len(records) > last {
+		records = records[len(records)-last:]
+	}
+	return records, nil
+}
@@ -0,0 +1,129 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"path/filepath"
+	"time"
+
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// deliverTo sends a payload to one destination, resolving its
+// secrets at call time so a queued delivery picks up rotated
+// URLs and tokens.
+//
+// Parameters:
+//   - channel: Channel name; empty for the .notify.enc webhook
+//   - p: Payload
+//
+// Returns:
+//   - error: Non-nil when the destination is gone or
+//     delivery fails
+func deliverTo(channel string, p entity.NotifyPayload) error {
+	if channel == "" {
+		url, loadErr := LoadWebhook()
+		if loadErr != nil {
+			return loadErr
+		}
+		if url == "" {
+			return errNotify.WebhookEmpty()
+		}
+		return postJSON(url, p)
+	}
+
+	ch, found := FindChannel(channel)
+	if !found {
+		return errNotify.UnknownChannel(channel)
+	}
+	secrets, loadErr := LoadSecrets()
+	if loadErr != nil {
+		return loadErr
+	}
+	s, ok := secrets[channel]
+	if !ok {
+		return errNotify.NoChannelSecret(channel)
+	}
+	return Deliver(ch, s, p)
+}
+
+// enqueue stores a failed first attempt in the outbox and
+// logs it as queued.
+//
+// Parameters:
+//   - channel: Channel name; empty for the .notify.enc webhook
+//   - p: Payload
+//   - cause: Error of the first attempt
+func enqueue(channel string, p entity.NotifyPayload, cause error) {
+	item := entity.OutboxItem{
+		ID:          newID(),
+		Channel:     channel,
+		Payload:     p,
+		Attempts:    1,
+		NextAttempt: time.Now().Add(backoff(1)),
+		LastError:   cause.Error(),
+	}
+	dir, dirErr := outboxDir()
+	if dirErr != nil {
+		return
+	}
+	if writeErr := writeItem(dir, item); writeErr != nil {
+		logWarn.Warn(cfgWarn.Write, dir, writeErr)
+		return
+	}
+	record(item, cfgNotify.StatusQueued, cause)
+}
+
+// retry makes one more attempt at a claimed delivery and
+// settles it: removed on success, dead-lettered after the
+// last attempt, otherwise requeued with a longer backoff.
+//
+// Parameters:
+//   - dir: Outbox directory
+//   - item: Claimed delivery
+//   - maxAttempts: Attempts before dead-lettering
+//   - r: Counts to update
+func retry(
+	dir string, item entity.OutboxItem, maxAttempts int,
+	r *entity.FlushResult,
+) {
+	claimed := itemPath(dir, item.ID) + cfgNotify.OutboxClaimExt
+	item.Attempts++
+	deliverErr := deliverTo(item.Channel, item.Payload)
+
+	status := cfgNotify.StatusSent
+	switch {
+	case deliverErr == nil:
+		r.Sent++
+	case item.Attempts >= maxAttempts:
+		status = cfgNotify.StatusDead
+		r.Dead++
+		item.LastError = deliverErr.Error()
+		deadDir := filepath.Join(dir, cfgNotify.OutboxDeadDir)
+		if writeErr := writeItem(deadDir, item); writeErr != nil {
+			logWarn.Warn(cfgWarn.Write, deadDir, writeErr)
+		}
+	default:
+		status = cfgNotify.StatusFailed
+		r.Failed++
+		r.Pending++
+		item.LastError = deliverErr.Error()
+		item.NextAttempt = time.Now().Add(backoff(item.Attempts))
+		if writeErr := writeItem(dir, item); writeErr != nil {
+			logWarn.Warn(cfgWarn.Write, dir, writeErr)
+			release(dir, filepath.Base(claimed))
+			record(item, status, deliverErr)
+			return
+		}
+	}
+	removeFile(claimed)
+	record(item, status, deliverErr)
+}
@@ -0,0 +1,315 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	cfgDir "github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// stateDir returns .context/state without creating it.
+//
+// Returns:
+//   - string: State directory path
+//   - error: Non-nil when the context directory is not declared
+func stateDir() (string, error) {
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return "", ctxErr
+	}
+	return filepath.Join(ctxDir, cfgDir.State), nil
+}
+
+// outboxDir returns the outbox directory without creating it.
+//
+// Returns:
+//   - string: Outbox directory path
+//   - error: Non-nil when the context directory is not declared
+func outboxDir() (string, error) {
+	dir, dirErr := stateDir()
+	if dirErr != nil {
+		return "", dirErr
+	}
+	return filepath.Join(dir, cfgNotify.OutboxDir), nil
+}
+
+// itemPath returns the file of a queued delivery.
+//
+// Parameters:
+//   - dir: Outbox directory
+//   - id: Delivery ID
+//
+// Returns:
+//   - string: Path of the delivery file
+func itemPath(dir, id string) string {
+	return filepath.Join(dir, id+cfgNotify.OutboxExt)
+}
+
+// readItem decodes one delivery file.
+//
+// Parameters:
+//   - path: Delivery file
+//
+// Returns:
+//   - entity.OutboxItem: The delivery
+//   - error: Non-nil on read or decode failure
+func readItem(path string) (entity.OutboxItem, error) {
+	var item entity.OutboxItem
+	data, readErr := io.SafeReadUserFile(path)
+	if readErr != nil {
+		return item, readErr
+	}
+	decodeErr := json.Unmarshal(data, &item)
+	return item, decodeErr
+}
+
+// writeItem stores a delivery file, creating the directory.
+//
+// Parameters:
+//   - dir: Outbox (or dead-letter) directory
+//   - item: Delivery to store
+//
+// Returns:
+//   - error: Non-nil on mkdir, encode or write failure
+func writeItem(dir string, item entity.OutboxItem) error {
+	if mkErr := io.SafeMkdirAll(dir, fs.PermRestrictedDir); mkErr != nil {
+		return mkErr
+	}
+	data, marshalErr := json.Marshal(item)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	return io.SafeWriteFile(itemPath(dir, item.ID), data, fs.PermFile)
+}
+
+// listItems reads the deliveries in dir, oldest first.
+// Claims older than [cfgNotify.ClaimStale] are released
+// first so an interrupted flush does not strand a delivery.
+// Unreadable files are skipped.
+//
+// Parameters:
+//   - dir: Outbox (or dead-letter) directory
+//
+// Returns:
+//   - []entity.OutboxItem: Deliveries; nil when dir is missing
+//   - error: Non-nil when dir exists but cannot be read
+func listItems(dir string) ([]entity.OutboxItem, error) {
+	entries, readErr := os.ReadDir(dir)
+	if readErr != nil {
+		if os.IsNotExist(readErr) {
+			return nil, nil
+		}
+		return nil, readErr
+	}
+
+	stale := time.Now().Add(-cfgNotify.ClaimStale * time.Second)
+	var names []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(name, cfgNotify.OutboxClaimExt) {
+			if info, infoErr := e.Info(); infoErr == nil &&
+				info.ModTime().Before(stale) {
+				release(dir, name)
+				names = append(names,
+					strings.TrimSuffix(name, cfgNotify.OutboxClaimExt),
+				)
+			}
+			continue
+		}
+		if strings.HasSuffix(name, cfgNotify.OutboxExt) {
+			names = append(names, name)
+		}
+	}
+	// IDs start with a nanosecond timestamp: name order is
+	// queue order.
+	sort.Strings(names)
+
+	var items []entity.OutboxItem
+	for _, name := range names {
+		item, itemErr := readItem(filepath.Join(dir, name))
+		if itemErr != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// release renames a stale claim back to its delivery file.
+//
+// Parameters:
+//   - dir: Outbox directory
+//   - name: Claim file name
+func release(dir, name string) {
+	claimed := filepath.Join(dir, name)
+	if renameErr := os.Rename(claimed, strings.TrimSuffix(
+		claimed, cfgNotify.OutboxClaimExt,
+	)); renameErr != nil {
+		logWarn.Warn(cfgWarn.Rename, claimed, renameErr)
+	}
+}
+
+// claim takes a delivery for this process by renaming its
+// file; rename is atomic, so concurrent flushes never attempt
+// the same delivery.
+//
+// Parameters:
+//   - dir: Outbox directory
+//   - id: Delivery ID
+//
+// Returns:
+//   - entity.OutboxItem: The delivery as stored at claim time
+//   - bool: False when another process claimed it first
+func claim(dir, id string) (entity.OutboxItem, bool) {
+	path := itemPath(dir, id)
+	claimed := path + cfgNotify.OutboxClaimExt
+	if renameErr := os.Rename(path, claimed); renameErr != nil {
+		return entity.OutboxItem{}, false
+	}
+	item, readErr := readItem(claimed)
+	if readErr != nil {
+		release(dir, filepath.Base(claimed))
+		return entity.OutboxItem{}, false
+	}
+	return item, true
+}
+
+// removeFile deletes a file, warning on failure.
+//
+// Parameters:
+//   - path: File to delete
+func removeFile(path string) {
+	if rmErr := os.Remove(path); rmErr != nil {
+		logWarn.Warn(cfgWarn.Remove, path, rmErr)
+	}
+}
+
+// newID returns a delivery ID that sorts in queue order.
+//
+// Returns:
+//   - string: Timestamp and process ID
+func newID() string {
+	return fmt.Sprintf(
+		cfgNotify.OutboxIDFormat, time.Now().UnixNano(), os.Getpid(),
+	)
+}
+
+// backoff returns the delay before the next retry: the base
+// delay doubled per failed attempt, capped.
+//
+// Parameters:
+//   - attempts: Attempts made so far (at least 1)
+//
+// Returns:
+//   - time.Duration: Delay before the next attempt
+func backoff(attempts int) time.Duration {
+	delay := cfgNotify.BackoffBase * time.Second
+	ceiling := cfgNotify.BackoffMax * time.Second
+	for i := 1; i < attempts && delay < ceiling; i++ {
+		delay *= 2
+	}
+	return min(delay, ceiling)
+}
+
+// record appends one entry to the delivery log, rotating the
+// log when it grows past [cfgNotify.DeliveryLogMaxBytes].
+// Failures only warn: the log must never block delivery.
+//
+// Parameters:
+//   - item: Delivery, with Attempts already counting this one
+//   - status: One of the cfgNotify Status constants
+//   - cause: Delivery error; nil on success
+func record(item entity.OutboxItem, status string, cause error) {
+	dir, dirErr := stateDir()
+	if dirErr != nil {
+		return
+	}
+	if mkErr := io.SafeMkdirAll(dir, fs.PermRestrictedDir); mkErr != nil {
+		logWarn.Warn(cfgWarn.Mkdir, dir, mkErr)
+		return
+	}
+	path := filepath.Join(dir, cfgNotify.FileDeliveryLog)
+	if info, statErr := os.Stat(path); statErr == nil &&
+		info.Size() > cfgNotify.DeliveryLogMaxBytes {
+		prev := filepath.Join(dir, cfgNotify.FileDeliveryLogPrev)
+		if renameErr := os.Rename(path, prev); renameErr != nil {
+			logWarn.Warn(cfgWarn.Rename, path, renameErr)
+		}
+	}
+
+	rec := entity.DeliveryRecord{
+		Time:    time.Now().UTC(),
+		ID:      item.ID,
+		Channel: item.Channel,
+		Event:   item.Payload.Event,
+		Status:  status,
+		Attempt: item.Attempts,
+	}
+	if cause != nil {
+		rec.Error = cause.Error()
+	}
+	line, marshalErr := json.Marshal(rec)
+	if marshalErr != nil {
+		logWarn.Warn(cfgWarn.Marshal, marshalErr)
+		return
+	}
+	line = append(line, token.NewlineLF[0])
+	if appendErr := io.AppendBytes(path, line, fs.PermFile); appendErr != nil {
+		logWarn.Warn(cfgWarn.Write, path, appendErr)
+	}
+}
+
+// readLog parses a JSONL delivery log, skipping malformed
+// lines.
+//
+// Parameters:
+//   - path: Log file
+//
+// Returns:
+//   - []entity.DeliveryRecord: Entries in file order
+//   - error: Non-nil when the file cannot be opened
+func readLog(path string) ([]entity.DeliveryRecord, error) {
+	f, openErr := io.SafeOpenUserFile(path)
+	if openErr != nil {
+		return nil, openErr
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			logWarn.Warn(cfgWarn.Close, path, closeErr)
+		}
+	}()
+
+	var records []entity.DeliveryRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec entity.DeliveryRecord
+		if json.Unmarshal(scanner.Bytes(), &rec) != nil {
+			continue
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
@@ -0,0 +1,254 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// flakyServer answers with whatever status is stored in the
+// returned pointer, counting the requests it receives.
+func flakyServer(
+	t *testing.T, status int,
+) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+	code := &atomic.Int32{}
+	code.Store(int32(status))
+	hits := &atomic.Int32{}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			w.WriteHeader(int(code.Load()))
+		},
+	))
+	t.Cleanup(ts.Close)
+	return ts, code, hits
+}
+
+func setupOutbox(t *testing.T, rcContent, url string) {
+	t.Helper()
+	tempDir, cleanup := setupTestDir(t)
+	t.Cleanup(cleanup)
+	_ = os.WriteFile(
+		filepath.Join(tempDir, ".ctxrc"), []byte(rcContent), 0o600,
+	)
+	rc.Reset()
+	if err := SaveSecret("alerts", Secret{URL: url}); err != nil {
+		t.Fatalf("SaveSecret() error = %v", err)
+	}
+}
+
+const outboxRC = `notify:
+  outbox: true
+  max_attempts: 3
+  channels:
+    - name: alerts
+      type: ntfy
+      events: [loop]
+`
+
+func TestOutbox_QueueThenFlush(t *testing.T) {
+	ts, code, hits := flakyServer(t, http.StatusBadGateway)
+	setupOutbox(t, outboxRC, ts.URL)
+
+	if err := Send("loop", "done", "", nil); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	pending, dead, err := Outbox()
+	if err != nil {
+		t.Fatalf("Outbox() error = %v", err)
+	}
+	if len(pending) != 1 || len(dead) != 0 {
+		t.Fatalf("outbox = %d pending, %d dead; want 1, 0",
+			len(pending), len(dead))
+	}
+	if pending[0].Channel != "alerts" || pending[0].Attempts != 1 ||
+		pending[0].LastError == "" {
+		t.Errorf("queued item = %+v", pending[0])
+	}
+
+	// Not due yet: an opportunistic flush leaves it alone.
+	code.Store(http.StatusOK)
+	r, err := Flush(false)
+	if err != nil {
+		t.Fatalf("Flush(false) error = %v", err)
+	}
+	if r.Sent != 0 || r.Pending != 1 || hits.Load() != 1 {
+		t.Fatalf("Flush(false) = %+v, hits = %d", r, hits.Load())
+	}
+
+	r, err = Flush(true)
+	if err != nil {
+		t.Fatalf("Flush(true) error = %v", err)
+	}
+	if r.Sent != 1 || r.Pending != 0 {
+		t.Fatalf("Flush(true) = %+v", r)
+	}
+	pending, _, _ = Outbox()
+	if len(pending) != 0 {
+		t.Errorf("outbox still has %d items", len(pending))
+	}
+
+	records, err := DeliveryLog(0)
+	if err != nil {
+		t.Fatalf("DeliveryLog() error = %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("log has %d records, want 2", len(records))
+	}
+	if records[0].Status != "queued" || records[0].Error == "" {
+		t.Errorf("first record = %+v", records[0])
+	}
+	if records[1].Status != "sent" || records[1].Attempt != 2 ||
+		records[1].Event != "loop" {
+		t.Errorf("second record = %+v", records[1])
+	}
+}
+
+func TestOutbox_DeadLetter(t *testing.T) {
+	ts, _, _ := flakyServer(t, http.StatusInternalServerError)
+	setupOutbox(t, outboxRC, ts.URL)
+
+	_ = Send("loop", "done", "", nil)
+	r, _ := Flush(true)
+	if r.Failed != 1 || r.Pending != 1 {
+		t.Fatalf("second attempt = %+v, want 1 failed", r)
+	}
+	r, _ = Flush(true)
+	if r.Dead != 1 || r.Pending != 0 {
+		t.Fatalf("third attempt = %+v, want 1 dead", r)
+	}
+
+	pending, dead, err := Outbox()
+	if err != nil {
+		t.Fatalf("Outbox() error = %v", err)
+	}
+	if len(pending) != 0 || len(dead) != 1 {
+		t.Fatalf("outbox = %d pending, %d dead; want 0, 1",
+			len(pending), len(dead))
+	}
+	if dead[0].Attempts != 3 {
+		t.Errorf("dead attempts = %d, want 3", dead[0].Attempts)
+	}
+
+	last, _ := DeliveryLog(1)
+	if len(last) != 1 || last[0].Status != "dead" {
+		t.Errorf("last record = %+v", last)
+	}
+}
+
+func TestOutbox_SuccessFlushesDue(t *testing.T) {
+	ts, code, hits := flakyServer(t, http.StatusServiceUnavailable)
+	setupOutbox(t, outboxRC, ts.URL)
+
+	_ = Send("loop", "first", "", nil)
+	pending, _, _ := Outbox()
+	if len(pending) != 1 {
+		t.Fatalf("pending = %d, want 1", len(pending))
+	}
+	// Make the queued item due.
+	dir, _ := outboxDir()
+	pending[0].NextAttempt = time.Now().Add(-time.Second)
+	if err := writeItem(dir, pending[0]); err != nil {
+		t.Fatalf("writeItem() error = %v", err)
+	}
+
+	code.Store(http.StatusOK)
+	_ = Send("loop", "second", "", nil)
+	if hits.Load() != 3 {
+		t.Errorf("hits = %d, want 3 (fail, send, retry)", hits.Load())
+	}
+	pending, _, _ = Outbox()
+	if len(pending) != 0 {
+		t.Errorf("pending = %d after online send, want 0", len(pending))
+	}
+}
+
+func TestClaimFlush_RateLimited(t *testing.T) {
+	ts, code, hits := flakyServer(t, http.StatusServiceUnavailable)
+	setupOutbox(t, outboxRC, ts.URL)
+
+	if ClaimFlush() {
+		t.Error("ClaimFlush() claimed an empty outbox")
+	}
+	_ = Send("loop", "offline", "", nil)
+	pending, _, _ := Outbox()
+	if len(pending) != 1 {
+		t.Fatalf("pending = %d, want 1", len(pending))
+	}
+	dir, _ := outboxDir()
+	pending[0].NextAttempt = time.Now().Add(-time.Second)
+	if err := writeItem(dir, pending[0]); err != nil {
+		t.Fatalf("writeItem() error = %v", err)
+	}
+
+	// Back online, but no new event: the claim is all the
+	// heartbeat does inline; it never delivers anything itself.
+	code.Store(http.StatusOK)
+	if !ClaimFlush() {
+		t.Fatal("ClaimFlush() should claim a non-empty outbox")
+	}
+	if hits.Load() != 1 {
+		t.Errorf("hits = %d, want 1 (claim must not deliver)", hits.Load())
+	}
+	if _, flushErr := Flush(false); flushErr != nil {
+		t.Fatalf("Flush() error = %v", flushErr)
+	}
+	pending, _, _ = Outbox()
+	if len(pending) != 0 {
+		t.Errorf("pending = %d after flush, want 0", len(pending))
+	}
+
+	code.Store(http.StatusServiceUnavailable)
+	_ = Send("loop", "offline again", "", nil)
+	if ClaimFlush() {
+		t.Error("ClaimFlush() should wait for the flush interval")
+	}
+}
+
+func TestOutbox_DisabledDropsFailures(t *testing.T) {
+	ts, _, _ := flakyServer(t, http.StatusBadGateway)
+	setupOutbox(t, `notify:
+  channels:
+    - name: alerts
+      type: ntfy
+      events: [loop]
+`, ts.URL)
+
+	_ = Send("loop", "done", "", nil)
+	pending, dead, _ := Outbox()
+	records, _ := DeliveryLog(0)
+	if len(pending) != 0 || len(dead) != 0 || len(records) != 0 {
+		t.Errorf("outbox disabled but got %d pending, %d dead, %d log",
+			len(pending), len(dead), len(records))
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
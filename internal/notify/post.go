@@ -0,0 +1,66 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	errNotify "github.com/ActiveMemory/ctx/internal/err/notify"
+	"github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// postJSON marshals v and posts it to url.
+//
+// Parameters:
+//   - url: Endpoint
+//   - v: Value to encode as the request body
+//
+// Returns:
+//   - error: Non-nil on encode, transport or non-2xx failure
+func postJSON(url string, v any) error {
+	body, marshalErr := json.Marshal(v)
+	if marshalErr != nil {
+		return errNotify.MarshalPayload(marshalErr)
+	}
+	req, reqErr := http.NewRequest(
+		http.MethodPost, url, bytes.NewReader(body),
+	)
+	if reqErr != nil {
+		return reqErr
+	}
+	req.Header.Set(cfgNotify.HeaderContentType, cfgHTTP.MimeJSON)
+	return do(req)
+}
+
+// do sends a request and maps non-2xx responses to errors.
+//
+// Parameters:
+//   - req: Prepared request
+//
+// Returns:
+//   - error: Non-nil on transport failure or non-2xx status
+func do(req *http.Request) error {
+	resp, doErr := io.SafeDo(req, cfgHTTP.WebhookTimeout*time.Second)
+	if doErr != nil {
+		return doErr
+	}
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		logWarn.Warn(cfgWarn.CloseResponse, closeErr)
+	}
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return errNotify.DeliveryStatus(resp.StatusCode)
+	}
+	return nil
+}
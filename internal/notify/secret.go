@@ -0,0 +1,101 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"path/filepath"
+
+	"github.com/ActiveMemory/ctx/internal/config/fs"
+	"github.com/ActiveMemory/ctx/internal/crypto"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// readSecret decrypts a secret file in the context directory.
+//
+// Returns (nil, nil) when the key or the file is missing, so
+// callers treat "never configured" as empty.
+//
+// Parameters:
+//   - name: File name under the context directory
+//
+// Returns:
+//   - []byte: Plaintext, or nil if not configured
+//   - error: Non-nil on resolver or decryption failure
+func readSecret(name string) ([]byte, error) {
+	kp, kpErr := rc.KeyPath()
+	if kpErr != nil {
+		return nil, kpErr
+	}
+	ctxDir, pathErr := rc.ContextDir()
+	if pathErr != nil {
+		return nil, pathErr
+	}
+
+	keys, loadErr := crypto.LoadKeyring(kp, rc.KeyGraceDays())
+	if loadErr != nil {
+		return nil, nil
+	}
+
+	ciphertext, readErr := io.SafeReadUserFile(
+		filepath.Join(ctxDir, name),
+	)
+	if readErr != nil {
+		return nil, nil
+	}
+
+	return crypto.DecryptAny(keys, ciphertext)
+}
+
+// writeSecret encrypts plaintext into a file in the context
+// directory, generating and saving the key first if it does
+// not exist.
+//
+// Parameters:
+//   - name: File name under the context directory
+//   - plaintext: Data to encrypt
+//
+// Returns:
+//   - error: Non-nil if key generation, encryption, or the
+//     write fails
+func writeSecret(name string, plaintext []byte) error {
+	kp, kpErr := rc.KeyPath()
+	if kpErr != nil {
+		return kpErr
+	}
+	ctxDir, ctxErr := rc.ContextDir()
+	if ctxErr != nil {
+		return ctxErr
+	}
+
+	key, loadErr := crypto.LoadKey(kp)
+	if loadErr != nil {
+		// Key doesn't exist: generate one.
+		var genErr error
+		key, genErr = crypto.GenerateKey()
+		if genErr != nil {
+			return genErr
+		}
+		if mkdirErr := io.SafeMkdirAll(
+			filepath.Dir(kp), fs.PermKeyDir,
+		); mkdirErr != nil {
+			return mkdirErr
+		}
+		if saveErr := crypto.SaveKey(kp, key); saveErr != nil {
+			return saveErr
+		}
+	}
+
+	ciphertext, encryptErr := crypto.Encrypt(key, plaintext)
+	if encryptErr != nil {
+		return encryptErr
+	}
+
+	return io.SafeWriteFile(
+		filepath.Join(ctxDir, name), ciphertext, fs.PermSecret,
+	)
+}
@@ -0,0 +1,115 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"os"
+	"path/filepath"
+
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/config/project"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// projectName resolves the project name from the working
+// directory, falling back to the default name.
+//
+// Returns:
+//   - string: Project directory name
+func projectName() string {
+	cwd, cwdErr := os.Getwd()
+	if cwdErr != nil {
+		logWarn.Warn(cfgWarn.Getwd, cwdErr)
+		return project.FallbackName
+	}
+	return filepath.Base(cwd)
+}
+
+// channelsFor returns the .ctxrc channels subscribed to event.
+//
+// Parameters:
+//   - event: Event name
+//
+// Returns:
+//   - []entity.NotifyChannel: Matching channels, in declaration order
+func channelsFor(event string) []entity.NotifyChannel {
+	var matched []entity.NotifyChannel
+	for _, ch := range rc.NotifyChannels() {
+		if EventAllowed(event, ch.Events) {
+			matched = append(matched, ch)
+		}
+	}
+	return matched
+}
+
+// targets lists the configured destinations among the
+// candidates: "" for the .notify.enc webhook when it is set and
+// accepts the event, then the names of subscribed channels that
+// have secrets. Unconfigured destinations are skipped silently.
+//
+// Parameters:
+//   - webhook: Whether the webhook filter accepts the event
+//   - channels: Subscribed channels
+//
+// Returns:
+//   - []string: Destinations, webhook first
+func targets(webhook bool, channels []entity.NotifyChannel) []string {
+	var out []string
+	if webhook {
+		if url, loadErr := LoadWebhook(); loadErr == nil && url != "" {
+			out = append(out, "")
+		}
+	}
+	if len(channels) == 0 {
+		return out
+	}
+	secrets, loadErr := LoadSecrets()
+	if loadErr != nil {
+		return out
+	}
+	for _, ch := range channels {
+		if _, ok := secrets[ch.Name]; ok {
+			out = append(out, ch.Name)
+		}
+	}
+	return out
+}
+
+// dispatch delivers the payload to every destination. With the
+// outbox enabled, each outcome is logged and failures are
+// queued for retry; otherwise failures are dropped.
+//
+// Parameters:
+//   - dests: Destinations from [targets]
+//   - p: Payload
+//
+// Returns:
+//   - bool: True when at least one delivery succeeded
+func dispatch(dests []string, p entity.NotifyPayload) bool {
+	outbox := rc.NotifyOutbox()
+	delivered := false
+	for _, dest := range dests {
+		deliverErr := deliverTo(dest, p)
+		if deliverErr == nil {
+			delivered = true
+		}
+		if !outbox {
+			continue // fire-and-forget
+		}
+		if deliverErr != nil {
+			enqueue(dest, p, deliverErr)
+			continue
+		}
+		record(entity.OutboxItem{
+			ID: newID(), Channel: dest, Payload: p, Attempts: 1,
+		}, cfgNotify.StatusSent, nil)
+	}
+	return delivered
+}
@@ -0,0 +1,46 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// slackBody builds a Block Kit message: a header with the
+// title, a section with the message and, when present, a
+// context block with the session and template reference.
+//
+// Parameters:
+//   - p: Payload
+//
+// Returns:
+//   - slackMessage: Incoming-webhook body
+func slackBody(p entity.NotifyPayload) slackMessage {
+	head := title(p)
+	blocks := []slackBlock{
+		{
+			Type: cfgNotify.SlackBlockHeader,
+			Text: &slackText{Type: cfgNotify.SlackTextPlain, Text: head},
+		},
+		{
+			Type: cfgNotify.SlackBlockSection,
+			Text: &slackText{
+				Type: cfgNotify.SlackTextPlain, Text: p.Message,
+			},
+		},
+	}
+	if line := contextLine(p); line != "" {
+		blocks = append(blocks, slackBlock{
+			Type: cfgNotify.SlackBlockContext,
+			Elements: []slackText{
+				{Type: cfgNotify.SlackTextPlain, Text: line},
+			},
+		})
+	}
+	return slackMessage{Text: head, Blocks: blocks}
+}
@@ -0,0 +1,72 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+// Secret holds the credentials of one notification channel.
+// Secrets are stored as a JSON map keyed by channel name in
+// .context/.notify-channels.enc.
+//
+// Fields:
+//   - URL: Webhook URL, ntfy topic URL, Matrix homeserver URL
+//     or SMTP server address (host:port)
+//   - Token: Bearer token (Matrix, ntfy)
+//   - User: SMTP user name
+//   - Password: SMTP password
+type Secret struct {
+	URL      string `json:"url"`
+	Token    string `json:"token,omitempty"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// slackMessage is a Slack incoming-webhook body. Text is the
+// fallback shown in notifications; Blocks is the rich layout.
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// slackBlock is one Block Kit layout block.
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+// slackText is a Block Kit text object.
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// discordMessage is a Discord webhook body.
+type discordMessage struct {
+	Username string         `json:"username"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+
+// discordEmbed is one Discord rich embed.
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Color       int            `json:"color"`
+	Timestamp   string         `json:"timestamp,omitempty"`
+	Footer      *discordFooter `json:"footer,omitempty"`
+}
+
+// discordFooter is the footer line of a Discord embed.
+type discordFooter struct {
+	Text string `json:"text"`
+}
+
+// matrixMessage is an m.room.message event body.
+type matrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format"`
+	FormattedBody string `json:"formatted_body"`
+}
@@ -0,0 +1,373 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/agent"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+)
+
+// scoringTiers returns the configured tier block, or nil.
+//
+// Returns:
+//   - *ScoringTiers: Tier overrides, or nil when unset
+func scoringTiers() *ScoringTiers {
+	s := RC().Scoring
+	if s == nil {
+		return nil
+	}
+	return s.Tiers
+}
+
+// driftCheck returns the .ctxrc override for a drift check.
+//
+// Parameters:
+//   - name: Check name
+//
+// Returns:
+//   - DriftCheckRC: The override
+//   - bool: False when no override is configured
+func driftCheck(name string) (DriftCheckRC, bool) {
+	d := RC().Drift
+	if d == nil {
+		return DriftCheckRC{}, false
+	}
+	c, ok := d.Checks[name]
+	return c, ok
+}
+
+// inPctRange reports whether a tier percentage is set and usable.
+//
+// Parameters:
+//   - pct: Configured percentage
+//
+// Returns:
+//   - bool: True for 1..agent.PctMax
+func inPctRange(pct int) bool {
+	return pct > 0 && pct <= agent.PctMax
+}
+
+// checkScoring reports semantic problems in a scoring block.
+//
+// Problems are returned as warnings rather than errors: a bad
+// value falls back to the default at runtime, so the file still
+// loads.
+//
+// Parameters:
+//   - s: Scoring block decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkScoring(s *ScoringRC) []string {
+	if s == nil {
+		return nil
+	}
+	var warnings []string
+	if s.RecencyHalfLifeDays < 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCScoringNegative),
+			agent.FieldHalfLife, s.RecencyHalfLifeDays,
+		))
+	}
+	if s.RelevanceMatchCap < 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCScoringNegative),
+			agent.FieldMatchCap, s.RelevanceMatchCap,
+		))
+	}
+	warnings = append(warnings, checkTiers(s.Tiers)...)
+	for _, kind := range slices.Sorted(maps.Keys(s.Weights)) {
+		w := s.Weights[kind]
+		if kind != cfgEntry.Decision && kind != cfgEntry.Learning {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCScoringUnknownType), kind,
+			))
+		}
+		if w <= 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCScoringWeight), kind, w,
+			))
+		}
+	}
+	for i, r := range s.Rules {
+		if r.Tag == "" && r.Section == "" {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCScoringRuleKey), i,
+			))
+		}
+		if r.Type != "" &&
+			r.Type != cfgEntry.Decision && r.Type != cfgEntry.Learning {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCScoringUnknownType), r.Type,
+			))
+		}
+	}
+	return warnings
+}
+
+// checkTiers reports out-of-range tier percentages.
+//
+// Parameters:
+//   - t: Tier block (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkTiers(t *ScoringTiers) []string {
+	if t == nil {
+		return nil
+	}
+	var warnings []string
+	fields := []struct {
+		name string
+		pct  int
+	}{
+		{agent.FieldTasksPct, t.TasksPct},
+		{agent.FieldConventionsPct, t.ConventionsPct},
+		{agent.FieldSplitMinPct, t.SplitMinPct},
+		{agent.FieldFullEntryPct, t.FullEntryPct},
+	}
+	for _, f := range fields {
+		if f.pct < 0 || f.pct > agent.PctMax {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCScoringPctRange),
+				f.name, f.pct, agent.PctMax,
+			))
+		}
+	}
+	if sum := t.TasksPct + t.ConventionsPct; sum > agent.PctMax {
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCScoringTierSum), sum, agent.PctMax,
+		))
+	}
+	// Decisions and learnings each get SplitMinPct, so two
+	// minimums above half the budget cannot both be honored.
+	if t.SplitMinPct*2 > agent.PctMax {
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCScoringSplitMin),
+			t.SplitMinPct, agent.PctMax/2,
+		))
+	}
+	return warnings
+}
+
+// checkDrift reports unknown severities in a drift block.
+//
+// Parameters:
+//   - d: Drift block decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkDrift(d *DriftRC) []string {
+	if d == nil {
+		return nil
+	}
+	var warnings []string
+	for _, name := range slices.Sorted(maps.Keys(d.Checks)) {
+		sev := d.Checks[name].Severity
+		if sev != "" &&
+			sev != cfgDrift.StatusWarning && sev != cfgDrift.StatusViolation {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCDriftSeverity), name, sev,
+			))
+		}
+	}
+	if d.Timeout < 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCDriftTimeout), d.Timeout,
+		))
+	}
+	return warnings
+}
+
+// checkSecrets reports unusable rules and a negative entropy
+// threshold in a secrets block.
+//
+// Parameters:
+//   - s: Secrets block decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkSecrets(s *SecretsRC) []string {
+	if s == nil {
+		return nil
+	}
+	var warnings []string
+	for i, r := range s.Rules {
+		if r.Name == "" {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCSecretRuleName), i,
+			))
+		}
+		if _, compileErr := regex.Compile(r.Pattern); compileErr != nil ||
+			r.Pattern == "" {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCSecretRulePattern), i, r.Pattern,
+			))
+		}
+	}
+	if s.Entropy < 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCSecretEntropy), s.Entropy,
+		))
+	}
+	return warnings
+}
+
+// checkRedaction reports an unknown mode, unknown kinds and
+// unusable patterns in the redaction settings.
+//
+// Parameters:
+//   - mode: redact value from .ctxrc (empty is valid)
+//   - r: Redaction block decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkRedaction(mode string, r *RedactionRC) []string {
+	var warnings []string
+	switch mode {
+	case "", cfgRedact.ModeOff, cfgRedact.ModeOn, cfgRedact.ModeRequired:
+	default:
+		warnings = append(warnings, fmt.Sprintf(
+			desc.Text(text.DescKeyRCRedactMode), mode,
+		))
+	}
+	if r == nil {
+		return warnings
+	}
+	for _, k := range r.Kinds {
+		if !slices.Contains(cfgRedact.Kinds, k) {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCRedactKind), k,
+			))
+		}
+	}
+	for i, p := range r.Patterns {
+		if p.Name == "" {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCRedactRuleName), i,
+			))
+		}
+		if _, compileErr := regex.Compile(p.Pattern); compileErr != nil ||
+			p.Pattern == "" {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCRedactRulePattern), i, p.Pattern,
+			))
+		}
+	}
+	return warnings
+}
+
+// checkNotify reports notification channels without a name,
+// with a duplicate name, or with an unknown provider type.
+//
+// Parameters:
+//   - n: Notify block decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkNotify(n *NotifyConfig) []string {
+	if n == nil {
+		return nil
+	}
+	var warnings []string
+	seen := make(map[string]bool, len(n.Channels))
+	for i, ch := range n.Channels {
+		switch {
+		case ch.Name == "":
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCNotifyChannelName), i,
+			))
+		case seen[ch.Name]:
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCNotifyChannelDupe), ch.Name,
+			))
+		}
+		seen[ch.Name] = true
+		if _, ok := cfgNotify.Fields[ch.Type]; !ok {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCNotifyChannelType), i, ch.Type,
+			))
+		}
+	}
+	return warnings
+}
+
+// checkPrices reports negative prices in the model price
+// table.
+//
+// Parameters:
+//   - prices: Price table decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkPrices(prices map[string]PriceRC) []string {
+	var warnings []string
+	for _, model := range slices.Sorted(maps.Keys(prices)) {
+		if p := prices[model]; p.Input < 0 || p.Output < 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCPriceNegative), model,
+			))
+		}
+	}
+	return warnings
+}
+
+// checkTrace reports external ref resolvers without a name,
+// with a duplicate or built-in ref type as name, or with an
+// unusable pattern.
+//
+// Parameters:
+//   - t: Trace block decoded from .ctxrc (nil is valid)
+//
+// Returns:
+//   - []string: Human-readable warnings, nil when clean
+func checkTrace(t *TraceRC) []string {
+	if t == nil {
+		return nil
+	}
+	var warnings []string
+	seen := make(map[string]bool, len(t.Resolvers))
+	for i, r := range t.Resolvers {
+		switch r.Name {
+		case "":
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCTraceResolverName), i,
+			))
+		case cfgTrace.RefTypeNote, cfgTrace.RefTypeSession,
+			cfgTrace.RefTypeDecision, cfgTrace.RefTypeLearning,
+			cfgTrace.RefTypeConvention, cfgTrace.RefTypeTask:
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCTraceResolverReserved), i, r.Name,
+			))
+		default:
+			if seen[r.Name] {
+				warnings = append(warnings, fmt.Sprintf(
+					desc.Text(text.DescKeyRCTraceResolverDupe), r.Name,
+				))
+			}
+		}
+		seen[r.Name] = true
+		if _, compileErr := regex.Compile(r.Pattern); compileErr != nil ||
+			r.Pattern == "" {
+			warnings = append(warnings, fmt.Sprintf(
+				desc.Text(text.DescKeyRCTraceResolverPattern), i, r.Pattern,
+			))
+		}
+	}
+	return warnings
+}
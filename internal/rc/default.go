@@ -31,6 +31,8 @@ const (
 	DefaultTaskNudgeInterval = runtime.DefaultTaskNudgeInterval
 	// DefaultKeyRotationDays is the default key rotation age.
 	DefaultKeyRotationDays = runtime.DefaultKeyRotationDays
+	// DefaultKeyGraceDays is the default rotated key grace period.
+	DefaultKeyGraceDays = runtime.DefaultKeyGraceDays
 	// DefaultStaleAgeDays is the default stale entry age.
 	DefaultStaleAgeDays = runtime.DefaultStaleAgeDays
 )
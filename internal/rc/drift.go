@@ -0,0 +1,89 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import (
+	"time"
+
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
+)
+
+// DriftCheckEnabled reports whether a drift check should run.
+//
+// Parameters:
+//   - name: Built-in check name or external check name
+//
+// Returns:
+//   - bool: False only when drift.checks.<name>.enabled is false
+func DriftCheckEnabled(name string) bool {
+	c, ok := driftCheck(name)
+	if !ok || c.Enabled == nil {
+		return true
+	}
+	return *c.Enabled
+}
+
+// DriftCheckOptedIn reports whether an opt-in drift check was
+// switched on.
+//
+// Parameters:
+//   - name: Built-in check name
+//
+// Returns:
+//   - bool: True only when drift.checks.<name>.enabled is true
+func DriftCheckOptedIn(name string) bool {
+	c, ok := driftCheck(name)
+	return ok && c.Enabled != nil && *c.Enabled
+}
+
+// DriftCheckSeverity returns the severity override for a drift
+// check.
+//
+// Parameters:
+//   - name: Built-in check name or external check name
+//
+// Returns:
+//   - string: "warning" or "violation", or empty when the check
+//     keeps its own levels (unset or unrecognized)
+func DriftCheckSeverity(name string) string {
+	c, ok := driftCheck(name)
+	if !ok {
+		return ""
+	}
+	switch c.Severity {
+	case cfgDrift.StatusWarning, cfgDrift.StatusViolation:
+		return c.Severity
+	default:
+		return ""
+	}
+}
+
+// DriftExternal reports whether external drift checks may run.
+//
+// Scripts in .context/checks/ are committed repo content, so they
+// only run when the project opts in with drift.external.
+//
+// Returns:
+//   - bool: True when drift.external is set
+func DriftExternal() bool {
+	d := RC().Drift
+	return d != nil && d.External
+}
+
+// DriftCheckTimeout returns the per-run timeout for external
+// drift checks.
+//
+// Returns:
+//   - time.Duration: Configured timeout, or
+//     cfgDrift.DefaultCheckTimeout (10s)
+func DriftCheckTimeout() time.Duration {
+	d := RC().Drift
+	if d == nil || d.Timeout <= 0 {
+		return cfgDrift.DefaultCheckTimeout
+	}
+	return time.Duration(d.Timeout) * time.Second
+}
@@ -0,0 +1,39 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import "strings"
+
+// Price looks up a model in the price table.
+//
+// An exact key wins; otherwise the longest key that prefixes
+// the model ID applies, so "claude-sonnet-4" prices every
+// dated sonnet-4 release. Matching ignores case.
+//
+// Parameters:
+//   - model: Model ID recorded in the session
+//
+// Returns:
+//   - input: USD per million input tokens
+//   - output: USD per million output tokens
+//   - ok: False when no entry matches
+func Price(model string) (input, output float64, ok bool) {
+	if model == "" {
+		return 0, 0, false
+	}
+	id := strings.ToLower(model)
+	best := -1
+	for key, p := range RC().Prices {
+		k := strings.ToLower(key)
+		if !strings.HasPrefix(id, k) || len(k) <= best {
+			continue
+		}
+		best = len(k)
+		input, output, ok = p.Input, p.Output, true
+	}
+	return input, output, ok
+}
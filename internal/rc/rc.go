@@ -16,8 +16,10 @@ import (
 	cfgEntry "github.com/ActiveMemory/ctx/internal/config/entry"
 	"github.com/ActiveMemory/ctx/internal/config/env"
 	cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+	cfgNotify "github.com/ActiveMemory/ctx/internal/config/notify"
 	"github.com/ActiveMemory/ctx/internal/config/parser"
 	"github.com/ActiveMemory/ctx/internal/crypto"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
 )
 
@@ -249,6 +251,41 @@ func NotifyEvents() []string {
 	return n.Events
 }
 
+// NotifyChannels returns the named notification channels.
+//
+// Returns:
+//   - []entity.NotifyChannel: Configured channels, or nil
+func NotifyChannels() []entity.NotifyChannel {
+	n := RC().Notify
+	if n == nil {
+		return nil
+	}
+	return n.Channels
+}
+
+// NotifyOutbox reports whether failed notifications are queued
+// for retry.
+//
+// Returns:
+//   - bool: True when notify.outbox is set
+func NotifyOutbox() bool {
+	n := RC().Notify
+	return n != nil && n.Outbox
+}
+
+// NotifyMaxAttempts returns the number of delivery attempts
+// before a queued notification is dead-lettered.
+//
+// Returns:
+//   - int: notify.max_attempts, or the default when unset
+func NotifyMaxAttempts() int {
+	n := RC().Notify
+	if n == nil || n.MaxAttempts <= 0 {
+		return cfgNotify.DefaultMaxAttempts
+	}
+	return n.MaxAttempts
+}
+
 // KeyPath returns the resolved encryption key file path.
 //
 // Under the explicit-context-dir model the caller must have a
@@ -299,6 +336,18 @@ func KeyRotationDays() int {
 	return DefaultKeyRotationDays
 }
 
+// KeyGraceDays returns how long a key replaced by
+// `ctx key rotate` stays usable for decryption.
+//
+// Returns:
+//   - int: Grace period in days
+func KeyGraceDays() int {
+	if d := RC().KeyGraceDays; d > 0 {
+		return d
+	}
+	return DefaultKeyGraceDays
+}
+
 // TaskNudgeInterval returns the number of Edit/Write calls between task
 // completion nudges. Returns 0 if disabled.
 //
@@ -528,6 +577,15 @@ func HooksEnabled() bool {
 	return true
 }
 
+// Inherit returns the configured context layers.
+//
+// Returns:
+//   - []InheritRC: Layers in .ctxrc order, nearest first, or nil
+//     when the project does not inherit
+func Inherit() []InheritRC {
+	return RC().Inherit
+}
+
 // Reset clears the cached configuration, forcing
 // reload on the next access.
 func Reset() {
@@ -10,11 +10,17 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/ActiveMemory/ctx/internal/config/agent"
 	"github.com/ActiveMemory/ctx/internal/config/ctx"
 	"github.com/ActiveMemory/ctx/internal/config/dir"
+	cfgDrift "github.com/ActiveMemory/ctx/internal/config/drift"
 	"github.com/ActiveMemory/ctx/internal/config/env"
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	cfgSecret "github.com/ActiveMemory/ctx/internal/config/secret"
 	errCtx "github.com/ActiveMemory/ctx/internal/err/context"
 )
 
@@ -798,3 +804,240 @@ func TestHooksEnabled_ExplicitFalse(t *testing.T) {
 		t.Error("HooksEnabled() = true, want false")
 	}
 }
+
+func TestScoring_Defaults(t *testing.T) {
+	declareContext(t, "")
+	if got := RecencyHalfLifeDays(); got != 0 {
+		t.Errorf("RecencyHalfLifeDays() = %v, want 0", got)
+	}
+	if got := RelevanceMatchCap(); got != agent.RelevanceMatchCap {
+		t.Errorf("RelevanceMatchCap() = %d, want %d", got, agent.RelevanceMatchCap)
+	}
+	if got := TaskBudgetPct(); got != agent.TaskBudgetPct {
+		t.Errorf("TaskBudgetPct() = %v, want %v", got, agent.TaskBudgetPct)
+	}
+	if got := ScoringWeight("decision"); got != agent.DefaultTypeWeight {
+		t.Errorf("ScoringWeight() = %v, want %v", got, agent.DefaultTypeWeight)
+	}
+	if got := ScoringRules(); got != nil {
+		t.Errorf("ScoringRules() = %v, want nil", got)
+	}
+}
+
+func TestScoring_Configured(t *testing.T) {
+	declareContext(t, `scoring:
+  recency_half_life_days: 14
+  relevance_match_cap: 5
+  tiers:
+    tasks_pct: 50
+    conventions_pct: 150
+    full_entry_pct: 60
+  weights:
+    learning: 0.5
+    decision: -2
+  rules:
+    - tag: infra
+      boost: 1
+`)
+	if got := RecencyHalfLifeDays(); got != 14 {
+		t.Errorf("RecencyHalfLifeDays() = %v, want 14", got)
+	}
+	if got := RelevanceMatchCap(); got != 5 {
+		t.Errorf("RelevanceMatchCap() = %d, want 5", got)
+	}
+	if got := TaskBudgetPct(); got != 0.5 {
+		t.Errorf("TaskBudgetPct() = %v, want 0.5", got)
+	}
+	// Out of range falls back to the default.
+	if got := ConventionBudgetPct(); got != agent.ConventionBudgetPct {
+		t.Errorf("ConventionBudgetPct() = %v, want default", got)
+	}
+	if got := SplitMinPct(); got != agent.SplitMinPct {
+		t.Errorf("SplitMinPct() = %d, want default", got)
+	}
+	if got := FullEntryPct(); got != 60 {
+		t.Errorf("FullEntryPct() = %d, want 60", got)
+	}
+	if got := ScoringWeight("learning"); got != 0.5 {
+		t.Errorf("ScoringWeight(learning) = %v, want 0.5", got)
+	}
+	if got := ScoringWeight("decision"); got != agent.DefaultTypeWeight {
+		t.Errorf("ScoringWeight(decision) = %v, want default", got)
+	}
+	if rules := ScoringRules(); len(rules) != 1 || rules[0].Tag != "infra" {
+		t.Errorf("ScoringRules() = %v", rules)
+	}
+}
+
+func TestDrift_Defaults(t *testing.T) {
+	declareContext(t, "")
+	if !DriftCheckEnabled("staleness_check") {
+		t.Error("DriftCheckEnabled() = false, want true")
+	}
+	if got := DriftCheckSeverity("staleness_check"); got != "" {
+		t.Errorf("DriftCheckSeverity() = %q, want empty", got)
+	}
+	if got := DriftCheckTimeout(); got != cfgDrift.DefaultCheckTimeout {
+		t.Errorf("DriftCheckTimeout() = %v, want default", got)
+	}
+}
+
+func TestDrift_Configured(t *testing.T) {
+	declareContext(t, `drift:
+  timeout: 3
+  checks:
+    file_age_check:
+      enabled: false
+    staleness_check:
+      severity: violation
+    license:
+      severity: fatal
+`)
+	if DriftCheckEnabled("file_age_check") {
+		t.Error("DriftCheckEnabled(file_age_check) = true, want false")
+	}
+	if !DriftCheckEnabled("staleness_check") {
+		t.Error("DriftCheckEnabled(staleness_check) = false, want true")
+	}
+	if got := DriftCheckSeverity("staleness_check"); got != "violation" {
+		t.Errorf("DriftCheckSeverity(staleness_check) = %q", got)
+	}
+	// Unknown severities are ignored.
+	if got := DriftCheckSeverity("license"); got != "" {
+		t.Errorf("DriftCheckSeverity(license) = %q, want empty", got)
+	}
+	if got := DriftCheckTimeout(); got != 3*time.Second {
+		t.Errorf("DriftCheckTimeout() = %v, want 3s", got)
+	}
+}
+
+func TestSecrets_Defaults(t *testing.T) {
+	ctxDir := declareContext(t, "")
+	if got := SecretRules(); got != nil {
+		t.Errorf("SecretRules() = %v, want nil", got)
+	}
+	if got := SecretEntropy(); got != cfgSecret.DefaultEntropy {
+		t.Errorf("SecretEntropy() = %v, want default", got)
+	}
+	want := filepath.Join(ctxDir, cfgSecret.AllowlistFile)
+	if got := SecretAllowlist(); got != want {
+		t.Errorf("SecretAllowlist() = %q, want %q", got, want)
+	}
+}
+
+func TestSecrets_Configured(t *testing.T) {
+	ctxDir := declareContext(t, `secrets:
+  entropy: 3.5
+  allowlist: config/allow.txt
+  rules:
+    - name: internal_token
+      pattern: 'itk_[a-z0-9]{16}'
+`)
+	rules := SecretRules()
+	if len(rules) != 1 || rules[0].Name != "internal_token" {
+		t.Errorf("SecretRules() = %v", rules)
+	}
+	if got := SecretEntropy(); got != 3.5 {
+		t.Errorf("SecretEntropy() = %v, want 3.5", got)
+	}
+	want := filepath.Join(filepath.Dir(ctxDir), "config", "allow.txt")
+	if got := SecretAllowlist(); got != want {
+		t.Errorf("SecretAllowlist() = %q, want %q", got, want)
+	}
+}
+
+func TestInherit(t *testing.T) {
+	declareContext(t, "")
+	if got := Inherit(); got != nil {
+		t.Errorf("Inherit() = %v, want nil", got)
+	}
+
+	declareContext(t, `inherit:
+  - path: ..
+    label: org
+  - ref: origin/main
+    path: platform/.context
+`)
+	got := Inherit()
+	if len(got) != 2 {
+		t.Fatalf("Inherit() = %v, want 2 layers", got)
+	}
+	if got[0].Path != ".." || got[0].Label != "org" {
+		t.Errorf("Inherit()[0] = %+v", got[0])
+	}
+	if got[1].Ref != "origin/main" || got[1].Path != "platform/.context" {
+		t.Errorf("Inherit()[1] = %+v", got[1])
+	}
+}
+
+func TestRedact_Defaults(t *testing.T) {
+	declareContext(t, "")
+	if got := RedactMode(); got != cfgRedact.ModeOn {
+		t.Errorf("RedactMode() = %q, want on", got)
+	}
+	if RedactRequired() {
+		t.Error("RedactRequired() = true, want false")
+	}
+	if got := RedactKinds(); !slices.Equal(got, cfgRedact.Kinds) {
+		t.Errorf("RedactKinds() = %v, want all", got)
+	}
+	if got := RedactHosts(); !slices.Equal(got, cfgRedact.DefaultHosts) {
+		t.Errorf("RedactHosts() = %v, want defaults", got)
+	}
+}
+
+func TestRedact_Configured(t *testing.T) {
+	declareContext(t, `redact: required
+redaction:
+  kinds: [host, email]
+  hosts: [corp.example.com]
+  patterns:
+    - name: ticket
+      pattern: 'ACME-[0-9]+'
+`)
+	if !RedactRequired() {
+		t.Error("RedactRequired() = false, want true")
+	}
+	// Kinds keep pipeline order regardless of .ctxrc order.
+	want := []string{cfgRedact.KindEmail, cfgRedact.KindHost}
+	if got := RedactKinds(); !slices.Equal(got, want) {
+		t.Errorf("RedactKinds() = %v, want %v", got, want)
+	}
+	if got := RedactHosts(); !slices.Equal(got, []string{"corp.example.com"}) {
+		t.Errorf("RedactHosts() = %v", got)
+	}
+	if got := RedactPatterns(); len(got) != 1 || got[0].Name != "ticket" {
+		t.Errorf("RedactPatterns() = %v", got)
+	}
+
+	declareContext(t, "redact: sometimes\n")
+	if got := RedactMode(); got != cfgRedact.ModeOn {
+		t.Errorf("RedactMode() = %q, want on for unknown mode", got)
+	}
+}
+
+func TestPrice_LongestPrefixWins(t *testing.T) {
+	declareContext(t, `prices:
+  claude-sonnet-4: { input: 3, output: 15 }
+  claude-sonnet-4-5: { input: 4, output: 20 }
+  Claude-Opus: { input: 15, output: 75 }
+`)
+	tests := []struct {
+		model   string
+		in, out float64
+		ok      bool
+	}{
+		{"claude-sonnet-4-20250514", 3, 15, true},
+		{"claude-sonnet-4-5-20250929", 4, 20, true},
+		{"claude-opus-4-1", 15, 75, true},
+		{"gpt-4o", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		in, out, ok := Price(tt.model)
+		if in != tt.in || out != tt.out || ok != tt.ok {
+			t.Errorf("Price(%q) = %v, %v, %v; want %v, %v, %v",
+				tt.model, in, out, ok, tt.in, tt.out, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import (
+	"slices"
+
+	cfgRedact "github.com/ActiveMemory/ctx/internal/config/redact"
+	cfgSecret "github.com/ActiveMemory/ctx/internal/config/secret"
+)
+
+// RedactMode returns the journal redaction mode.
+//
+// Returns:
+//   - string: redact from .ctxrc when it names a known mode,
+//     otherwise cfgRedact.ModeOn
+func RedactMode() string {
+	switch m := RC().Redact; m {
+	case cfgRedact.ModeOff, cfgRedact.ModeRequired:
+		return m
+	default:
+		return cfgRedact.ModeOn
+	}
+}
+
+// RedactRequired reports whether exporters must refuse
+// unredacted journal entries.
+//
+// Returns:
+//   - bool: True when redact is set to required
+func RedactRequired() bool {
+	return RedactMode() == cfgRedact.ModeRequired
+}
+
+// RedactKinds returns the built-in redaction kinds to apply.
+//
+// Returns:
+//   - []string: Known kinds from redaction.kinds in pipeline
+//     order, or cfgRedact.Kinds when unset
+func RedactKinds() []string {
+	r := RC().Redaction
+	if r == nil || len(r.Kinds) == 0 {
+		return cfgRedact.Kinds
+	}
+	var kinds []string
+	for _, k := range cfgRedact.Kinds {
+		if slices.Contains(r.Kinds, k) {
+			kinds = append(kinds, k)
+		}
+	}
+	return kinds
+}
+
+// RedactHosts returns the internal domain suffixes whose
+// hostnames are redacted.
+//
+// Returns:
+//   - []string: redaction.hosts, or cfgRedact.DefaultHosts
+//     when unset
+func RedactHosts() []string {
+	r := RC().Redaction
+	if r == nil || len(r.Hosts) == 0 {
+		return cfgRedact.DefaultHosts
+	}
+	return r.Hosts
+}
+
+// RedactPatterns returns the project redaction patterns.
+//
+// Returns:
+//   - []cfgSecret.Rule: Patterns from redaction.patterns, nil
+//     when none are configured
+func RedactPatterns() []cfgSecret.Rule {
+	r := RC().Redaction
+	if r == nil {
+		return nil
+	}
+	return r.Patterns
+}
@@ -0,0 +1,126 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import (
+	"github.com/ActiveMemory/ctx/internal/config/agent"
+	"github.com/ActiveMemory/ctx/internal/config/stats"
+)
+
+// RecencyHalfLifeDays returns the configured recency half-life.
+//
+// Returns 0 when unset, which keeps the bucketed recency
+// scoring from config/agent.
+//
+// Returns:
+//   - float64: Half-life in days, or 0 for bucketed recency
+func RecencyHalfLifeDays() float64 {
+	s := RC().Scoring
+	if s == nil || s.RecencyHalfLifeDays <= 0 {
+		return 0
+	}
+	return s.RecencyHalfLifeDays
+}
+
+// RelevanceMatchCap returns the keyword match count that yields
+// maximum relevance.
+//
+// Returns:
+//   - int: Configured cap, or agent.RelevanceMatchCap (3)
+func RelevanceMatchCap() int {
+	s := RC().Scoring
+	if s == nil || s.RelevanceMatchCap <= 0 {
+		return agent.RelevanceMatchCap
+	}
+	return s.RelevanceMatchCap
+}
+
+// TaskBudgetPct returns the fraction of the token budget
+// allocated to active tasks. Out-of-range values fall back to
+// the default.
+//
+// Returns:
+//   - float64: Configured fraction, or agent.TaskBudgetPct (0.40)
+func TaskBudgetPct() float64 {
+	t := scoringTiers()
+	if t == nil || !inPctRange(t.TasksPct) {
+		return agent.TaskBudgetPct
+	}
+	return float64(t.TasksPct) / stats.PercentMultiplier
+}
+
+// ConventionBudgetPct returns the fraction of the token budget
+// allocated to conventions.
+//
+// Returns:
+//   - float64: Configured fraction, or
+//     agent.ConventionBudgetPct (0.20)
+func ConventionBudgetPct() float64 {
+	t := scoringTiers()
+	if t == nil || !inPctRange(t.ConventionsPct) {
+		return agent.ConventionBudgetPct
+	}
+	return float64(t.ConventionsPct) / stats.PercentMultiplier
+}
+
+// SplitMinPct returns the minimum percentage each of decisions
+// and learnings receives when they compete for budget.
+//
+// Returns:
+//   - int: Configured percentage, or agent.SplitMinPct (30)
+func SplitMinPct() int {
+	t := scoringTiers()
+	if t == nil || !inPctRange(t.SplitMinPct) {
+		return agent.SplitMinPct
+	}
+	return t.SplitMinPct
+}
+
+// FullEntryPct returns the percentage of a section budget spent
+// on full entries before title-only summaries take over.
+//
+// Returns:
+//   - int: Configured percentage, or agent.FullEntryPct (80)
+func FullEntryPct() int {
+	t := scoringTiers()
+	if t == nil || !inPctRange(t.FullEntryPct) {
+		return agent.FullEntryPct
+	}
+	return t.FullEntryPct
+}
+
+// ScoringWeight returns the score multiplier for an entry type.
+//
+// Parameters:
+//   - kind: Entry type (e.g. "decision", "learning")
+//
+// Returns:
+//   - float64: Configured weight, or agent.DefaultTypeWeight (1.0)
+//     when the type has no entry or a non-positive weight
+func ScoringWeight(kind string) float64 {
+	s := RC().Scoring
+	if s == nil {
+		return agent.DefaultTypeWeight
+	}
+	w, ok := s.Weights[kind]
+	if !ok || w <= 0 {
+		return agent.DefaultTypeWeight
+	}
+	return w
+}
+
+// ScoringRules returns the configured boost/penalty rules.
+//
+// Returns:
+//   - []ScoringRule: Rules in file order, or nil if unset
+func ScoringRules() []ScoringRule {
+	s := RC().Scoring
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
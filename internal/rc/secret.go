@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+import (
+	"path/filepath"
+
+	cfgSecret "github.com/ActiveMemory/ctx/internal/config/secret"
+)
+
+// SecretRules returns the project-defined secret patterns.
+//
+// Returns:
+//   - []cfgSecret.Rule: Custom rules from secrets.rules, nil when
+//     none are configured
+func SecretRules() []cfgSecret.Rule {
+	s := RC().Secrets
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
+
+// SecretEntropy returns the entropy threshold for bare
+// high-entropy tokens.
+//
+// Returns:
+//   - float64: Configured bits per character, or
+//     cfgSecret.DefaultEntropy (4.5)
+func SecretEntropy() float64 {
+	s := RC().Secrets
+	if s == nil || s.Entropy <= 0 {
+		return cfgSecret.DefaultEntropy
+	}
+	return s.Entropy
+}
+
+// SecretAllowlist returns the path of the secret allowlist file.
+//
+// Returns:
+//   - string: secrets.allowlist resolved against the project
+//     root, or .context/secrets.allow; empty when the context
+//     directory is not declared
+func SecretAllowlist() string {
+	ctxDir, ctxErr := ContextDir()
+	if ctxErr != nil {
+		return ""
+	}
+	s := RC().Secrets
+	if s == nil || s.Allowlist == "" {
+		return filepath.Join(ctxDir, cfgSecret.AllowlistFile)
+	}
+	if filepath.IsAbs(s.Allowlist) {
+		return s.Allowlist
+	}
+	return filepath.Join(filepath.Dir(ctxDir), s.Allowlist)
+}
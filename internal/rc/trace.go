@@ -0,0 +1,30 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package rc
+
+// TraceNotes reports whether commit context links are written to
+// git notes instead of the local JSONL history.
+//
+// Returns:
+//   - bool: True when trace.notes is set
+func TraceNotes() bool {
+	t := RC().Trace
+	return t != nil && t.Notes
+}
+
+// TraceResolvers returns the configured external ref resolvers.
+//
+// Returns:
+//   - []TraceResolverRC: Resolvers from trace.resolvers, nil when
+//     none are configured
+func TraceResolvers() []TraceResolverRC {
+	t := RC().Trace
+	if t == nil {
+		return nil
+	}
+	return t.Resolvers
+}
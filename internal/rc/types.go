@@ -6,7 +6,11 @@
 
 package rc
 
-import cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+import (
+	cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
+	cfgSecret "github.com/ActiveMemory/ctx/internal/config/secret"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
 
 // CtxRC represents the configuration from the .ctxrc file.
 //
@@ -32,6 +36,8 @@ import cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
 //     (default false)
 //   - KeyRotationDays: Days before encryption key
 //     rotation nudge (default 90)
+//   - KeyGraceDays: Days a key replaced by ctx key rotate
+//     stays usable for decryption (default 14)
 //   - TaskNudgeInterval: Edit/Write calls between task
 //     completion nudges (default 5, 0 = disabled)
 //   - KeyPathOverride: Explicit encryption key file
@@ -57,6 +63,21 @@ import cfgMemory "github.com/ActiveMemory/ctx/internal/config/memory"
 //   - Hooks: Hook system configuration overrides
 //   - ProvenanceRequired: Per-project relaxation of
 //     provenance flags for ctx add (default: all required)
+//   - Scoring: Agent packet scoring overrides (recency
+//     half-life, tier percentages, weights, rules)
+//   - Inherit: Parent or sibling context layers merged into
+//     ctx load, ctx agent, and ctx drift
+//   - Drift: Drift check overrides (enable/disable, severity)
+//     and the external check timeout
+//   - Secrets: Secret scanner settings (custom rules, entropy
+//     threshold, allowlist file)
+//   - Redact: Journal redaction mode: off, on (default) or
+//     required
+//   - Redaction: Journal redaction rules (kinds, internal
+//     domains, custom patterns)
+//   - Prices: Per-model token prices for ctx journal stats,
+//     keyed by model ID or model ID prefix
+//   - Trace: Commit context tracing settings (git notes)
 type CtxRC struct {
 	Profile             string                   `yaml:"profile"`
 	Tool                string                   `yaml:"tool"`
@@ -73,6 +94,7 @@ type CtxRC struct {
 	BillingTokenWarn    int                      `yaml:"billing_token_warn"`
 	EventLog            bool                     `yaml:"event_log"`
 	KeyRotationDays     int                      `yaml:"key_rotation_days"`
+	KeyGraceDays        int                      `yaml:"key_grace_days"`
 	TaskNudgeInterval   int                      `yaml:"task_nudge_interval"`
 	KeyPathOverride     string                   `yaml:"key_path"`
 	StaleAgeDays        int                      `yaml:"stale_age_days"`
@@ -86,6 +108,14 @@ type CtxRC struct {
 	Steering            *SteeringRC              `yaml:"steering"`
 	Hooks               *HooksRC                 `yaml:"hooks"`
 	ProvenanceRequired  *ProvenanceConfig        `yaml:"provenance_required"`
+	Scoring             *ScoringRC               `yaml:"scoring"`
+	Inherit             []InheritRC              `yaml:"inherit"`
+	Drift               *DriftRC                 `yaml:"drift"`
+	Secrets             *SecretsRC               `yaml:"secrets"`
+	Redact              string                   `yaml:"redact"`
+	Redaction           *RedactionRC             `yaml:"redaction"`
+	Prices              map[string]PriceRC       `yaml:"prices"`
+	Trace               *TraceRC                 `yaml:"trace"`
 }
 
 // ProvenanceConfig controls which provenance flags are
@@ -123,11 +153,19 @@ type FreshnessFile struct {
 // instead. This field is retained for backwards compatibility with existing
 // .ctxrc files that have key_rotation_days nested under notify.
 // Fields:
-//   - Events: Event filter list (loop, nudge, relay, heartbeat)
+//   - Events: Event filter list for the .notify.enc webhook
 //   - KeyRotationDays: Deprecated; use top-level CtxRC.KeyRotationDays
+//   - Channels: Named channels, each with its own provider and
+//     event filter
+//   - Outbox: Queue failed deliveries for retry (opt-in)
+//   - MaxAttempts: Attempts before a queued delivery is
+//     dead-lettered (0 = default)
 type NotifyConfig struct {
-	Events          []string `yaml:"events"`
-	KeyRotationDays int      `yaml:"key_rotation_days"`
+	Events          []string               `yaml:"events"`
+	KeyRotationDays int                    `yaml:"key_rotation_days"`
+	Channels        []entity.NotifyChannel `yaml:"channels"`
+	Outbox          bool                   `yaml:"outbox"`
+	MaxAttempts     int                    `yaml:"max_attempts"`
 }
 
 // SteeringRC holds steering layer configuration from .ctxrc.
@@ -160,3 +198,191 @@ type HooksRC struct {
 	Timeout int    `yaml:"timeout"`
 	Enabled *bool  `yaml:"enabled"`
 }
+
+// ScoringRC holds agent packet scoring overrides from .ctxrc.
+//
+// Every field is optional; unset fields fall back to the
+// compile-time defaults in config/agent.
+//
+// Fields:
+//   - RecencyHalfLifeDays: Half-life in days for continuous
+//     recency decay. 0 (default) keeps the week/month/quarter
+//     buckets
+//   - RelevanceMatchCap: Keyword matches that yield maximum
+//     relevance (default 3)
+//   - Tiers: Budget percentages per packet tier
+//   - Weights: Score multiplier per entry type
+//     (decision, learning; default 1.0)
+//   - Rules: Boost/penalty rules keyed on tags or sections
+type ScoringRC struct {
+	RecencyHalfLifeDays float64            `yaml:"recency_half_life_days"`
+	RelevanceMatchCap   int                `yaml:"relevance_match_cap"`
+	Tiers               *ScoringTiers      `yaml:"tiers"`
+	Weights             map[string]float64 `yaml:"weights"`
+	Rules               []ScoringRule      `yaml:"rules"`
+}
+
+// ScoringTiers holds packet tier percentages. Zero means
+// "use the default".
+//
+// Fields:
+//   - TasksPct: Share of the budget for active tasks
+//     (default 40)
+//   - ConventionsPct: Share of the budget for conventions
+//     (default 20)
+//   - SplitMinPct: Minimum share each of decisions and
+//     learnings receives when both compete (default 30)
+//   - FullEntryPct: Share of a section budget spent on
+//     full entries before falling back to title-only
+//     summaries (default 80)
+type ScoringTiers struct {
+	TasksPct       int `yaml:"tasks_pct"`
+	ConventionsPct int `yaml:"conventions_pct"`
+	SplitMinPct    int `yaml:"split_min_pct"`
+	FullEntryPct   int `yaml:"full_entry_pct"`
+}
+
+// ScoringRule adjusts the score of matching entries.
+//
+// A rule matches when every non-empty key matches: Tag
+// against a #tag in the entry text, Section against the
+// entry heading, and Type against the entry type.
+//
+// Fields:
+//   - Tag: Tag name without the leading # (case-insensitive)
+//   - Section: Substring of the entry heading
+//     (case-insensitive)
+//   - Type: Optional entry type restriction
+//     (decision, learning)
+//   - Boost: Added to the score; negative values penalize
+type ScoringRule struct {
+	Tag     string  `yaml:"tag"`
+	Section string  `yaml:"section"`
+	Type    string  `yaml:"type"`
+	Boost   float64 `yaml:"boost"`
+}
+
+// InheritRC names one inherited context layer.
+//
+// Without Ref, Path is a directory on disk, relative to the
+// project root unless absolute; it may name a project root
+// (its .context/ is used) or a .context/ directory itself.
+// With Ref, Path is the .context/ path inside the repository
+// at that git ref.
+//
+// Fields:
+//   - Path: Layer location
+//   - Ref: Optional git ref (e.g. origin/main)
+//   - Label: Optional provenance label (default: the path,
+//     or ref:path for git layers)
+type InheritRC struct {
+	Path  string `yaml:"path"`
+	Ref   string `yaml:"ref"`
+	Label string `yaml:"label"`
+}
+
+// DriftRC holds drift detection overrides from .ctxrc.
+//
+// Fields:
+//   - Checks: Per-check overrides keyed by check name (a
+//     built-in name such as path_references, or an external
+//     check's file name without extension)
+//   - Timeout: Per-run timeout for external checks in seconds
+//     (default 10)
+//   - External: Run the executables in .context/checks/ from an
+//     explicit ctx drift (default false; they are repo content)
+type DriftRC struct {
+	Checks   map[string]DriftCheckRC `yaml:"checks"`
+	Timeout  int                     `yaml:"timeout"`
+	External bool                    `yaml:"external"`
+}
+
+// DriftCheckRC overrides one drift check.
+//
+// Fields:
+//   - Enabled: Whether the check runs (default true). Pointer
+//     type distinguishes unset (nil → true) from false
+//   - Severity: "warning" or "violation" to report every issue
+//     from the check at that level; empty keeps the check's own
+//     levels
+type DriftCheckRC struct {
+	Enabled  *bool  `yaml:"enabled"`
+	Severity string `yaml:"severity"`
+}
+
+// SecretsRC configures the content secret scanner.
+//
+// Fields:
+//   - Rules: Project-specific patterns scanned in addition to
+//     the built-in rules
+//   - Entropy: Minimum bits per character for bare high-entropy
+//     tokens (default 4.5)
+//   - Allowlist: Allowlist file path relative to the project
+//     root (default .context/secrets.allow)
+type SecretsRC struct {
+	Rules     []cfgSecret.Rule `yaml:"rules"`
+	Entropy   float64          `yaml:"entropy"`
+	Allowlist string           `yaml:"allowlist"`
+}
+
+// RedactionRC configures the journal redaction pipeline.
+//
+// Fields:
+//   - Kinds: Built-in kinds to apply (default all: secret,
+//     email, home, host)
+//   - Hosts: Internal domain suffixes whose hostnames are
+//     redacted (default internal, corp, lan, intranet,
+//     localdomain)
+//   - Patterns: Project patterns redacted after the built-in
+//     kinds
+type RedactionRC struct {
+	Kinds    []string         `yaml:"kinds"`
+	Hosts    []string         `yaml:"hosts"`
+	Patterns []cfgSecret.Rule `yaml:"patterns"`
+}
+
+// PriceRC is one entry of the model price table, in USD per
+// million tokens.
+//
+// Fields:
+//   - Input: Price of input (prompt) tokens
+//   - Output: Price of output (completion) tokens
+type PriceRC struct {
+	Input  float64 `yaml:"input"`
+	Output float64 `yaml:"output"`
+}
+
+// TraceRC configures commit context tracing.
+//
+// Fields:
+//   - Notes: Record commit context links as git notes under
+//     refs/notes/ctx instead of .context/trace/*.jsonl, so they
+//     travel with the repository (default false)
+//   - Resolvers: Issue trackers whose keys (#1234, PAY-567) are
+//     recognized in commit messages and branch names
+type TraceRC struct {
+	Notes     bool              `yaml:"notes"`
+	Resolvers []TraceResolverRC `yaml:"resolvers"`
+}
+
+// TraceResolverRC maps an issue-key pattern to an issue tracker.
+//
+// Fields:
+//   - Name: Ref type of matched keys (refs read "<name>:<id>")
+//   - Pattern: Regular expression for the key; its first capture
+//     group, when present, is the ID
+//   - URL: Link template; {id} is replaced with the ID
+//   - API: Optional JSON endpoint template ctx trace sync reads
+//     titles from
+//   - TitleField: Dotted path of the title in the API response
+//     (default "title")
+//   - TokenEnv: Environment variable holding a bearer token for
+//     the API
+type TraceResolverRC struct {
+	Name       string `yaml:"name"`
+	Pattern    string `yaml:"pattern"`
+	URL        string `yaml:"url"`
+	API        string `yaml:"api"`
+	TitleField string `yaml:"title_field"`
+	TokenEnv   string `yaml:"token_env"`
+}
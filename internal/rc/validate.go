@@ -17,7 +17,14 @@ import (
 // Validate performs strict YAML decoding of .ctxrc content.
 //
 // Unknown fields are returned as warnings (not errors) so callers can
-// distinguish typos from genuinely broken YAML.
+// distinguish typos from genuinely broken YAML. Semantic problems in
+// the scoring block (out-of-range percentages, unknown entry types,
+// rules without a key), the drift block (unknown severities,
+// negative timeout), the secrets block (unnamed rules, bad
+// patterns, negative entropy), notification channels (unnamed,
+// duplicate or of an unknown type) and trace resolvers (unnamed,
+// duplicate, shadowing a built-in ref type, bad pattern) are
+// appended as warnings too.
 //
 // Parameters:
 //   - data: Raw YAML content from a .ctxrc file
@@ -37,13 +44,29 @@ func Validate(data []byte) (warnings []string, err error) {
 		}
 
 		// yaml.v3 returns *yaml.TypeError for unknown fields.
+		// Decoding continues past them, so cfg is still usable.
 		if te, ok := errors.AsType[*yaml.TypeError](decErr); ok {
-			return te.Errors, nil
+			warnings = append(te.Errors, checkScoring(cfg.Scoring)...)
+			warnings = append(warnings, checkDrift(cfg.Drift)...)
+			warnings = append(warnings, checkSecrets(cfg.Secrets)...)
+			warnings = append(
+				warnings, checkRedaction(cfg.Redact, cfg.Redaction)...,
+			)
+			warnings = append(warnings, checkNotify(cfg.Notify)...)
+			warnings = append(warnings, checkTrace(cfg.Trace)...)
+			return append(warnings, checkPrices(cfg.Prices)...), nil
 		}
 
 		// Genuinely broken YAML.
 		return nil, decErr
 	}
 
-	return nil, nil
+	warnings = append(checkScoring(cfg.Scoring), checkDrift(cfg.Drift)...)
+	warnings = append(warnings, checkSecrets(cfg.Secrets)...)
+	warnings = append(
+		warnings, checkRedaction(cfg.Redact, cfg.Redaction)...,
+	)
+	warnings = append(warnings, checkNotify(cfg.Notify)...)
+	warnings = append(warnings, checkTrace(cfg.Trace)...)
+	return append(warnings, checkPrices(cfg.Prices)...), nil
 }
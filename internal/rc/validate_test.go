@@ -110,3 +110,214 @@ notify:
 		t.Errorf("expected no warnings for full valid config, got %v", warnings)
 	}
 }
+
+func TestValidate_ScoringValid(t *testing.T) {
+	data := []byte(`scoring:
+  recency_half_life_days: 365
+  relevance_match_cap: 4
+  tiers:
+    tasks_pct: 30
+    conventions_pct: 25
+    split_min_pct: 20
+    full_entry_pct: 70
+  weights:
+    decision: 1.5
+    learning: 0.5
+  rules:
+    - tag: infra
+      boost: 0.5
+    - section: spike
+      type: learning
+      boost: -1
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+}
+
+func TestValidate_ScoringSemanticWarnings(t *testing.T) {
+	data := []byte(`scoring:
+  recency_half_life_days: -1
+  tiers:
+    tasks_pct: 120
+    conventions_pct: 10
+  weights:
+    task: 2
+    learning: -1
+  rules:
+    - boost: 1
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{
+		"recency_half_life_days", "tasks_pct", "exceeds",
+		`"task"`, "rules[0]", "weights.learning",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings missing %q: %v", want, warnings)
+		}
+	}
+}
+
+func TestValidate_ScoringWarningsWithUnknownField(t *testing.T) {
+	data := []byte("scoring:\n  tiers:\n    tasks_pct: 200\n  wieghts: {}\n")
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	joined := strings.Join(warnings, "\n")
+	if !strings.Contains(joined, "wieghts") ||
+		!strings.Contains(joined, "tasks_pct: 200") {
+		t.Errorf("expected unknown-field and range warnings, got %v", warnings)
+	}
+}
+
+func TestValidate_DriftSemanticWarnings(t *testing.T) {
+	data := []byte(`drift:
+  timeout: -5
+  checks:
+    staleness_check:
+      severity: error
+    file_age_check:
+      enabled: false
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", warnings)
+	}
+	joined := strings.Join(warnings, "\n")
+	if !strings.Contains(joined, "staleness_check") ||
+		!strings.Contains(joined, "timeout") {
+		t.Errorf("expected severity and timeout warnings, got %v", warnings)
+	}
+}
+
+func TestValidate_SecretSemanticWarnings(t *testing.T) {
+	data := []byte(`secrets:
+  entropy: -1
+  rules:
+    - name: ok
+      pattern: 'tok_[a-z]+'
+    - pattern: '('
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %v", warnings)
+	}
+	joined := strings.Join(warnings, "\n")
+	if !strings.Contains(joined, "secrets.rules[1]") ||
+		!strings.Contains(joined, "secrets.entropy") {
+		t.Errorf("expected rule and entropy warnings, got %v", warnings)
+	}
+}
+
+func TestValidate_RedactSemanticWarnings(t *testing.T) {
+	data := []byte(`redact: always
+redaction:
+  kinds: [email, phone]
+  patterns:
+    - name: ticket
+      pattern: 'ACME-[0-9]+'
+    - name: broken
+      pattern: '['
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %v", warnings)
+	}
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{
+		`"always"`, `"phone"`, "redaction.patterns[1]",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected %s warning, got %v", want, warnings)
+		}
+	}
+}
+
+func TestValidate_NegativePrice(t *testing.T) {
+	data := []byte(`prices:
+  claude-sonnet-4: { input: 3, output: 15 }
+  gpt-4o: { input: -1, output: 10 }
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "prices.gpt-4o") {
+		t.Fatalf("expected one gpt-4o warning, got %v", warnings)
+	}
+}
+
+func TestValidate_NotifyChannels(t *testing.T) {
+	data := []byte(`notify:
+  channels:
+    - name: team
+      type: slack
+      events: [loop]
+    - name: team
+      type: pager
+    - type: ntfy
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %v", warnings)
+	}
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{
+		`duplicate channel name "team"`, `"pager"`, "channels[2]",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings missing %q: %v", want, warnings)
+		}
+	}
+}
+
+func TestValidate_TraceResolvers(t *testing.T) {
+	data := []byte(`trace:
+  resolvers:
+    - name: jira
+      pattern: '[A-Z]+-\d+'
+      url: https://acme.atlassian.net/browse/{id}
+    - name: jira
+      pattern: '#(\d+'
+    - name: task
+      pattern: 'T-\d+'
+    - pattern: x
+`)
+	warnings, err := Validate(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 4 {
+		t.Fatalf("expected 4 warnings, got %v", warnings)
+	}
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{
+		`duplicate resolver name "jira"`, `resolvers[1]: invalid pattern`,
+		`"task" is a built-in ref type`, "resolvers[3]: name is required",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings missing %q: %v", want, warnings)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package secret scans text for credentials that should never
+// land in context files, journal entries, the scratchpad, or
+// the hub.
+//
+// # Rules
+//
+// Built-in rules cover AWS keys, GitHub and Slack tokens, JWTs,
+// PEM private key blocks, sk- provider keys, values assigned to
+// secret-looking keys, and bare high-entropy tokens. Projects
+// add patterns under secrets.rules in .ctxrc. The generic rules
+// only fire when the candidate passes an entropy test, which
+// keeps commit hashes, paths, and prose out of the results.
+//
+// # Allowlist
+//
+// Known false positives go in .context/secrets.allow (or the
+// file named by secrets.allowlist), one regular expression per
+// line. Findings whose value matches an entry are dropped.
+//
+// # Usage
+//
+//	findings := secret.Scan(content)
+//	clean, findings := secret.Redact(content)
+//
+// [Scan] reports findings with masked previews; [Redact]
+// replaces each secret with a [REDACTED:<rule>] placeholder.
+// ctx drift, the journal importer, ctx add, and hub publish all
+// go through this package.
+package secret
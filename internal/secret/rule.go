@@ -0,0 +1,249 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgSecret "github.com/ActiveMemory/ctx/internal/config/secret"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	ctxIo "github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// rules returns the active rules in precedence order: built-in
+// provider rules, project rules from .ctxrc (invalid patterns
+// are skipped; ctx config validate reports them), then the
+// generic entropy-gated rules.
+//
+// Returns:
+//   - []rule: Compiled rules
+func rules() []rule {
+	active := []rule{
+		{name: cfgSecret.RulePrivateKey, pattern: regex.SecretPrivateKey},
+		{name: cfgSecret.RuleAWSAccessKey, pattern: regex.SecretAWSAccessKey},
+		{
+			name: cfgSecret.RuleAWSSecretKey, pattern: regex.SecretAWSSecretKey,
+			group: 1,
+		},
+		{name: cfgSecret.RuleGitHubToken, pattern: regex.SecretGitHubToken},
+		{name: cfgSecret.RuleJWT, pattern: regex.SecretJWT},
+		{name: cfgSecret.RuleSlackToken, pattern: regex.SecretSlackToken},
+		{name: cfgSecret.RuleAPIKey, pattern: regex.SecretAPIKey},
+	}
+	for _, custom := range rc.SecretRules() {
+		re, compileErr := regex.Compile(custom.Pattern)
+		if compileErr != nil || custom.Pattern == "" {
+			continue
+		}
+		active = append(active, rule{
+			name: custom.Name, pattern: re, group: min(re.NumSubexp(), 1),
+		})
+	}
+	return append(active,
+		rule{
+			name: cfgSecret.RuleAssignment, pattern: regex.SecretAssignment,
+			group: 1, entropy: cfgSecret.AssignEntropy,
+		},
+		rule{
+			name: cfgSecret.RuleHighEntropy, pattern: regex.SecretHighEntropy,
+			entropy: rc.SecretEntropy(), mixed: true,
+		},
+	)
+}
+
+// accepts reports whether a matched value passes the rule's
+// randomness tests and is not an obvious placeholder.
+//
+// Parameters:
+//   - value: Matched secret
+//
+// Returns:
+//   - bool: True when the value should be reported
+func (r rule) accepts(value string) bool {
+	if r.entropy == 0 {
+		return true
+	}
+	lower := strings.ToLower(value)
+	for _, p := range cfgSecret.Placeholders {
+		if strings.Contains(lower, p) {
+			return false
+		}
+	}
+	if r.mixed && !mixedClasses(value) {
+		return false
+	}
+	return entropy(value) >= r.entropy
+}
+
+// span returns the byte range of the secret within a match.
+//
+// Parameters:
+//   - loc: Submatch index pairs from FindAllStringSubmatchIndex
+//   - group: Capture group holding the secret; 0 for the match
+//
+// Returns:
+//   - int: Start offset, -1 when the group did not participate
+//   - int: End offset
+func span(loc []int, group int) (int, int) {
+	return loc[2*group], loc[2*group+1]
+}
+
+// inURL reports whether an offset falls inside a URL, where
+// long random-looking identifiers (review IDs, node IDs) are
+// addresses rather than credentials.
+//
+// Parameters:
+//   - content: Scanned text
+//   - start: Offset of the candidate
+//
+// Returns:
+//   - bool: True when the whitespace-delimited word holding the
+//     candidate contains a URL scheme separator
+func inURL(content string, start int) bool {
+	wordStart := strings.LastIndexAny(content[:start], token.Whitespace+
+		token.NewlineLF) + 1
+	return strings.Contains(content[wordStart:start], token.SchemeSep)
+}
+
+// overlaps reports whether a range intersects an existing
+// finding.
+//
+// Parameters:
+//   - findings: Findings so far
+//   - start: Candidate start offset
+//   - end: Candidate end offset
+//
+// Returns:
+//   - bool: True when the candidate overlaps a finding
+func overlaps(findings []entity.SecretFinding, start, end int) bool {
+	for _, f := range findings {
+		if start < f.End && f.Start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// entropy returns the Shannon entropy of s in bits per byte.
+//
+// Parameters:
+//   - s: Value to measure
+//
+// Returns:
+//   - float64: Entropy; 0 for an empty string
+func entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := map[byte]int{}
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	n := float64(len(s))
+	var bits float64
+	for _, c := range counts {
+		p := float64(c) / n
+		bits -= p * math.Log2(p)
+	}
+	return bits
+}
+
+// mixedClasses reports whether s has upper case, lower case,
+// and digit characters.
+//
+// Parameters:
+//   - s: Value to inspect
+//
+// Returns:
+//   - bool: True when all three classes appear
+func mixedClasses(s string) bool {
+	var upper, lower, digit bool
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	return upper && lower && digit
+}
+
+// mask hides the middle of a secret for display.
+//
+// Parameters:
+//   - value: Secret value
+//
+// Returns:
+//   - string: First and last few characters around an ellipsis,
+//     or just the ellipsis for short values
+func mask(value string) string {
+	value = strings.TrimSpace(value)
+	if first, _, found := strings.Cut(value, token.NewlineLF); found {
+		value = first
+	}
+	if len(value) <= 2*cfgSecret.MaskKeep {
+		return cfgSecret.MaskFill
+	}
+	return value[:cfgSecret.MaskKeep] + cfgSecret.MaskFill +
+		value[len(value)-cfgSecret.MaskKeep:]
+}
+
+// allowlist loads the allowlist patterns. Each non-empty,
+// non-comment line is a regular expression; a line that does
+// not compile matches literally.
+//
+// Returns:
+//   - []*regexp.Regexp: Patterns, nil when the file is missing
+func allowlist() []*regexp.Regexp {
+	path := rc.SecretAllowlist()
+	if path == "" {
+		return nil
+	}
+	data, readErr := ctxIo.SafeReadUserFile(path)
+	if readErr != nil {
+		return nil
+	}
+	var patterns []*regexp.Regexp
+	for _, line := range strings.Split(string(data), token.NewlineLF) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, cfgSecret.AllowComment) {
+			continue
+		}
+		re, compileErr := regex.Compile(line)
+		if compileErr != nil {
+			re, _ = regex.Compile(regexp.QuoteMeta(line))
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns
+}
+
+// allowed reports whether a value matches an allowlist entry.
+//
+// Parameters:
+//   - value: Secret value
+//   - allow: Allowlist patterns
+//
+// Returns:
+//   - bool: True when the value is allowlisted
+func allowed(value string, allow []*regexp.Regexp) bool {
+	for _, re := range allow {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
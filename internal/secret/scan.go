@@ -0,0 +1,88 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	cfgSecret "github.com/ActiveMemory/ctx/internal/config/secret"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Scan finds possible secrets in content.
+//
+// Every rule runs over the whole text, so multi-line private key
+// blocks are caught. When matches overlap, the more specific rule
+// (built-in provider rules, then project rules, then the generic
+// ones) wins. Allowlisted values are dropped.
+//
+// Parameters:
+//   - content: Text to scan
+//
+// Returns:
+//   - []entity.SecretFinding: Findings in offset order, nil when
+//     the content is clean
+func Scan(content string) []entity.SecretFinding {
+	allow := allowlist()
+	var findings []entity.SecretFinding
+	for _, r := range rules() {
+		for _, loc := range r.pattern.FindAllStringSubmatchIndex(
+			content, -1,
+		) {
+			start, end := span(loc, r.group)
+			if start < 0 {
+				continue
+			}
+			value := content[start:end]
+			if !r.accepts(value) || allowed(value, allow) ||
+				overlaps(findings, start, end) ||
+				r.mixed && inURL(content, start) {
+				continue
+			}
+			findings = append(findings, entity.SecretFinding{
+				Rule:    r.name,
+				Line:    strings.Count(content[:start], token.NewlineLF) + 1,
+				Start:   start,
+				End:     end,
+				Preview: mask(value),
+			})
+		}
+	}
+	slices.SortFunc(findings, func(a, b entity.SecretFinding) int {
+		return a.Start - b.Start
+	})
+	return findings
+}
+
+// Redact replaces every possible secret in content with a
+// placeholder naming its rule.
+//
+// Parameters:
+//   - content: Text to clean
+//
+// Returns:
+//   - string: Content with secrets replaced by [REDACTED:<rule>]
+//   - []entity.SecretFinding: What was replaced, with offsets into
+//     the original content
+func Redact(content string) (string, []entity.SecretFinding) {
+	findings := Scan(content)
+	if len(findings) == 0 {
+		return content, nil
+	}
+	var b strings.Builder
+	last := 0
+	for _, f := range findings {
+		b.WriteString(content[last:f.Start])
+		b.WriteString(fmt.Sprintf(cfgSecret.RedactFormat, f.Rule))
+		last = f.End
+	}
+	b.WriteString(content[last:])
+	return b.String(), findings
+}
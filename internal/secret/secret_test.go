@@ -0,0 +1,174 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// Test values are assembled at run time so the source file itself
+// does not trip secret scanners.
+var (
+	awsKey  = "AKIA" + "Q3EGRTYUIOPASDFG"
+	ghToken = "ghp_" + "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY3zA5"
+	jwt     = "eyJ" + "hbGciOiJIUzI1NiJ9.eyJ" + "zdWIiOiIxMjM0NTY3ODkwIn0." +
+		"dBjftJeZ4CVPmB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	pemBlock = "-----BEGIN RSA " + "PRIVATE KEY-----\nMIIEpAIBAAKCAQEA\n" +
+		"-----END RSA " + "PRIVATE KEY-----"
+	random = "Zx8Qp2Lm7Rt4Vy9Kb3Nc6Hd1Jf5Gs0Wq"
+)
+
+// declare points CTX_DIR at a temp project with the given .ctxrc
+// and allowlist contents.
+func declare(t *testing.T, rcContent, allow string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	ctxDir := filepath.Join(tmpDir, ".context")
+	if mkErr := os.MkdirAll(ctxDir, 0o750); mkErr != nil {
+		t.Fatal(mkErr)
+	}
+	if rcContent != "" {
+		if wErr := os.WriteFile(
+			filepath.Join(tmpDir, ".ctxrc"), []byte(rcContent), 0o600,
+		); wErr != nil {
+			t.Fatal(wErr)
+		}
+	}
+	if allow != "" {
+		if wErr := os.WriteFile(
+			filepath.Join(ctxDir, "secrets.allow"), []byte(allow), 0o600,
+		); wErr != nil {
+			t.Fatal(wErr)
+		}
+	}
+	testctx.Declare(t, tmpDir)
+}
+
+func rulesOf(findings []entity.SecretFinding) []string {
+	names := make([]string, 0, len(findings))
+	for _, f := range findings {
+		names = append(names, f.Rule)
+	}
+	return names
+}
+
+func TestScan_BuiltinRules(t *testing.T) {
+	declare(t, "", "")
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"aws access key", "key id " + awsKey + " here", "aws_access_key"},
+		{
+			"aws secret key",
+			"aws_secret_access_key = " +
+				strings.Repeat("wJalrXUtnFEMI/K7MDENG+", 2)[:40],
+			"aws_secret_key",
+		},
+		{"github token", "token: " + ghToken, "github_token"},
+		{"jwt", "Authorization: Bearer " + jwt, "jwt"},
+		{"private key", "cert:\n" + pemBlock + "\n", "private_key"},
+		{"assignment", "password = Tr0ub4dor&3horse!", "secret_assignment"},
+		{"high entropy", "blob " + random + " end", "high_entropy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Scan(tt.content)
+			if len(got) != 1 || got[0].Rule != tt.want {
+				t.Fatalf("Scan() rules = %v, want [%s]", rulesOf(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestScan_Clean(t *testing.T) {
+	declare(t, "", "")
+	clean := []string{
+		"Use internal/drift/check_secret.go for the scanner.",
+		"password = changeme-please-now",
+		"api_key: your-api-key-goes-here",
+		"see https://github.com/org/repo/pull/1#pullrequestreview-" +
+			random,
+		strings.Repeat("a", 40),
+		"commit 3f2c1a9b8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b",
+	}
+	for _, content := range clean {
+		if got := Scan(content); len(got) != 0 {
+			t.Errorf("Scan(%q) = %v, want none", content, rulesOf(got))
+		}
+	}
+}
+
+func TestScan_LineAndPreview(t *testing.T) {
+	declare(t, "", "")
+	got := Scan("first\nsecond " + ghToken + "\n")
+	if len(got) != 1 {
+		t.Fatalf("Scan() = %v, want 1 finding", rulesOf(got))
+	}
+	if got[0].Line != 2 {
+		t.Errorf("Line = %d, want 2", got[0].Line)
+	}
+	if strings.Contains(got[0].Preview, ghToken) ||
+		!strings.HasPrefix(got[0].Preview, "ghp_") {
+		t.Errorf("Preview = %q, want masked token", got[0].Preview)
+	}
+}
+
+func TestScan_CustomRule(t *testing.T) {
+	declare(t, `secrets:
+  rules:
+    - name: internal_token
+      pattern: 'itk=(itk_[a-z0-9]{16})'
+`, "")
+	content := "itk=itk_0123456789abcdef"
+	got := Scan(content)
+	if len(got) != 1 || got[0].Rule != "internal_token" {
+		t.Fatalf("Scan() rules = %v, want [internal_token]", rulesOf(got))
+	}
+	if content[got[0].Start:got[0].End] != "itk_0123456789abcdef" {
+		t.Errorf("finding span = %q, want capture group",
+			content[got[0].Start:got[0].End])
+	}
+}
+
+func TestScan_EntropyThreshold(t *testing.T) {
+	declare(t, "secrets:\n  entropy: 6\n", "")
+	if got := Scan("blob " + random); len(got) != 0 {
+		t.Errorf("Scan() = %v, want none above threshold", rulesOf(got))
+	}
+}
+
+func TestScan_Allowlist(t *testing.T) {
+	declare(t, "", "# fixtures\n"+awsKey+"\n^ghp_aB3d\n")
+	got := Scan(awsKey + " " + ghToken + " " + jwt)
+	if len(got) != 1 || got[0].Rule != "jwt" {
+		t.Errorf("Scan() rules = %v, want [jwt]", rulesOf(got))
+	}
+}
+
+func TestRedact(t *testing.T) {
+	declare(t, "", "")
+	content := "aws " + awsKey + "\n" + pemBlock + "\nend"
+	clean, findings := Redact(content)
+	if len(findings) != 2 {
+		t.Fatalf("Redact() findings = %v, want 2", rulesOf(findings))
+	}
+	want := "aws [REDACTED:aws_access_key]\n[REDACTED:private_key]\nend"
+	if clean != want {
+		t.Errorf("Redact() = %q, want %q", clean, want)
+	}
+	if again, more := Redact(clean); again != clean || more != nil {
+		t.Errorf("Redact() not idempotent: %q %v", again, rulesOf(more))
+	}
+}
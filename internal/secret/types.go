@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import "regexp"
+
+// rule is one compiled secret pattern.
+//
+// Fields:
+//   - name: Rule name for findings and placeholders
+//   - pattern: Compiled expression
+//   - group: Capture group holding the secret; 0 for the whole
+//     match
+//   - entropy: Minimum bits per character of the secret; 0
+//     disables the test
+//   - mixed: Require upper case, lower case, and digits, and
+//     skip candidates inside URLs
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+	group   int
+	entropy float64
+	mixed   bool
+}
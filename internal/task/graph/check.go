@@ -0,0 +1,122 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"slices"
+
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	errTask "github.com/ActiveMemory/ctx/internal/err/task"
+)
+
+// Cycles finds the dependency cycles of a graph. A task that
+// names itself is a cycle of one.
+//
+// Parameters:
+//   - g: task graph
+//
+// Returns:
+//   - [][]int: one slice of node indexes per cycle, each
+//     starting and ending with the same node, dependencies first
+func Cycles(g entity.TaskGraph) [][]int {
+	seen := make(map[int]bool)
+	var cycles [][]int
+	for i := range g.Nodes {
+		if !seen[i] {
+			cycles = visit(g, i, seen, map[int]bool{}, nil, cycles)
+		}
+	}
+	return cycles
+}
+
+// CriticalPath finds the longest chain of pending tasks, each
+// waiting for the one before it. Completed tasks do not count.
+//
+// Parameters:
+//   - g: task graph
+//
+// Returns:
+//   - []int: node indexes, the task to start first first; nil
+//     when no pending task is linked
+func CriticalPath(g entity.TaskGraph) []int {
+	memo := make(map[int][]int)
+	var longest []int
+	for i, n := range g.Nodes {
+		if n.Done || !Linked(n) {
+			continue
+		}
+		if c := chain(g, i, memo, map[int]bool{}); len(c) > len(longest) {
+			longest = c
+		}
+	}
+	return longest
+}
+
+// Check reports every problem in the dependency metadata:
+// duplicate IDs, references to unknown IDs and cycles.
+//
+// Parameters:
+//   - g: task graph
+//
+// Returns:
+//   - []error: one error per problem, nil when consistent
+func Check(g entity.TaskGraph) []error {
+	var problems []error
+	seen := make(map[string]bool)
+	for _, n := range g.Nodes {
+		if n.ID != "" && seen[n.ID] {
+			problems = append(problems, errTask.DuplicateID(n.ID))
+		}
+		seen[n.ID] = true
+	}
+	for _, n := range g.Nodes {
+		for _, ref := range slices.Concat(n.After, n.Blocks) {
+			if _, ok := g.IDs[ref]; !ok {
+				problems = append(problems, errTask.UnknownDep(Name(n), ref))
+			}
+		}
+	}
+	for _, c := range Cycles(g) {
+		problems = append(problems, errTask.Cycle(Path(g, c)))
+	}
+	return problems
+}
+
+// CheckAdded validates the dependency metadata of a task line
+// about to be added to TASKS.md: its ID must be new, the IDs it
+// names must exist, and it must not close a cycle.
+//
+// Parameters:
+//   - existing: current TASKS.md content
+//   - line: formatted task line
+//
+// Returns:
+//   - error: the first problem found, nil when the line is valid
+func CheckAdded(existing, line string) error {
+	before := Parse(existing)
+	after := Parse(existing + token.NewlineLF + line)
+	last := len(after.Nodes) - 1
+	if last < len(before.Nodes) {
+		return nil
+	}
+	n := after.Nodes[last]
+	if _, dup := before.IDs[n.ID]; n.ID != "" && dup {
+		return errTask.DuplicateID(n.ID)
+	}
+	for _, ref := range slices.Concat(n.After, n.Blocks) {
+		if _, ok := before.IDs[ref]; !ok && ref != n.ID {
+			return errTask.UnknownDep(Name(n), ref)
+		}
+	}
+	for _, c := range Cycles(after) {
+		if slices.Contains(c, last) {
+			return errTask.Cycle(Path(after, c))
+		}
+	}
+	return nil
+}
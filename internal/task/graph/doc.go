@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package graph builds the dependency graph between
+// TASKS.md tasks from their inline metadata tags.
+//
+// # Metadata
+//
+//   - [ ] Design schema #id:db-schema
+//   - [ ] Build auth API #id:auth-api #after:db-schema
+//   - [ ] Login screen #after:auth-api #blocks:release
+//   - [ ] Ship 1.0 #id:release
+//
+// #id: names a task. #after: lists the IDs a task waits
+// for; #blocks: lists the IDs that wait for it. Both
+// take one ID or a comma-separated list and may be
+// repeated. A task is blocked while any task it waits
+// for is unchecked.
+//
+// # Public Surface
+//
+//   - [Parse]: one node per task line, with
+//     references resolved into Deps.
+//   - [Blocked], [BlockedLines]: which pending tasks
+//     still wait for pending work; used by MCP
+//     ctx_next and the ctx agent task tier.
+//   - [Cycles], [CriticalPath]: graph analysis for
+//     ctx task graph.
+//   - [Check], [CheckAdded]: duplicate IDs, unknown
+//     references and cycles, for the whole file or for
+//     a task about to be added.
+//   - [Linked], [Name], [Path]: display helpers.
+//
+// References to unknown IDs never block a task, so a
+// typo or an archived dependency cannot hide work;
+// [Check] reports them instead.
+//
+// # Concurrency
+//
+// All functions are pure. Concurrent callers never
+// race.
+package graph
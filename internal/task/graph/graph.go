@@ -0,0 +1,124 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Parse builds the dependency graph of every task in TASKS.md.
+//
+// Each task line becomes a node; #after: and #blocks: tags that
+// name an existing #id: become edges. References to unknown IDs
+// stay in the node's After and Blocks fields so [Check] can
+// report them, but they never block a task.
+//
+// Parameters:
+//   - content: TASKS.md content
+//
+// Returns:
+//   - entity.TaskGraph: nodes in file order with resolved Deps
+func Parse(content string) entity.TaskGraph {
+	g := entity.TaskGraph{IDs: make(map[string]int)}
+	for i, line := range strings.Split(content, token.NewlineLF) {
+		match := regex.Task.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		n := node(match, i)
+		if _, dup := g.IDs[n.ID]; n.ID != "" && !dup {
+			g.IDs[n.ID] = len(g.Nodes)
+		}
+		g.Nodes = append(g.Nodes, n)
+	}
+	link(&g)
+	return g
+}
+
+// Blocked reports whether a task waits for a task that is still
+// pending.
+//
+// Parameters:
+//   - g: task graph
+//   - i: node index
+//
+// Returns:
+//   - bool: true when any dependency is unchecked
+func Blocked(g entity.TaskGraph, i int) bool {
+	for _, dep := range g.Nodes[i].Deps {
+		if !g.Nodes[dep].Done {
+			return true
+		}
+	}
+	return false
+}
+
+// BlockedLines finds the pending tasks that wait for another
+// pending task.
+//
+// Parameters:
+//   - content: TASKS.md content
+//
+// Returns:
+//   - map[int]bool: zero-based line indexes of blocked tasks
+func BlockedLines(content string) map[int]bool {
+	g := Parse(content)
+	blocked := make(map[int]bool)
+	for i, n := range g.Nodes {
+		if !n.Done && Blocked(g, i) {
+			blocked[n.Line] = true
+		}
+	}
+	return blocked
+}
+
+// Linked reports whether a task takes part in the dependency
+// graph: it has an ID or names other tasks.
+//
+// Parameters:
+//   - n: task node
+//
+// Returns:
+//   - bool: true when the task carries dependency metadata
+func Linked(n entity.TaskNode) bool {
+	return n.ID != "" || len(n.After) > 0 || len(n.Blocks) > 0
+}
+
+// Name returns the display name of a task: its ID, or its text
+// when it has none.
+//
+// Parameters:
+//   - n: task node
+//
+// Returns:
+//   - string: ID or label
+func Name(n entity.TaskNode) string {
+	if n.ID != "" {
+		return n.ID
+	}
+	return n.Label
+}
+
+// Path joins the names of a chain of tasks for display.
+//
+// Parameters:
+//   - g: task graph
+//   - chain: node indexes in order
+//
+// Returns:
+//   - string: names joined by arrows
+func Path(g entity.TaskGraph, chain []int) string {
+	names := make([]string, 0, len(chain))
+	for _, i := range chain {
+		names = append(names, Name(g.Nodes[i]))
+	}
+	return strings.Join(names, token.Arrow)
+}
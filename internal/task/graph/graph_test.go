@@ -0,0 +1,133 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+const sample = `# Tasks
+
+## Phase 1
+
+- [x] Design schema #id:db-schema #added:2026-01-10-120000
+- [ ] Build auth API #id:auth-api #after:db-schema #priority:high
+- [ ] Login screen #id:login #after:auth-api #blocks:release
+- [ ] Write docs
+- [ ] Ship 1.0 #id:release
+`
+
+func TestParse(t *testing.T) {
+	g := Parse(sample)
+	if len(g.Nodes) != 5 {
+		t.Fatalf("Parse() nodes = %d, want 5", len(g.Nodes))
+	}
+
+	api := g.Nodes[g.IDs["auth-api"]]
+	if api.Label != "Build auth API" {
+		t.Errorf("auth-api label = %q", api.Label)
+	}
+	if api.Line != 5 {
+		t.Errorf("auth-api line = %d, want 5", api.Line)
+	}
+
+	release := g.Nodes[g.IDs["release"]]
+	if len(release.Deps) != 1 || g.Nodes[release.Deps[0]].ID != "login" {
+		t.Errorf("release deps = %v, want [login] via #blocks", release.Deps)
+	}
+	if Linked(g.Nodes[3]) {
+		t.Errorf("untagged task should not be linked")
+	}
+}
+
+func TestBlocked(t *testing.T) {
+	g := Parse(sample)
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"auth-api", false}, // waits only for a completed task
+		{"login", true},
+		{"release", true},
+	}
+	for _, tc := range tests {
+		if got := Blocked(g, g.IDs[tc.id]); got != tc.want {
+			t.Errorf("Blocked(%s) = %v, want %v", tc.id, got, tc.want)
+		}
+	}
+
+	lines := BlockedLines(sample)
+	if len(lines) != 2 || !lines[6] || !lines[8] {
+		t.Errorf("BlockedLines() = %v, want lines 6 and 8", lines)
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	g := Parse(sample)
+	if got := Path(g, CriticalPath(g)); got != "auth-api → login → release" {
+		t.Errorf("CriticalPath() = %q", got)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if problems := Check(Parse(sample)); len(problems) != 0 {
+		t.Errorf("Check(sample) = %v, want none", problems)
+	}
+
+	bad := `- [ ] A #id:a #after:c
+- [ ] B #id:b #after:a
+- [ ] C #id:c #after:b
+- [ ] Again #id:a
+- [ ] Typo #after:nope
+- [ ] Self #id:self #after:self
+`
+	var got []string
+	for _, p := range Check(Parse(bad)) {
+		got = append(got, p.Error())
+	}
+	joined := strings.Join(got, "\n")
+	for _, want := range []string{
+		`duplicate task id "a"`,
+		`Typo: unknown task id "nope"`,
+		"dependency cycle: b → c → a → b",
+		"dependency cycle: self → self",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("Check() missing %q in:\n%s", want, joined)
+		}
+	}
+	if len(got) != 4 {
+		t.Errorf("Check() = %d problems, want 4:\n%s", len(got), joined)
+	}
+}
+
+func TestCheckAdded(t *testing.T) {
+	existing := "- [ ] A #id:a\n- [ ] B #id:b #after:a\n"
+	tests := []struct {
+		line    string
+		wantErr string
+	}{
+		{"- [ ] C #id:c #after:b\n", ""},
+		{"- [ ] Plain task\n", ""},
+		{"- [ ] Other #id:a\n", "duplicate task id"},
+		{"- [ ] C #after:x\n", "unknown task id"},
+		{"- [ ] C #id:c #after:b #blocks:a\n", "dependency cycle"},
+	}
+	for _, tc := range tests {
+		err := CheckAdded(existing, tc.line)
+		if tc.wantErr == "" {
+			if err != nil {
+				t.Errorf("CheckAdded(%q) = %v, want nil", tc.line, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("CheckAdded(%q) = %v, want %q", tc.line, err, tc.wantErr)
+		}
+	}
+}
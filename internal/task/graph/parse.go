@@ -0,0 +1,78 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/task"
+)
+
+// node builds the graph node for one task line.
+//
+// Parameters:
+//   - match: result of regex.Task.FindStringSubmatch
+//   - line: zero-based line index
+//
+// Returns:
+//   - entity.TaskNode: node with ID, label and raw references
+func node(match []string, line int) entity.TaskNode {
+	text := task.Content(match)
+	n := entity.TaskNode{
+		Label: strings.TrimSpace(regex.TaskTag.ReplaceAllString(text, "")),
+		Line:  line,
+		Done:  task.Completed(match),
+	}
+	for _, m := range regex.TaskDep.FindAllStringSubmatch(text, -1) {
+		refs := strings.Split(m[2], token.Comma)
+		switch m[1] {
+		case cfgTask.TagID:
+			n.ID = refs[0]
+		case cfgTask.TagBlocks:
+			n.Blocks = append(n.Blocks, refs...)
+		case cfgTask.TagAfter:
+			n.After = append(n.After, refs...)
+		}
+	}
+	return n
+}
+
+// link resolves #after: and #blocks: references into Deps.
+//
+// Parameters:
+//   - g: graph whose nodes and IDs are populated
+func link(g *entity.TaskGraph) {
+	for i, n := range g.Nodes {
+		for _, ref := range n.After {
+			if dep, ok := g.IDs[ref]; ok {
+				addDep(g, i, dep)
+			}
+		}
+		for _, ref := range n.Blocks {
+			if waiter, ok := g.IDs[ref]; ok {
+				addDep(g, waiter, i)
+			}
+		}
+	}
+}
+
+// addDep records that node i waits for node dep, once.
+//
+// Parameters:
+//   - g: graph to update
+//   - i: waiting node index
+//   - dep: dependency node index
+func addDep(g *entity.TaskGraph, i, dep int) {
+	if !slices.Contains(g.Nodes[i].Deps, dep) {
+		g.Nodes[i].Deps = append(g.Nodes[i].Deps, dep)
+	}
+}
@@ -0,0 +1,80 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"slices"
+
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// visit walks the dependencies of node i depth first and records
+// every cycle closed by an edge back into the current path.
+//
+// Parameters:
+//   - g: task graph
+//   - i: node to visit
+//   - seen: nodes already visited from any start
+//   - onPath: nodes on the current walk
+//   - path: current walk, outermost waiter first
+//   - cycles: cycles found so far
+//
+// Returns:
+//   - [][]int: cycles with any found below i appended
+func visit(
+	g entity.TaskGraph, i int,
+	seen, onPath map[int]bool, path []int, cycles [][]int,
+) [][]int {
+	seen[i] = true
+	onPath[i] = true
+	path = append(path, i)
+	for _, dep := range g.Nodes[i].Deps {
+		switch {
+		case onPath[dep]:
+			loop := slices.Clone(path[slices.Index(path, dep):])
+			slices.Reverse(loop)
+			cycles = append(cycles, append(loop, loop[0]))
+		case !seen[dep]:
+			cycles = visit(g, dep, seen, onPath, path, cycles)
+		}
+	}
+	delete(onPath, i)
+	return cycles
+}
+
+// chain returns the longest chain of pending tasks ending at
+// node i.
+//
+// Parameters:
+//   - g: task graph
+//   - i: last node of the chain
+//   - memo: chains already computed, by last node
+//   - onPath: nodes on the current walk, to stop at cycles
+//
+// Returns:
+//   - []int: node indexes, first task first, ending with i
+func chain(
+	g entity.TaskGraph, i int, memo map[int][]int, onPath map[int]bool,
+) []int {
+	if c, ok := memo[i]; ok {
+		return c
+	}
+	onPath[i] = true
+	var longest []int
+	for _, dep := range g.Nodes[i].Deps {
+		if g.Nodes[dep].Done || onPath[dep] {
+			continue
+		}
+		if c := chain(g, dep, memo, onPath); len(c) > len(longest) {
+			longest = c
+		}
+	}
+	delete(onPath, i)
+	c := append(slices.Clone(longest), i)
+	memo[i] = c
+	return c
+}
@@ -0,0 +1,37 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"os/exec"
+
+	"github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// Blame runs git blame on a file and returns each line with the
+// commit that last changed it.
+//
+// Parameters:
+//   - path: file to blame
+//   - start: first line of the range; 0 blames the whole file
+//   - end: last line of the range; 0 means start to end of file
+//
+// Returns:
+//   - BlameResult: blamed lines and the commits they point at
+//   - error: non-nil if git fails, carrying git's own message
+//     (untracked file, range past the end of the file)
+func Blame(path string, start, end int) (BlameResult, error) {
+	out, blameErr := git.Blame(path, start, end)
+	if blameErr != nil {
+		if exitErr, ok := errors.AsType[*exec.ExitError](blameErr); ok {
+			return BlameResult{}, gitFailure(exitErr.Stderr, blameErr)
+		}
+		return BlameResult{}, blameErr
+	}
+	return parseBlame(out), nil
+}
@@ -0,0 +1,82 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// parseBlame parses git blame --porcelain output.
+//
+// Each blamed line starts with a "<sha> <orig> <final> [n]"
+// header. The first time a commit appears, key-value lines
+// (author, author-time, summary, ...) follow; the line itself
+// always comes last, prefixed with a tab.
+//
+// Parameters:
+//   - out: raw porcelain output
+//
+// Returns:
+//   - BlameResult: parsed lines and commits
+func parseBlame(out []byte) BlameResult {
+	result := BlameResult{Commits: map[string]BlameCommit{}}
+	var (
+		hash   string
+		line   int
+		header = true
+	)
+	for _, raw := range strings.Split(string(out), token.NewlineLF) {
+		if strings.HasPrefix(raw, cfgGit.BlameContent) {
+			result.Lines = append(result.Lines, BlameLine{
+				Line:    line,
+				Commit:  hash,
+				Content: strings.TrimPrefix(raw, cfgGit.BlameContent),
+			})
+			header = true
+			continue
+		}
+		if header {
+			fields := strings.Fields(raw)
+			if len(fields) < cfgGit.BlameHeaderFields {
+				continue
+			}
+			hash = fields[0]
+			line, _ = strconv.Atoi(fields[2])
+			if _, ok := result.Commits[hash]; !ok {
+				result.Commits[hash] = BlameCommit{
+					Hash:      hash,
+					Committed: strings.Trim(hash, cfgGit.BlameZeroDigit) != "",
+				}
+			}
+			header = false
+			continue
+		}
+		key, value, _ := strings.Cut(raw, token.Space)
+		c := result.Commits[hash]
+		switch key {
+		case cfgGit.BlameAuthor:
+			c.Author = value
+		case cfgGit.BlameAuthorTime:
+			if sec, parseErr := strconv.ParseInt(
+				value, 10, 64,
+			); parseErr == nil {
+				c.Time = time.Unix(sec, 0)
+			}
+		case cfgGit.BlameSummary:
+			c.Summary = value
+		default:
+			continue
+		}
+		result.Commits[hash] = c
+	}
+	return result
+}
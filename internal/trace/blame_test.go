@@ -0,0 +1,56 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import "testing"
+
+const (
+	blameSHA1 = "1111111111111111111111111111111111111111"
+	blameZero = "0000000000000000000000000000000000000000"
+)
+
+func TestParseBlame(t *testing.T) {
+	out := blameSHA1 + " 1 1 2\n" +
+		"author Ada\n" +
+		"author-time 1700000000\n" +
+		"summary Add auth\n" +
+		"filename auth.go\n" +
+		"\tpackage auth\n" +
+		blameSHA1 + " 2 2\n" +
+		"\t\n" +
+		blameZero + " 3 3 1\n" +
+		"author Not Committed Yet\n" +
+		"summary Version of auth.go from auth.go\n" +
+		"filename auth.go\n" +
+		"\t\tx := 1\n"
+
+	r := parseBlame([]byte(out))
+
+	if len(r.Lines) != 3 {
+		t.Fatalf("lines = %d, want 3: %+v", len(r.Lines), r.Lines)
+	}
+	if r.Lines[0].Content != "package auth" || r.Lines[0].Line != 1 {
+		t.Errorf("line 1 = %+v", r.Lines[0])
+	}
+	if r.Lines[1].Commit != blameSHA1 || r.Lines[1].Content != "" {
+		t.Errorf("line 2 = %+v", r.Lines[1])
+	}
+	if r.Lines[2].Content != "\tx := 1" || r.Lines[2].Line != 3 {
+		t.Errorf("line 3 = %+v", r.Lines[2])
+	}
+
+	c := r.Commits[blameSHA1]
+	if !c.Committed || c.Author != "Ada" || c.Summary != "Add auth" {
+		t.Errorf("commit = %+v", c)
+	}
+	if c.Time.Unix() != 1700000000 {
+		t.Errorf("time = %v", c.Time)
+	}
+	if r.Commits[blameZero].Committed {
+		t.Error("zero hash should not be committed")
+	}
+}
@@ -27,6 +27,7 @@
 //   - `task:8`:        TASKS.md item #8
 //   - `session:abc`:   AI session ID `abc`
 //   - `"free note"`:   quoted free-form note
+//   - `jira:PAY-567`:  issue key of a configured resolver
 //
 // [parseRef] turns a string into (type, number, text); [Resolve]
 // looks up the entry and returns a [ResolvedRef] populated with
@@ -52,7 +53,8 @@
 //     committed it" without any tagging.
 //  3. **Working state**: [WorkingRefs] adds in-progress task
 //     refs (from TASKS.md) plus an `session:<id>` ref derived
-//     from `$CTX_SESSION_ID` when an AI session is active.
+//     from `$CTX_SESSION_ID` when an AI session is active, and
+//     issue keys found in the branch name.
 //
 // First-source-wins ordering means a ref a developer explicitly
 // pinned via `ctx trace tag` always shows up before one auto-
@@ -91,6 +93,27 @@
 // [appendJSONL] which creates the parent directory on demand and
 // stamps a UTC timestamp when the caller leaves it zero.
 //
+// # Git Notes
+//
+// JSONL history never leaves the machine that made the
+// commit. With `trace.notes: true` in `.ctxrc`, the
+// post-commit hook and `ctx trace tag` call
+// [WriteHistoryNote] / [WriteOverrideNote] instead, which
+// append one JSON line per record to the commit's note
+// under `refs/notes/ctx`. Notes are read from every clone:
+// [CollectRefsForCommit] always includes the commit's notes,
+// looked up in a [NoteIndex] that [LoadNoteIndex] builds once
+// per command with one `git notes list` and one
+// `git cat-file --batch` (nothing at all when the ref is
+// missing).
+//
+// [PushNotes] and [FetchNotes] sync the notes ref with a
+// remote; fetching merges with git's `cat_sort_uniq`
+// strategy, so records written for the same commit in
+// different clones are all kept. [MigrateNotes] copies the
+// existing JSONL files into notes once, skipping lines
+// already present.
+//
 // # Resolution
 //
 // The CLI side (`ctx trace <commit>`, `ctx trace file <path>`)
@@ -100,12 +123,25 @@
 //   - [Resolve](ref, contextDir) → [ResolvedRef] with title and
 //     one-line preview (or `Found: false` for stale refs).
 //   - [CollectRefsForCommit] picks the ref set for a given
-//     commit, preferring override → history.
+//     commit from history, notes, trailers and overrides.
 //   - [ResolveCommitHash] takes a short hash, abbrev, or
 //     ref-like string and returns the full SHA via `git
 //     rev-parse`.
 //   - [CommitMessage] / [CommitDate] are thin `git log` wrappers
 //     used to render the trace output.
+//   - [Blame] runs `git blame --porcelain` so `ctx trace blame`
+//     can look up the refs of each line's commit.
+//
+// # External Refs
+//
+// `trace.resolvers` in `.ctxrc` maps issue-key patterns
+// (`#1234`, `PAY-567`) to issue trackers. [ExternalRefs] turns
+// the keys found in a commit message or branch name into
+// `<name>:<id>` refs, and [Resolve] gives those refs the
+// tracker URL plus a title from the offline cache
+// (`trace/titles.jsonl`). [RecentExternalRefs] and
+// [SyncTitles] back `ctx trace sync`, which fills the cache
+// from each resolver's JSON API.
 //
 // # Concurrency and Safety
 //
@@ -0,0 +1,128 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	errTrace "github.com/ActiveMemory/ctx/internal/err/trace"
+	"github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// ExternalRefs finds issue keys matched by the configured trace
+// resolvers in free text such as a commit message or a branch
+// name.
+//
+// Parameters:
+//   - text: text to scan
+//
+// Returns:
+//   - []string: deduplicated "<name>:<id>" refs in resolver order,
+//     nil when nothing matches or no resolvers are configured
+func ExternalRefs(text string) []string {
+	var refs []string
+	for _, r := range resolvers() {
+		for _, m := range r.pattern.FindAllStringSubmatch(text, -1) {
+			id := m[min(len(m)-1, 1)]
+			if id == "" {
+				continue
+			}
+			refs = append(refs, fmt.Sprintf(
+				cfgTrace.ExternalRefFormat, r.name, id,
+			))
+		}
+	}
+	return Deduplicate(refs)
+}
+
+// RecentExternalRefs gathers the external refs of the last N
+// commits, from their messages and from their recorded context.
+//
+// Parameters:
+//   - traceDir: absolute path to the trace directory
+//   - last: number of commits to scan
+//
+// Returns:
+//   - []string: deduplicated external refs, newest commit first
+//   - error: non-nil when git log fails
+func RecentExternalRefs(traceDir string, last int) ([]string, error) {
+	if len(resolvers()) == 0 {
+		return nil, nil
+	}
+	out, logErr := git.Run(
+		cfgGit.Log, fmt.Sprintf(cfgGit.FlagLastN, last),
+		cfgGit.FormatHashBody,
+	)
+	if logErr != nil {
+		return nil, errTrace.GitLog(logErr)
+	}
+
+	notes := LoadNoteIndex()
+	var refs []string
+	for _, record := range strings.Split(string(out), cfgGit.LogRecordSep) {
+		hash, message, found := strings.Cut(
+			strings.TrimSpace(record), cfgGit.LogFieldSep,
+		)
+		if !found {
+			continue
+		}
+		refs = append(refs, ExternalRefs(message)...)
+		for _, ref := range CollectRefsForCommit(hash, traceDir, notes, false) {
+			if _, _, ok := lookupResolver(ref); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return Deduplicate(refs), nil
+}
+
+// SyncTitles fetches the titles of external refs from their
+// resolvers' APIs into the offline title cache. Refs whose
+// resolver has no api template are ignored.
+//
+// Parameters:
+//   - traceDir: absolute path to the trace directory
+//   - refs: external refs to sync
+//   - refresh: re-fetch titles that are already cached
+//
+// Returns:
+//   - SyncResult: fetched, cached and failed counts
+//   - error: non-nil when the cache cannot be written
+func SyncTitles(
+	traceDir string, refs []string, refresh bool,
+) (SyncResult, error) {
+	var result SyncResult
+	cached := readTitles(traceDir)
+	for _, ref := range refs {
+		r, id, ok := lookupResolver(ref)
+		if !ok || r.api == "" {
+			continue
+		}
+		if _, have := cached[ref]; have && !refresh {
+			result.Cached++
+			continue
+		}
+		title, fetchErr := fetchTitle(r, id)
+		if fetchErr != nil {
+			result.Failures = append(result.Failures, SyncFailure{
+				Ref: ref, Err: fetchErr,
+			})
+			continue
+		}
+		if writeErr := appendJSONL(traceDir, cfgTrace.FileTitles, titleEntry{
+			Ref: ref, Title: title, Fetched: time.Now().UTC(),
+		}); writeErr != nil {
+			return result, errTrace.TitlesWrite(writeErr)
+		}
+		result.Fetched++
+	}
+	return result, nil
+}
@@ -0,0 +1,94 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	cfgHTTP "github.com/ActiveMemory/ctx/internal/config/http"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	cfgWarn "github.com/ActiveMemory/ctx/internal/config/warn"
+	errTrace "github.com/ActiveMemory/ctx/internal/err/trace"
+	"github.com/ActiveMemory/ctx/internal/io"
+	logWarn "github.com/ActiveMemory/ctx/internal/log/warn"
+)
+
+// fetchTitle reads an issue title from a resolver's JSON API.
+//
+// Parameters:
+//   - r: resolver with a non-empty api template
+//   - id: issue ID
+//
+// Returns:
+//   - string: the title
+//   - error: non-nil on transport failure, non-2xx status, a
+//     response that is not JSON, or a missing title field
+func fetchTitle(r resolver, id string) (string, error) {
+	req, reqErr := http.NewRequest(http.MethodGet, expand(r.api, id), nil)
+	if reqErr != nil {
+		return "", reqErr
+	}
+	req.Header.Set(cfgHTTP.HeaderAccept, cfgHTTP.MimeJSON)
+	if tok := os.Getenv(r.tokenEnv); r.tokenEnv != "" && tok != "" {
+		req.Header.Set(
+			cfgHTTP.HeaderAuthorization, fmt.Sprintf(cfgHTTP.BearerFormat, tok),
+		)
+	}
+
+	resp, doErr := io.SafeDo(req, cfgTrace.TitleTimeout*time.Second)
+	if doErr != nil {
+		return "", doErr
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logWarn.Warn(cfgWarn.CloseResponse, closeErr)
+		}
+	}()
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return "", errTrace.TitleStatus(resp.StatusCode)
+	}
+
+	var body any
+	if decodeErr := json.NewDecoder(resp.Body).Decode(&body); decodeErr != nil {
+		return "", errTrace.TitleDecode(decodeErr)
+	}
+	title, ok := titleAt(body, r.titleField)
+	if !ok {
+		return "", errTrace.TitleField(r.titleField)
+	}
+	return title, nil
+}
+
+// titleAt walks a dotted path (e.g. "fields.summary") through a
+// decoded JSON document.
+//
+// Parameters:
+//   - doc: decoded JSON value
+//   - path: dotted field path
+//
+// Returns:
+//   - string: the trimmed string at path
+//   - bool: false when the path is missing, not a string, or empty
+func titleAt(doc any, path string) (string, bool) {
+	for _, key := range strings.Split(path, token.Dot) {
+		obj, isObj := doc.(map[string]any)
+		if !isObj {
+			return "", false
+		}
+		doc = obj[key]
+	}
+	title, isStr := doc.(string)
+	title = strings.TrimSpace(title)
+	return title, isStr && title != ""
+}
@@ -0,0 +1,127 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveMemory/ctx/internal/config/dir"
+	"github.com/ActiveMemory/ctx/internal/config/regex"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	"github.com/ActiveMemory/ctx/internal/rc"
+)
+
+// resolvers compiles the trace.resolvers entries from .ctxrc.
+// Entries without a name or with an unusable pattern are
+// skipped; ctx config validate reports them.
+//
+// Returns:
+//   - []resolver: compiled resolvers in configuration order
+func resolvers() []resolver {
+	configured := rc.TraceResolvers()
+	compiled := make([]resolver, 0, len(configured))
+	for _, r := range configured {
+		re, compileErr := regex.Compile(r.Pattern)
+		if compileErr != nil || r.Pattern == "" || r.Name == "" {
+			continue
+		}
+		field := r.TitleField
+		if field == "" {
+			field = cfgTrace.DefaultTitleField
+		}
+		compiled = append(compiled, resolver{
+			name:       r.Name,
+			pattern:    re,
+			url:        r.URL,
+			api:        r.API,
+			titleField: field,
+			tokenEnv:   r.TokenEnv,
+		})
+	}
+	return compiled
+}
+
+// lookupResolver finds the resolver named by an external ref.
+//
+// Parameters:
+//   - ref: raw reference string (e.g. "jira:PAY-567")
+//
+// Returns:
+//   - resolver: the matching resolver
+//   - string: the issue ID after the colon
+//   - bool: false when ref does not name a configured resolver
+func lookupResolver(ref string) (resolver, string, bool) {
+	name, id, found := strings.Cut(ref, token.Colon)
+	if !found || id == "" {
+		return resolver{}, "", false
+	}
+	for _, r := range resolvers() {
+		if r.name == name {
+			return r, id, true
+		}
+	}
+	return resolver{}, "", false
+}
+
+// expand fills the {id} placeholder of a url or api template.
+//
+// Parameters:
+//   - template: url or api template (empty stays empty)
+//   - id: issue ID
+//
+// Returns:
+//   - string: expanded URL
+func expand(template, id string) string {
+	return strings.ReplaceAll(template, cfgTrace.ResolverID, id)
+}
+
+// resolveExternal resolves a ref handled by a trace resolver,
+// taking its title from the offline cache.
+//
+// Parameters:
+//   - resolved: partially populated ResolvedRef (Raw set)
+//   - contextDir: absolute path to the .context/ directory
+//
+// Returns:
+//   - ResolvedRef: populated with Type, URL and cached Title
+//   - bool: false when the ref names no configured resolver
+func resolveExternal(
+	resolved ResolvedRef, contextDir string,
+) (ResolvedRef, bool) {
+	r, id, ok := lookupResolver(resolved.Raw)
+	if !ok {
+		return resolved, false
+	}
+	resolved.Type = r.name
+	resolved.URL = expand(r.url, id)
+	resolved.Title = readTitles(
+		filepath.Join(contextDir, dir.Trace),
+	)[resolved.Raw]
+	resolved.Found = true
+	return resolved, true
+}
+
+// readTitles loads the external title cache. Later lines for
+// the same ref win; a missing or unreadable cache is empty.
+//
+// Parameters:
+//   - traceDir: absolute path to the trace directory
+//
+// Returns:
+//   - map[string]string: titles keyed by ref
+func readTitles(traceDir string) map[string]string {
+	entries, _ := readJSONL[titleEntry](
+		filepath.Join(traceDir, cfgTrace.FileTitles),
+	)
+	titles := make(map[string]string, len(entries))
+	for _, e := range entries {
+		titles[e.Ref] = e.Title
+	}
+	return titles
+}
@@ -0,0 +1,166 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveMemory/ctx/internal/rc"
+	"github.com/ActiveMemory/ctx/internal/testutil/testctx"
+)
+
+// setupResolvers writes a .ctxrc with a jira resolver backed by
+// api and a GitHub resolver without one, and returns the temp dir.
+func setupResolvers(t *testing.T, api string) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	t.Chdir(tempDir)
+	testctx.Declare(t, tempDir)
+	rcContent := `trace:
+  resolvers:
+    - name: jira
+      pattern: '\b([A-Z]+-[0-9]+)\b'
+      url: https://jira.example.com/browse/{id}
+      api: ` + api + `/issue/{id}
+      title_field: fields.summary
+    - name: gh
+      pattern: '#([0-9]+)'
+      url: https://github.com/acme/app/issues/{id}
+`
+	if err := os.WriteFile(
+		filepath.Join(tempDir, ".ctxrc"), []byte(rcContent), 0o600,
+	); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	rc.Reset()
+	return tempDir
+}
+
+func TestExternalRefs(t *testing.T) {
+	setupResolvers(t, "http://unused")
+
+	got := ExternalRefs("PAY-567: fix checkout (#12), see PAY-567")
+	want := []string{"jira:PAY-567", "gh:12"}
+	if len(got) != len(want) {
+		t.Fatalf("ExternalRefs() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ExternalRefs()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if refs := ExternalRefs("no issue keys here"); len(refs) != 0 {
+		t.Errorf("ExternalRefs() = %v, want none", refs)
+	}
+}
+
+func TestExternalRefsNoResolvers(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Chdir(tempDir)
+	testctx.Declare(t, tempDir)
+
+	if refs := ExternalRefs("PAY-567 #12"); len(refs) != 0 {
+		t.Errorf("ExternalRefs() = %v, want none", refs)
+	}
+}
+
+func TestSyncTitlesAndResolve(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.URL.Path != "/issue/PAY-567" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"fields":{"summary":"Checkout fails"}}`))
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	tempDir := setupResolvers(t, srv.URL)
+	contextDir := filepath.Join(tempDir, ".context")
+	traceDir := filepath.Join(contextDir, "trace")
+	refs := []string{"jira:PAY-567", "jira:PAY-1", "gh:12"}
+
+	result, err := SyncTitles(traceDir, refs, false)
+	if err != nil {
+		t.Fatalf("SyncTitles() error: %v", err)
+	}
+	if result.Fetched != 1 || result.Cached != 0 {
+		t.Errorf("first sync = %+v, want 1 fetched", result)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Ref != "jira:PAY-1" {
+		t.Errorf("failures = %+v, want jira:PAY-1", result.Failures)
+	}
+
+	result, err = SyncTitles(traceDir, refs, false)
+	if err != nil {
+		t.Fatalf("SyncTitles() error: %v", err)
+	}
+	if result.Fetched != 0 || result.Cached != 1 {
+		t.Errorf("second sync = %+v, want 1 cached", result)
+	}
+	if calls != 3 {
+		t.Errorf("API calls = %d, want 3 (cached title not re-fetched)", calls)
+	}
+
+	result, err = SyncTitles(traceDir, refs[:1], true)
+	if err != nil || result.Fetched != 1 {
+		t.Errorf("refresh sync = %+v, %v, want 1 fetched", result, err)
+	}
+
+	jira := Resolve("jira:PAY-567", contextDir)
+	if !jira.Found || jira.Type != "jira" {
+		t.Errorf("Resolve(jira) = %+v, want found jira ref", jira)
+	}
+	if jira.Title != "Checkout fails" {
+		t.Errorf("Resolve(jira).Title = %q, want cached title", jira.Title)
+	}
+	if jira.URL != "https://jira.example.com/browse/PAY-567" {
+		t.Errorf("Resolve(jira).URL = %q", jira.URL)
+	}
+
+	gh := Resolve("gh:12", contextDir)
+	if gh.URL != "https://github.com/acme/app/issues/12" || gh.Title != "" {
+		t.Errorf("Resolve(gh) = %+v, want URL without title", gh)
+	}
+
+	if note := Resolve("linear:ABC-1", contextDir); note.Type != "note" {
+		t.Errorf("Resolve(unconfigured) type = %q, want note", note.Type)
+	}
+}
+
+func Test_titleAt(t *testing.T) {
+	doc := map[string]any{
+		"title":  " Top ",
+		"fields": map[string]any{"summary": "Nested", "n": 3.0},
+	}
+	tests := []struct {
+		path   string
+		want   string
+		wantOK bool
+	}{
+		{"title", "Top", true},
+		{"fields.summary", "Nested", true},
+		{"fields.n", "", false},
+		{"fields.missing", "", false},
+		{"title.deeper", "", false},
+	}
+	for _, tc := range tests {
+		got, ok := titleAt(doc, tc.path)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("titleAt(%q) = %q, %v, want %q, %v",
+				tc.path, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
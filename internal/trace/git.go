@@ -98,6 +98,25 @@ func CommitMessage(hash string) (string, error) {
 	return strings.TrimSpace(string(out)), nil
 }
 
+// CommitBody returns the full message of a commit, subject and
+// body.
+//
+// Parameters:
+//   - hash: full commit hash
+//
+// Returns:
+//   - string: commit message
+//   - error: non-nil if git log fails
+func CommitBody(hash string) (string, error) {
+	out, err := git.Run(
+		cfgGit.Log, cfgGit.FlagLast, cfgGit.FormatBody, hash,
+	)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 // CommitDate returns the commit date string in ISO format.
 //
 // Parameters:
@@ -116,17 +135,19 @@ func CommitDate(hash string) string {
 }
 
 // CollectRefsForCommit gathers context refs for a commit from
-// history, overrides, and optionally git trailers.
+// history, git notes, overrides, and optionally git trailers.
 //
 // Parameters:
 //   - commitHash: full or abbreviated commit hash
 //   - traceDir: absolute path to the trace directory
+//   - notes: refs/notes/ctx loaded once per command by
+//     [LoadNoteIndex]
 //   - includeTrailers: whether to read git trailers (slow for bulk)
 //
 // Returns:
 //   - []string: deduplicated refs from all sources
 func CollectRefsForCommit(
-	commitHash, traceDir string, includeTrailers bool,
+	commitHash, traceDir string, notes NoteIndex, includeTrailers bool,
 ) []string {
 	var all []string
 
@@ -135,12 +156,16 @@ func CollectRefsForCommit(
 		all = append(all, entry.Refs...)
 	}
 
-	// Source 2: git trailers (optional, slow for bulk operations)
+	// Source 2: git notes (refs/notes/ctx), present in any clone
+	// that fetched them
+	all = append(all, notes.Refs(commitHash)...)
+
+	// Source 3: git trailers (optional, slow for bulk operations)
 	if includeTrailers {
 		all = append(all, ReadTrailerRefs(commitHash)...)
 	}
 
-	// Source 3: overrides.jsonl
+	// Source 4: overrides.jsonl
 	all = append(all, ReadOverridesForCommit(commitHash, traceDir)...)
 
 	result := Deduplicate(all)
@@ -0,0 +1,195 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"strings"
+	"time"
+
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	errTrace "github.com/ActiveMemory/ctx/internal/err/trace"
+	"github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// WriteHistoryNote records a HistoryEntry as a git note on its
+// commit under refs/notes/ctx.
+// If entry.Timestamp is zero it is set to the current UTC time.
+//
+// Parameters:
+//   - entry: history entry; Commit must name an existing commit
+//
+// Returns:
+//   - error: non-nil when git cannot write the note
+func WriteHistoryNote(entry HistoryEntry) error {
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+	_, appendErr := appendNote(entry.Commit, historyRecord(entry))
+	return appendErr
+}
+
+// WriteOverrideNote records an OverrideEntry as a git note on its
+// commit under refs/notes/ctx.
+// If entry.Timestamp is zero it is set to the current UTC time.
+//
+// Parameters:
+//   - entry: override entry; Commit must name an existing commit
+//
+// Returns:
+//   - error: non-nil when git cannot write the note
+func WriteOverrideNote(entry OverrideEntry) error {
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+	_, appendErr := appendNote(entry.Commit, overrideRecord(entry))
+	return appendErr
+}
+
+// LoadNoteIndex reads every refs/notes/ctx note with one git
+// notes list and one git cat-file --batch, so commands that look
+// up many commits do not spawn git per commit.
+//
+// Returns:
+//   - NoteIndex: refs keyed by full commit hash; nil when the
+//     repository has no refs/notes/ctx or it cannot be read
+func LoadNoteIndex() NoteIndex {
+	if !git.RefExists(cfgTrace.NotesRef) {
+		return nil
+	}
+	out, listErr := git.NoteList(cfgTrace.NotesRef)
+	if listErr != nil {
+		return nil
+	}
+	commits := parseNoteList(out)
+	if len(commits) == 0 {
+		return nil
+	}
+	blobs := make([]string, 0, len(commits))
+	for blob := range commits {
+		blobs = append(blobs, blob)
+	}
+	data, catErr := git.CatFileBatch(blobs)
+	if catErr != nil {
+		return nil
+	}
+
+	idx := NoteIndex{}
+	for blob, text := range parseBatch(data) {
+		var refs []string
+		for _, rec := range decodeRecords(splitNote(text)) {
+			refs = append(refs, rec.Refs...)
+		}
+		for _, commit := range commits[blob] {
+			idx[commit] = refs
+		}
+	}
+	return idx
+}
+
+// Refs returns the refs noted on a commit.
+//
+// Parameters:
+//   - commitHash: full or abbreviated commit hash
+//
+// Returns:
+//   - []string: refs in note order; nil when the commit has
+//     no note
+func (n NoteIndex) Refs(commitHash string) []string {
+	if commitHash == "" {
+		return nil
+	}
+	if refs, ok := n[commitHash]; ok {
+		return refs
+	}
+	for hash, refs := range n {
+		if strings.HasPrefix(hash, commitHash) {
+			return refs
+		}
+	}
+	return nil
+}
+
+// PushNotes pushes refs/notes/ctx to a remote.
+//
+// Parameters:
+//   - remote: remote name or URL
+//
+// Returns:
+//   - error: non-nil when the push fails or is rejected because
+//     the remote has notes this clone has not fetched
+func PushNotes(remote string) error {
+	out, pushErr := git.Push(remote, cfgTrace.NotesRef)
+	if pushErr != nil {
+		return errTrace.NotesPush(remote, gitFailure(out, pushErr))
+	}
+	return nil
+}
+
+// FetchNotes fetches refs/notes/ctx from a remote and merges it
+// into the local notes line by line, so records written in
+// different clones for the same commit are all kept.
+//
+// Parameters:
+//   - remote: remote name or URL
+//
+// Returns:
+//   - error: non-nil when the fetch or the merge fails
+func FetchNotes(remote string) error {
+	out, fetchErr := git.Fetch(remote, fetchRefspec())
+	if fetchErr != nil {
+		return errTrace.NotesFetch(remote, gitFailure(out, fetchErr))
+	}
+	out, mergeErr := git.NoteMerge(
+		cfgTrace.NotesRef, cfgTrace.NotesMergeStrategy,
+		cfgTrace.NotesIncomingRef,
+	)
+	if mergeErr != nil {
+		return errTrace.NotesMerge(gitFailure(out, mergeErr))
+	}
+	return nil
+}
+
+// MigrateNotes copies history.jsonl and overrides.jsonl from
+// traceDir into git notes. Records already present in a note are
+// skipped, so the migration can be re-run safely; records for
+// commits that are not in this repository (rewritten or
+// unfetched history) are counted as missing. The JSONL files
+// are left in place.
+//
+// Parameters:
+//   - traceDir: absolute path to the trace directory
+//
+// Returns:
+//   - MigrateResult: counts of migrated, skipped and missing
+//     records
+//   - error: non-nil when a JSONL file cannot be read or git
+//     cannot write a note
+func MigrateNotes(traceDir string) (MigrateResult, error) {
+	var r MigrateResult
+	history, histErr := ReadHistory(traceDir)
+	if histErr != nil {
+		return r, histErr
+	}
+	overrides, overErr := ReadOverrides(traceDir)
+	if overErr != nil {
+		return r, overErr
+	}
+
+	for _, e := range history {
+		migrateErr := migrate(e.Commit, historyRecord(e), &r)
+		if migrateErr != nil {
+			return r, migrateErr
+		}
+	}
+	for _, e := range overrides {
+		migrateErr := migrate(e.Commit, overrideRecord(e), &r)
+		if migrateErr != nil {
+			return r, migrateErr
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,259 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	cfgGit "github.com/ActiveMemory/ctx/internal/config/git"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	errGit "github.com/ActiveMemory/ctx/internal/err/git"
+	errTrace "github.com/ActiveMemory/ctx/internal/err/trace"
+	"github.com/ActiveMemory/ctx/internal/exec/git"
+)
+
+// historyRecord converts a HistoryEntry to its note record.
+//
+// Parameters:
+//   - e: history entry
+//
+// Returns:
+//   - noteRecord: record of kind history
+func historyRecord(e HistoryEntry) noteRecord {
+	return noteRecord{
+		Kind:      cfgTrace.NoteKindHistory,
+		Refs:      e.Refs,
+		Message:   e.Message,
+		Timestamp: e.Timestamp,
+	}
+}
+
+// overrideRecord converts an OverrideEntry to its note record.
+//
+// Parameters:
+//   - e: override entry
+//
+// Returns:
+//   - noteRecord: record of kind override
+func overrideRecord(e OverrideEntry) noteRecord {
+	return noteRecord{
+		Kind:      cfgTrace.NoteKindOverride,
+		Refs:      e.Refs,
+		Timestamp: e.Timestamp,
+	}
+}
+
+// noteLines returns the non-empty lines of a commit's note.
+// A missing note yields nil.
+//
+// Parameters:
+//   - commitHash: commit to read
+//
+// Returns:
+//   - []string: note lines
+func noteLines(commitHash string) []string {
+	out, showErr := git.NoteShow(cfgTrace.NotesRef, commitHash)
+	if showErr != nil {
+		return nil
+	}
+	return splitNote(string(out))
+}
+
+// splitNote returns the non-empty lines of a note's text.
+//
+// Parameters:
+//   - text: note content
+//
+// Returns:
+//   - []string: trimmed note lines
+func splitNote(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, token.NewlineLF) {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// readNote decodes the records of a commit's note. Malformed
+// lines are silently skipped.
+//
+// Parameters:
+//   - commitHash: commit to read
+//
+// Returns:
+//   - []noteRecord: records in note order
+func readNote(commitHash string) []noteRecord {
+	return decodeRecords(noteLines(commitHash))
+}
+
+// decodeRecords decodes note lines into records. Malformed
+// lines are silently skipped.
+//
+// Parameters:
+//   - lines: note lines
+//
+// Returns:
+//   - []noteRecord: records in line order
+func decodeRecords(lines []string) []noteRecord {
+	var records []noteRecord
+	for _, line := range lines {
+		var rec noteRecord
+		if unmarshalErr := json.Unmarshal(
+			[]byte(line), &rec,
+		); unmarshalErr != nil {
+			continue
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+// parseNoteList maps each note object in git notes list output
+// to the commits it annotates; identical notes share one object.
+//
+// Parameters:
+//   - out: "<note-object> <commit>" lines
+//
+// Returns:
+//   - map[string][]string: commit hashes keyed by note object
+func parseNoteList(out []byte) map[string][]string {
+	commits := map[string][]string{}
+	for _, line := range strings.Split(string(out), token.NewlineLF) {
+		fields := strings.Fields(line)
+		if len(fields) != cfgGit.NoteListFields {
+			continue
+		}
+		commits[fields[0]] = append(commits[fields[0]], fields[1])
+	}
+	return commits
+}
+
+// parseBatch splits git cat-file --batch output into object
+// contents. Missing objects and a truncated tail are skipped.
+//
+// Parameters:
+//   - out: batch output
+//
+// Returns:
+//   - map[string]string: object content keyed by object name
+func parseBatch(out []byte) map[string]string {
+	objects := map[string]string{}
+	for len(out) > 0 {
+		header, rest, found := bytes.Cut(out, []byte(token.NewlineLF))
+		if !found {
+			break
+		}
+		fields := strings.Fields(string(header))
+		out = rest
+		if len(fields) != cfgGit.BatchHeaderFields {
+			continue // "<name> missing"
+		}
+		size, sizeErr := strconv.Atoi(fields[2])
+		if sizeErr != nil || size > len(out) {
+			break
+		}
+		objects[fields[0]] = string(out[:size])
+		out = out[size:]
+		if len(out) > 0 {
+			out = out[1:] // newline after the content
+		}
+	}
+	return objects
+}
+
+// appendNote adds a record to a commit's note unless an
+// identical line is already there.
+//
+// Parameters:
+//   - commitHash: commit to annotate
+//   - rec: record to add
+//
+// Returns:
+//   - bool: true when the record was written
+//   - error: non-nil when git cannot write the note
+func appendNote(commitHash string, rec noteRecord) (bool, error) {
+	line, marshalErr := json.Marshal(rec)
+	if marshalErr != nil {
+		return false, marshalErr
+	}
+	if slices.Contains(noteLines(commitHash), string(line)) {
+		return false, nil
+	}
+	out, appendErr := git.NoteAppend(
+		cfgTrace.NotesRef, commitHash, string(line),
+	)
+	if appendErr != nil {
+		return false, errTrace.NoteWrite(
+			ShortHash(commitHash), gitFailure(out, appendErr),
+		)
+	}
+	return true, nil
+}
+
+// migrate copies one JSONL record into notes and counts the
+// outcome.
+//
+// Parameters:
+//   - commitHash: commit the record belongs to
+//   - rec: record to copy
+//   - r: counts to update
+//
+// Returns:
+//   - error: non-nil when git cannot write the note
+func migrate(commitHash string, rec noteRecord, r *MigrateResult) error {
+	if !git.CommitExists(commitHash) {
+		r.Missing++
+		return nil
+	}
+	added, appendErr := appendNote(commitHash, rec)
+	if appendErr != nil {
+		return appendErr
+	}
+	if added {
+		r.Migrated++
+	} else {
+		r.Skipped++
+	}
+	return nil
+}
+
+// fetchRefspec maps the remote notes ref onto the local
+// incoming ref, forcing the update so every fetch replaces it.
+//
+// Returns:
+//   - string: "+refs/notes/ctx:refs/notes/ctx-incoming"
+func fetchRefspec() string {
+	return fmt.Sprintf(cfgGit.RefspecFormat,
+		cfgGit.RefspecForce, cfgTrace.NotesRef, cfgTrace.NotesIncomingRef,
+	)
+}
+
+// gitFailure adds git's own output to the bare exit status when
+// explaining a failed command.
+//
+// Parameters:
+//   - out: combined git output
+//   - cause: exec error
+//
+// Returns:
+//   - error: git's message wrapping cause, or cause when git
+//     printed nothing
+func gitFailure(out []byte, cause error) error {
+	msg := strings.TrimSpace(string(out))
+	if msg == "" {
+		return cause
+	}
+	return errGit.Command(msg, cause)
+}
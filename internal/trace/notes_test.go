@@ -0,0 +1,98 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// gitIn runs git in the current directory and returns its
+// trimmed output.
+func gitIn(t *testing.T, args ...string) string {
+	t.Helper()
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestLoadNoteIndex(t *testing.T) {
+	repo := t.TempDir()
+	origDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+	if err := os.Chdir(repo); err != nil {
+		t.Fatal(err)
+	}
+	gitIn(t, "init", "-q")
+	gitIn(t, "config", "user.email", "test@test.com")
+	gitIn(t, "config", "user.name", "Test")
+	gitIn(t, "commit", "-q", "--allow-empty", "-m", "first")
+	first := gitIn(t, "rev-parse", "HEAD")
+	gitIn(t, "commit", "-q", "--allow-empty", "-m", "second")
+	second := gitIn(t, "rev-parse", "HEAD")
+	gitIn(t, "commit", "-q", "--allow-empty", "-m", "third")
+	third := gitIn(t, "rev-parse", "HEAD")
+
+	if idx := LoadNoteIndex(); idx != nil {
+		t.Fatalf("LoadNoteIndex() without notes = %v, want nil", idx)
+	}
+
+	for _, e := range []HistoryEntry{
+		{Commit: first, Refs: []string{"decision:1"}},
+		{Commit: second, Refs: []string{"task:2"}},
+		// Same content as the second: git stores one object.
+		{Commit: third, Refs: []string{"task:2"}},
+	} {
+		if err := WriteHistoryNote(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := WriteOverrideNote(
+		OverrideEntry{Commit: first, Refs: []string{`"shared"`}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	idx := LoadNoteIndex()
+	if got := strings.Join(idx.Refs(first), ","); got != `decision:1,"shared"` {
+		t.Errorf("Refs(first) = %q", got)
+	}
+	if got := strings.Join(idx.Refs(ShortHash(second)), ","); got != "task:2" {
+		t.Errorf("Refs(short second) = %q", got)
+	}
+	if got := strings.Join(idx.Refs(third), ","); got != "task:2" {
+		t.Errorf("Refs(third) = %q", got)
+	}
+	if refs := idx.Refs(strings.Repeat("0", 40)); refs != nil {
+		t.Errorf("Refs(unknown) = %v, want nil", refs)
+	}
+	for _, h := range []string{first, second, third} {
+		var want []string
+		for _, rec := range readNote(h) {
+			want = append(want, rec.Refs...)
+		}
+		got := strings.Join(idx.Refs(h), ",")
+		if got != strings.Join(want, ",") {
+			t.Errorf("index %s = %q, note = %q", h, got, want)
+		}
+	}
+}
+
+func TestParseBatch(t *testing.T) {
+	out := []byte("aaa blob 5\nhello\nbbb missing\nccc blob 0\n\n")
+	got := parseBatch(out)
+	if len(got) != 2 || got["aaa"] != "hello" || got["ccc"] != "" {
+		t.Errorf("parseBatch() = %q", got)
+	}
+	if got := parseBatch([]byte("ddd blob 99\nshort\n")); len(got) != 0 {
+		t.Errorf("parseBatch(truncated) = %q, want empty", got)
+	}
+}
@@ -12,6 +12,8 @@ import (
 )
 
 // Resolve looks up a raw reference and returns its full details.
+// Refs of the form "<name>:<id>" whose name matches a configured
+// trace resolver resolve to the issue URL and its cached title.
 //
 // Parameters:
 //   - ref: raw reference string (e.g. "decision:12", "task:8", `"Some note"`)
@@ -42,6 +44,9 @@ func Resolve(ref, contextDir string) ResolvedRef {
 		resolved.Found = true
 		return resolved
 	default: // cfgTrace.RefTypeNote
+		if external, ok := resolveExternal(resolved, contextDir); ok {
+			return external
+		}
 		resolved.Title = text
 		resolved.Found = true
 		return resolved
@@ -6,7 +6,10 @@
 
 package trace
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 // PendingEntry records a context reference that has been staged for
 // attachment to the next git commit.
@@ -33,12 +36,96 @@ type OverrideEntry struct {
 }
 
 // ResolvedRef holds the result of resolving a raw context reference
-// (e.g. "T-3", "D-1", "L-5") to its full details.
+// (e.g. "T-3", "D-1", "L-5") to its full details. URL is set for
+// external refs handled by a trace resolver.
 type ResolvedRef struct {
 	Raw    string
 	Type   string
 	Number int
 	Title  string
 	Detail string
+	URL    string
 	Found  bool
 }
+
+// MigrateResult counts the outcome of copying JSONL history and
+// overrides into git notes.
+type MigrateResult struct {
+	Migrated int
+	Skipped  int
+	Missing  int
+}
+
+// BlameLine is one line of a blamed file and the commit that
+// last changed it.
+type BlameLine struct {
+	Line    int
+	Commit  string
+	Content string
+}
+
+// BlameCommit describes a commit that last changed one or more
+// blamed lines. Committed is false for the all-zero hash git
+// reports for uncommitted edits.
+type BlameCommit struct {
+	Hash      string
+	Author    string
+	Time      time.Time
+	Summary   string
+	Committed bool
+}
+
+// BlameResult holds the lines of a blamed file together with
+// the commits they point at, keyed by full hash.
+type BlameResult struct {
+	Lines   []BlameLine
+	Commits map[string]BlameCommit
+}
+
+// SyncFailure records an external ref whose title could not be
+// fetched.
+type SyncFailure struct {
+	Ref string
+	Err error
+}
+
+// SyncResult counts the outcome of ctx trace sync: titles fetched,
+// refs skipped because their title was already cached, and refs
+// whose fetch failed.
+type SyncResult struct {
+	Fetched  int
+	Cached   int
+	Failures []SyncFailure
+}
+
+// titleEntry is one line of the external title cache. Later lines
+// for the same ref win.
+type titleEntry struct {
+	Ref     string    `json:"ref"`
+	Title   string    `json:"title"`
+	Fetched time.Time `json:"fetched"`
+}
+
+// resolver is a compiled trace.resolvers entry from .ctxrc.
+type resolver struct {
+	name       string
+	pattern    *regexp.Regexp
+	url        string
+	api        string
+	titleField string
+	tokenEnv   string
+}
+
+// NoteIndex holds the refs recorded in refs/notes/ctx, keyed by
+// full commit hash. Build it once per command with
+// [LoadNoteIndex]; a nil index has no refs.
+type NoteIndex map[string][]string
+
+// noteRecord is one JSONL line of a commit's git note: either
+// the refs recorded at commit time or a later override.
+type noteRecord struct {
+	Kind      string    `json:"kind"`
+	Refs      []string  `json:"refs"`
+	Message   string    `json:"message,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -12,18 +12,20 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/config/env"
 	cfgTrace "github.com/ActiveMemory/ctx/internal/config/trace"
+	"github.com/ActiveMemory/ctx/internal/exec/git"
 )
 
 // WorkingRefs detects context refs from the current working state.
 //
-// It combines in-progress task refs from TASKS.md with an active AI session
-// ref (if CTX_SESSION_ID is set).
+// It combines in-progress task refs from TASKS.md, an active AI session
+// ref (if CTX_SESSION_ID is set), and issue keys the configured trace
+// resolvers find in the current branch name (e.g. "feat/PAY-567-retry").
 //
 // Parameters:
 //   - contextDir: absolute path to the .context/ directory
 //
 // Returns:
-//   - []string: refs like "task:1", "session:<id>"
+//   - []string: refs like "task:1", "session:<id>", "jira:PAY-567"
 func WorkingRefs(contextDir string) []string {
 	var refs []string
 
@@ -33,5 +35,9 @@ func WorkingRefs(contextDir string) []string {
 		refs = append(refs, fmt.Sprintf(cfgTrace.SessionRefFormat, id))
 	}
 
+	if len(resolvers()) > 0 {
+		refs = append(refs, ExternalRefs(git.CurrentBranch())...)
+	}
+
 	return refs
 }
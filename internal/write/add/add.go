@@ -26,6 +26,18 @@ func Added(cmd *cobra.Command, filename string) {
 	)
 }
 
+// Redacted reports how many possible secrets were redacted before
+// the entry was written.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - count: Number of redacted values
+func Redacted(cmd *cobra.Command, count int) {
+	cmd.Println(
+		fmt.Sprintf(desc.Text(text.DescKeyWriteAddRedacted), count),
+	)
+}
+
 // SpecNudge prints a tip suggesting a spec when appropriate.
 //
 // Parameters:
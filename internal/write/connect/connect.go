@@ -58,6 +58,18 @@ func Published(cmd *cobra.Command, count int) {
 	))
 }
 
+// Redacted warns that possible secrets were redacted from entries
+// before they were published.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - count: number of redacted values
+func Redacted(cmd *cobra.Command, count int) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteConnectRedacted), count,
+	))
+}
+
 // Listening confirms the listen stream is active.
 //
 // Parameters:
@@ -0,0 +1,45 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// Checks prints the drift check list with each check's source,
+// state, and severity override. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - checks: Checks to list, in run order
+func Checks(cmd *cobra.Command, checks []entity.DriftCheck) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyDriftChecksHeading), len(checks)))
+	for _, c := range checks {
+		state := desc.Text(text.DescKeyDriftChecksEnabled)
+		if !c.Enabled {
+			state = desc.Text(text.DescKeyDriftChecksDisabled)
+		}
+		severity := ""
+		if c.Severity != "" {
+			severity = fmt.Sprintf(
+				desc.Text(text.DescKeyDriftChecksSeverity), c.Severity)
+		}
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyDriftChecksLine),
+			c.Name, c.Source, state, severity))
+	}
+}
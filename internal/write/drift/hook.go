@@ -0,0 +1,38 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// HookEnabled reports that the drift pre-commit hook was
+// installed. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func HookEnabled(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyDriftHookEnabled))
+}
+
+// HookDisabled reports that the drift pre-commit hook was
+// removed. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func HookDisabled(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyDriftHookDisabled))
+}
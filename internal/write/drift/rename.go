@@ -0,0 +1,108 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+)
+
+// FixDeadPath confirms a dead path reference was rewritten to its
+// renamed target. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - oldPath: the dead path
+//   - newPath: the path it was renamed to
+//   - file: context file that was rewritten
+func FixDeadPath(cmd *cobra.Command, oldPath, newPath, file string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyDriftFixDeadPath), oldPath, newPath, file))
+}
+
+// SkipDeadPathAmbiguous prints a skip message for a dead path with
+// several rename targets and no choice made. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - file: file containing the dead path
+//   - line: line number
+//   - path: the dead path
+//   - candidates: existing paths it was renamed to
+func SkipDeadPathAmbiguous(
+	cmd *cobra.Command, file string, line int, path string,
+	candidates []string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyDriftSkipDeadPathAmbiguous),
+		file, line, path,
+		strings.Join(candidates, token.CommaSpace)))
+}
+
+// SkipDryRun prints that a fixable file was left alone because
+// --dry-run is set. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - file: file that would have been fixed
+func SkipDryRun(cmd *cobra.Command, file string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(desc.Text(text.DescKeyDriftSkipDryRun), file))
+}
+
+// RenameChoice prints the numbered rename candidates for a dead
+// path and the input prompt. Nil cmd is a no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - file: file containing the dead path
+//   - line: line number
+//   - path: the dead path
+//   - candidates: existing paths it was renamed to
+func RenameChoice(
+	cmd *cobra.Command, file string, line int, path string,
+	candidates []string,
+) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyDriftRenamePrompt), path, file, line))
+	for i, c := range candidates {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyDriftRenameOption), i+1, c))
+	}
+	cmd.Print(fmt.Sprintf(
+		desc.Text(text.DescKeyDriftRenameChoose), len(candidates)))
+}
+
+// Diff prints a unified diff of a previewed fix. Nil cmd is a
+// no-op.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - body: Diff text from format.Unified
+func Diff(cmd *cobra.Command, body string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(body)
+}
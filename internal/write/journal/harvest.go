@@ -0,0 +1,130 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// HarvestCandidate prints one candidate for review: its
+// position, type, source turn, why it was proposed, and title.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - i: 1-based position of the candidate
+//   - n: Number of candidates
+//   - c: Candidate to print
+func HarvestCandidate(
+	cmd *cobra.Command, i, n int, c entity.HarvestCandidate,
+) {
+	if cmd == nil {
+		return
+	}
+	why := desc.Text(text.DescKeyWriteJournalHarvestMarked)
+	if !c.Explicit {
+		why = fmt.Sprintf(
+			desc.Text(text.DescKeyWriteJournalHarvestKeywords),
+			strings.Join(c.Keywords, token.CommaSpace),
+		)
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalHarvestCandidate),
+		i, n, c.Params.Type, c.Turn, c.Role, why, c.Params.Content,
+	))
+}
+
+// HarvestPrompt prints the accept, edit, reject prompt without
+// a trailing newline.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+func HarvestPrompt(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(desc.Text(text.DescKeyWriteJournalHarvestPrompt))
+}
+
+// HarvestEdit prints the replacement title prompt without a
+// trailing newline.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+func HarvestEdit(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(desc.Text(text.DescKeyWriteJournalHarvestEdit))
+}
+
+// HarvestAdded confirms that a candidate was written.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - file: Context file the entry was added to
+func HarvestAdded(cmd *cobra.Command, file string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalHarvestAdded), file,
+	))
+}
+
+// HarvestSummary prints the closing counts of a review.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - added: Candidates written
+//   - rejected: Candidates rejected or skipped by quitting
+//   - known: Candidates already recorded before the review
+func HarvestSummary(cmd *cobra.Command, added, rejected, known int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalHarvestSummary),
+		added, rejected, known,
+	))
+}
+
+// HarvestDryRun prints the closing line of a dry run.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - n: Number of candidates listed
+func HarvestDryRun(cmd *cobra.Command, n int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalHarvestDryRun), n,
+	))
+}
+
+// HarvestNone reports a session without new candidates.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - session: Session slug or ID
+//   - known: Candidates already recorded
+func HarvestNone(cmd *cobra.Command, session string, known int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalHarvestNone), session, known,
+	))
+}
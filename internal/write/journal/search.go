@@ -0,0 +1,97 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+)
+
+// SearchHits prints ranked search results: a header with date,
+// title, and tool, the file and turn, then the snippet with the
+// matching lines marked.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - hits: Ranked hits, best first
+func SearchHits(cmd *cobra.Command, hits []entity.JournalHit) {
+	if cmd == nil {
+		return
+	}
+	if len(hits) == 0 {
+		cmd.Println(desc.Text(text.DescKeyWriteJournalSearchNone))
+		return
+	}
+	for i, h := range hits {
+		if i > 0 {
+			cmd.Println()
+		}
+		tool := ""
+		if h.Tool != "" {
+			tool = fmt.Sprintf(
+				desc.Text(text.DescKeyWriteJournalSearchTool), h.Tool,
+			)
+		}
+		when := strings.TrimSpace(h.Date + token.Space + h.Time)
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteJournalSearchHit), when, h.Title, tool,
+		))
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteJournalSearchWhere),
+			h.Filename, h.Turn, h.Score,
+		))
+		for _, l := range h.Lines {
+			key := text.DescKeyWriteJournalSearchLine
+			if l.Match {
+				key = text.DescKeyWriteJournalSearchMatch
+			}
+			cmd.Println(fmt.Sprintf(desc.Text(key), l.Num, l.Text))
+		}
+	}
+}
+
+// SearchJSON prints search results as indented JSON.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - hits: Ranked hits, best first
+//
+// Returns:
+//   - error: Non-nil if encoding fails
+func SearchJSON(cmd *cobra.Command, hits []entity.JournalHit) error {
+	if hits == nil {
+		hits = []entity.JournalHit{}
+	}
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", token.Indent2)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(hits)
+}
+
+// SearchIndex prints the search index refresh summary after a
+// sync. Nothing is printed when the index was already current.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - updated: Files (re)indexed
+//   - removed: Files dropped from the index
+func SearchIndex(cmd *cobra.Command, updated, removed int) {
+	if cmd == nil || (updated == 0 && removed == 0) {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalSearchIndex), updated, removed,
+	))
+}
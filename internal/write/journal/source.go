@@ -15,6 +15,7 @@ import (
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
 	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	writeIo "github.com/ActiveMemory/ctx/internal/write/line"
 )
 
@@ -55,6 +56,44 @@ func ImportedFile(cmd *cobra.Command, filename, suffix string) {
 	}
 }
 
+// SecretsRedacted warns that possible secrets were redacted from an
+// imported session before it was written.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - filename: the imported file name.
+//   - count: number of redacted values.
+func SecretsRedacted(cmd *cobra.Command, filename string, count int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalSourceRedacted),
+		filename, count))
+}
+
+// Redactions reports the placeholders the redaction pipeline
+// wrote into an imported session.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - filename: the imported file name.
+//   - redactions: placeholders with their occurrence counts.
+func Redactions(
+	cmd *cobra.Command, filename string, redactions []entity.Redaction,
+) {
+	if cmd == nil || len(redactions) == 0 {
+		return
+	}
+	values := 0
+	for _, r := range redactions {
+		values += r.Count
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteJournalSourceRedactions),
+		filename, values, len(redactions)))
+}
+
 // ImportSummary prints what an import will (or would) do based on
 // aggregate counters.
 //
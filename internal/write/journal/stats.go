@@ -0,0 +1,132 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package journal
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgStats "github.com/ActiveMemory/ctx/internal/config/stats"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/journal/stats"
+)
+
+// StatsTable prints the stats report as an aligned table with
+// a totals row, the most-used tools, and any unpriced models.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - report: Stats report to print
+func StatsTable(cmd *cobra.Command, report entity.JournalStats) {
+	if cmd == nil {
+		return
+	}
+	rows := [][]string{stats.Headers(report.By)}
+	for _, g := range report.Groups {
+		rows = append(rows, stats.Cells(g))
+	}
+	rows = append(rows, stats.Cells(report.Total))
+
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			layout := tpl.JournalStatsCellRight
+			if i == 0 {
+				layout = tpl.JournalStatsCellLeft
+			}
+			cells[i] = fmt.Sprintf(layout, widths[i], cell)
+		}
+		cmd.Println(strings.TrimRight(
+			strings.Join(cells, tpl.JournalStatsCellSep), token.Space,
+		))
+	}
+
+	if len(report.Tools) > 0 {
+		cmd.Println(desc.Text(text.DescKeyWriteJournalStatsTools))
+		for _, t := range report.Tools {
+			cmd.Println(fmt.Sprintf(
+				desc.Text(text.DescKeyWriteJournalStatsTool), t.Name, t.Count,
+			))
+		}
+	}
+	if len(report.Unpriced) > 0 {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteJournalStatsUnpriced),
+			strings.Join(report.Unpriced, token.CommaSpace),
+		))
+	}
+}
+
+// StatsJSON prints the stats report as indented JSON.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - report: Stats report to print
+//
+// Returns:
+//   - error: Non-nil if encoding fails
+func StatsJSON(cmd *cobra.Command, report entity.JournalStats) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", token.Indent2)
+	return enc.Encode(report)
+}
+
+// StatsCSV prints one CSV row per group with raw numbers,
+// headed by the grouping dimension. Totals and tools are left
+// out so spreadsheet sums stay correct.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - report: Stats report to print
+//
+// Returns:
+//   - error: Non-nil if writing fails
+func StatsCSV(cmd *cobra.Command, report entity.JournalStats) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+	header := append([]string{report.By}, cfgStats.CSVHeader...)
+	if writeErr := w.Write(header); writeErr != nil {
+		return writeErr
+	}
+	num := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	for _, g := range report.Groups {
+		if writeErr := w.Write([]string{
+			g.Key,
+			strconv.Itoa(g.Sessions),
+			strconv.Itoa(g.Turns),
+			strconv.Itoa(g.TokensIn),
+			strconv.Itoa(g.TokensOut),
+			num(g.Cost),
+			strconv.Itoa(g.Unpriced),
+			strconv.Itoa(g.Errors),
+			num(g.ErrorRate),
+			num(g.AvgTurns),
+			num(g.AvgMinutes),
+		}); writeErr != nil {
+			return writeErr
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
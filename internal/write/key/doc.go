@@ -0,0 +1,13 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package key provides terminal output for the key
+// management commands (ctx key rotate).
+//
+// [Reencrypted] lists each file moved to the new key,
+// [Rotated] confirms the swap and where the grace key
+// lives, and [RolledBack] confirms an abandoned rotation.
+package key
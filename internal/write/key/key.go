@@ -0,0 +1,60 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Reencrypted prints one file covered by a rotation.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - path: Re-encrypted file path
+func Reencrypted(cmd *cobra.Command, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteKeyReencrypted), path,
+	))
+}
+
+// Rotated confirms a completed rotation.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - keyPath: Active key path
+//   - gracePath: Where the previous key is kept
+//   - days: Grace period in days
+func Rotated(cmd *cobra.Command, keyPath, gracePath string, days int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteKeyRotated), keyPath, gracePath, days,
+	))
+}
+
+// RolledBack confirms an abandoned rotation.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - keyPath: Active key path
+func RolledBack(cmd *cobra.Command, keyPath string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteKeyRolledBack), keyPath,
+	))
+}
@@ -0,0 +1,84 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// ChannelPrompt prints the prompt for one channel secret.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - field: secret field name (url, token, ...).
+//   - name: channel name.
+//   - required: false marks the field as optional.
+func ChannelPrompt(cmd *cobra.Command, field, name string, required bool) {
+	if cmd == nil {
+		return
+	}
+	key := text.DescKeyWriteNotifyChannelPrompt
+	if !required {
+		key = text.DescKeyWriteNotifyChannelOptional
+	}
+	cmd.Print(fmt.Sprintf(desc.Text(key), field, name))
+}
+
+// ChannelDone prints the success block after saving channel
+// secrets.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - name: channel name.
+//   - kind: provider type.
+//   - maskedURL: masked channel URL for display.
+//   - encPath: encrypted secrets file name.
+func ChannelDone(cmd *cobra.Command, name, kind, maskedURL, encPath string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteNotifyChannelDone),
+		name, kind, maskedURL, encPath,
+	))
+}
+
+// ChannelFiltered prints the notice when a channel does not
+// subscribe to the test event.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - name: channel name.
+func ChannelFiltered(cmd *cobra.Command, name string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteNotifyChannelFiltered), name,
+	))
+}
+
+// ChannelSent prints the confirmation of a delivered channel
+// test notification.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - name: channel name.
+//   - kind: provider type.
+func ChannelSent(cmd *cobra.Command, name, kind string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteNotifyChannelSent), name, kind,
+	))
+}
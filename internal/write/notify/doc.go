@@ -5,8 +5,8 @@
 //                 SPDX-License-Identifier: Apache-2.0
 
 // Package notify provides terminal output for webhook
-// notification setup and testing (ctx hook notify setup,
-// ctx hook notify test).
+// notification setup, testing, and the delivery outbox
+// (ctx hook notify setup, test, flush, log).
 //
 // # Setup Flow
 //
@@ -28,6 +28,22 @@
 // event type is excluded by the user's event filter
 // configuration.
 //
+// # Channels
+//
+// [ChannelPrompt] asks for one secret of a named channel,
+// marking optional fields. [ChannelDone] confirms the saved
+// secrets; [ChannelSent] confirms a delivered channel test
+// and [ChannelFiltered] notes when the channel does not
+// subscribe to test events.
+//
+// # Outbox
+//
+// [FlushResult] prints the counts from a forced outbox
+// flush, or a notice when nothing was queued. [LogLines]
+// prints formatted delivery log lines, [LogEmpty] the
+// notice for an empty log, and [LogOutbox] the pending and
+// dead-lettered counts under it.
+//
 // # Message Categories
 //
 //   - Info: setup confirmation, test results
@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	writeIO "github.com/ActiveMemory/ctx/internal/write/line"
+)
+
+// FlushResult prints the outcome of an outbox flush, or a
+// short notice when nothing was queued.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - r: flush counts.
+func FlushResult(cmd *cobra.Command, r entity.FlushResult) {
+	if cmd == nil {
+		return
+	}
+	if r == (entity.FlushResult{}) {
+		cmd.Println(desc.Text(text.DescKeyWriteNotifyFlushEmpty))
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteNotifyFlushResult),
+		r.Sent, r.Failed, r.Dead, r.Pending,
+	))
+}
+
+// LogEmpty prints the notice for an empty delivery log.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+func LogEmpty(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteNotifyLogEmpty))
+}
+
+// LogLines prints pre-formatted delivery log lines.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - lines: formatted lines.
+func LogLines(cmd *cobra.Command, lines []string) {
+	writeIO.All(cmd, lines)
+}
+
+// LogOutbox prints the outbox summary under the delivery log.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - pending: queued deliveries.
+//   - dead: dead-lettered deliveries.
+func LogOutbox(cmd *cobra.Command, pending, dead int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteNotifyLogOutbox), pending, dead,
+	))
+}
@@ -0,0 +1,61 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pad
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// GcItem prints one expired entry found by gc.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: stable entry ID.
+//   - display: entry text as shown in list output.
+//   - expires: the entry's expiry date.
+func GcItem(cmd *cobra.Command, id int, display, expires string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadGcItem), id, display, expires,
+	))
+}
+
+// GcSummary prints the gc summary or "no expired entries".
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - count: number of expired entries.
+//   - mark: whether entries were tagged instead of removed.
+//   - dryRun: whether this was a dry run.
+func GcSummary(cmd *cobra.Command, count int, mark, dryRun bool) {
+	if cmd == nil {
+		return
+	}
+	if count == 0 {
+		cmd.Println(desc.Text(text.DescKeyWritePadGcNone))
+		return
+	}
+	key := text.DescKeyWritePadGcVerbRemove
+	switch {
+	case mark && dryRun:
+		key = text.DescKeyWritePadGcVerbMarkDryRun
+	case mark:
+		key = text.DescKeyWritePadGcVerbMark
+	case dryRun:
+		key = text.DescKeyWritePadGcVerbRemoveDryRun
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadGcSummary), desc.Text(key), count,
+	))
+}
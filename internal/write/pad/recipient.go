@@ -0,0 +1,114 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package pad
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// IdentityCreated prints an identity creation notice to stderr.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - path: identity file path.
+func IdentityCreated(cmd *cobra.Command, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.PrintErrln(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadIdentityCreated), path,
+	))
+}
+
+// RecipientAdded confirms a new recipient and the resulting
+// recipient count.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - label: recipient name or public key.
+//   - n: number of recipients after the change.
+func RecipientAdded(cmd *cobra.Command, label string, n int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadRecipientAdded), label, n,
+	))
+}
+
+// RecipientRemoved confirms a removed recipient. When no
+// recipients remain it reports the return to the single key.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - label: recipient name or public key.
+//   - n: number of recipients after the change.
+func RecipientRemoved(cmd *cobra.Command, label string, n int) {
+	if cmd == nil {
+		return
+	}
+	if n == 0 {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWritePadRecipientSingleKey), label,
+		))
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadRecipientRemoved), label, n,
+	))
+}
+
+// RecipientRow prints one recipient line.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - key: recipient public key.
+//   - name: optional recipient name.
+//   - self: whether the key belongs to the current user.
+func RecipientRow(cmd *cobra.Command, key, name string, self bool) {
+	if cmd == nil {
+		return
+	}
+	marker := ""
+	if self {
+		marker = desc.Text(text.DescKeyWritePadRecipientYou)
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadRecipientRow), key, name, marker,
+	))
+}
+
+// RecipientNone prints the message shown when the scratchpad has
+// no recipients.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+func RecipientNone(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWritePadRecipientNone))
+}
+
+// RecipientSelf prints the current user's public key.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - key: the public key.
+func RecipientSelf(cmd *cobra.Command, key string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadRecipientSelf), key,
+	))
+}
@@ -32,3 +32,33 @@ func ResolveSide(cmd *cobra.Command, side string, entries []string) {
 		)
 	}
 }
+
+// MergeDriverConflict reports an entry that the git merge
+// driver kept in both versions. Written to stderr so git shows
+// it during the merge.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: stable ID of the entry changed on both sides.
+func MergeDriverConflict(cmd *cobra.Command, id int) {
+	if cmd == nil {
+		return
+	}
+	cmd.PrintErrln(fmt.Sprintf(
+		desc.Text(text.DescKeyWritePadMergeDriverConflict), id,
+	))
+}
+
+// Textconv prints the decrypted entry lines git diff compares.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - lines: one display line per entry.
+func Textconv(cmd *cobra.Command, lines []string) {
+	if cmd == nil {
+		return
+	}
+	for _, line := range lines {
+		cmd.Println(line)
+	}
+}
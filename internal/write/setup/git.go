@@ -0,0 +1,71 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// InfoGitIntegration prints git scratchpad integration
+// instructions.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func InfoGitIntegration(cmd *cobra.Command) {
+	cmd.Println(desc.Text(text.DescKeyWriteSetupGitHead))
+	cmd.Println(desc.Text(text.DescKeyWriteSetupGitRun))
+	cmd.Println(desc.Text(text.DescKeyWriteSetupGitAttr))
+	cmd.Println(desc.Text(text.DescKeyWriteSetupGitConfig))
+}
+
+// GitAttrAdded prints that an attributes line was added.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - line: The line added to .gitattributes
+func GitAttrAdded(cmd *cobra.Command, line string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupGitAttrAdded), line))
+}
+
+// GitAttrExists prints that .gitattributes already routes
+// the pad to the drivers.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - path: Pad path relative to the repository root
+func GitAttrExists(cmd *cobra.Command, path string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupGitAttrExists), path))
+}
+
+// GitConfigSet prints that a git config key was written.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - key: The git config key
+func GitConfigSet(cmd *cobra.Command, key string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupGitConfigSet), key))
+}
+
+// GitComplete prints the completion message for the git
+// integration.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - tool: Display name of the integration
+func GitComplete(cmd *cobra.Command, tool string) {
+	cmd.Println()
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSetupDeployComplete), tool))
+}
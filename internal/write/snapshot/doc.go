@@ -0,0 +1,33 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot provides terminal output for the context
+// snapshot commands (ctx snapshot create, list, show, diff,
+// restore).
+//
+// # Capture
+//
+// [Created] reports the new snapshot ID with its file
+// count and how many objects were new to the store.
+//
+// # Browsing
+//
+// [Item] renders one line of the snapshot list and [None]
+// handles the empty store. [Header] and [File] render a
+// snapshot's manifest; [Content] prints a captured file
+// verbatim.
+//
+// # Comparing
+//
+// [Change] prints one status-marked path, [Diff] a
+// unified diff body, and [Identical] the no-difference
+// case.
+//
+// # Restoring
+//
+// [Safety] announces the snapshot taken before a restore
+// and [Restored] confirms how many files were rewritten.
+package snapshot
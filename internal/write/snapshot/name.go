@@ -0,0 +1,28 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// nameSuffix formats an optional snapshot name.
+//
+// Parameters:
+//   - name: snapshot name (empty for none).
+//
+// Returns:
+//   - string: "  (name)" or empty.
+func nameSuffix(name string) string {
+	if name == "" {
+		return ""
+	}
+	return fmt.Sprintf(desc.Text(text.DescKeyWriteSnapshotNameSuffix), name)
+}
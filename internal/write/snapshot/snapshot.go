@@ -0,0 +1,173 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+)
+
+// Created prints the confirmation for a new snapshot.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: snapshot ID.
+//   - files: number of files captured.
+//   - objects: number of objects new to the store.
+func Created(cmd *cobra.Command, id string, files, objects int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotCreated), id, files, objects,
+	))
+}
+
+// Item prints one snapshot in the list.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: snapshot ID.
+//   - created: formatted creation time.
+//   - files: number of files captured.
+//   - name: optional snapshot name (empty for none).
+func Item(cmd *cobra.Command, id, created string, files int, name string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotItem),
+		id, created, files, nameSuffix(name),
+	))
+}
+
+// None prints the message when the store is empty.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+func None(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteSnapshotNone))
+}
+
+// Header prints the heading of a snapshot manifest.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: snapshot ID.
+//   - created: formatted creation time.
+//   - name: optional snapshot name (empty for none).
+func Header(cmd *cobra.Command, id, created, name string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotHeader),
+		id, created, nameSuffix(name),
+	))
+}
+
+// File prints one file of a snapshot manifest.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - size: formatted file size.
+//   - path: path relative to .context/.
+func File(cmd *cobra.Command, size, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotFile), size, path,
+	))
+}
+
+// Content prints a captured file verbatim.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - content: file content.
+func Content(cmd *cobra.Command, content []byte) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(string(content))
+}
+
+// Change prints one changed path with its status marker.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - status: A, D, or M.
+//   - path: path relative to .context/.
+func Change(cmd *cobra.Command, status, path string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotChange), status, path,
+	))
+}
+
+// Diff prints a unified diff body.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - body: diff text, ending in a newline.
+func Diff(cmd *cobra.Command, body string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Print(body)
+}
+
+// Identical prints the message when two trees match.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+func Identical(cmd *cobra.Command) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(desc.Text(text.DescKeyWriteSnapshotIdentical))
+}
+
+// Safety prints the ID of the snapshot taken before a
+// restore.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - id: safety snapshot ID.
+func Safety(cmd *cobra.Command, id string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotSafety), id, id,
+	))
+}
+
+// Restored prints the restore summary.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - count: number of files rewritten.
+//   - id: restored snapshot ID.
+func Restored(cmd *cobra.Command, count int, id string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteSnapshotRestored), count, id,
+	))
+}
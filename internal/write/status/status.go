@@ -13,6 +13,7 @@ import (
 
 	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
 	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	"github.com/ActiveMemory/ctx/internal/entity"
 	"github.com/ActiveMemory/ctx/internal/format"
 )
 
@@ -74,3 +75,50 @@ func Activity(cmd *cobra.Command, entries []ActivityInfo) {
 			e.Name, e.Ago))
 	}
 }
+
+// Health prints the health score and its components.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - score: Overall score, 0-100.
+//   - c: Component scores.
+func Health(cmd *cobra.Command, score int, c entity.HealthComponents) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteStatusHealth),
+		score, c.Drift, c.Staleness, c.Velocity, c.Index, c.Tokens,
+	))
+}
+
+// TrendHeader prints the health trend heading.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - weeks: Number of weeks shown.
+//   - start: First day of the oldest week (YYYY-MM-DD).
+func TrendHeader(cmd *cobra.Command, weeks int, start string) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteStatusTrendHeader), weeks, start,
+	))
+}
+
+// TrendRow prints one sparkline row of the health trend.
+//
+// Parameters:
+//   - cmd: Cobra command for output. Nil is a no-op.
+//   - label: Row label (e.g. "score", "drift").
+//   - spark: Rendered sparkline, one character per week.
+//   - current: Value from the current run.
+func TrendRow(cmd *cobra.Command, label, spark string, current int) {
+	if cmd == nil {
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteStatusTrendRow), label, spark, current,
+	))
+}
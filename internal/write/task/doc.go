@@ -0,0 +1,32 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+// Package task provides terminal output for the
+// ctx task graph command.
+//
+// # Text View
+//
+// [GraphText] lists the pending tasks that carry
+// dependency metadata under "Ready" and "Blocked",
+// each with the pending tasks it still waits for,
+// then prints the critical path: the longest chain
+// of pending tasks that must finish one after the
+// other.
+//
+// # Diagrams
+//
+// [GraphDOT] and [GraphMermaid] render the same
+// tasks for Graphviz and Mermaid. Edges point from a
+// task to the tasks waiting for it; completed tasks
+// are drawn dashed. Templates live in
+// [internal/assets/tpl].
+//
+// # Problems
+//
+// [GraphProblem] prints one duplicate ID, unknown
+// reference or cycle to stderr, so diagrams piped to
+// a file stay valid.
+package task
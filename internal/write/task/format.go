@@ -0,0 +1,129 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/io"
+	"github.com/ActiveMemory/ctx/internal/task/graph"
+)
+
+// section prints a heading and its items; nothing when empty.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - heading: text key of the heading
+//   - items: formatted lines
+func section(cmd *cobra.Command, heading string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	cmd.Println(desc.Text(heading))
+	for _, it := range items {
+		cmd.Println(fmt.Sprintf(desc.Text(text.DescKeyWriteTaskGraphItem), it))
+	}
+}
+
+// item formats one task for the text view, with the pending tasks
+// it waits for.
+//
+// Parameters:
+//   - g: task dependency graph
+//   - i: node index
+//
+// Returns:
+//   - string: "[id] label (after a, b)"
+func item(g entity.TaskGraph, i int) string {
+	n := g.Nodes[i]
+	line := n.Label
+	if n.ID != "" {
+		line = fmt.Sprintf(desc.Text(text.DescKeyWriteTaskGraphItemID),
+			n.ID, n.Label)
+	}
+	var waiting []string
+	for _, dep := range n.Deps {
+		if !g.Nodes[dep].Done {
+			waiting = append(waiting, graph.Name(g.Nodes[dep]))
+		}
+	}
+	if len(waiting) > 0 {
+		line += fmt.Sprintf(desc.Text(text.DescKeyWriteTaskGraphAfter),
+			strings.Join(waiting, token.CommaSpace))
+	}
+	return line
+}
+
+// diagram renders the linked tasks with one set of DOT or
+// Mermaid templates.
+//
+// Parameters:
+//   - g: task dependency graph
+//   - header, node, nodeDone, edge, footer: format templates
+//   - label: node label builder, escaping for the target syntax
+//
+// Returns:
+//   - string: the complete diagram source
+func diagram(
+	g entity.TaskGraph,
+	header, node, nodeDone, edge, footer string,
+	label func(entity.TaskNode) string,
+) string {
+	var sb strings.Builder
+	sb.WriteString(header)
+	for _, n := range g.Nodes {
+		if !graph.Linked(n) {
+			continue
+		}
+		format := node
+		if n.Done {
+			format = nodeDone
+		}
+		io.SafeFprintf(&sb, format, nodeKey(n), label(n))
+	}
+	for _, n := range g.Nodes {
+		for _, dep := range n.Deps {
+			io.SafeFprintf(&sb, edge, nodeKey(g.Nodes[dep]), nodeKey(n))
+		}
+	}
+	sb.WriteString(footer)
+	return sb.String()
+}
+
+// nodeKey names a node by its one-based TASKS.md line.
+//
+// Parameters:
+//   - n: task node
+//
+// Returns:
+//   - string: diagram node key (e.g. "t12")
+func nodeKey(n entity.TaskNode) string {
+	return fmt.Sprintf(cfgTask.NodeKeyFormat, n.Line+1)
+}
+
+// nodeLabel builds the diagram label of a task.
+//
+// Parameters:
+//   - n: task node
+//
+// Returns:
+//   - string: "id: label", or the label alone without an ID
+func nodeLabel(n entity.TaskNode) string {
+	if n.ID == "" {
+		return n.Label
+	}
+	return fmt.Sprintf(tpl.TaskGraphLabel, n.ID, n.Label)
+}
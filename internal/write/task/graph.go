@@ -0,0 +1,103 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/assets/tpl"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	cfgTask "github.com/ActiveMemory/ctx/internal/config/task"
+	"github.com/ActiveMemory/ctx/internal/config/token"
+	"github.com/ActiveMemory/ctx/internal/entity"
+	"github.com/ActiveMemory/ctx/internal/task/graph"
+)
+
+// GraphText prints the pending linked tasks split into ready and
+// blocked, followed by the critical path unless a cycle makes it
+// meaningless.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - g: task dependency graph
+func GraphText(cmd *cobra.Command, g entity.TaskGraph) {
+	var ready, blocked []string
+	for i, n := range g.Nodes {
+		if n.Done || !graph.Linked(n) {
+			continue
+		}
+		if graph.Blocked(g, i) {
+			blocked = append(blocked, item(g, i))
+		} else {
+			ready = append(ready, item(g, i))
+		}
+	}
+	if len(ready)+len(blocked) == 0 {
+		cmd.Println(desc.Text(text.DescKeyWriteTaskGraphEmpty))
+		return
+	}
+	section(cmd, text.DescKeyWriteTaskGraphReady, ready)
+	section(cmd, text.DescKeyWriteTaskGraphBlocked, blocked)
+	if len(graph.Cycles(g)) > 0 {
+		return
+	}
+	if path := graph.CriticalPath(g); len(path) > 1 {
+		cmd.Println()
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteTaskGraphCritical),
+			len(path), graph.Path(g, path),
+		))
+	}
+}
+
+// GraphDOT prints the linked tasks as a Graphviz digraph, edges
+// pointing from a task to the tasks waiting for it.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - g: task dependency graph
+func GraphDOT(cmd *cobra.Command, g entity.TaskGraph) {
+	cmd.Print(diagram(g,
+		tpl.TaskGraphDOTHeader, tpl.TaskGraphDOTNode,
+		tpl.TaskGraphDOTNodeDone, tpl.TaskGraphDOTEdge,
+		tpl.TaskGraphDOTFooter, nodeLabel,
+	))
+}
+
+// GraphMermaid prints the linked tasks as a Mermaid flowchart,
+// edges pointing from a task to the tasks waiting for it.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - g: task dependency graph
+func GraphMermaid(cmd *cobra.Command, g entity.TaskGraph) {
+	cmd.Print(diagram(g,
+		tpl.TaskGraphMermaidHeader, tpl.TaskGraphMermaidNode,
+		tpl.TaskGraphMermaidNodeDone, tpl.TaskGraphMermaidEdge,
+		tpl.TaskGraphMermaidFooter,
+		func(n entity.TaskNode) string {
+			return strings.ReplaceAll(
+				nodeLabel(n), token.DoubleQuote, cfgTask.MermaidQuote,
+			)
+		},
+	))
+}
+
+// GraphProblem reports one dependency metadata problem on stderr.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - problem: duplicate ID, unknown reference or cycle
+func GraphProblem(cmd *cobra.Command, problem error) {
+	cmd.PrintErrln(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTaskGraphProblem), problem,
+	))
+}
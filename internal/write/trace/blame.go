@@ -0,0 +1,73 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	internalTrace "github.com/ActiveMemory/ctx/internal/trace"
+)
+
+// BlameHeader prints the commit line above a block of blamed
+// lines.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - shortHash: abbreviated commit hash
+//   - date: author date
+//   - summary: commit subject line
+func BlameHeader(cmd *cobra.Command, shortHash, date, summary string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceBlameHeader),
+		shortHash, date, summary,
+	))
+}
+
+// BlameUncommitted prints the header above lines that are not
+// committed yet.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func BlameUncommitted(cmd *cobra.Command) {
+	cmd.Println(desc.Text(text.DescKeyWriteTraceBlameUncommitted))
+}
+
+// BlameRef prints a context ref under a blame header, showing
+// its title when it resolves.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - rr: resolved reference to display
+func BlameRef(cmd *cobra.Command, rr internalTrace.ResolvedRef) {
+	if title, _ := display(rr); rr.Found && title != "" {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteTraceBlameRef),
+			typeLabel(rr.Type), rr.Raw, title,
+		))
+		return
+	}
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceBlameRefRaw),
+		typeLabel(rr.Type), rr.Raw,
+	))
+}
+
+// BlameLine prints one line of the blamed file.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - line: line number in the file
+//   - content: line content
+func BlameLine(cmd *cobra.Command, line int, content string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceBlameLine), line, content,
+	))
+}
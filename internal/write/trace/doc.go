@@ -6,7 +6,8 @@
 
 // Package trace provides terminal output for the
 // context trace commands (ctx trace, ctx trace tag,
-// ctx trace enable/disable).
+// ctx trace enable/disable, ctx trace notes,
+// ctx trace blame, ctx trace sync).
 //
 // The trace system attaches context references to
 // git commits and renders commit history with
@@ -36,6 +37,13 @@
 // differently depending on whether the reference
 // was found and has metadata.
 //
+// # Blame
+//
+// [BlameHeader] and [BlameUncommitted] open a block of
+// lines last changed by one commit; [BlameRef] lists
+// the commit's refs by title and [BlameLine] prints
+// each numbered source line.
+//
 // # Tagging and Hooks
 //
 // [Tagged] confirms a commit was annotated with a
@@ -43,4 +51,16 @@
 // report trace hook installation and removal.
 // [Trailer] prints a collected context trailer
 // line when non-empty.
+//
+// # Git Notes
+//
+// [NotesPushed] and [NotesFetched] confirm notes sync
+// with a remote. [NotesMigrated] summarizes a JSONL to
+// notes migration and hints at enabling trace.notes.
+//
+// # External Titles
+//
+// [Synced] summarizes a title cache sync and lists refs
+// whose titles could not be fetched. [SyncNoResolvers]
+// reports that .ctxrc configures no resolvers.
 package trace
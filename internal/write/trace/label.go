@@ -0,0 +1,48 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"strings"
+
+	internalTrace "github.com/ActiveMemory/ctx/internal/trace"
+)
+
+// typeLabel capitalizes a ref type for display ("decision" →
+// "Decision").
+//
+// Parameters:
+//   - refType: lower-case ref type
+//
+// Returns:
+//   - string: display label
+func typeLabel(refType string) string {
+	if refType == "" {
+		return refType
+	}
+	return strings.ToUpper(refType[0:1]) + refType[1:]
+}
+
+// display picks the title and detail shown for a resolved ref.
+// External refs show their URL: in place of the title when no
+// title is cached, otherwise as the detail.
+//
+// Parameters:
+//   - rr: resolved reference
+//
+// Returns:
+//   - string: title to show (may be empty)
+//   - string: detail to show (may be empty)
+func display(rr internalTrace.ResolvedRef) (string, string) {
+	if rr.URL == "" {
+		return rr.Title, rr.Detail
+	}
+	if rr.Title == "" {
+		return rr.URL, ""
+	}
+	return rr.Title, rr.URL
+}
@@ -0,0 +1,59 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	internalTrace "github.com/ActiveMemory/ctx/internal/trace"
+)
+
+// NotesPushed confirms that refs/notes/ctx was pushed.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - remote: remote the notes were pushed to
+func NotesPushed(cmd *cobra.Command, remote string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceNotesPushed), remote,
+	))
+}
+
+// NotesFetched confirms that remote notes were fetched and
+// merged.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - remote: remote the notes came from
+func NotesFetched(cmd *cobra.Command, remote string) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceNotesFetched), remote,
+	))
+}
+
+// NotesMigrated prints the migration summary, and a hint to
+// enable trace.notes when new commits still go to JSONL.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - r: migration counts
+//   - enabled: whether trace.notes is already set
+func NotesMigrated(
+	cmd *cobra.Command, r internalTrace.MigrateResult, enabled bool,
+) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceNotesMigrated),
+		r.Migrated, r.Skipped, r.Missing,
+	))
+	if !enabled {
+		cmd.Println(desc.Text(text.DescKeyWriteTraceNotesHint))
+	}
+}
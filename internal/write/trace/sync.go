@@ -0,0 +1,44 @@
+//   /    ctx:                         https://ctx.ist
+// ,'`./    do you remember?
+// `.,'\
+//   \    Copyright 2026-present Context contributors.
+//                 SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ActiveMemory/ctx/internal/assets/read/desc"
+	"github.com/ActiveMemory/ctx/internal/config/embed/text"
+	internalTrace "github.com/ActiveMemory/ctx/internal/trace"
+)
+
+// SyncNoResolvers reports that no external ref resolvers are
+// configured.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+func SyncNoResolvers(cmd *cobra.Command) {
+	cmd.Println(desc.Text(text.DescKeyWriteTraceSyncNoResolvers))
+}
+
+// Synced prints the title sync summary followed by one line per
+// failed ref.
+//
+// Parameters:
+//   - cmd: Cobra command for output
+//   - r: sync outcome
+func Synced(cmd *cobra.Command, r internalTrace.SyncResult) {
+	cmd.Println(fmt.Sprintf(
+		desc.Text(text.DescKeyWriteTraceSynced),
+		r.Fetched, r.Cached, len(r.Failures),
+	))
+	for _, f := range r.Failures {
+		cmd.Println(fmt.Sprintf(
+			desc.Text(text.DescKeyWriteTraceSyncFailed), f.Ref, f.Err,
+		))
+	}
+}
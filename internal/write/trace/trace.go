@@ -8,7 +8,6 @@ package trace
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -112,23 +111,24 @@ func LastEntry(cmd *cobra.Command, shortHash, message, refSummary string) {
 //   - cmd: Cobra command for output
 //   - rr: resolved reference to display
 func Resolved(cmd *cobra.Command, rr internalTrace.ResolvedRef) {
-	typeLabel := strings.ToUpper(rr.Type[0:1]) + rr.Type[1:]
-	if rr.Found && rr.Title != "" {
-		if rr.Detail != "" {
+	label := typeLabel(rr.Type)
+	title, detail := display(rr)
+	if rr.Found && title != "" {
+		if detail != "" {
 			cmd.Println(fmt.Sprintf(
 				desc.Text(text.DescKeyWriteTraceResolvedFull),
-				typeLabel, rr.Raw, rr.Title, rr.Detail,
+				label, rr.Raw, title, detail,
 			))
 		} else {
 			cmd.Println(fmt.Sprintf(
 				desc.Text(text.DescKeyWriteTraceResolvedTitle),
-				typeLabel, rr.Raw, rr.Title,
+				label, rr.Raw, title,
 			))
 		}
 	} else {
 		cmd.Println(fmt.Sprintf(
 			desc.Text(text.DescKeyWriteTraceResolvedRaw),
-			typeLabel, rr.Raw,
+			label, rr.Raw,
 		))
 	}
 }